@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/mashingan/smapping"
 	"gopkg.in/Knetic/govaluate.v3"
 )
 
@@ -20,44 +23,64 @@ type ForkVersion struct {
 
 // https://github.com/ethereum/consensus-specs/blob/dev/configs/mainnet.yaml
 type ChainSpec struct {
-	PresetBase                         string            `yaml:"PRESET_BASE"`
-	ConfigName                         string            `yaml:"CONFIG_NAME" check-if:"false"`
-	MinGenesisTime                     time.Time         `yaml:"MIN_GENESIS_TIME"`
-	GenesisForkVersion                 phase0.Version    `yaml:"GENESIS_FORK_VERSION"`
-	AltairForkVersion                  phase0.Version    `yaml:"ALTAIR_FORK_VERSION"`
-	AltairForkEpoch                    *uint64           `yaml:"ALTAIR_FORK_EPOCH"`
-	BellatrixForkVersion               phase0.Version    `yaml:"BELLATRIX_FORK_VERSION"`
-	BellatrixForkEpoch                 *uint64           `yaml:"BELLATRIX_FORK_EPOCH"`
-	CapellaForkVersion                 phase0.Version    `yaml:"CAPELLA_FORK_VERSION"`
-	CapellaForkEpoch                   *uint64           `yaml:"CAPELLA_FORK_EPOCH"`
-	DenebForkVersion                   phase0.Version    `yaml:"DENEB_FORK_VERSION"`
-	DenebForkEpoch                     *uint64           `yaml:"DENEB_FORK_EPOCH"`
-	ElectraForkVersion                 phase0.Version    `yaml:"ELECTRA_FORK_VERSION" check-if-fork:"ElectraForkEpoch"`
-	ElectraForkEpoch                   *uint64           `yaml:"ELECTRA_FORK_EPOCH"`
-	Eip7594ForkVersion                 phase0.Version    `yaml:"EIP7594_FORK_VERSION" check-if-fork:"Eip7594ForkEpoch"`
-	Eip7594ForkEpoch                   *uint64           `yaml:"EIP7594_FORK_EPOCH"`
-	SecondsPerSlot                     time.Duration     `yaml:"SECONDS_PER_SLOT"`
-	SlotsPerEpoch                      uint64            `yaml:"SLOTS_PER_EPOCH"`
-	EpochsPerHistoricalVector          uint64            `yaml:"EPOCHS_PER_HISTORICAL_VECTOR"`
-	EpochsPerSlashingVector            uint64            `yaml:"EPOCHS_PER_SLASHINGS_VECTOR"`
-	EpochsPerSyncCommitteePeriod       uint64            `yaml:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
-	MinSeedLookahead                   uint64            `yaml:"MIN_SEED_LOOKAHEAD"`
-	ShuffleRoundCount                  uint64            `yaml:"SHUFFLE_ROUND_COUNT"`
-	MaxEffectiveBalance                uint64            `yaml:"MAX_EFFECTIVE_BALANCE"`
-	MaxEffectiveBalanceElectra         uint64            `yaml:"MAX_EFFECTIVE_BALANCE_ELECTRA" check-if-fork:"ElectraForkEpoch"`
-	TargetCommitteeSize                uint64            `yaml:"TARGET_COMMITTEE_SIZE"`
-	MaxCommitteesPerSlot               uint64            `yaml:"MAX_COMMITTEES_PER_SLOT"`
-	MinPerEpochChurnLimit              uint64            `yaml:"MIN_PER_EPOCH_CHURN_LIMIT"`
-	ChurnLimitQuotient                 uint64            `yaml:"CHURN_LIMIT_QUOTIENT"`
-	DomainBeaconProposer               phase0.DomainType `yaml:"DOMAIN_BEACON_PROPOSER"`
-	DomainBeaconAttester               phase0.DomainType `yaml:"DOMAIN_BEACON_ATTESTER"`
-	DomainSyncCommittee                phase0.DomainType `yaml:"DOMAIN_SYNC_COMMITTEE"`
-	SyncCommitteeSize                  uint64            `yaml:"SYNC_COMMITTEE_SIZE"`
-	DepositContractAddress             []byte            `yaml:"DEPOSIT_CONTRACT_ADDRESS"`
-	MaxConsolidationRequestsPerPayload uint64            `yaml:"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD" check-if-fork:"ElectraForkEpoch"`
-	MaxWithdrawalRequestsPerPayload    uint64            `yaml:"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD"    check-if-fork:"ElectraForkEpoch"`
-	DepositChainId                     uint64            `yaml:"DEPOSIT_CHAIN_ID"`
-	MinActivationBalance               uint64            `yaml:"MIN_ACTIVATION_BALANCE"`
+	PresetBase                              string            `yaml:"PRESET_BASE"`
+	ConfigName                              string            `yaml:"CONFIG_NAME" check-if:"false"`
+	MinGenesisTime                          time.Time         `yaml:"MIN_GENESIS_TIME"`
+	GenesisForkVersion                      phase0.Version    `yaml:"GENESIS_FORK_VERSION"`
+	AltairForkVersion                       phase0.Version    `yaml:"ALTAIR_FORK_VERSION"`
+	AltairForkEpoch                         *uint64           `yaml:"ALTAIR_FORK_EPOCH"`
+	BellatrixForkVersion                    phase0.Version    `yaml:"BELLATRIX_FORK_VERSION"`
+	BellatrixForkEpoch                      *uint64           `yaml:"BELLATRIX_FORK_EPOCH"`
+	CapellaForkVersion                      phase0.Version    `yaml:"CAPELLA_FORK_VERSION"`
+	CapellaForkEpoch                        *uint64           `yaml:"CAPELLA_FORK_EPOCH"`
+	DenebForkVersion                        phase0.Version    `yaml:"DENEB_FORK_VERSION"`
+	DenebForkEpoch                          *uint64           `yaml:"DENEB_FORK_EPOCH"`
+	ElectraForkVersion                      phase0.Version    `yaml:"ELECTRA_FORK_VERSION" check-if-fork:"ElectraForkEpoch"`
+	ElectraForkEpoch                        *uint64           `yaml:"ELECTRA_FORK_EPOCH"`
+	Eip7594ForkVersion                      phase0.Version    `yaml:"EIP7594_FORK_VERSION" check-if-fork:"Eip7594ForkEpoch"`
+	Eip7594ForkEpoch                        *uint64           `yaml:"EIP7594_FORK_EPOCH"`
+	SecondsPerSlot                          time.Duration     `yaml:"SECONDS_PER_SLOT"`
+	SlotsPerEpoch                           uint64            `yaml:"SLOTS_PER_EPOCH"`
+	EpochsPerHistoricalVector               uint64            `yaml:"EPOCHS_PER_HISTORICAL_VECTOR"`
+	EpochsPerSlashingVector                 uint64            `yaml:"EPOCHS_PER_SLASHINGS_VECTOR"`
+	EpochsPerSyncCommitteePeriod            uint64            `yaml:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+	MinSeedLookahead                        uint64            `yaml:"MIN_SEED_LOOKAHEAD"`
+	MaxSeedLookahead                        uint64            `yaml:"MAX_SEED_LOOKAHEAD"`
+	ShuffleRoundCount                       uint64            `yaml:"SHUFFLE_ROUND_COUNT"`
+	MaxEffectiveBalance                     uint64            `yaml:"MAX_EFFECTIVE_BALANCE"`
+	MaxEffectiveBalanceElectra              uint64            `yaml:"MAX_EFFECTIVE_BALANCE_ELECTRA" check-if-fork:"ElectraForkEpoch"`
+	TargetCommitteeSize                     uint64            `yaml:"TARGET_COMMITTEE_SIZE"`
+	MaxCommitteesPerSlot                    uint64            `yaml:"MAX_COMMITTEES_PER_SLOT"`
+	MinPerEpochChurnLimit                   uint64            `yaml:"MIN_PER_EPOCH_CHURN_LIMIT"`
+	ChurnLimitQuotient                      uint64            `yaml:"CHURN_LIMIT_QUOTIENT"`
+	MinPerEpochChurnLimitElectra            uint64            `yaml:"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA" check-if-fork:"ElectraForkEpoch"`
+	MaxPerEpochActivationExitChurnLimit     uint64            `yaml:"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT" check-if-fork:"ElectraForkEpoch"`
+	EffectiveBalanceIncrement               uint64            `yaml:"EFFECTIVE_BALANCE_INCREMENT"`
+	MinValidatorWithdrawabilityDelay        uint64            `yaml:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
+	ShardCommitteePeriod                    uint64            `yaml:"SHARD_COMMITTEE_PERIOD"`
+	DomainBeaconProposer                    phase0.DomainType `yaml:"DOMAIN_BEACON_PROPOSER"`
+	DomainBeaconAttester                    phase0.DomainType `yaml:"DOMAIN_BEACON_ATTESTER"`
+	DomainSyncCommittee                     phase0.DomainType `yaml:"DOMAIN_SYNC_COMMITTEE"`
+	SyncCommitteeSize                       uint64            `yaml:"SYNC_COMMITTEE_SIZE"`
+	MinEpochsToInactivityPenalty            uint64            `yaml:"MIN_EPOCHS_TO_INACTIVITY_PENALTY"`
+	MinSlashingPenaltyQuotient              uint64            `yaml:"MIN_SLASHING_PENALTY_QUOTIENT"`
+	MinSlashingPenaltyQuotientAltair        uint64            `yaml:"MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR"`
+	MinSlashingPenaltyQuotientBellatrix     uint64            `yaml:"MIN_SLASHING_PENALTY_QUOTIENT_BELLATRIX"`
+	MinSlashingPenaltyQuotientElectra       uint64            `yaml:"MIN_SLASHING_PENALTY_QUOTIENT_ELECTRA" check-if-fork:"ElectraForkEpoch"`
+	ProportionalSlashingMultiplier          uint64            `yaml:"PROPORTIONAL_SLASHING_MULTIPLIER"`
+	ProportionalSlashingMultiplierAltair    uint64            `yaml:"PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR"`
+	ProportionalSlashingMultiplierBellatrix uint64            `yaml:"PROPORTIONAL_SLASHING_MULTIPLIER_BELLATRIX"`
+	WhistleblowerRewardQuotient             uint64            `yaml:"WHISTLEBLOWER_REWARD_QUOTIENT"`
+	WhistleblowerRewardQuotientElectra      uint64            `yaml:"WHISTLEBLOWER_REWARD_QUOTIENT_ELECTRA" check-if-fork:"ElectraForkEpoch"`
+	DepositContractAddress                  []byte            `yaml:"DEPOSIT_CONTRACT_ADDRESS"`
+	Eth1FollowDistance                      uint64            `yaml:"ETH1_FOLLOW_DISTANCE"`
+	SecondsPerEth1Block                     time.Duration     `yaml:"SECONDS_PER_ETH1_BLOCK"`
+	EpochsPerEth1VotingPeriod               uint64            `yaml:"EPOCHS_PER_ETH1_VOTING_PERIOD"`
+	MaxDeposits                             uint64            `yaml:"MAX_DEPOSITS"`
+	MaxConsolidationRequestsPerPayload      uint64            `yaml:"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD" check-if-fork:"ElectraForkEpoch"`
+	MaxWithdrawalRequestsPerPayload         uint64            `yaml:"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD"    check-if-fork:"ElectraForkEpoch"`
+	DepositChainId                          uint64            `yaml:"DEPOSIT_CHAIN_ID"`
+	MinActivationBalance                    uint64            `yaml:"MIN_ACTIVATION_BALANCE"`
 
 	// EIP7594: PeerDAS
 	NumberOfColumns              *uint64 `yaml:"NUMBER_OF_COLUMNS"                check-if-fork:"Eip7594ForkEpoch"`
@@ -72,6 +95,142 @@ var byteType = reflect.TypeOf(byte(0))
 var specExpressionCache = map[string]*govaluate.EvaluableExpression{}
 var specExpressionCacheMutex sync.Mutex
 
+// ParseChainSpec parses the spec values returned by the beacon node config/spec endpoint.
+func ParseChainSpec(specValues map[string]interface{}) (*ChainSpec, error) {
+	specs := &ChainSpec{}
+	err := smapping.FillStructByTags(specs, specValues, "yaml")
+	if err != nil {
+		return nil, err
+	}
+	return specs, nil
+}
+
+// ForkEpochOverride describes a fork epoch that was overridden by the explorer configuration (shadow forks).
+type ForkEpochOverride struct {
+	Fork      string
+	Epoch     uint64
+	SpecEpoch *uint64 // fork epoch reported by the client specs, nil if the fork is unknown to the client
+}
+
+// getForkEpochField returns the fork epoch field for the given fork name.
+func (chain *ChainSpec) getForkEpochField(fork string) **uint64 {
+	switch strings.ToLower(fork) {
+	case "altair":
+		return &chain.AltairForkEpoch
+	case "bellatrix":
+		return &chain.BellatrixForkEpoch
+	case "capella":
+		return &chain.CapellaForkEpoch
+	case "deneb":
+		return &chain.DenebForkEpoch
+	case "electra":
+		return &chain.ElectraForkEpoch
+	case "eip7594":
+		return &chain.Eip7594ForkEpoch
+	default:
+		return nil
+	}
+}
+
+// applyForkEpochOverrides replaces the fork epochs from the client specs with the configured overrides.
+// overrides always take precedence, even if the client does not know the fork at all.
+func (chain *ChainSpec) applyForkEpochOverrides(overrides map[string]uint64) []*ForkEpochOverride {
+	applied := make([]*ForkEpochOverride, 0, len(overrides))
+	for fork, epoch := range overrides {
+		field := chain.getForkEpochField(fork)
+		if field == nil {
+			continue
+		}
+
+		override := &ForkEpochOverride{
+			Fork:  fork,
+			Epoch: epoch,
+		}
+		if *field != nil {
+			specEpoch := **field
+			override.SpecEpoch = &specEpoch
+		}
+
+		overrideEpoch := epoch
+		*field = &overrideEpoch
+		applied = append(applied, override)
+	}
+
+	sort.Slice(applied, func(a, b int) bool {
+		return applied[a].Epoch < applied[b].Epoch
+	})
+
+	return applied
+}
+
+// IsAltairActive returns true if the altair fork (sync committees) is active at the given epoch.
+// networks that never activate altair return false for all epochs.
+func (chain *ChainSpec) IsAltairActive(epoch phase0.Epoch) bool {
+	return chain.AltairForkEpoch != nil && uint64(epoch) >= *chain.AltairForkEpoch
+}
+
+// IsInactivityLeak returns true if the given finality delay (previous epoch - finalized epoch) triggers the inactivity leak.
+func (chain *ChainSpec) IsInactivityLeak(finalityDelay uint64) bool {
+	minEpochs := chain.MinEpochsToInactivityPenalty
+	if minEpochs == 0 {
+		minEpochs = 4
+	}
+	return finalityDelay > minEpochs
+}
+
+// IsElectraActive returns true if the electra fork is active at the given epoch.
+func (chain *ChainSpec) IsElectraActive(epoch phase0.Epoch) bool {
+	return chain.ElectraForkEpoch != nil && uint64(epoch) >= *chain.ElectraForkEpoch
+}
+
+// GetMinSlashingPenaltyQuotient returns the initial slashing penalty quotient of the fork active at the given epoch.
+func (chain *ChainSpec) GetMinSlashingPenaltyQuotient(epoch phase0.Epoch) uint64 {
+	switch {
+	case chain.IsElectraActive(epoch):
+		return specValueOrDefault(chain.MinSlashingPenaltyQuotientElectra, 4096)
+	case chain.IsBellatrixActive(epoch):
+		return specValueOrDefault(chain.MinSlashingPenaltyQuotientBellatrix, 32)
+	case chain.IsAltairActive(epoch):
+		return specValueOrDefault(chain.MinSlashingPenaltyQuotientAltair, 64)
+	default:
+		return specValueOrDefault(chain.MinSlashingPenaltyQuotient, 128)
+	}
+}
+
+// GetProportionalSlashingMultiplier returns the correlation penalty multiplier of the fork active at the given epoch.
+func (chain *ChainSpec) GetProportionalSlashingMultiplier(epoch phase0.Epoch) uint64 {
+	switch {
+	case chain.IsBellatrixActive(epoch):
+		return specValueOrDefault(chain.ProportionalSlashingMultiplierBellatrix, 3)
+	case chain.IsAltairActive(epoch):
+		return specValueOrDefault(chain.ProportionalSlashingMultiplierAltair, 2)
+	default:
+		return specValueOrDefault(chain.ProportionalSlashingMultiplier, 1)
+	}
+}
+
+// GetWhistleblowerRewardQuotient returns the whistleblower reward quotient of the fork active at the given epoch.
+func (chain *ChainSpec) GetWhistleblowerRewardQuotient(epoch phase0.Epoch) uint64 {
+	if chain.IsElectraActive(epoch) {
+		return specValueOrDefault(chain.WhistleblowerRewardQuotientElectra, 4096)
+	}
+	return specValueOrDefault(chain.WhistleblowerRewardQuotient, 512)
+}
+
+// specValueOrDefault returns the given spec value or the mainnet default if the client did not provide it.
+func specValueOrDefault(value uint64, defaultValue uint64) uint64 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// IsBellatrixActive returns true if the bellatrix fork (execution payloads) is active at the given epoch.
+// networks that never activate bellatrix return false for all epochs.
+func (chain *ChainSpec) IsBellatrixActive(epoch phase0.Epoch) bool {
+	return chain.BellatrixForkEpoch != nil && uint64(epoch) >= *chain.BellatrixForkEpoch
+}
+
 func (chain *ChainSpec) CheckMismatch(chain2 *ChainSpec) ([]string, error) {
 	mismatches := []string{}
 
@@ -0,0 +1,76 @@
+package consensus
+
+import (
+	"math"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+func forkEpoch(epoch uint64) *uint64 {
+	return &epoch
+}
+
+func TestForkActivation(t *testing.T) {
+	tests := []struct {
+		name      string
+		forkEpoch *uint64
+		epoch     phase0.Epoch
+		active    bool
+	}{
+		{"fork unknown", nil, 100, false},
+		{"fork at genesis", forkEpoch(0), 0, true},
+		{"before fork", forkEpoch(10), 9, false},
+		{"at fork", forkEpoch(10), 10, true},
+		{"after fork", forkEpoch(10), 11, true},
+		{"fork far future", forkEpoch(math.MaxUint64), 1000000, false},
+		{"fork far future, max epoch", forkEpoch(math.MaxUint64), math.MaxUint64, true},
+	}
+
+	forks := []struct {
+		name     string
+		specs    func(forkEpoch *uint64) *ChainSpec
+		isActive func(specs *ChainSpec, epoch phase0.Epoch) bool
+	}{
+		{
+			name:     "altair",
+			specs:    func(forkEpoch *uint64) *ChainSpec { return &ChainSpec{AltairForkEpoch: forkEpoch} },
+			isActive: (*ChainSpec).IsAltairActive,
+		},
+		{
+			name:     "bellatrix",
+			specs:    func(forkEpoch *uint64) *ChainSpec { return &ChainSpec{BellatrixForkEpoch: forkEpoch} },
+			isActive: (*ChainSpec).IsBellatrixActive,
+		},
+		{
+			name:     "electra",
+			specs:    func(forkEpoch *uint64) *ChainSpec { return &ChainSpec{ElectraForkEpoch: forkEpoch} },
+			isActive: (*ChainSpec).IsElectraActive,
+		},
+	}
+
+	for _, fork := range forks {
+		for _, test := range tests {
+			t.Run(fork.name+"/"+test.name, func(t *testing.T) {
+				if active := fork.isActive(fork.specs(test.forkEpoch), test.epoch); active != test.active {
+					t.Errorf("expected active %v at epoch %v, got %v", test.active, test.epoch, active)
+				}
+			})
+		}
+	}
+}
+
+func TestForkActivationIndependent(t *testing.T) {
+	// altair far in the future, bellatrix scheduled (misconfigured network or shadow fork override)
+	specs := &ChainSpec{
+		AltairForkEpoch:    forkEpoch(math.MaxUint64),
+		BellatrixForkEpoch: forkEpoch(5),
+	}
+
+	if specs.IsAltairActive(10) {
+		t.Errorf("altair must not be active before its fork epoch")
+	}
+	if !specs.IsBellatrixActive(10) {
+		t.Errorf("bellatrix must be active after its fork epoch")
+	}
+}
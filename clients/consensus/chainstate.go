@@ -14,18 +14,28 @@ import (
 )
 
 type ChainState struct {
-	specMutex sync.RWMutex
-	specs     *ChainSpec
+	specMutex          sync.RWMutex
+	specs              *ChainSpec
+	clientSpecsLoaded  bool
+	forkEpochOverrides map[string]uint64
+	appliedOverrides   []*ForkEpochOverride
 
 	genesisMutex sync.Mutex
 	genesis      *v1.Genesis
 
-	wallclockMutex sync.Mutex
-	wallclock      *ethwallclock.EthereumBeaconChain
+	wallclockMutex  sync.Mutex
+	wallclock       *ethwallclock.EthereumBeaconChain
+	wallclockOffset time.Duration
 
 	finalityMutex sync.RWMutex
 	finality      *v1.Finality
 
+	clockMutex          sync.RWMutex
+	useNodeClock        bool
+	clockDriftThreshold time.Duration
+	clockDrift          *ClockDrift
+	clockOffset         time.Duration
+
 	checkpointDispatcher     Dispatcher[*v1.Finality]
 	wallclockEpochDispatcher Dispatcher[*ethwallclock.Epoch]
 	wallclockSlotDispatcher  Dispatcher[*ethwallclock.Slot]
@@ -35,6 +45,14 @@ func newChainState() *ChainState {
 	return &ChainState{}
 }
 
+// NewStaticChainState creates a chain state with fixed specs, genesis & finality checkpoints that is not backed by a client pool.
+// used for fixture based data backends (e.g. handler tests), the wallclock is initialized from the genesis.
+func NewStaticChainState(specs *ChainSpec, genesis *v1.Genesis, finality *v1.Finality) *ChainState {
+	chainState := newChainState()
+	chainState.RestoreCachedState(specs, genesis, finality)
+	return chainState
+}
+
 func (cs *ChainState) setGenesis(genesis *v1.Genesis) error {
 	cs.genesisMutex.Lock()
 	defer cs.genesisMutex.Unlock()
@@ -58,8 +76,9 @@ func (cs *ChainState) setClientSpecs(specValues map[string]interface{}) (error,
 	cs.specMutex.Lock()
 	defer cs.specMutex.Unlock()
 
+	// specs restored from the db cache are replaced by the first client specs without mismatch check
 	specs := cs.specs
-	if specs == nil {
+	if specs == nil || !cs.clientSpecsLoaded {
 		specs = &ChainSpec{}
 	} else {
 		specs = specs.Clone()
@@ -70,9 +89,11 @@ func (cs *ChainState) setClientSpecs(specValues map[string]interface{}) (error,
 		return nil, err
 	}
 
+	appliedOverrides := specs.applyForkEpochOverrides(cs.forkEpochOverrides)
+
 	var warning error
 
-	if cs.specs != nil {
+	if cs.specs != nil && cs.clientSpecsLoaded {
 		mismatches, err := cs.specs.CheckMismatch(specs)
 		if err != nil {
 			return nil, err
@@ -86,6 +107,7 @@ func (cs *ChainState) setClientSpecs(specValues map[string]interface{}) (error,
 		if err != nil {
 			return nil, err
 		}
+		newSpecs.applyForkEpochOverrides(cs.forkEpochOverrides)
 
 		mismatches, err = cs.specs.CheckMismatch(newSpecs)
 		if err != nil {
@@ -96,11 +118,60 @@ func (cs *ChainState) setClientSpecs(specValues map[string]interface{}) (error,
 		}
 	}
 
+	if !cs.clientSpecsLoaded {
+		cs.appliedOverrides = appliedOverrides
+	}
 	cs.specs = specs
+	cs.clientSpecsLoaded = true
 
 	return warning, nil
 }
 
+// RestoreCachedState initializes the chain state from a previously persisted state (specs, genesis & finality checkpoints),
+// so the explorer can start serving data before any client is ready. no-op if the specs have already been loaded.
+// the restored specs are replaced by the specs of the first ready client, the genesis is still checked against all clients.
+func (cs *ChainState) RestoreCachedState(specs *ChainSpec, genesis *v1.Genesis, finality *v1.Finality) {
+	cs.specMutex.Lock()
+	if cs.specs != nil {
+		cs.specMutex.Unlock()
+		return
+	}
+	specs = specs.Clone()
+	specs.applyForkEpochOverrides(cs.forkEpochOverrides)
+	cs.specs = specs
+	cs.specMutex.Unlock()
+
+	cs.genesisMutex.Lock()
+	if cs.genesis == nil {
+		cs.genesis = genesis
+	}
+	cs.genesisMutex.Unlock()
+
+	if finality != nil {
+		cs.finalityMutex.Lock()
+		if cs.finality == nil {
+			cs.finality = finality
+		}
+		cs.finalityMutex.Unlock()
+	}
+
+	cs.initWallclock()
+}
+
+// HasClientSpecs returns true if the specs have been loaded from a client (and not only restored from the db cache).
+func (cs *ChainState) HasClientSpecs() bool {
+	cs.specMutex.RLock()
+	defer cs.specMutex.RUnlock()
+	return cs.clientSpecsLoaded
+}
+
+// GetForkEpochOverrides returns the configured fork epoch overrides along with the fork epochs from the first loaded client specs.
+func (cs *ChainState) GetForkEpochOverrides() []*ForkEpochOverride {
+	cs.specMutex.RLock()
+	defer cs.specMutex.RUnlock()
+	return cs.appliedOverrides
+}
+
 func (cs *ChainState) initWallclock() {
 	cs.wallclockMutex.Lock()
 	defer cs.wallclockMutex.Unlock()
@@ -113,13 +184,53 @@ func (cs *ChainState) initWallclock() {
 		return
 	}
 
-	cs.wallclock = ethwallclock.NewEthereumBeaconChain(cs.genesis.GenesisTime, cs.specs.SecondsPerSlot, cs.specs.SlotsPerEpoch)
-	cs.wallclock.OnEpochChanged(func(current ethwallclock.Epoch) {
-		cs.wallclockEpochDispatcher.Fire(&current)
+	cs.startWallclock(cs.GetClockOffset())
+}
+
+// updateWallclockOffset restarts the wallclock if the clock offset of the slot clock changed by more than `wallclockOffsetTolerance`,
+// so the slot & epoch events follow the node time source too.
+func (cs *ChainState) updateWallclockOffset() {
+	cs.wallclockMutex.Lock()
+	defer cs.wallclockMutex.Unlock()
+
+	if cs.wallclock == nil {
+		return
+	}
+
+	clockOffset := cs.GetClockOffset()
+	offsetChange := clockOffset - cs.wallclockOffset
+	if offsetChange < wallclockOffsetTolerance && offsetChange > -wallclockOffsetTolerance {
+		return
+	}
+
+	// stopping blocks until the pending slot & epoch timers elapsed, events of the stopped wallclock are dropped by startWallclock
+	go cs.wallclock.Stop()
+	cs.startWallclock(clockOffset)
+}
+
+// startWallclock starts the wallclock with the given offset to the local time.
+// the genesis time is shifted by the offset, so the events fire at the slot boundaries of the corrected time.
+func (cs *ChainState) startWallclock(clockOffset time.Duration) {
+	wallclock := ethwallclock.NewEthereumBeaconChain(cs.genesis.GenesisTime.Add(-clockOffset), cs.specs.SecondsPerSlot, cs.specs.SlotsPerEpoch)
+	wallclock.OnEpochChanged(func(current ethwallclock.Epoch) {
+		if cs.isActiveWallclock(wallclock) {
+			cs.wallclockEpochDispatcher.Fire(&current)
+		}
 	})
-	cs.wallclock.OnSlotChanged(func(current ethwallclock.Slot) {
-		cs.wallclockSlotDispatcher.Fire(&current)
+	wallclock.OnSlotChanged(func(current ethwallclock.Slot) {
+		if cs.isActiveWallclock(wallclock) {
+			cs.wallclockSlotDispatcher.Fire(&current)
+		}
 	})
+
+	cs.wallclock = wallclock
+	cs.wallclockOffset = clockOffset
+}
+
+func (cs *ChainState) isActiveWallclock(wallclock *ethwallclock.EthereumBeaconChain) bool {
+	cs.wallclockMutex.Lock()
+	defer cs.wallclockMutex.Unlock()
+	return cs.wallclock == wallclock
 }
 
 func (cs *ChainState) setFinalizedCheckpoint(finality *v1.Finality) {
@@ -154,6 +265,13 @@ func (cs *ChainState) GetFinalizedCheckpoint() (phase0.Epoch, phase0.Root) {
 	return cs.finality.Finalized.Epoch, cs.finality.Finalized.Root
 }
 
+// GetFinality returns the latest finality checkpoints, or nil if not loaded yet.
+func (cs *ChainState) GetFinality() *v1.Finality {
+	cs.finalityMutex.RLock()
+	defer cs.finalityMutex.RUnlock()
+	return cs.finality
+}
+
 func (cs *ChainState) GetJustifiedCheckpoint() (phase0.Epoch, phase0.Root) {
 	cs.finalityMutex.RLock()
 	defer cs.finalityMutex.RUnlock()
@@ -181,7 +299,7 @@ func (cs *ChainState) GetFinalizedSlot() phase0.Slot {
 }
 
 func (cs *ChainState) CurrentSlot() phase0.Slot {
-	return cs.TimeToSlot(time.Now())
+	return cs.TimeToSlot(cs.Now())
 }
 
 func (cs *ChainState) CurrentEpoch() phase0.Epoch {
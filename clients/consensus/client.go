@@ -19,6 +19,9 @@ type ClientConfig struct {
 	Headers    map[string]string
 	SshConfig  *sshtunnel.SshConfig
 	DisableSSZ bool
+
+	// history clients only serve historic blocks & states, they are not used for head tracking (no event stream)
+	HistoryOnly bool
 }
 
 type Client struct {
@@ -30,6 +33,7 @@ type Client struct {
 	rpcClient               *rpc.BeaconClient
 	logger                  *logrus.Entry
 	isOnline                bool
+	streamReady             bool
 	isSyncing               bool
 	isOptimistic            bool
 	versionStr              string
@@ -49,6 +53,8 @@ type Client struct {
 	lastPeerUpdateEpoch     phase0.Epoch
 	lastSyncUpdateEpoch     phase0.Epoch
 	peers                   []*v1.Peer
+	clockMutex              sync.Mutex
+	clockSample             *clientClockSample
 	blockDispatcher         Dispatcher[*v1.BlockEvent]
 	headDispatcher          Dispatcher[*v1.HeadEvent]
 	checkpointDispatcher    Dispatcher[*v1.Finality]
@@ -71,7 +77,11 @@ func (pool *Pool) newPoolClient(clientIdx uint16, endpoint *ClientConfig) (*Clie
 	}
 	client.resetContext()
 
-	go client.runClientLoop()
+	if endpoint.HistoryOnly {
+		go client.runHistoryClientLoop()
+	} else {
+		go client.runClientLoop()
+	}
 
 	return &client, nil
 }
@@ -14,6 +14,9 @@ import (
 	"github.com/ethpandaops/dora/clients/consensus/rpc"
 )
 
+// head polling interval while the event stream is connected (fallback if no events are received)
+const eventStreamPollTimeout = 30 * time.Second
+
 func (client *Client) runClientLoop() {
 	defer func() {
 		if err := recover(); err != nil {
@@ -117,6 +120,11 @@ func (client *Client) checkClient() error {
 		return err
 	}
 
+	// sample node clock for the clock drift detection
+	if err = client.updateClockSample(ctx); err != nil {
+		client.logger.Warnf("could not sample node clock: %v", err)
+	}
+
 	return nil
 }
 
@@ -133,18 +141,29 @@ func (client *Client) runClientLogic() error {
 	}
 
 	// start event stream
-	blockStream := client.rpcClient.NewBlockStream(client.clientCtx, client.logger, rpc.StreamBlockEvent|rpc.StreamHeadEvent|rpc.StreamFinalizedEvent)
+	blockStream := client.rpcClient.NewBlockStream(client.clientCtx, client.logger, rpc.StreamBlockEvent|rpc.StreamHeadEvent|rpc.StreamFinalizedEvent|rpc.StreamReorgEvent)
 	defer blockStream.Close()
 
+	// the client is usable as soon as the head can be polled, the event stream only reduces the latency.
+	// the head is polled every slot while the event stream is unavailable.
+	client.isOnline = true
+	client.lastError = nil
+	client.streamReady = false
+
 	// process events
 	client.lastEvent = time.Now()
 
 	for {
+		pollInterval := eventStreamPollTimeout
+		if !client.streamReady {
+			pollInterval = client.getHeadPollInterval()
+		}
+
 		eventTimeout := time.Since(client.lastEvent)
-		if eventTimeout > 30*time.Second {
+		if eventTimeout > pollInterval {
 			eventTimeout = 0
 		} else {
-			eventTimeout = 30*time.Second - eventTimeout
+			eventTimeout = pollInterval - eventTimeout
 		}
 
 		select {
@@ -171,23 +190,29 @@ func (client *Client) runClientLogic() error {
 				if err != nil {
 					client.logger.Warnf("failed processing finalized event: %v", err)
 				}
+
+			case rpc.StreamReorgEvent:
+				err := client.processReorgEvent(evt.Data.(*v1.ChainReorgEvent))
+				if err != nil {
+					client.logger.Warnf("failed processing chain_reorg event: %v", err)
+				}
 			}
 
 			client.logger.Tracef("event (%v) processing time: %v ms", evt.Event, time.Since(now).Milliseconds())
 			client.lastEvent = time.Now()
 		case streamStatus := <-blockStream.ReadyChan:
-			if client.isOnline != streamStatus.Ready {
-				client.isOnline = streamStatus.Ready
+			if client.streamReady != streamStatus.Ready {
+				client.streamReady = streamStatus.Ready
 				if streamStatus.Ready {
 					client.logger.Debug("RPC event stream connected")
-					client.lastError = nil
 				} else {
-					client.logger.Debug("RPC event stream disconnected")
-					client.lastError = streamStatus.Error
+					client.logger.Infof("RPC event stream disconnected, polling chain head until reconnected: %v", streamStatus.Error)
 				}
 			}
 		case <-time.After(eventTimeout):
-			client.logger.Debug("no head event since 30 secs, polling chain head")
+			if client.streamReady {
+				client.logger.Debugf("no head event since %v, polling chain head", pollInterval)
+			}
 
 			err := client.pollClientHead()
 			if err != nil {
@@ -211,6 +236,13 @@ func (client *Client) runClientLogic() error {
 			if client.isSyncing {
 				return fmt.Errorf("beacon node is synchronizing")
 			}
+
+			go func() {
+				// update node clock sample
+				if err := client.updateClockSample(client.clientCtx); err != nil {
+					client.logger.Warnf("could not sample node clock: %v", err)
+				}
+			}()
 		}
 
 		if currentEpoch-client.lastFinalityUpdateEpoch >= 1 && client.pool.chainState.SlotToSlotIndex(currentSlot) > 1 {
@@ -350,6 +382,37 @@ func (client *Client) processFinalizedEvent(evt *v1.FinalizedCheckpointEvent) er
 	return nil
 }
 
+// processReorgEvent applies the new head of a chain_reorg event immediately, so the indexer can follow the reorg without waiting for the next head event.
+func (client *Client) processReorgEvent(evt *v1.ChainReorgEvent) error {
+	client.logger.Debugf("chain reorg at slot %v (depth %v): %v -> %v", evt.Slot, evt.Depth, evt.OldHeadBlock.String(), evt.NewHeadBlock.String())
+
+	client.headMutex.Lock()
+	if bytes.Equal(evt.NewHeadBlock[:], client.headRoot[:]) {
+		client.headMutex.Unlock()
+		return nil
+	}
+
+	client.headSlot = evt.Slot
+	client.headRoot = evt.NewHeadBlock
+	client.headMutex.Unlock()
+
+	client.headDispatcher.Fire(&v1.HeadEvent{
+		Slot:  evt.Slot,
+		Block: evt.NewHeadBlock,
+		State: evt.NewHeadState,
+	})
+
+	return nil
+}
+
+// getHeadPollInterval returns the head polling interval used while the event stream is unavailable (one slot).
+func (client *Client) getHeadPollInterval() time.Duration {
+	if specs := client.pool.chainState.GetSpecs(); specs != nil && specs.SecondsPerSlot > 0 {
+		return specs.SecondsPerSlot
+	}
+	return 12 * time.Second
+}
+
 func (client *Client) pollClientHead() error {
 	ctx, cancel := context.WithTimeout(client.clientCtx, 10*time.Second)
 	defer cancel()
@@ -29,14 +29,18 @@ var clientTypePatterns = map[ClientType]*regexp.Regexp{
 }
 
 func (client *Client) parseClientVersion(version string) {
+	client.clientType = ParseClientTypeFromVersion(version)
+}
+
+// ParseClientTypeFromVersion returns the client type for a node version string (e.g. "Lighthouse/v5.3.0-d6ba8c3").
+func ParseClientTypeFromVersion(version string) ClientType {
 	for clientType, versionPattern := range clientTypePatterns {
 		if versionPattern.MatchString(version) {
-			client.clientType = clientType
-			return
+			return clientType
 		}
 	}
 
-	client.clientType = UnknownClient
+	return UnknownClient
 }
 
 func ParseClientType(name string) ClientType {
@@ -0,0 +1,206 @@
+package consensus
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ethpandaops/dora/clients/consensus/rpc"
+)
+
+// the Date header has second precision, the node time is assumed to be in the middle of the reported second
+const clockDateHeaderPrecision = time.Second
+
+// clock samples older than this are not used for the drift detection
+const clockSampleMaxAge = 30 * time.Minute
+
+// the node time of samples with a longer round trip is too inaccurate for the drift detection
+const clockSampleMaxRoundTrip = 2 * time.Second
+
+// the wallclock is only restarted if the clock offset changed by more than this, to avoid restarts on sampling jitter
+const wallclockOffsetTolerance = 250 * time.Millisecond
+
+// ClockDrift is the clock drift of the explorer host compared to the consensus clients.
+type ClockDrift struct {
+	Offset     time.Duration // median offset of the node clocks to the local clock (positive: local clock is behind)
+	HasOffset  bool          // true if at least one node reported its time via the Date header
+	SlotOffset int64         // median offset of the head slot of in-sync nodes to the local slot clock
+	Samples    int           // number of clients with a recent clock sample
+	IsSkewed   bool          // true if the drift exceeds the configured threshold
+	UpdatedAt  time.Time
+}
+
+// clientClockSample is the last clock sample of a client.
+type clientClockSample struct {
+	offset        time.Duration
+	hasOffset     bool
+	slotOffset    int64
+	hasSlotOffset bool
+	sampledAt     time.Time
+}
+
+// SetTimeSource configures the time source of the slot clock and the threshold of the clock drift detection.
+// if useNodeClock is set, the local time is corrected by the clock drift to the consensus clients as soon as it exceeds the threshold.
+// needs to be called before the first client is added.
+func (pool *Pool) SetTimeSource(useNodeClock bool, driftThreshold time.Duration) {
+	pool.chainState.clockMutex.Lock()
+	defer pool.chainState.clockMutex.Unlock()
+	pool.chainState.useNodeClock = useNodeClock
+	pool.chainState.clockDriftThreshold = driftThreshold
+}
+
+// GetClockOffset returns the clock offset of the node to the local clock from the last clock sample.
+func (client *Client) GetClockOffset() (offset time.Duration, slotOffset int64, ok bool) {
+	client.clockMutex.Lock()
+	defer client.clockMutex.Unlock()
+
+	if client.clockSample == nil {
+		return 0, 0, false
+	}
+
+	return client.clockSample.offset, client.clockSample.slotOffset, true
+}
+
+func (client *Client) updateClockSample(ctx context.Context) error {
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	nodeClock, err := client.rpcClient.GetNodeClock(ctx)
+	if err != nil {
+		return err
+	}
+
+	client.clockMutex.Lock()
+	client.clockSample = newClientClockSample(nodeClock, client.pool.chainState)
+	client.clockMutex.Unlock()
+
+	client.pool.updateClockDrift()
+	client.pool.chainState.updateWallclockOffset()
+
+	return nil
+}
+
+// newClientClockSample estimates the clock offset of the node NTP-style from the request round trip.
+// the slot offset is only sampled if the node has a block for its current slot (head slot equals the node's wallclock slot),
+// as the head slot of a node lags behind its slot clock on missed slots, which would look like a skewed local clock.
+func newClientClockSample(nodeClock *rpc.NodeClock, chainState *ChainState) *clientClockSample {
+	localTime := nodeClock.LocalTime()
+	sample := &clientClockSample{
+		sampledAt: nodeClock.ResponseTime,
+	}
+
+	if !nodeClock.IsSyncing && nodeClock.SyncDistance == 0 {
+		sample.slotOffset = int64(nodeClock.HeadSlot) - int64(chainState.TimeToSlot(localTime))
+		sample.hasSlotOffset = true
+	}
+
+	if !nodeClock.NodeTime.IsZero() && nodeClock.RoundTrip() <= clockSampleMaxRoundTrip {
+		sample.offset = nodeClock.NodeTime.Add(clockDateHeaderPrecision / 2).Sub(localTime)
+		sample.hasOffset = true
+	}
+
+	return sample
+}
+
+// updateClockDrift aggregates the clock samples of all clients and updates the clock drift of the chain state.
+func (pool *Pool) updateClockDrift() {
+	offsets := []time.Duration{}
+	slotOffsets := []int64{}
+	samples := 0
+
+	for _, client := range pool.clients {
+		client.clockMutex.Lock()
+		sample := client.clockSample
+		client.clockMutex.Unlock()
+
+		if sample == nil || time.Since(sample.sampledAt) > clockSampleMaxAge {
+			continue
+		}
+
+		samples++
+		if sample.hasOffset {
+			offsets = append(offsets, sample.offset)
+		}
+		if sample.hasSlotOffset {
+			slotOffsets = append(slotOffsets, sample.slotOffset)
+		}
+	}
+
+	if samples == 0 {
+		return
+	}
+
+	sort.Slice(offsets, func(a, b int) bool { return offsets[a] < offsets[b] })
+	sort.Slice(slotOffsets, func(a, b int) bool { return slotOffsets[a] < slotOffsets[b] })
+
+	drift := &ClockDrift{
+		Samples:   samples,
+		UpdatedAt: time.Now(),
+	}
+	if len(slotOffsets) > 0 {
+		drift.SlotOffset = slotOffsets[len(slotOffsets)/2]
+	}
+	if len(offsets) > 0 {
+		drift.Offset = offsets[len(offsets)/2]
+		drift.HasOffset = true
+	}
+
+	wasSkewed, isSkewed := pool.chainState.setClockDrift(drift)
+	if isSkewed && !wasSkewed {
+		hint := "check the time synchronization of the host or set chain.timeSource to 'nodes'"
+		if pool.chainState.GetClockOffset() != 0 {
+			hint = "the slot clock is corrected by the drift"
+		}
+		pool.logger.Warnf("explorer host clock is skewed: offset to the consensus clients %v, slot offset %v (%v clients), %v.", drift.Offset, drift.SlotOffset, drift.Samples, hint)
+	} else if wasSkewed && !isSkewed {
+		pool.logger.Infof("explorer host clock is in sync with the consensus clients again: offset %v, slot offset %v", drift.Offset, drift.SlotOffset)
+	}
+}
+
+// setClockDrift sets the clock drift & the resulting offset of the slot clock, returns the skew state before & after the update.
+func (cs *ChainState) setClockDrift(drift *ClockDrift) (bool, bool) {
+	cs.clockMutex.Lock()
+	defer cs.clockMutex.Unlock()
+
+	// the slot offset is only used if no node reported its time, a single slot of difference may be caused by sampling at the slot boundary
+	offset := drift.Offset
+	if !drift.HasOffset {
+		offset = 0
+		if cs.specs != nil && (drift.SlotOffset > 1 || drift.SlotOffset < -1) {
+			offset = time.Duration(drift.SlotOffset) * cs.specs.SecondsPerSlot
+		}
+	}
+
+	drift.IsSkewed = offset > cs.clockDriftThreshold || offset < -cs.clockDriftThreshold
+
+	wasSkewed := cs.clockDrift != nil && cs.clockDrift.IsSkewed
+	cs.clockDrift = drift
+
+	cs.clockOffset = 0
+	if cs.useNodeClock && drift.IsSkewed {
+		cs.clockOffset = offset
+	}
+
+	return wasSkewed, drift.IsSkewed
+}
+
+// GetClockDrift returns the clock drift of the explorer host to the consensus clients, nil if no clock samples are available.
+func (cs *ChainState) GetClockDrift() *ClockDrift {
+	cs.clockMutex.RLock()
+	defer cs.clockMutex.RUnlock()
+	return cs.clockDrift
+}
+
+// GetClockOffset returns the offset applied to the local time of the slot clock (only with node time source).
+func (cs *ChainState) GetClockOffset() time.Duration {
+	cs.clockMutex.RLock()
+	defer cs.clockMutex.RUnlock()
+	return cs.clockOffset
+}
+
+// Now returns the current time of the slot clock, which is the local time corrected by the clock offset to the consensus clients
+// if the node time source is configured.
+func (cs *ChainState) Now() time.Time {
+	return time.Now().Add(cs.GetClockOffset())
+}
@@ -0,0 +1,112 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus/rpc"
+)
+
+func newTestClockChainState(useNodeClock bool) *ChainState {
+	chainState := NewStaticChainState(&ChainSpec{
+		SlotsPerEpoch:  32,
+		SecondsPerSlot: 12 * time.Second,
+	}, &v1.Genesis{
+		GenesisTime: time.Now().Add(-1000 * 12 * time.Second),
+	}, nil)
+	chainState.useNodeClock = useNodeClock
+	chainState.clockDriftThreshold = 2 * time.Second
+
+	return chainState
+}
+
+func TestClientClockSampleSlotOffset(t *testing.T) {
+	chainState := newTestClockChainState(false)
+	localTime := time.Now()
+	localSlot := chainState.TimeToSlot(localTime)
+
+	tests := []struct {
+		name          string
+		headSlot      phase0.Slot
+		syncDistance  phase0.Slot
+		isSyncing     bool
+		hasSlotOffset bool
+		slotOffset    int64
+	}{
+		{"in sync", localSlot, 0, false, true, 0},
+		{"node clock ahead", localSlot + 3, 0, false, true, 3},
+		{"node clock behind", localSlot - 3, 0, false, true, -3},
+		{"missed slots", localSlot - 3, 3, false, false, 0},
+		{"syncing", localSlot - 100, 0, true, false, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sample := newClientClockSample(&rpc.NodeClock{
+				RequestTime:  localTime,
+				ResponseTime: localTime,
+				HeadSlot:     test.headSlot,
+				SyncDistance: test.syncDistance,
+				IsSyncing:    test.isSyncing,
+			}, chainState)
+
+			if sample.hasSlotOffset != test.hasSlotOffset {
+				t.Fatalf("expected slot offset sampled: %v, got %v", test.hasSlotOffset, sample.hasSlotOffset)
+			}
+			if sample.slotOffset != test.slotOffset {
+				t.Errorf("expected slot offset %v, got %v", test.slotOffset, sample.slotOffset)
+			}
+			if sample.hasOffset {
+				t.Errorf("expected no time offset without Date header")
+			}
+		})
+	}
+}
+
+func TestWallclockOffset(t *testing.T) {
+	chainState := newTestClockChainState(true)
+	defer func() {
+		go chainState.wallclock.Stop()
+	}()
+
+	initialWallclock := chainState.wallclock
+	if initialWallclock == nil || chainState.wallclockOffset != 0 {
+		t.Fatalf("expected wallclock without offset")
+	}
+
+	// drifts within the threshold are not applied to the slot clock
+	chainState.setClockDrift(&ClockDrift{Offset: time.Second, HasOffset: true})
+	chainState.updateWallclockOffset()
+	if chainState.GetClockOffset() != 0 || chainState.wallclock != initialWallclock {
+		t.Fatalf("expected no clock correction within the drift threshold")
+	}
+
+	chainState.setClockDrift(&ClockDrift{Offset: 5 * time.Second, HasOffset: true})
+	chainState.updateWallclockOffset()
+	if chainState.GetClockOffset() != 5*time.Second {
+		t.Fatalf("expected clock offset 5s, got %v", chainState.GetClockOffset())
+	}
+	if chainState.wallclock == initialWallclock || chainState.wallclockOffset != 5*time.Second {
+		t.Fatalf("expected wallclock restart with offset 5s, got offset %v", chainState.wallclockOffset)
+	}
+	if chainState.isActiveWallclock(initialWallclock) {
+		t.Errorf("expected events of the replaced wallclock to be dropped")
+	}
+
+	// the wallclock slot follows the corrected time
+	wallclockSlot, _, _ := chainState.wallclock.Now()
+	if phase0.Slot(wallclockSlot.Number()) != chainState.CurrentSlot() {
+		t.Errorf("wallclock slot %v does not match the corrected current slot %v", wallclockSlot.Number(), chainState.CurrentSlot())
+	}
+
+	// small changes of the offset do not restart the wallclock
+	offsetWallclock := chainState.wallclock
+	chainState.setClockDrift(&ClockDrift{Offset: 5*time.Second + 100*time.Millisecond, HasOffset: true})
+	chainState.updateWallclockOffset()
+	if chainState.wallclock != offsetWallclock {
+		t.Errorf("expected no wallclock restart on offset jitter")
+	}
+}
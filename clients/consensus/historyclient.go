@@ -0,0 +1,106 @@
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// history clients are checked less frequently, as they are only used by the synchronizer for finalized epochs
+const historyClientCheckInterval = 5 * time.Minute
+
+// runHistoryClientLoop periodically checks the availability of a history only client.
+// history clients do not track the chain head, so there is no event stream and the chain state is only compared (genesis)
+// but never updated from these clients.
+func (client *Client) runHistoryClientLoop() {
+	defer func() {
+		if err := recover(); err != nil {
+			client.logger.WithError(err.(error)).Errorf("uncaught panic in clients.consensus.Client.runHistoryClientLoop subroutine: %v, stack: %v", err, string(debug.Stack()))
+			time.Sleep(10 * time.Second)
+
+			go client.runHistoryClientLoop()
+		}
+	}()
+
+	for {
+		waitTime := historyClientCheckInterval
+
+		err := client.checkHistoryClient()
+		if err == nil {
+			if !client.isOnline {
+				client.logger.Infof("history client ready (finalized epoch: %v)", client.finalizedEpoch)
+			}
+			client.isOnline = true
+			client.lastError = nil
+			client.retryCounter = 0
+		} else {
+			client.isOnline = false
+			client.lastError = err
+			client.retryCounter++
+
+			waitTime = 10 * time.Second
+			if client.retryCounter > 10 {
+				waitTime = 300 * time.Second
+			} else if client.retryCounter > 5 {
+				waitTime = 60 * time.Second
+			}
+
+			client.logger.Warnf("history client error: %v, retrying in %v...", err, waitTime)
+		}
+		client.lastEvent = time.Now()
+
+		select {
+		case <-client.clientCtx.Done():
+			return
+		case <-time.After(waitTime):
+		}
+	}
+}
+
+// checkHistoryClient checks the node version, genesis & finality checkpoints of a history client.
+func (client *Client) checkHistoryClient() error {
+	ctx, cancel := context.WithTimeout(client.clientCtx, 60*time.Second)
+	defer cancel()
+
+	if client.versionStr == "" {
+		err := client.rpcClient.Initialize(ctx)
+		if err != nil {
+			return fmt.Errorf("initialization of attestantio/go-eth2-client failed: %w", err)
+		}
+
+		nodeVersion, err := client.rpcClient.GetNodeVersion(ctx)
+		if err != nil {
+			return fmt.Errorf("error while fetching node version: %v", err)
+		}
+
+		client.versionStr = nodeVersion
+		client.parseClientVersion(nodeVersion)
+	}
+
+	// the genesis needs to match the live clients
+	genesis, err := client.rpcClient.GetGenesis(ctx)
+	if err != nil {
+		return fmt.Errorf("error while fetching genesis: %v", err)
+	}
+
+	err = client.pool.chainState.setGenesis(genesis)
+	if err != nil {
+		return fmt.Errorf("invalid genesis: %v", err)
+	}
+
+	// track the finality checkpoints of the history client, without updating the chain state
+	finalizedCheckpoints, err := client.rpcClient.GetFinalityCheckpoints(ctx)
+	if err != nil {
+		return fmt.Errorf("error while fetching finality checkpoints: %v", err)
+	}
+
+	client.headMutex.Lock()
+	client.justifiedEpoch = finalizedCheckpoints.Justified.Epoch
+	client.justifiedRoot = finalizedCheckpoints.Justified.Root
+	client.finalizedEpoch = finalizedCheckpoints.Finalized.Epoch
+	client.finalizedRoot = finalizedCheckpoints.Finalized.Root
+	client.headMutex.Unlock()
+
+	return nil
+}
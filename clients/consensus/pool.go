@@ -11,11 +11,12 @@ import (
 )
 
 type Pool struct {
-	ctx           context.Context
-	logger        logrus.FieldLogger
-	clientCounter uint16
-	clients       []*Client
-	chainState    *ChainState
+	ctx            context.Context
+	logger         logrus.FieldLogger
+	clientCounter  uint16
+	clients        []*Client
+	historyClients []*Client
+	chainState     *ChainState
 }
 
 func NewPool(ctx context.Context, logger logrus.FieldLogger) *Pool {
@@ -27,6 +28,14 @@ func NewPool(ctx context.Context, logger logrus.FieldLogger) *Pool {
 	}
 }
 
+// SetForkEpochOverrides sets fork epochs (by lowercase fork name) that take precedence over the fork epochs from the client specs.
+// needs to be called before the first client is added, unknown fork names are ignored.
+func (pool *Pool) SetForkEpochOverrides(overrides map[string]uint64) {
+	pool.chainState.specMutex.Lock()
+	defer pool.chainState.specMutex.Unlock()
+	pool.chainState.forkEpochOverrides = overrides
+}
+
 func (pool *Pool) SubscribeFinalizedEvent(capacity int) *Subscription[*v1.Finality] {
 	return pool.chainState.checkpointDispatcher.Subscribe(capacity, false)
 }
@@ -52,7 +61,11 @@ func (pool *Pool) AddEndpoint(endpoint *ClientConfig) (*Client, error) {
 		return nil, err
 	}
 
-	pool.clients = append(pool.clients, client)
+	if endpoint.HistoryOnly {
+		pool.historyClients = append(pool.historyClients, client)
+	} else {
+		pool.clients = append(pool.clients, client)
+	}
 
 	return client, nil
 }
@@ -61,6 +74,11 @@ func (pool *Pool) GetAllEndpoints() []*Client {
 	return pool.clients
 }
 
+// GetHistoryEndpoints returns the history only clients, which are not part of the live client set.
+func (pool *Pool) GetHistoryEndpoints() []*Client {
+	return pool.historyClients
+}
+
 func (pool *Pool) GetReadyEndpoint(clientType ClientType) *Client {
 	readyClients := []*Client{}
 
@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// apiVersionRecheckInterval is the interval after which an endpoint that fell back to an older version
+// is probed with the preferred version again, as nodes might have been upgraded in the meantime.
+const apiVersionRecheckInterval = 1 * time.Hour
+
+// apiEndpointVersion is a single version of a beacon api endpoint.
+type apiEndpointVersion struct {
+	version int
+	path    string
+}
+
+// apiEndpoint is a beacon api endpoint with all supported versions, ordered by preference (newest first).
+// endpoints that are provided via go-eth2-client (blocks, states, ...) are versioned by the library.
+type apiEndpoint struct {
+	name     string
+	versions []apiEndpointVersion
+}
+
+var (
+	apiEndpointNodeVersion = &apiEndpoint{
+		name:     "node_version",
+		versions: []apiEndpointVersion{{1, "/eth/v1/node/version"}},
+	}
+	apiEndpointNodeIdentity = &apiEndpoint{
+		name:     "node_identity",
+		versions: []apiEndpointVersion{{1, "/eth/v1/node/identity"}},
+	}
+	apiEndpointNodeSyncing = &apiEndpoint{
+		name:     "node_syncing",
+		versions: []apiEndpointVersion{{1, "/eth/v1/node/syncing"}},
+	}
+	apiEndpointEvents = &apiEndpoint{
+		name:     "events",
+		versions: []apiEndpointVersion{{1, "/eth/v1/events"}},
+	}
+	apiEndpointPoolAttesterSlashings = &apiEndpoint{
+		name: "pool_attester_slashings",
+		versions: []apiEndpointVersion{
+			{2, "/eth/v2/beacon/pool/attester_slashings"},
+			{1, "/eth/v1/beacon/pool/attester_slashings"},
+		},
+	}
+	apiEndpointPoolProposerSlashings = &apiEndpoint{
+		name:     "pool_proposer_slashings",
+		versions: []apiEndpointVersion{{1, "/eth/v1/beacon/pool/proposer_slashings"}},
+	}
+)
+
+// APIError is returned for beacon api requests that failed with a non-200 status code.
+type APIError struct {
+	StatusCode int
+	URL        string
+	Response   []byte
+}
+
+func (e *APIError) Error() string {
+	if e.StatusCode == nethttp.StatusNotFound {
+		return "not found"
+	}
+
+	return "url: " + e.URL + ", error-response: " + string(e.Response)
+}
+
+// isUnsupportedEndpointError checks if the error indicates that the node does not serve the requested endpoint version.
+// 404 is ambiguous (missing resource or unknown route), so a fallback is only persisted if the older version succeeds.
+func isUnsupportedEndpointError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	switch apiErr.StatusCode {
+	case nethttp.StatusNotFound, nethttp.StatusMethodNotAllowed, nethttp.StatusNotImplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+type apiVersionSelection struct {
+	index     int
+	checkedAt time.Time
+}
+
+// apiVersionTracker holds the negotiated endpoint versions of a beacon client.
+type apiVersionTracker struct {
+	mutex     sync.Mutex
+	selection map[*apiEndpoint]*apiVersionSelection
+}
+
+func (t *apiVersionTracker) getIndex(endpoint *apiEndpoint) int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	selection := t.selection[endpoint]
+	if selection == nil || time.Since(selection.checkedAt) > apiVersionRecheckInterval {
+		return 0
+	}
+
+	return selection.index
+}
+
+func (t *apiVersionTracker) setIndex(endpoint *apiEndpoint, index int) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.selection == nil {
+		t.selection = map[*apiEndpoint]*apiVersionSelection{}
+	}
+
+	selection := t.selection[endpoint]
+	changed := selection == nil && index != 0 || selection != nil && selection.index != index
+	t.selection[endpoint] = &apiVersionSelection{
+		index:     index,
+		checkedAt: time.Now(),
+	}
+
+	return changed
+}
+
+// getEndpointURL returns the url of the negotiated version of the endpoint.
+func (bc *BeaconClient) getEndpointURL(endpoint *apiEndpoint) string {
+	return bc.endpoint + endpoint.versions[bc.apiVersions.getIndex(endpoint)].path
+}
+
+// GetAPIFallbacks returns the endpoints that fell back to an older version than preferred (e.g. "pool_attester_slashings: v1").
+func (bc *BeaconClient) GetAPIFallbacks() []string {
+	bc.apiVersions.mutex.Lock()
+	defer bc.apiVersions.mutex.Unlock()
+
+	fallbacks := []string{}
+	for endpoint, selection := range bc.apiVersions.selection {
+		if selection.index > 0 {
+			fallbacks = append(fallbacks, fmt.Sprintf("%v: v%v", endpoint.name, endpoint.versions[selection.index].version))
+		}
+	}
+	sort.Strings(fallbacks)
+
+	return fallbacks
+}
+
+// callVersionedEndpoint calls the endpoint with the negotiated version and falls back to older versions
+// if the node does not support it. versions below minVersion are skipped (e.g. for payloads of newer forks).
+func (bc *BeaconClient) callVersionedEndpoint(ctx context.Context, endpoint *apiEndpoint, minVersion int, call func(ctx context.Context, url string, version int) error) error {
+	startIndex := bc.apiVersions.getIndex(endpoint)
+	if endpoint.versions[startIndex].version < minVersion {
+		startIndex = 0
+	}
+
+	var firstErr error
+	for index := startIndex; index < len(endpoint.versions); index++ {
+		endpointVersion := endpoint.versions[index]
+		if endpointVersion.version < minVersion {
+			break
+		}
+
+		err := call(ctx, bc.endpoint+endpointVersion.path, endpointVersion.version)
+		if err != nil && isUnsupportedEndpointError(err) {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if bc.apiVersions.setIndex(endpoint, index) {
+			bc.logger.Infof("using %v endpoint version v%v", endpoint.name, endpointVersion.version)
+		}
+
+		return err
+	}
+
+	if firstErr == nil {
+		return fmt.Errorf("no %v endpoint version >= v%v available", endpoint.name, minVersion)
+	}
+
+	return firstErr
+}
@@ -28,13 +28,16 @@ import (
 )
 
 type BeaconClient struct {
-	name       string
-	endpoint   string
-	headers    map[string]string
-	sshtunnel  *sshtunnel.SSHTunnel
-	disableSSZ bool
-	clientSvc  eth2client.Service
-	logger     logrus.FieldLogger
+	name        string
+	endpoint    string
+	headers     map[string]string
+	sshtunnel   *sshtunnel.SSHTunnel
+	disableSSZ  bool
+	clientSvc   eth2client.Service
+	logger      logrus.FieldLogger
+	rpcStats    rpcStatsTracker
+	apiVersions apiVersionTracker
+	transport   *nethttp.Transport
 }
 
 // NewBeaconClient is used to create a new beacon client
@@ -45,6 +48,7 @@ func NewBeaconClient(name, endpoint string, headers map[string]string, sshcfg *s
 		headers:    headers,
 		disableSSZ: disableSSZ,
 		logger:     logger,
+		transport:  newBeaconTransport(),
 	}
 
 	if sshcfg != nil {
@@ -107,6 +111,9 @@ func (bc *BeaconClient) Initialize(ctx context.Context) error {
 		http.WithTimeout(10 * time.Minute),
 		http.WithLogLevel(zerolog.Disabled),
 		http.WithCustomSpecSupport(true),
+		http.WithHTTPClient(&nethttp.Client{
+			Transport: bc.newMetricsTransport(),
+		}),
 	}
 
 	// set extra endpoint headers
@@ -140,7 +147,7 @@ func (bc *BeaconClient) getJSON(ctx context.Context, requrl string, returnValue
 		req.Header.Set(headerKey, headerVal)
 	}
 
-	client := &nethttp.Client{Timeout: time.Second * 300}
+	client := &nethttp.Client{Timeout: time.Second * 300, Transport: bc.newMetricsTransport()}
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -150,14 +157,12 @@ func (bc *BeaconClient) getJSON(ctx context.Context, requrl string, returnValue
 	defer resp.Body.Close()
 
 	if resp.StatusCode != nethttp.StatusOK {
-		if resp.StatusCode == nethttp.StatusNotFound {
-			return fmt.Errorf("not found")
-		}
-
 		data, _ := io.ReadAll(resp.Body)
-		bc.logger.Debugf("RPC Error %v: %v", resp.StatusCode, data)
+		if resp.StatusCode != nethttp.StatusNotFound {
+			bc.logger.Debugf("RPC Error %v: %v", resp.StatusCode, data)
+		}
 
-		return fmt.Errorf("url: %v, error-response: %s", logurl, data)
+		return &APIError{StatusCode: resp.StatusCode, URL: logurl, Response: data}
 	}
 
 	dec := json.NewDecoder(resp.Body)
@@ -170,7 +175,7 @@ func (bc *BeaconClient) getJSON(ctx context.Context, requrl string, returnValue
 	return nil
 }
 
-func (bc *BeaconClient) postJSON(ctx context.Context, requrl string, postData, returnValue interface{}) error {
+func (bc *BeaconClient) postJSON(ctx context.Context, requrl string, headers map[string]string, postData, returnValue interface{}) error {
 	logurl := getRedactedURL(requrl)
 
 	postDataBytes, err := json.Marshal(postData)
@@ -187,11 +192,15 @@ func (bc *BeaconClient) postJSON(ctx context.Context, requrl string, postData, r
 
 	req.Header.Set("Content-Type", "application/json")
 
+	for headerKey, headerVal := range headers {
+		req.Header.Set(headerKey, headerVal)
+	}
+
 	for headerKey, headerVal := range bc.headers {
 		req.Header.Set(headerKey, headerVal)
 	}
 
-	client := &nethttp.Client{Timeout: time.Second * 300}
+	client := &nethttp.Client{Timeout: time.Second * 300, Transport: bc.newMetricsTransport()}
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -201,13 +210,9 @@ func (bc *BeaconClient) postJSON(ctx context.Context, requrl string, postData, r
 	defer resp.Body.Close()
 
 	if resp.StatusCode != nethttp.StatusOK {
-		if resp.StatusCode == nethttp.StatusNotFound {
-			return fmt.Errorf("not found")
-		}
-
 		data, _ := io.ReadAll(resp.Body)
 
-		return fmt.Errorf("url: %v, error-response: %s", logurl, data)
+		return &APIError{StatusCode: resp.StatusCode, URL: logurl, Response: data}
 	}
 
 	if returnValue != nil {
@@ -278,7 +283,7 @@ type apiNodeVersion struct {
 func (bc *BeaconClient) GetNodeVersion(ctx context.Context) (string, error) {
 	var nodeVersion apiNodeVersion
 
-	err := bc.getJSON(ctx, fmt.Sprintf("%s/eth/v1/node/version", bc.endpoint), &nodeVersion)
+	err := bc.getJSON(ctx, bc.getEndpointURL(apiEndpointNodeVersion), &nodeVersion)
 	if err != nil {
 		return "", fmt.Errorf("error retrieving node version: %v", err)
 	}
@@ -458,6 +463,74 @@ func (bc *BeaconClient) GetForkState(ctx context.Context, stateRef string) (*pha
 	return result.Data, nil
 }
 
+func (bc *BeaconClient) GetBeaconCommittees(ctx context.Context, stateRef string, epoch *phase0.Epoch) ([]*v1.BeaconCommittee, error) {
+	provider, isProvider := bc.clientSvc.(eth2client.BeaconCommitteesProvider)
+	if !isProvider {
+		return nil, fmt.Errorf("get beacon committees not supported")
+	}
+
+	result, err := provider.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{
+		State: stateRef,
+		Epoch: epoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+func (bc *BeaconClient) GetAttestationRewards(ctx context.Context, epoch phase0.Epoch) (*v1.AttestationRewards, error) {
+	provider, isProvider := bc.clientSvc.(eth2client.AttestationRewardsProvider)
+	if !isProvider {
+		return nil, fmt.Errorf("get attestation rewards not supported")
+	}
+
+	result, err := provider.AttestationRewards(ctx, &api.AttestationRewardsOpts{
+		Epoch: epoch,
+		Common: api.CommonOpts{
+			Timeout: 0,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+func (bc *BeaconClient) GetBlockRewards(ctx context.Context, blockroot phase0.Root) (*v1.BlockRewards, error) {
+	provider, isProvider := bc.clientSvc.(eth2client.BlockRewardsProvider)
+	if !isProvider {
+		return nil, fmt.Errorf("get block rewards not supported")
+	}
+
+	result, err := provider.BlockRewards(ctx, &api.BlockRewardsOpts{
+		Block: fmt.Sprintf("0x%x", blockroot),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+func (bc *BeaconClient) GetSyncCommitteeRewards(ctx context.Context, blockroot phase0.Root) ([]*v1.SyncCommitteeReward, error) {
+	provider, isProvider := bc.clientSvc.(eth2client.SyncCommitteeRewardsProvider)
+	if !isProvider {
+		return nil, fmt.Errorf("get sync committee rewards not supported")
+	}
+
+	result, err := provider.SyncCommitteeRewards(ctx, &api.SyncCommitteeRewardsOpts{
+		Block: fmt.Sprintf("0x%x", blockroot),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
 func (bc *BeaconClient) GetNodePeers(ctx context.Context) ([]*v1.Peer, error) {
 	provider, isProvider := bc.clientSvc.(eth2client.NodePeersProvider)
 	if !isProvider {
@@ -484,7 +557,7 @@ func (bc *BeaconClient) GetNodeIdentity(ctx context.Context) (*NodeIdentity, err
 		Data *NodeIdentity `json:"data"`
 	}{}
 
-	err := bc.getJSON(ctx, fmt.Sprintf("%s/eth/v1/node/identity", bc.endpoint), &response)
+	err := bc.getJSON(ctx, bc.getEndpointURL(apiEndpointNodeIdentity), &response)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving node identity: %v", err)
 	}
@@ -519,17 +592,42 @@ func (bc *BeaconClient) SubmitVoluntaryExits(ctx context.Context, exit *phase0.S
 	return nil
 }
 
-func (bc *BeaconClient) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
-	err := bc.postJSON(ctx, fmt.Sprintf("%s/eth/v1/beacon/pool/attester_slashings", bc.endpoint), slashing, nil)
-	if err != nil {
-		return err
-	}
+// SubmitAttesterSlashing submits an attester slashing to the pool.
+// the v2 endpoint is preferred, pre-electra slashings fall back to v1 on nodes without v2 support.
+func (bc *BeaconClient) SubmitAttesterSlashing(ctx context.Context, slashing *spec.VersionedAttesterSlashing) error {
+	var slashingData interface{}
+	minVersion := 1
+
+	switch slashing.Version {
+	case spec.DataVersionPhase0:
+		slashingData = slashing.Phase0
+	case spec.DataVersionAltair:
+		slashingData = slashing.Altair
+	case spec.DataVersionBellatrix:
+		slashingData = slashing.Bellatrix
+	case spec.DataVersionCapella:
+		slashingData = slashing.Capella
+	case spec.DataVersionDeneb:
+		slashingData = slashing.Deneb
+	case spec.DataVersionElectra:
+		slashingData = slashing.Electra
+		minVersion = 2
+	default:
+		return fmt.Errorf("unsupported attester slashing version: %v", slashing.Version)
+	}
+
+	return bc.callVersionedEndpoint(ctx, apiEndpointPoolAttesterSlashings, minVersion, func(ctx context.Context, url string, version int) error {
+		headers := map[string]string{}
+		if version >= 2 {
+			headers["Eth-Consensus-Version"] = slashing.Version.String()
+		}
 
-	return nil
+		return bc.postJSON(ctx, url, headers, slashingData, nil)
+	})
 }
 
 func (bc *BeaconClient) SubmitProposerSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
-	err := bc.postJSON(ctx, fmt.Sprintf("%s/eth/v1/beacon/pool/proposer_slashings", bc.endpoint), slashing, nil)
+	err := bc.postJSON(ctx, bc.getEndpointURL(apiEndpointPoolProposerSlashings), nil, slashing, nil)
 	if err != nil {
 		return err
 	}
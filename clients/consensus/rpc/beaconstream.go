@@ -20,6 +20,7 @@ const (
 	StreamBlockEvent     uint16 = 0x01
 	StreamHeadEvent      uint16 = 0x02
 	StreamFinalizedEvent uint16 = 0x04
+	StreamReorgEvent     uint16 = 0x08
 )
 
 type BeaconStreamEvent struct {
@@ -87,6 +88,8 @@ func (bs *BeaconStream) startStream() {
 					bs.processHeadEvent(evt)
 				case "finalized_checkpoint":
 					bs.processFinalizedEvent(evt)
+				case "chain_reorg":
+					bs.processReorgEvent(evt)
 				}
 			case <-stream.Ready:
 				bs.ReadyChan <- &BeaconStreamStatus{
@@ -148,6 +151,16 @@ func (bs *BeaconStream) subscribeStream(endpoint string, events uint16) *eventst
 		topicsCount++
 	}
 
+	if events&StreamReorgEvent > 0 {
+		if topicsCount > 0 {
+			fmt.Fprintf(&topics, ",")
+		}
+
+		fmt.Fprintf(&topics, "chain_reorg")
+
+		topicsCount++
+	}
+
 	if topicsCount == 0 {
 		return nil
 	}
@@ -155,7 +168,7 @@ func (bs *BeaconStream) subscribeStream(endpoint string, events uint16) *eventst
 	for {
 		var stream *eventstream.Stream
 
-		streamURL := fmt.Sprintf("%s/eth/v1/events?topics=%v", endpoint, topics.String())
+		streamURL := fmt.Sprintf("%s?topics=%v", bs.client.getEndpointURL(apiEndpointEvents), topics.String())
 		req, err := http.NewRequestWithContext(bs.ctx, "GET", streamURL, http.NoBody)
 
 		if err == nil {
@@ -225,6 +238,21 @@ func (bs *BeaconStream) processFinalizedEvent(evt eventsource.Event) {
 	}
 }
 
+func (bs *BeaconStream) processReorgEvent(evt eventsource.Event) {
+	var parsed v1.ChainReorgEvent
+
+	err := json.Unmarshal([]byte(evt.Data()), &parsed)
+	if err != nil {
+		bs.logger.Warnf("beacon block stream failed to decode chain_reorg event: %v", err)
+		return
+	}
+
+	bs.EventChan <- &BeaconStreamEvent{
+		Event: StreamReorgEvent,
+		Data:  &parsed,
+	}
+}
+
 func getRedactedURL(requrl string) string {
 	var logurl string
 
@@ -0,0 +1,311 @@
+// Package conformance runs the beacon api rpc layer against recorded responses of the different consensus clients.
+// responses are recorded from live nodes via a recording proxy and replayed later via a local fixture server,
+// so decoding divergences between clients (optional fields, number-vs-string encodings, ...) are caught without a devnet.
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fixture holds the recorded beacon api responses of a single client.
+// synthetic fixtures are hand-built to reproduce known client encoding quirks and were not recorded from a live node,
+// so they carry no recording time and share the same chain data across clients.
+type Fixture struct {
+	Client      string             `json:"client"`
+	NodeVersion string             `json:"node_version"`
+	Synthetic   bool               `json:"synthetic,omitempty"`
+	RecordedAt  time.Time          `json:"recorded_at,omitempty"`
+	SSZ         bool               `json:"ssz"`
+	WithState   bool               `json:"with_state"`
+	Responses   []*FixtureResponse `json:"responses"`
+}
+
+// FixtureResponse is a single recorded beacon api response.
+// json bodies are stored inline, so fixtures can be edited by hand to reproduce client quirks.
+type FixtureResponse struct {
+	Method      string          `json:"method"`
+	Path        string          `json:"path"`
+	Status      int             `json:"status"`
+	ContentType string          `json:"content_type,omitempty"`
+	Version     string          `json:"consensus_version,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	RawBody     []byte          `json:"raw_body,omitempty"`
+}
+
+func fixtureKey(method string, path string) string {
+	return fmt.Sprintf("%v %v", method, path)
+}
+
+// LoadFixture reads a fixture file.
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading fixture file: %v", err)
+	}
+
+	fixture := &Fixture{}
+	if err := json.Unmarshal(data, fixture); err != nil {
+		return nil, fmt.Errorf("error parsing fixture file %v: %v", path, err)
+	}
+
+	return fixture, nil
+}
+
+// LoadFixtures reads all fixture files (*.json) from the given directory, sorted by file name.
+func LoadFixtures(dir string) ([]*Fixture, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	fixtures := make([]*Fixture, 0, len(files))
+	for _, file := range files {
+		fixture, err := LoadFixture(file)
+		if err != nil {
+			return nil, err
+		}
+		fixtures = append(fixtures, fixture)
+	}
+
+	return fixtures, nil
+}
+
+// Save writes the fixture to the given file.
+func (fixture *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding fixture: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating fixture directory: %v", err)
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// fixtureServer serves http requests on a local port, either proxied to a live node (recording) or from a fixture (replay).
+type fixtureServer struct {
+	listener   net.Listener
+	server     *http.Server
+	mutex      sync.Mutex
+	responses  map[string]*FixtureResponse
+	order      []string
+	unmatched  []string
+	upstream   *url.URL
+	httpClient *http.Client
+}
+
+func startFixtureServer(handler func(srv *fixtureServer, w http.ResponseWriter, r *http.Request)) (*fixtureServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("error starting fixture server: %v", err)
+	}
+
+	srv := &fixtureServer{
+		listener:  listener,
+		responses: map[string]*FixtureResponse{},
+	}
+	srv.server = &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(srv, w, r)
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go srv.server.Serve(listener) //nolint:errcheck // returns on Close
+
+	return srv, nil
+}
+
+// URL returns the base url of the local fixture server.
+func (srv *fixtureServer) URL() string {
+	return fmt.Sprintf("http://%v", srv.listener.Addr().String())
+}
+
+// Close stops the local fixture server.
+func (srv *fixtureServer) Close() error {
+	return srv.server.Close()
+}
+
+// Recorder proxies requests to a live beacon node and records all responses.
+type Recorder struct {
+	*fixtureServer
+}
+
+// NewRecorder starts a recording proxy for the given beacon node endpoint.
+// request headers (e.g. authorization) are forwarded to the node, but never stored in the fixture.
+func NewRecorder(endpoint string) (*Recorder, error) {
+	upstream, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint url: %v", err)
+	}
+
+	srv, err := startFixtureServer((*fixtureServer).proxyRequest)
+	if err != nil {
+		return nil, err
+	}
+	srv.upstream = upstream
+	srv.httpClient = &http.Client{Timeout: 10 * time.Minute}
+
+	return &Recorder{srv}, nil
+}
+
+func (srv *fixtureServer) proxyRequest(w http.ResponseWriter, r *http.Request) {
+	// repeated requests are answered from the first recorded response, so the suite sees the same data as on replay
+	srv.mutex.Lock()
+	recorded := srv.responses[fixtureKey(r.Method, r.URL.RequestURI())]
+	srv.mutex.Unlock()
+	if recorded != nil {
+		srv.replayRequest(w, r)
+		return
+	}
+
+	reqBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, srv.upstream.String()+r.URL.RequestURI(), bytes.NewReader(reqBody))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for headerKey, headerVals := range r.Header {
+		// let the transport handle compression, so the stored bodies are plain
+		if headerKey == "Accept-Encoding" {
+			continue
+		}
+		req.Header[headerKey] = headerVals
+	}
+
+	resp, err := srv.httpClient.Do(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	response := &FixtureResponse{
+		Method:      r.Method,
+		Path:        r.URL.RequestURI(),
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Version:     resp.Header.Get("Eth-Consensus-Version"),
+	}
+	if len(respBody) > 0 {
+		if strings.HasPrefix(response.ContentType, "application/json") && json.Valid(respBody) {
+			response.Body = json.RawMessage(respBody)
+		} else {
+			response.RawBody = respBody
+		}
+	}
+
+	key := fixtureKey(response.Method, response.Path)
+	srv.mutex.Lock()
+	if srv.responses[key] == nil {
+		srv.responses[key] = response
+		srv.order = append(srv.order, key)
+	}
+	srv.mutex.Unlock()
+
+	srv.writeResponse(w, resp.Header, response.Status, respBody)
+}
+
+func (srv *fixtureServer) writeResponse(w http.ResponseWriter, header http.Header, status int, body []byte) {
+	for _, headerKey := range []string{"Content-Type", "Eth-Consensus-Version"} {
+		if headerVal := header.Get(headerKey); headerVal != "" {
+			w.Header().Set(headerKey, headerVal)
+		}
+	}
+	w.WriteHeader(status)
+	w.Write(body) //nolint:errcheck // client side errors are irrelevant here
+}
+
+// Responses returns the recorded responses in request order.
+func (rec *Recorder) Responses() []*FixtureResponse {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	responses := make([]*FixtureResponse, 0, len(rec.order))
+	for _, key := range rec.order {
+		responses = append(responses, rec.responses[key])
+	}
+
+	return responses
+}
+
+// Replayer serves the responses of a fixture.
+type Replayer struct {
+	*fixtureServer
+}
+
+// NewReplayer starts a local server replaying the responses of the given fixture.
+// requests without recorded response are answered with 404 and reported via Unmatched.
+func NewReplayer(fixture *Fixture) (*Replayer, error) {
+	srv, err := startFixtureServer((*fixtureServer).replayRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, response := range fixture.Responses {
+		srv.responses[fixtureKey(response.Method, response.Path)] = response
+	}
+
+	return &Replayer{srv}, nil
+}
+
+func (srv *fixtureServer) replayRequest(w http.ResponseWriter, r *http.Request) {
+	key := fixtureKey(r.Method, r.URL.RequestURI())
+
+	srv.mutex.Lock()
+	response := srv.responses[key]
+	if response == nil {
+		srv.unmatched = append(srv.unmatched, key)
+	}
+	srv.mutex.Unlock()
+
+	if response == nil {
+		http.Error(w, `{"code":404,"message":"no recorded response"}`, http.StatusNotFound)
+		return
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", response.ContentType)
+	header.Set("Eth-Consensus-Version", response.Version)
+
+	body := []byte(response.Body)
+	if len(response.RawBody) > 0 {
+		body = response.RawBody
+	}
+
+	srv.writeResponse(w, header, response.Status, body)
+}
+
+// Unmatched returns the requests that had no recorded response.
+func (rep *Replayer) Unmatched() []string {
+	rep.mutex.Lock()
+	defer rep.mutex.Unlock()
+
+	return append([]string{}, rep.unmatched...)
+}
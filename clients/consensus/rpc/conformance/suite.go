@@ -0,0 +1,464 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/consensus/rpc"
+)
+
+// CheckResult is the result of a single conformance check.
+type CheckResult struct {
+	Name     string
+	Optional bool
+	Skipped  bool
+	Err      error
+	Duration time.Duration
+}
+
+// SuiteResult holds the results of a suite run against a single client.
+type SuiteResult struct {
+	NodeVersion string
+	GenesisRoot phase0.Root
+	Specs       *consensus.ChainSpec
+	Checks      []*CheckResult
+}
+
+// Failed returns the number of failed non-optional checks.
+func (result *SuiteResult) Failed() int {
+	failed := 0
+	for _, check := range result.Checks {
+		if check.Err != nil && !check.Optional {
+			failed++
+		}
+	}
+	return failed
+}
+
+// SuiteOptions configures a suite run.
+type SuiteOptions struct {
+	SSZ       bool
+	WithState bool
+	Headers   map[string]string
+}
+
+// suiteRun threads the data loaded by earlier checks into later ones.
+type suiteRun struct {
+	client  *rpc.BeaconClient
+	options *SuiteOptions
+	result  *SuiteResult
+	head    *v1.BeaconBlockHeader
+	block   *spec.VersionedSignedBeaconBlock
+	epoch   phase0.Epoch
+}
+
+type suiteCheck struct {
+	name     string
+	optional bool
+	run      func(ctx context.Context, run *suiteRun) error
+}
+
+var errSkipped = fmt.Errorf("skipped")
+
+// the checks are executed in order and the same requests are made on record & replay, as long as the responses match.
+var suiteChecks = []*suiteCheck{
+	{name: "node_version", run: checkNodeVersion},
+	{name: "genesis", run: checkGenesis},
+	{name: "config_spec", run: checkConfigSpec},
+	{name: "node_syncing", run: checkNodeSyncing},
+	{name: "node_identity", run: checkNodeIdentity},
+	{name: "node_peers", optional: true, run: checkNodePeers},
+	{name: "head_header", run: checkHeadHeader},
+	{name: "finality_checkpoints", run: checkFinalityCheckpoints},
+	{name: "header_by_slot", run: checkHeaderBySlot},
+	{name: "header_by_root", run: checkHeaderByRoot},
+	{name: "block_body", run: checkBlockBody},
+	{name: "blob_sidecars", run: checkBlobSidecars},
+	{name: "fork_state", run: checkForkState},
+	{name: "beacon_committees", run: checkBeaconCommittees},
+	{name: "block_rewards", optional: true, run: checkBlockRewards},
+	{name: "beacon_state", run: checkBeaconState},
+}
+
+// RunSuite runs all conformance checks against the beacon api at the given endpoint.
+// checks depending on the result of a failed check are skipped.
+func RunSuite(ctx context.Context, name string, endpoint string, options *SuiteOptions, logger logrus.FieldLogger) (*SuiteResult, error) {
+	client, err := rpc.NewBeaconClient(name, endpoint, options.Headers, nil, !options.SSZ, logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating beacon client: %v", err)
+	}
+
+	if err := client.Initialize(ctx); err != nil {
+		return nil, fmt.Errorf("error initializing beacon client: %v", err)
+	}
+
+	run := &suiteRun{
+		client:  client,
+		options: options,
+		result:  &SuiteResult{},
+	}
+
+	for _, check := range suiteChecks {
+		checkResult := &CheckResult{
+			Name:     check.name,
+			Optional: check.optional,
+		}
+
+		start := time.Now()
+		err := check.run(ctx, run)
+		checkResult.Duration = time.Since(start)
+
+		if err == errSkipped {
+			checkResult.Skipped = true
+		} else {
+			checkResult.Err = err
+		}
+
+		run.result.Checks = append(run.result.Checks, checkResult)
+	}
+
+	return run.result, nil
+}
+
+func checkNodeVersion(ctx context.Context, run *suiteRun) error {
+	version, err := run.client.GetNodeVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version == "" {
+		return fmt.Errorf("empty node version")
+	}
+
+	run.result.NodeVersion = version
+	return nil
+}
+
+func checkGenesis(ctx context.Context, run *suiteRun) error {
+	genesis, err := run.client.GetGenesis(ctx)
+	if err != nil {
+		return err
+	}
+	if genesis.GenesisTime.Unix() <= 0 {
+		return fmt.Errorf("invalid genesis time: %v", genesis.GenesisTime)
+	}
+	if genesis.GenesisValidatorsRoot == (phase0.Root{}) {
+		return fmt.Errorf("empty genesis validators root")
+	}
+
+	run.result.GenesisRoot = genesis.GenesisValidatorsRoot
+	return nil
+}
+
+// requiredSpecForks are the forks every client has to report a fork version and epoch for.
+var requiredSpecForks = []string{"ALTAIR", "BELLATRIX", "CAPELLA", "DENEB"}
+
+// checkConfigSpec parses the specs the same way the client pool does, so number-vs-string divergences surface here.
+func checkConfigSpec(ctx context.Context, run *suiteRun) error {
+	specValues, err := run.client.GetConfigSpecs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, fork := range requiredSpecForks {
+		for _, key := range []string{fork + "_FORK_VERSION", fork + "_FORK_EPOCH"} {
+			if _, ok := specValues[key]; !ok {
+				return fmt.Errorf("missing %v", key)
+			}
+		}
+	}
+
+	specs, err := consensus.ParseChainSpec(specValues)
+	if err != nil {
+		return fmt.Errorf("error parsing specs: %v", err)
+	}
+	if specs.SlotsPerEpoch == 0 || specs.SecondsPerSlot == 0 {
+		return fmt.Errorf("missing SLOTS_PER_EPOCH or SECONDS_PER_SLOT")
+	}
+	if specs.PresetBase == "" {
+		return fmt.Errorf("missing PRESET_BASE")
+	}
+
+	run.result.Specs = specs
+	return nil
+}
+
+func checkNodeSyncing(ctx context.Context, run *suiteRun) error {
+	syncState, err := run.client.GetNodeSyncing(ctx)
+	if err != nil {
+		return err
+	}
+	if syncState.HeadSlot == 0 && !syncState.IsSyncing {
+		return fmt.Errorf("head slot 0 reported for synced node")
+	}
+
+	return nil
+}
+
+func checkNodeIdentity(ctx context.Context, run *suiteRun) error {
+	identity, err := run.client.GetNodeIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	if identity.PeerID == "" {
+		return fmt.Errorf("empty peer id")
+	}
+
+	return nil
+}
+
+func checkNodePeers(ctx context.Context, run *suiteRun) error {
+	peers, err := run.client.GetNodePeers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, peer := range peers {
+		if peer.PeerID == "" {
+			return fmt.Errorf("peer with empty peer id")
+		}
+	}
+
+	return nil
+}
+
+func checkHeadHeader(ctx context.Context, run *suiteRun) error {
+	header, err := run.client.GetLatestBlockHead(ctx)
+	if err != nil {
+		return err
+	}
+	if header.Header == nil || header.Header.Message == nil {
+		return fmt.Errorf("missing header message")
+	}
+	if header.Root == (phase0.Root{}) {
+		return fmt.Errorf("empty head root")
+	}
+
+	run.head = header
+	if run.result.Specs != nil {
+		run.epoch = phase0.Epoch(uint64(header.Header.Message.Slot) / run.result.Specs.SlotsPerEpoch)
+	}
+	return nil
+}
+
+func checkFinalityCheckpoints(ctx context.Context, run *suiteRun) error {
+	finality, err := run.client.GetFinalityCheckpoints(ctx)
+	if err != nil {
+		return err
+	}
+	if finality.Finalized == nil || finality.Justified == nil || finality.PreviousJustified == nil {
+		return fmt.Errorf("missing checkpoints")
+	}
+	if finality.Finalized.Epoch > finality.Justified.Epoch {
+		return fmt.Errorf("finalized epoch %v ahead of justified epoch %v", finality.Finalized.Epoch, finality.Justified.Epoch)
+	}
+
+	return nil
+}
+
+func checkHeaderBySlot(ctx context.Context, run *suiteRun) error {
+	if run.head == nil {
+		return errSkipped
+	}
+
+	header, err := run.client.GetBlockHeaderBySlot(ctx, run.head.Header.Message.Slot)
+	if err != nil {
+		return err
+	}
+
+	return compareHeaders(header, run.head)
+}
+
+func checkHeaderByRoot(ctx context.Context, run *suiteRun) error {
+	if run.head == nil {
+		return errSkipped
+	}
+
+	header, err := run.client.GetBlockHeaderByBlockroot(ctx, run.head.Root)
+	if err != nil {
+		return err
+	}
+
+	return compareHeaders(header, run.head)
+}
+
+func compareHeaders(header *v1.BeaconBlockHeader, expected *v1.BeaconBlockHeader) error {
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return fmt.Errorf("missing header message")
+	}
+	if header.Root != expected.Root {
+		return fmt.Errorf("root mismatch: %v != %v", header.Root.String(), expected.Root.String())
+	}
+	if header.Header.Message.ParentRoot != expected.Header.Message.ParentRoot {
+		return fmt.Errorf("parent root mismatch")
+	}
+
+	return nil
+}
+
+// checkBlockBody recomputes the block root from the decoded block (mainnet preset only), so missing or misdecoded fields change the root.
+func checkBlockBody(ctx context.Context, run *suiteRun) error {
+	if run.head == nil {
+		return errSkipped
+	}
+
+	block, err := run.client.GetBlockBodyByBlockroot(ctx, run.head.Root)
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		return fmt.Errorf("block not found")
+	}
+
+	slot, err := block.Slot()
+	if err != nil {
+		return fmt.Errorf("error getting block slot: %v", err)
+	}
+	if slot != run.head.Header.Message.Slot {
+		return fmt.Errorf("slot mismatch: %v != %v", slot, run.head.Header.Message.Slot)
+	}
+
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return fmt.Errorf("error getting block parent root: %v", err)
+	}
+	if parentRoot != run.head.Header.Message.ParentRoot {
+		return fmt.Errorf("parent root mismatch: %v != %v", parentRoot.String(), run.head.Header.Message.ParentRoot.String())
+	}
+
+	// the static ssz types only support the mainnet preset
+	if run.result.Specs != nil && run.result.Specs.PresetBase == "mainnet" {
+		root, err := block.Root()
+		if err != nil {
+			return fmt.Errorf("error computing block root: %v", err)
+		}
+		if root != run.head.Root {
+			return fmt.Errorf("block root mismatch (decoded %v block): %v != %v", block.Version.String(), root.String(), run.head.Root.String())
+		}
+	}
+
+	run.block = block
+	return nil
+}
+
+func checkBlobSidecars(ctx context.Context, run *suiteRun) error {
+	if run.block == nil || run.block.Version < spec.DataVersionDeneb {
+		return errSkipped
+	}
+
+	commitments, err := run.block.BlobKZGCommitments()
+	if err != nil {
+		return fmt.Errorf("error getting blob commitments: %v", err)
+	}
+
+	blobs, err := run.client.GetBlobSidecarsByBlockroot(ctx, run.head.Root[:])
+	if err != nil {
+		return err
+	}
+	if len(blobs) != len(commitments) {
+		return fmt.Errorf("blob count mismatch: %v sidecars for %v commitments", len(blobs), len(commitments))
+	}
+	for _, blob := range blobs {
+		if int(blob.Index) >= len(commitments) {
+			return fmt.Errorf("blob index %v out of range", blob.Index)
+		}
+		if !bytes.Equal(blob.KZGCommitment[:], commitments[blob.Index][:]) {
+			return fmt.Errorf("commitment mismatch for blob %v", blob.Index)
+		}
+	}
+
+	return nil
+}
+
+func checkForkState(ctx context.Context, run *suiteRun) error {
+	if run.head == nil {
+		return errSkipped
+	}
+
+	fork, err := run.client.GetForkState(ctx, run.head.Header.Message.StateRoot.String())
+	if err != nil {
+		return err
+	}
+	if fork.Epoch > run.epoch {
+		return fmt.Errorf("fork epoch %v ahead of head epoch %v", fork.Epoch, run.epoch)
+	}
+
+	return nil
+}
+
+func checkBeaconCommittees(ctx context.Context, run *suiteRun) error {
+	if run.head == nil || run.result.Specs == nil {
+		return errSkipped
+	}
+
+	committees, err := run.client.GetBeaconCommittees(ctx, run.head.Header.Message.StateRoot.String(), &run.epoch)
+	if err != nil {
+		return err
+	}
+	if uint64(len(committees)) < run.result.Specs.SlotsPerEpoch {
+		return fmt.Errorf("expected at least %v committees, got %v", run.result.Specs.SlotsPerEpoch, len(committees))
+	}
+	for _, committee := range committees {
+		if len(committee.Validators) == 0 {
+			return fmt.Errorf("empty committee at slot %v index %v", committee.Slot, committee.Index)
+		}
+	}
+
+	return nil
+}
+
+func checkBlockRewards(ctx context.Context, run *suiteRun) error {
+	if run.head == nil {
+		return errSkipped
+	}
+
+	rewards, err := run.client.GetBlockRewards(ctx, run.head.Root)
+	if err != nil {
+		return err
+	}
+	if rewards.ProposerIndex != run.head.Header.Message.ProposerIndex {
+		return fmt.Errorf("proposer index mismatch: %v != %v", rewards.ProposerIndex, run.head.Header.Message.ProposerIndex)
+	}
+
+	return nil
+}
+
+// checkBeaconState loads the full head state (only with the state option, as states are large).
+func checkBeaconState(ctx context.Context, run *suiteRun) error {
+	if run.head == nil || !run.options.WithState {
+		return errSkipped
+	}
+
+	state, err := run.client.GetState(ctx, run.head.Header.Message.StateRoot.String())
+	if err != nil {
+		return err
+	}
+
+	slot, err := state.Slot()
+	if err != nil {
+		return fmt.Errorf("error getting state slot: %v", err)
+	}
+	if slot != run.head.Header.Message.Slot {
+		return fmt.Errorf("slot mismatch: %v != %v", slot, run.head.Header.Message.Slot)
+	}
+
+	validators, err := state.Validators()
+	if err != nil {
+		return fmt.Errorf("error getting state validators: %v", err)
+	}
+	balances, err := state.ValidatorBalances()
+	if err != nil {
+		return fmt.Errorf("error getting state balances: %v", err)
+	}
+	if len(validators) == 0 || len(validators) != len(balances) {
+		return fmt.Errorf("invalid validator set: %v validators, %v balances", len(validators), len(balances))
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+)
+
+// fixtureDir is the directory with the committed synthetic client fixtures (relative to this package).
+const fixtureDir = "../../../../.hack/conformance/synthetic"
+
+var fixtureClients = []string{"grandine", "lighthouse", "lodestar", "nimbus", "prysm", "teku"}
+
+// fixtureForks are the fork versions and epochs of the network the synthetic fixtures are built from.
+var fixtureForks = []struct {
+	name    string
+	version func(specs *consensus.ChainSpec) phase0.Version
+	epoch   func(specs *consensus.ChainSpec) *uint64
+	expect  phase0.Version
+	atEpoch uint64
+}{
+	{"altair", func(s *consensus.ChainSpec) phase0.Version { return s.AltairForkVersion }, func(s *consensus.ChainSpec) *uint64 { return s.AltairForkEpoch }, phase0.Version{0x02, 0x01, 0x70, 0x00}, 0},
+	{"bellatrix", func(s *consensus.ChainSpec) phase0.Version { return s.BellatrixForkVersion }, func(s *consensus.ChainSpec) *uint64 { return s.BellatrixForkEpoch }, phase0.Version{0x03, 0x01, 0x70, 0x00}, 0},
+	{"capella", func(s *consensus.ChainSpec) phase0.Version { return s.CapellaForkVersion }, func(s *consensus.ChainSpec) *uint64 { return s.CapellaForkEpoch }, phase0.Version{0x04, 0x01, 0x70, 0x00}, 256},
+	{"deneb", func(s *consensus.ChainSpec) phase0.Version { return s.DenebForkVersion }, func(s *consensus.ChainSpec) *uint64 { return s.DenebForkEpoch }, phase0.Version{0x05, 0x01, 0x70, 0x00}, 29696},
+}
+
+func TestFixtures(t *testing.T) {
+	fixtures, err := LoadFixtures(fixtureDir)
+	if err != nil {
+		t.Fatalf("failed loading fixtures: %v", err)
+	}
+
+	clients := map[string]bool{}
+	for _, fixture := range fixtures {
+		clients[fixture.Client] = true
+		if !fixture.Synthetic {
+			t.Errorf("fixture for %v in %v is not marked as synthetic", fixture.Client, fixtureDir)
+		}
+	}
+	for _, client := range fixtureClients {
+		if !clients[client] {
+			t.Errorf("missing fixture for %v", client)
+		}
+	}
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	for _, fixture := range fixtures {
+		fixture := fixture
+		t.Run(fixture.Client, func(t *testing.T) {
+			replayer, err := NewReplayer(fixture)
+			if err != nil {
+				t.Fatalf("failed starting replayer: %v", err)
+			}
+			defer replayer.Close()
+
+			options := &SuiteOptions{
+				SSZ:       fixture.SSZ,
+				WithState: fixture.WithState,
+			}
+			result, err := RunSuite(context.Background(), fixture.Client, replayer.URL(), options, logger.WithField("client", fixture.Client))
+			if err != nil {
+				t.Fatalf("failed running suite: %v", err)
+			}
+
+			for _, check := range result.Checks {
+				if check.Err != nil && !check.Optional {
+					t.Errorf("check %v failed: %v", check.Name, check.Err)
+				}
+			}
+			for _, request := range replayer.Unmatched() {
+				t.Errorf("no recorded response for %v", request)
+			}
+			if result.NodeVersion != fixture.NodeVersion {
+				t.Errorf("node version mismatch: %v != %v", result.NodeVersion, fixture.NodeVersion)
+			}
+
+			if result.Specs == nil {
+				t.Fatalf("no parsed chain spec")
+			}
+			if result.Specs.GenesisForkVersion != (phase0.Version{0x01, 0x01, 0x70, 0x00}) {
+				t.Errorf("unexpected genesis fork version: %v", result.Specs.GenesisForkVersion)
+			}
+			for _, fork := range fixtureForks {
+				if version := fork.version(result.Specs); version != fork.expect {
+					t.Errorf("unexpected %v fork version: %v != %v", fork.name, version, fork.expect)
+				}
+				if epoch := fork.epoch(result.Specs); epoch == nil {
+					t.Errorf("missing %v fork epoch", fork.name)
+				} else if *epoch != fork.atEpoch {
+					t.Errorf("unexpected %v fork epoch: %v != %v", fork.name, *epoch, fork.atEpoch)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package rpc
+
+import (
+	"fmt"
+	"io"
+	"net"
+	nethttp "net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	rpcRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dora_beacon_rpc_requests_total",
+		Help: "Number of beacon api requests by client, endpoint and result",
+	}, []string{"client", "endpoint", "result"})
+	rpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dora_beacon_rpc_request_duration_seconds",
+		Help:    "Duration of beacon api requests by client and endpoint (including response body transfer)",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"client", "endpoint"})
+)
+
+// RPCEndpointStats holds the request statistics of a single beacon api endpoint.
+type RPCEndpointStats struct {
+	Endpoint      string
+	Requests      uint64
+	Errors        uint64
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+}
+
+// RPCStats holds the request statistics of a beacon client.
+type RPCStats struct {
+	Requests      uint64
+	Errors        uint64
+	TotalDuration time.Duration
+	LastError     error
+	LastErrorTime time.Time
+	LastErrorCall string
+	Endpoints     []*RPCEndpointStats
+}
+
+type rpcStatsTracker struct {
+	mutex         sync.Mutex
+	endpoints     map[string]*RPCEndpointStats
+	lastError     error
+	lastErrorTime time.Time
+	lastErrorCall string
+}
+
+// GetRPCStats returns a snapshot of the request statistics of the client.
+// endpoints are sorted by total request duration.
+func (bc *BeaconClient) GetRPCStats() *RPCStats {
+	tracker := &bc.rpcStats
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	stats := &RPCStats{
+		LastError:     tracker.lastError,
+		LastErrorTime: tracker.lastErrorTime,
+		LastErrorCall: tracker.lastErrorCall,
+		Endpoints:     make([]*RPCEndpointStats, 0, len(tracker.endpoints)),
+	}
+
+	for _, endpointStats := range tracker.endpoints {
+		endpointCopy := *endpointStats
+		stats.Endpoints = append(stats.Endpoints, &endpointCopy)
+		stats.Requests += endpointStats.Requests
+		stats.Errors += endpointStats.Errors
+		stats.TotalDuration += endpointStats.TotalDuration
+	}
+
+	sort.Slice(stats.Endpoints, func(a, b int) bool {
+		return stats.Endpoints[a].TotalDuration > stats.Endpoints[b].TotalDuration
+	})
+
+	return stats
+}
+
+func (bc *BeaconClient) trackRequest(method string, endpoint string, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	rpcRequestsCounter.WithLabelValues(bc.name, endpoint, result).Inc()
+	rpcRequestDuration.WithLabelValues(bc.name, endpoint).Observe(duration.Seconds())
+
+	tracker := &bc.rpcStats
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	if tracker.endpoints == nil {
+		tracker.endpoints = map[string]*RPCEndpointStats{}
+	}
+
+	endpointStats := tracker.endpoints[endpoint]
+	if endpointStats == nil {
+		endpointStats = &RPCEndpointStats{
+			Endpoint: endpoint,
+		}
+		tracker.endpoints[endpoint] = endpointStats
+	}
+
+	endpointStats.Requests++
+	endpointStats.TotalDuration += duration
+	if duration > endpointStats.MaxDuration {
+		endpointStats.MaxDuration = duration
+	}
+
+	if err != nil {
+		endpointStats.Errors++
+		tracker.lastError = err
+		tracker.lastErrorTime = time.Now()
+		tracker.lastErrorCall = fmt.Sprintf("%v %v", method, endpoint)
+	}
+}
+
+// metricsTransport records request counts, latencies and errors of all beacon api requests.
+type metricsTransport struct {
+	client *BeaconClient
+	next   nethttp.RoundTripper
+}
+
+// newBeaconTransport returns a transport configured like the default transport of go-eth2-client (with the 10 min client timeout as dial timeout),
+// which keeps more idle connections per host than nethttp.DefaultTransport.
+// the transport is shared by all requests of a client, so the connection pool is reused.
+func newBeaconTransport() *nethttp.Transport {
+	return &nethttp.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Minute,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:        64,
+		MaxConnsPerHost:     64,
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     600 * time.Second,
+	}
+}
+
+func (bc *BeaconClient) newMetricsTransport() nethttp.RoundTripper {
+	return &metricsTransport{
+		client: bc,
+		next:   bc.transport,
+	}
+}
+
+func (t *metricsTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	endpoint := getEndpointLabel(req.URL.Path)
+	start := time.Now()
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.client.trackRequest(req.Method, endpoint, time.Since(start), err)
+		return nil, err
+	}
+
+	// 404 responses are expected for missing blocks & states and not treated as failures
+	var respErr error
+	if resp.StatusCode >= 400 && resp.StatusCode != nethttp.StatusNotFound {
+		respErr = fmt.Errorf("http status %v", resp.StatusCode)
+	}
+
+	// track the request when the body is fully read, so large state downloads are measured completely
+	resp.Body = &metricsResponseBody{
+		ReadCloser: resp.Body,
+		onClose: func(bodyErr error) {
+			if respErr == nil {
+				respErr = bodyErr
+			}
+			t.client.trackRequest(req.Method, endpoint, time.Since(start), respErr)
+		},
+	}
+
+	return resp, nil
+}
+
+type metricsResponseBody struct {
+	io.ReadCloser
+	readErr error
+	once    sync.Once
+	onClose func(err error)
+}
+
+func (b *metricsResponseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		b.readErr = err
+	}
+	return n, err
+}
+
+func (b *metricsResponseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.onClose(b.readErr)
+	})
+	return err
+}
+
+// getEndpointLabel replaces dynamic path segments (block & state ids, roots, indices) with placeholders,
+// so requests are grouped by api endpoint.
+func getEndpointLabel(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if isDynamicPathSegment(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isDynamicPathSegment(segment string) bool {
+	switch segment {
+	case "head", "genesis", "finalized", "justified":
+		return true
+	}
+
+	if strings.HasPrefix(segment, "0x") {
+		return true
+	}
+
+	if segment == "" {
+		return false
+	}
+	for _, c := range segment {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
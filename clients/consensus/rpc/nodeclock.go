@@ -0,0 +1,94 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// NodeClock is a clock sample of a beacon node, taken from the node syncing status and the http Date header of the response.
+type NodeClock struct {
+	RequestTime  time.Time // local time before the request was sent
+	ResponseTime time.Time // local time after the response was received
+	NodeTime     time.Time // node time from the Date header (second precision), zero if the node did not send one
+	HeadSlot     phase0.Slot
+	SyncDistance phase0.Slot
+	IsSyncing    bool
+}
+
+// RoundTrip returns the duration of the request.
+func (clock *NodeClock) RoundTrip() time.Duration {
+	return clock.ResponseTime.Sub(clock.RequestTime)
+}
+
+// LocalTime returns the local time the node time corresponds to (the middle of the request).
+func (clock *NodeClock) LocalTime() time.Time {
+	return clock.RequestTime.Add(clock.RoundTrip() / 2)
+}
+
+// CurrentSlot returns the current slot of the node's slot clock (head slot + sync distance).
+func (clock *NodeClock) CurrentSlot() phase0.Slot {
+	return clock.HeadSlot + clock.SyncDistance
+}
+
+// GetNodeClock samples the clock of the node via the node syncing status.
+// the request is not sent via go-eth2-client, as the response headers are needed for the node time.
+func (bc *BeaconClient) GetNodeClock(ctx context.Context) (*NodeClock, error) {
+	req, err := nethttp.NewRequestWithContext(ctx, "GET", bc.getEndpointURL(apiEndpointNodeSyncing), nethttp.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for headerKey, headerVal := range bc.headers {
+		req.Header.Set(headerKey, headerVal)
+	}
+
+	client := &nethttp.Client{Timeout: time.Second * 30, Transport: bc.newMetricsTransport()}
+	clock := &NodeClock{
+		RequestTime: time.Now(),
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	clock.ResponseTime = time.Now()
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != nethttp.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{StatusCode: resp.StatusCode, URL: getRedactedURL(req.URL.String()), Response: data}
+	}
+
+	if dateHeader := resp.Header.Get("Date"); dateHeader != "" {
+		if nodeTime, err := nethttp.ParseTime(dateHeader); err == nil {
+			clock.NodeTime = nodeTime
+		}
+	}
+
+	var syncResponse struct {
+		Data *v1.SyncState `json:"data"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&syncResponse); err != nil {
+		return nil, fmt.Errorf("error parsing json response: %v", err)
+	}
+
+	if syncResponse.Data == nil {
+		return nil, fmt.Errorf("empty node syncing response")
+	}
+
+	clock.HeadSlot = syncResponse.Data.HeadSlot
+	clock.SyncDistance = syncResponse.Data.SyncDistance
+	clock.IsSyncing = syncResponse.Data.IsSyncing
+
+	return clock, nil
+}
@@ -0,0 +1,44 @@
+package consensus
+
+import "testing"
+
+func TestDispatcherUnsubscribe(t *testing.T) {
+	dispatcher := &Dispatcher[int]{}
+	sub1 := dispatcher.Subscribe(1, false)
+	sub2 := dispatcher.Subscribe(1, false)
+
+	sub1.Unsubscribe()
+	if len(dispatcher.subscriptions) != 1 || dispatcher.subscriptions[0] != sub2 {
+		t.Fatalf("expected only the second subscription to remain, got %v subscriptions", len(dispatcher.subscriptions))
+	}
+	if sub1.dispatcher != nil {
+		t.Errorf("expected unsubscribed subscription to be detached from the dispatcher")
+	}
+
+	dispatcher.Fire(1)
+	select {
+	case <-sub1.Channel():
+		t.Errorf("unsubscribed subscription received an event")
+	default:
+	}
+	select {
+	case value := <-sub2.Channel():
+		if value != 1 {
+			t.Errorf("expected event 1, got %v", value)
+		}
+	default:
+		t.Errorf("subscription did not receive the event")
+	}
+
+	// unsubscribing twice must be a no-op
+	sub1.Unsubscribe()
+	dispatcher.Unsubscribe(sub1)
+	if len(dispatcher.subscriptions) != 1 {
+		t.Errorf("expected 1 subscription after double unsubscribe, got %v", len(dispatcher.subscriptions))
+	}
+
+	sub2.Unsubscribe()
+	if len(dispatcher.subscriptions) != 0 {
+		t.Errorf("expected no subscriptions, got %v", len(dispatcher.subscriptions))
+	}
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/consensus/rpc/conformance"
+)
+
+type headerFlags map[string]string
+
+func (h headerFlags) String() string {
+	return fmt.Sprintf("%v", map[string]string(h))
+}
+
+func (h headerFlags) Set(value string) error {
+	key, val, found := strings.Cut(value, ":")
+	if !found {
+		return fmt.Errorf("invalid header %q, expected 'Key: Value'", value)
+	}
+	h[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	return nil
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	fixtureDir := flags.String("dir", "", "Path to the fixture directory")
+	endpoint := flags.String("endpoint", "", "Beacon node endpoint to record from")
+	clientName := flags.String("client", "", "Client name (fixture file name), defaults to the client type of the node version")
+	withState := flags.Bool("state", false, "Record & verify the full head state")
+	useSSZ := flags.Bool("ssz", false, "Allow ssz encoded responses (json is enforced by default)")
+	verbose := flags.Bool("verbose", false, "Show debug output")
+	headers := headerFlags{}
+	flags.Var(headers, "header", "Extra request header for the beacon node ('Key: Value', repeatable)")
+
+	switch command {
+	case "record", "verify":
+		flags.Parse(os.Args[2:])
+	default:
+		printUsage()
+		os.Exit(1)
+	}
+
+	if *fixtureDir == "" {
+		logrus.Fatalf("missing fixture directory (-dir)")
+	}
+	if *verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "record":
+		if *endpoint == "" {
+			logrus.Fatalf("missing beacon node endpoint (-endpoint)")
+		}
+		err := recordFixture(ctx, *fixtureDir, *endpoint, *clientName, headers, *withState, *useSSZ)
+		if err != nil {
+			logrus.Fatalf("record failed: %v", err)
+		}
+	case "verify":
+		failed, err := verifyFixtures(ctx, *fixtureDir, *clientName)
+		if err != nil {
+			logrus.Fatalf("verify failed: %v", err)
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+	}
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: %v <command> [options]\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	fmt.Fprintf(os.Stderr, "  record   run the conformance suite against a live beacon node and record the responses as fixture\n")
+	fmt.Fprintf(os.Stderr, "  verify   run the conformance suite against all recorded fixtures (exits with 1 on failures)\n\n")
+	fmt.Fprintf(os.Stderr, "Options:\n")
+	fmt.Fprintf(os.Stderr, "  -dir       path to the fixture directory\n")
+	fmt.Fprintf(os.Stderr, "  -endpoint  beacon node endpoint to record from\n")
+	fmt.Fprintf(os.Stderr, "  -client    client name (fixture file name on record, fixture filter on verify)\n")
+	fmt.Fprintf(os.Stderr, "  -header    extra request header for the beacon node, not stored in the fixture (repeatable)\n")
+	fmt.Fprintf(os.Stderr, "  -state     record & verify the full head state\n")
+	fmt.Fprintf(os.Stderr, "  -ssz       allow ssz encoded responses\n")
+	fmt.Fprintf(os.Stderr, "  -verbose   show debug output\n")
+}
+
+func recordFixture(ctx context.Context, fixtureDir string, endpoint string, clientName string, headers map[string]string, withState bool, useSSZ bool) error {
+	recorder, err := conformance.NewRecorder(endpoint)
+	if err != nil {
+		return err
+	}
+	defer recorder.Close()
+
+	options := &conformance.SuiteOptions{
+		SSZ:       useSSZ,
+		WithState: withState,
+		Headers:   headers,
+	}
+	result, err := conformance.RunSuite(ctx, "record", recorder.URL(), options, logrus.WithField("client", "record"))
+	if err != nil {
+		return err
+	}
+
+	if clientName == "" {
+		clientType := consensus.ParseClientTypeFromVersion(result.NodeVersion)
+		if clientType == consensus.UnknownClient {
+			return fmt.Errorf("unknown client type for node version %q, use -client to set a name", result.NodeVersion)
+		}
+		clientName = clientType.String()
+	}
+
+	printResult(clientName, result)
+
+	fixture := &conformance.Fixture{
+		Client:      clientName,
+		NodeVersion: result.NodeVersion,
+		RecordedAt:  time.Now().UTC(),
+		SSZ:         useSSZ,
+		WithState:   withState,
+		Responses:   recorder.Responses(),
+	}
+	fixturePath := filepath.Join(fixtureDir, fmt.Sprintf("%v.json", clientName))
+	if err := fixture.Save(fixturePath); err != nil {
+		return err
+	}
+
+	logrus.Infof("recorded %v responses to %v", len(fixture.Responses), fixturePath)
+	if result.Failed() > 0 {
+		logrus.Warnf("%v checks failed against the live node, the fixture reproduces these failures", result.Failed())
+	}
+
+	return nil
+}
+
+func verifyFixtures(ctx context.Context, fixtureDir string, clientFilter string) (int, error) {
+	fixtures, err := conformance.LoadFixtures(fixtureDir)
+	if err != nil {
+		return 0, err
+	}
+	if len(fixtures) == 0 {
+		return 0, fmt.Errorf("no fixtures found in %v", fixtureDir)
+	}
+
+	failed := 0
+	results := map[string]*conformance.SuiteResult{}
+	for _, fixture := range fixtures {
+		if clientFilter != "" && fixture.Client != clientFilter {
+			continue
+		}
+
+		result, unmatched, err := verifyFixture(ctx, fixture)
+		if err != nil {
+			logrus.Errorf("%v: %v", fixture.Client, err)
+			failed++
+			continue
+		}
+
+		printResult(fmt.Sprintf("%v (%v)", fixture.Client, fixture.NodeVersion), result)
+		for _, request := range unmatched {
+			fmt.Printf("  warning: no recorded response for %v (fixture outdated?)\n", request)
+		}
+
+		failed += result.Failed()
+		results[fixture.Client] = result
+	}
+
+	failed += compareSpecs(results)
+
+	fmt.Printf("\n%v fixtures verified, %v failures\n", len(results), failed)
+	return failed, nil
+}
+
+func verifyFixture(ctx context.Context, fixture *conformance.Fixture) (*conformance.SuiteResult, []string, error) {
+	replayer, err := conformance.NewReplayer(fixture)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer replayer.Close()
+
+	options := &conformance.SuiteOptions{
+		SSZ:       fixture.SSZ,
+		WithState: fixture.WithState,
+	}
+	result, err := conformance.RunSuite(ctx, fixture.Client, replayer.URL(), options, logrus.WithField("client", fixture.Client))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, replayer.Unmatched(), nil
+}
+
+// compareSpecs reports spec divergences between fixtures recorded from the same network.
+func compareSpecs(results map[string]*conformance.SuiteResult) int {
+	failed := 0
+	compared := map[string]bool{}
+	for clientA, resultA := range results {
+		for clientB, resultB := range results {
+			if clientA == clientB || compared[clientB+"/"+clientA] || resultA.Specs == nil || resultB.Specs == nil {
+				continue
+			}
+			if resultA.GenesisRoot != resultB.GenesisRoot {
+				continue
+			}
+			compared[clientA+"/"+clientB] = true
+
+			mismatches, err := resultA.Specs.CheckMismatch(resultB.Specs)
+			if err != nil {
+				fmt.Printf("spec comparison %v <> %v failed: %v\n", clientA, clientB, err)
+				failed++
+			} else if len(mismatches) > 0 {
+				fmt.Printf("spec mismatch %v <> %v: %v\n", clientA, clientB, strings.Join(mismatches, ", "))
+				failed++
+			}
+		}
+	}
+
+	return failed
+}
+
+func printResult(title string, result *conformance.SuiteResult) {
+	fmt.Printf("\n%v\n", title)
+	for _, check := range result.Checks {
+		status := "ok"
+		switch {
+		case check.Skipped:
+			status = "skipped"
+		case check.Err != nil && check.Optional:
+			status = fmt.Sprintf("warning: %v", check.Err)
+		case check.Err != nil:
+			status = fmt.Sprintf("FAILED: %v", check.Err)
+		}
+		fmt.Printf("  %-22v %8v  %v\n", check.Name, check.Duration.Round(time.Millisecond), status)
+	}
+}
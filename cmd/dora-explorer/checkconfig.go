@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	crpc "github.com/ethpandaops/dora/clients/consensus/rpc"
+	erpc "github.com/ethpandaops/dora/clients/execution/rpc"
+	"github.com/ethpandaops/dora/clients/sshtunnel"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const checkConfigProbeTimeout = 30 * time.Second
+
+// configCheck collects the results of the --check-config dry run.
+type configCheck struct {
+	ctx      context.Context
+	logger   logrus.FieldLogger
+	errors   int
+	warnings int
+}
+
+// runConfigCheck validates the loaded config, probes all configured endpoints and the database
+// and prints the derived chain spec. returns false if any fatal problem was found.
+func runConfigCheck(ctx context.Context, cfg *types.Config, configPath string) bool {
+	// rpc clients log connection issues on their own, the check reports them instead
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	check := &configCheck{
+		ctx:    ctx,
+		logger: logger,
+	}
+
+	fmt.Printf("checking config %v\n", configPathName(configPath))
+
+	fmt.Printf("\nconfig:\n")
+	for _, issue := range utils.ValidateConfig(cfg, configPath) {
+		if issue.Fatal {
+			check.fail("%v", issue.Message)
+		} else {
+			check.warn("%v", issue.Message)
+		}
+	}
+
+	fmt.Printf("\nconsensus endpoints:\n")
+	var chainSpec *consensus.ChainSpec
+	var genesisTime time.Time
+	for _, endpoint := range cfg.BeaconApi.Endpoints {
+		endpointSpec, endpointGenesis := check.probeBeaconEndpoint(endpoint, cfg.KillSwitch.DisableSSZRequests)
+		if endpointSpec == nil {
+			continue
+		}
+		if chainSpec == nil {
+			chainSpec = endpointSpec
+			genesisTime = endpointGenesis
+			continue
+		}
+
+		mismatches, err := chainSpec.CheckMismatch(endpointSpec)
+		if err != nil {
+			check.fail("%v: error comparing chain specs: %v", endpoint.Name, err)
+		} else if len(mismatches) > 0 {
+			check.fail("%v: chain spec mismatch: %v", endpoint.Name, mismatches)
+		}
+		if !endpointGenesis.Equal(genesisTime) {
+			check.fail("%v: genesis time mismatch: %v != %v", endpoint.Name, endpointGenesis, genesisTime)
+		}
+	}
+	if chainSpec == nil {
+		check.fail("no consensus endpoint is reachable")
+	}
+
+	fmt.Printf("\nexecution endpoints:\n")
+	for _, endpoint := range cfg.ExecutionApi.Endpoints {
+		chainId := check.probeExecutionEndpoint(endpoint)
+		if chainId != "" && chainSpec != nil && chainId != fmt.Sprintf("%v", chainSpec.DepositChainId) {
+			check.fail("%v: chain id %v does not match the deposit chain id %v", endpoint.Name, chainId, chainSpec.DepositChainId)
+		}
+	}
+
+	fmt.Printf("\ndatabase:\n")
+	if err := db.CheckDbConnection(); err != nil {
+		check.fail("%v: %v", cfg.Database.Engine, err)
+	} else {
+		check.ok("%v: connected", cfg.Database.Engine)
+	}
+
+	if chainSpec != nil {
+		printChainSpec(chainSpec, genesisTime)
+	}
+
+	fmt.Printf("\n%v errors, %v warnings\n", check.errors, check.warnings)
+	return check.errors == 0
+}
+
+func configPathName(configPath string) string {
+	if configPath == "" {
+		return "(embedded default config)"
+	}
+	return configPath
+}
+
+func (check *configCheck) ok(format string, args ...interface{}) {
+	fmt.Printf("  [ok]    %v\n", fmt.Sprintf(format, args...))
+}
+
+func (check *configCheck) warn(format string, args ...interface{}) {
+	check.warnings++
+	fmt.Printf("  [warn]  %v\n", fmt.Sprintf(format, args...))
+}
+
+func (check *configCheck) fail(format string, args ...interface{}) {
+	check.errors++
+	fmt.Printf("  [error] %v\n", fmt.Sprintf(format, args...))
+}
+
+// resolveEndpoint checks that the endpoint host resolves, endpoints behind ssh tunnels are resolved on the remote host.
+func (check *configCheck) resolveEndpoint(endpoint types.EndpointConfig) bool {
+	if endpoint.Ssh != nil {
+		return true
+	}
+
+	endpointUrl, err := url.Parse(endpoint.Url)
+	if err != nil {
+		check.fail("%v: invalid url: %v", endpoint.Name, err)
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(check.ctx, 10*time.Second)
+	defer cancel()
+
+	if _, err := net.DefaultResolver.LookupHost(ctx, endpointUrl.Hostname()); err != nil {
+		check.fail("%v: could not resolve %v: %v", endpoint.Name, endpointUrl.Hostname(), err)
+		return false
+	}
+	return true
+}
+
+func getEndpointSshConfig(endpoint types.EndpointConfig) *sshtunnel.SshConfig {
+	if endpoint.Ssh == nil {
+		return nil
+	}
+	return &sshtunnel.SshConfig{
+		Host:     endpoint.Ssh.Host,
+		Port:     endpoint.Ssh.Port,
+		User:     endpoint.Ssh.User,
+		Password: endpoint.Ssh.Password,
+		Keyfile:  endpoint.Ssh.Keyfile,
+	}
+}
+
+// probeBeaconEndpoint connects to a beacon node and returns its chain spec and genesis time.
+func (check *configCheck) probeBeaconEndpoint(endpoint types.EndpointConfig, disableSSZ bool) (*consensus.ChainSpec, time.Time) {
+	if !check.resolveEndpoint(endpoint) {
+		return nil, time.Time{}
+	}
+
+	client, err := crpc.NewBeaconClient(endpoint.Name, endpoint.Url, endpoint.Headers, getEndpointSshConfig(endpoint), disableSSZ, check.logger)
+	if err != nil {
+		check.fail("%v: %v", endpoint.Name, err)
+		return nil, time.Time{}
+	}
+
+	ctx, cancel := context.WithTimeout(check.ctx, checkConfigProbeTimeout)
+	defer cancel()
+
+	if err := client.Initialize(ctx); err != nil {
+		check.fail("%v: could not connect: %v", endpoint.Name, err)
+		return nil, time.Time{}
+	}
+
+	version, err := client.GetNodeVersion(ctx)
+	if err != nil {
+		check.fail("%v: error fetching node version: %v", endpoint.Name, err)
+		return nil, time.Time{}
+	}
+
+	genesis, err := client.GetGenesis(ctx)
+	if err != nil {
+		check.fail("%v: error fetching genesis: %v", endpoint.Name, err)
+		return nil, time.Time{}
+	}
+
+	specValues, err := client.GetConfigSpecs(ctx)
+	if err != nil {
+		check.fail("%v: error fetching chain specs: %v", endpoint.Name, err)
+		return nil, time.Time{}
+	}
+	chainSpec, err := consensus.ParseChainSpec(specValues)
+	if err != nil {
+		check.fail("%v: invalid chain specs: %v", endpoint.Name, err)
+		return nil, time.Time{}
+	}
+
+	syncState, err := client.GetNodeSyncing(ctx)
+	if err != nil {
+		check.warn("%v: error fetching sync status: %v", endpoint.Name, err)
+	} else if syncState.IsSyncing {
+		check.warn("%v: %v, synchronizing (head slot %v, distance %v)", endpoint.Name, version, syncState.HeadSlot, syncState.SyncDistance)
+	} else {
+		check.ok("%v: %v, synchronized (head slot %v)", endpoint.Name, version, syncState.HeadSlot)
+	}
+
+	return chainSpec, genesis.GenesisTime
+}
+
+// probeExecutionEndpoint connects to an execution node and returns its chain id.
+func (check *configCheck) probeExecutionEndpoint(endpoint types.EndpointConfig) string {
+	if !check.resolveEndpoint(endpoint) {
+		return ""
+	}
+
+	client, err := erpc.NewExecutionClient(endpoint.Name, endpoint.Url, endpoint.Headers, getEndpointSshConfig(endpoint), check.logger)
+	if err != nil {
+		check.fail("%v: %v", endpoint.Name, err)
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(check.ctx, checkConfigProbeTimeout)
+	defer cancel()
+
+	if err := client.Initialize(ctx); err != nil {
+		check.fail("%v: could not connect: %v", endpoint.Name, err)
+		return ""
+	}
+
+	version, err := client.GetClientVersion(ctx)
+	if err != nil {
+		check.fail("%v: error fetching client version: %v", endpoint.Name, err)
+		return ""
+	}
+
+	chainSpec, err := client.GetChainSpec(ctx)
+	if err != nil {
+		check.fail("%v: error fetching chain id: %v", endpoint.Name, err)
+		return ""
+	}
+
+	syncStatus, err := client.GetNodeSyncing(ctx)
+	if err != nil {
+		check.warn("%v: error fetching sync status: %v", endpoint.Name, err)
+	} else if syncStatus.IsSyncing {
+		check.warn("%v: %v, synchronizing (block %v of %v)", endpoint.Name, version, syncStatus.CurrentBlock, syncStatus.HighestBlock)
+	} else {
+		check.ok("%v: %v, synchronized (chain id %v)", endpoint.Name, version, chainSpec.ChainID)
+	}
+
+	return chainSpec.ChainID
+}
+
+func printChainSpec(chainSpec *consensus.ChainSpec, genesisTime time.Time) {
+	fmt.Printf("\nchain spec:\n")
+	fmt.Printf("  config name:        %v\n", chainSpec.ConfigName)
+	fmt.Printf("  preset:             %v\n", chainSpec.PresetBase)
+	fmt.Printf("  genesis time:       %v\n", genesisTime.UTC())
+	fmt.Printf("  genesis version:    %#x\n", chainSpec.GenesisForkVersion)
+	fmt.Printf("  seconds per slot:   %v\n", chainSpec.SecondsPerSlot)
+	fmt.Printf("  slots per epoch:    %v\n", chainSpec.SlotsPerEpoch)
+	fmt.Printf("  deposit chain id:   %v\n", chainSpec.DepositChainId)
+	fmt.Printf("  deposit contract:   %#x\n", chainSpec.DepositContractAddress)
+
+	forks := []struct {
+		name    string
+		epoch   *uint64
+		version []byte
+	}{
+		{"altair", chainSpec.AltairForkEpoch, chainSpec.AltairForkVersion[:]},
+		{"bellatrix", chainSpec.BellatrixForkEpoch, chainSpec.BellatrixForkVersion[:]},
+		{"capella", chainSpec.CapellaForkEpoch, chainSpec.CapellaForkVersion[:]},
+		{"deneb", chainSpec.DenebForkEpoch, chainSpec.DenebForkVersion[:]},
+		{"electra", chainSpec.ElectraForkEpoch, chainSpec.ElectraForkVersion[:]},
+		{"eip7594", chainSpec.Eip7594ForkEpoch, chainSpec.Eip7594ForkVersion[:]},
+	}
+	for _, fork := range forks {
+		if fork.epoch == nil || *fork.epoch == ^uint64(0) {
+			fmt.Printf("  %-19v not scheduled\n", fork.name+":")
+			continue
+		}
+		fmt.Printf("  %-19v epoch %v (%#x)\n", fork.name+":", *fork.epoch, fork.version)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	_ "net/http/pprof"
+	"os"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -15,6 +16,8 @@ import (
 
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/handlers"
+	"github.com/ethpandaops/dora/handlers/api"
+	"github.com/ethpandaops/dora/plugins"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/static"
 	"github.com/ethpandaops/dora/types"
@@ -24,6 +27,7 @@ import (
 
 func main() {
 	configPath := flag.String("config", "", "Path to the config file, if empty string defaults will be used")
+	checkConfig := flag.Bool("check-config", false, "Validate the config, probe the configured endpoints and database, print the chain spec and exit")
 	flag.Parse()
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -35,6 +39,14 @@ func main() {
 		logrus.Fatalf("error reading config file: %v", err)
 	}
 	utils.Config = cfg
+
+	if *checkConfig {
+		if !runConfigCheck(ctx, cfg, *configPath) {
+			os.Exit(1)
+		}
+		return
+	}
+
 	logWriter, logger := utils.InitLogger()
 	defer logWriter.Dispose()
 
@@ -54,6 +66,11 @@ func main() {
 
 	var webserver *http.Server
 	if cfg.Frontend.Enabled {
+		err = services.InitShareTokenSecret()
+		if err != nil {
+			logger.Fatalf("error initializing share token secret: %v", err)
+		}
+
 		websrv, err := startWebserver(logger)
 		if err != nil {
 			logger.Fatalf("error starting webserver: %v", err)
@@ -64,6 +81,16 @@ func main() {
 		if err != nil {
 			logger.Fatalf("error starting frontend cache service: %v", err)
 		}
+
+		err = services.StartThemeService()
+		if err != nil {
+			logger.Fatalf("error starting theme service: %v", err)
+		}
+
+		err = services.StartGraffitiFilter()
+		if err != nil {
+			logger.Fatalf("error starting graffiti filter: %v", err)
+		}
 	}
 
 	err = services.GlobalBeaconService.StartService()
@@ -71,6 +98,18 @@ func main() {
 		logger.Fatalf("error starting beacon service: %v", err)
 	}
 
+	if cfg.Frontend.Enabled {
+		err = services.StartIndexSummaryService()
+		if err != nil {
+			logger.Fatalf("error starting index summary service: %v", err)
+		}
+
+		err = services.StartHeadVoteReportService()
+		if err != nil {
+			logger.Fatalf("error starting head vote report service: %v", err)
+		}
+	}
+
 	err = services.StartTxSignaturesService()
 	if err != nil {
 		logger.Fatalf("error starting tx signature service: %v", err)
@@ -83,6 +122,20 @@ func main() {
 		}
 	}
 
+	if cfg.Metrics.Enabled {
+		err = services.StartMetricsServer(logger)
+		if err != nil {
+			logger.Fatalf("error starting metrics server: %v", err)
+		}
+	}
+
+	if cfg.Metrics.Enabled || cfg.Frontend.Pprof {
+		err = services.StartDbStatsService()
+		if err != nil {
+			logger.Fatalf("error starting db stats service: %v", err)
+		}
+	}
+
 	if webserver != nil {
 		startFrontend(webserver)
 	}
@@ -147,24 +200,51 @@ func startFrontend(webserver *http.Server) {
 	router.HandleFunc("/clients/consensus", handlers.ClientsCL).Methods("GET")
 	router.HandleFunc("/clients/execution", handlers.ClientsEl).Methods("GET")
 	router.HandleFunc("/forks", handlers.Forks).Methods("GET")
+	router.HandleFunc("/network", handlers.Network).Methods("GET")
 	router.HandleFunc("/epochs", handlers.Epochs).Methods("GET")
+	router.HandleFunc("/epochs/{from:[0-9]+}-{to:[0-9]+}", handlers.EpochsRange).Methods("GET")
 	router.HandleFunc("/epoch/{epoch}", handlers.Epoch).Methods("GET")
 	router.HandleFunc("/slots", handlers.Slots).Methods("GET")
 	router.HandleFunc("/slots/filtered", handlers.SlotsFiltered).Methods("GET")
+	router.HandleFunc("/slots/sizes", handlers.BlockSizes).Methods("GET")
+	router.HandleFunc("/slots/tx_types", handlers.TxTypes).Methods("GET")
+	router.HandleFunc("/slots/fees", handlers.BlockFees).Methods("GET")
+	router.HandleFunc("/slots/orphans", handlers.OrphanRates).Methods("GET")
+	router.HandleFunc("/graffiti", handlers.Graffiti).Methods("GET")
+	router.HandleFunc("/graffiti/wall", handlers.GraffitiWall).Methods("GET")
+	router.HandleFunc("/slots/{from:[0-9]+}-{to:[0-9]+}", handlers.SlotsRange).Methods("GET")
 	router.HandleFunc("/slot/{slotOrHash}", handlers.Slot).Methods("GET")
 	router.HandleFunc("/slot/{root}/blob/{commitment}", handlers.SlotBlob).Methods("GET")
+	router.HandleFunc("/slot/{root}/raw", handlers.SlotRaw).Methods("GET")
+	router.HandleFunc("/slot/{root}/attestations", handlers.SlotAttestations).Methods("GET")
+	router.HandleFunc("/slot/{root}/blobs", handlers.SlotBlobs).Methods("GET")
 	router.HandleFunc("/mev/blocks", handlers.MevBlocks).Methods("GET")
 
 	router.HandleFunc("/search", handlers.Search).Methods("GET")
 	router.HandleFunc("/search/{type}", handlers.SearchAhead).Methods("GET")
 	router.HandleFunc("/validators", handlers.Validators).Methods("GET")
+	router.HandleFunc("/dashboard", handlers.Dashboard).Methods("GET")
+	router.HandleFunc("/dashboard/{token}", handlers.SharedDashboard).Methods("GET")
 	router.HandleFunc("/validators/activity", handlers.ValidatorsActivity).Methods("GET")
+	router.HandleFunc("/validators/client_performance", handlers.ValidatorsClientPerformance).Methods("GET")
+	router.HandleFunc("/validators/head_votes", handlers.ValidatorsHeadVotes).Methods("GET")
+	router.HandleFunc("/validators/inclusion_distance", handlers.InclusionDistance).Methods("GET")
+	router.HandleFunc("/validators/blob_inclusion", handlers.BlobInclusion).Methods("GET")
+	router.HandleFunc("/validators/set_growth", handlers.ValidatorsSetGrowth).Methods("GET")
+	router.HandleFunc("/validators/balances", handlers.ValidatorsBalances).Methods("GET")
+	router.HandleFunc("/validators/withdrawal_throughput", handlers.WithdrawalThroughput).Methods("GET")
+	router.HandleFunc("/validators/rewards", handlers.EpochRewards).Methods("GET")
+	router.HandleFunc("/validators/penalties", handlers.InactivityLeaks).Methods("GET")
 	router.HandleFunc("/validators/deposits", handlers.Deposits).Methods("GET")
 	router.HandleFunc("/validators/deposits/submit", handlers.SubmitDeposit).Methods("GET", "POST")
 	router.HandleFunc("/validators/initiated_deposits", handlers.InitiatedDeposits).Methods("GET")
+	router.HandleFunc("/validators/awaiting_deposits", handlers.AwaitingDeposits).Methods("GET")
 	router.HandleFunc("/validators/included_deposits", handlers.IncludedDeposits).Methods("GET")
 	router.HandleFunc("/validators/voluntary_exits", handlers.VoluntaryExits).Methods("GET")
 	router.HandleFunc("/validators/slashings", handlers.Slashings).Methods("GET")
+	router.HandleFunc("/validators/whistleblowers", handlers.Whistleblowers).Methods("GET")
+	router.HandleFunc("/validators/events", handlers.ValidatorEvents).Methods("GET")
+	router.HandleFunc("/validators/names", handlers.ValidatorNames).Methods("GET")
 	router.HandleFunc("/validators/el_withdrawals", handlers.ElWithdrawals).Methods("GET")
 	router.HandleFunc("/validators/el_consolidations", handlers.ElConsolidations).Methods("GET")
 	router.HandleFunc("/validators/submit_consolidations", handlers.SubmitConsolidation).Methods("GET")
@@ -172,10 +252,50 @@ func startFrontend(webserver *http.Server) {
 	router.HandleFunc("/validator/{idxOrPubKey}", handlers.Validator).Methods("GET")
 	router.HandleFunc("/validator/{index}/slots", handlers.ValidatorSlots).Methods("GET")
 
+	// json api, admin endpoints are registered on a separate router without CORS headers
+	adminRouter := router.PathPrefix("/api/v1").Subrouter()
+	adminRouter.HandleFunc("/slot/{root:0x[0-9a-fA-F]{64}}/reindex", api.Handler(5, api.AdminOnly(api.ReindexSlot))).Methods("POST")
+	adminRouter.HandleFunc("/annotations", api.Handler(1, api.AdminOnly(api.CreateAnnotation))).Methods("POST")
+	adminRouter.HandleFunc("/annotations/{id:[0-9]+}", api.Handler(1, api.AdminOnly(api.DeleteAnnotation))).Methods("DELETE")
+	adminRouter.HandleFunc("/validator_names", api.Handler(1, api.AdminOnly(api.SetValidatorName))).Methods("POST")
+	adminRouter.HandleFunc("/validator_names/{key}", api.Handler(1, api.AdminOnly(api.DeleteValidatorName))).Methods("DELETE")
+	adminRouter.HandleFunc("/test_runs", api.Handler(1, api.AdminOnly(api.RegisterTestRun))).Methods("POST")
+	adminRouter.HandleFunc("/test_runs/{id:[0-9]+}", api.Handler(1, api.AdminOnly(api.UpdateTestRun))).Methods("PUT")
+
+	apiRouter := router.PathPrefix("/api/v1").Subrouter()
+	apiRouter.Use(api.CorsMiddleware(adminRouter))
+	apiRouter.HandleFunc("/slots", api.Handler(1, api.GetSlots)).Methods("GET")
+	apiRouter.HandleFunc("/slot/{slotOrRoot}", api.Handler(1, api.GetSlot)).Methods("GET")
+	apiRouter.HandleFunc("/blocktree", api.Handler(2, api.GetBlockTree)).Methods("GET")
+	apiRouter.HandleFunc("/blocktree.dot", api.GetBlockTreeDot).Methods("GET")
+	apiRouter.HandleFunc("/epoch/{epoch}", api.Handler(1, api.GetEpoch)).Methods("GET")
+	apiRouter.HandleFunc("/epoch/{epoch}/duties", api.Handler(2, api.GetEpochDuties)).Methods("GET")
+	apiRouter.HandleFunc("/epoch/{epoch}/slots", api.Handler(1, api.GetEpochSlots)).Methods("GET")
+	apiRouter.HandleFunc("/validator/{indexOrPubkey}", api.Handler(1, api.GetValidator)).Methods("GET")
+	apiRouter.HandleFunc("/validator/{index:[0-9]+}/badge.svg", api.GetValidatorBadge).Methods("GET")
+	apiRouter.HandleFunc("/validator/{index:[0-9]+}/exit_estimation", api.Handler(1, api.GetValidatorExitEstimation)).Methods("GET")
+	apiRouter.HandleFunc("/validators/status", api.Handler(2, api.GetValidatorsStatus)).Methods("POST")
+	apiRouter.HandleFunc("/validators/diff", api.Handler(5, api.GetValidatorsDiff)).Methods("GET")
+	apiRouter.HandleFunc("/dashboard", api.Handler(5, api.GetDashboard)).Methods("GET")
+	apiRouter.HandleFunc("/blob_inclusion", api.Handler(2, api.GetBlobInclusion)).Methods("GET")
+	apiRouter.HandleFunc("/search", api.Handler(2, api.GetSearch)).Methods("GET")
+	apiRouter.HandleFunc("/events", api.Handler(2, api.GetEvents)).Methods("GET")
+	apiRouter.HandleFunc("/ws", api.WebSocket).Methods("GET")
+	apiRouter.HandleFunc("/annotations", api.Handler(1, api.GetAnnotations)).Methods("GET")
+	apiRouter.HandleFunc("/validator_names", api.Handler(2, api.GetValidatorNames)).Methods("GET")
+	apiRouter.HandleFunc("/test_runs", api.Handler(1, api.GetTestRuns)).Methods("GET")
+
+	// plugin routes
+	plugins.RegisterRoutes(router, apiRouter)
+
+	apiRouter.PathPrefix("/").HandlerFunc(api.NotFound)
+
 	if utils.Config.Frontend.Pprof {
 		// add pprof handler
 		router.PathPrefix("/debug/pprof/").Handler(http.DefaultServeMux)
 		router.HandleFunc("/debug/cache", handlers.DebugCache).Methods("GET")
+		router.HandleFunc("/debug/database", handlers.DebugDatabase).Methods("GET")
+		router.HandleFunc("/debug/integrity", handlers.DebugIntegrity).Methods("GET")
 	}
 
 	if utils.Config.Frontend.Debug {
@@ -206,7 +326,7 @@ func startFrontend(webserver *http.Server) {
 	n := negroni.New()
 	n.Use(negroni.NewRecovery())
 	//n.Use(gzip.Gzip(gzip.DefaultCompression))
-	n.UseHandler(router)
+	n.UseHandler(handlers.PageJsonHandler(router))
 
 	webserver.Handler = n
 }
@@ -0,0 +1,8 @@
+package main
+
+// Plugins are enabled at build time by importing their packages here, e.g.:
+//
+//	import _ "github.com/example/dora-plugin-custom"
+//
+// Imported plugin packages register themselves via plugins.Register from their init function.
+// See the plugins package for the available extension points.
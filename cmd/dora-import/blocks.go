@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// parseBlockRecord converts a block record to a db slot.
+// supported fields follow the beaconcha.in block api naming (slot, proposer, status, blockroot, parentroot, ...),
+// status can be numeric (1 = proposed, 2 = missed, 3 = orphaned) or textual.
+func parseBlockRecord(record *dumpRecord) (*dbtypes.Slot, error) {
+	var err error
+	slot := &dbtypes.Slot{}
+
+	if slot.Slot, err = record.getUint(true, "slot"); err != nil {
+		return nil, err
+	}
+	if slot.Proposer, err = record.getUint(true, "proposer", "proposer_index", "validatorindex"); err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(record.getField("status")) {
+	case "1", "proposed", "canonical", "":
+		slot.Status = dbtypes.Canonical
+	case "2", "missed", "missing":
+		slot.Status = dbtypes.Missing
+		return slot, nil
+	case "3", "orphaned":
+		slot.Status = dbtypes.Orphaned
+	default:
+		return nil, fmt.Errorf("invalid status: %v", record.getField("status"))
+	}
+
+	if slot.Root, err = record.getBytes(32, 0, "blockroot", "block_root", "root"); err != nil {
+		return nil, err
+	}
+	if slot.Root == nil {
+		return nil, fmt.Errorf("missing field blockroot")
+	}
+	if slot.ParentRoot, err = record.getBytes(32, 0, "parentroot", "parent_root"); err != nil {
+		return nil, err
+	}
+	if slot.ParentRoot == nil {
+		return nil, fmt.Errorf("missing field parentroot")
+	}
+	if slot.StateRoot, err = record.getBytes(32, 0, "stateroot", "state_root"); err != nil {
+		return nil, err
+	}
+
+	if slot.Graffiti, err = record.getBytes(0, 32, "graffiti"); err != nil {
+		return nil, err
+	}
+	if slot.Graffiti != nil {
+		slot.GraffitiText = utils.GraffitiToString(slot.Graffiti)
+	} else {
+		slot.GraffitiText = record.getField("graffiti_text", "graffititext")
+	}
+
+	if slot.AttestationCount, err = record.getUint(false, "attestationscount", "attestation_count"); err != nil {
+		return nil, err
+	}
+	if slot.DepositCount, err = record.getUint(false, "depositscount", "deposit_count"); err != nil {
+		return nil, err
+	}
+	if slot.ExitCount, err = record.getUint(false, "voluntaryexitscount", "exit_count"); err != nil {
+		return nil, err
+	}
+	if slot.AttesterSlashingCount, err = record.getUint(false, "attesterslashingscount", "attester_slashing_count"); err != nil {
+		return nil, err
+	}
+	if slot.ProposerSlashingCount, err = record.getUint(false, "proposerslashingscount", "proposer_slashing_count"); err != nil {
+		return nil, err
+	}
+	if slot.BLSChangeCount, err = record.getUint(false, "blsexecutionchangescount", "bls_change_count"); err != nil {
+		return nil, err
+	}
+
+	syncParticipation, err := record.getFloat("syncaggregate_participation", "sync_participation")
+	if err != nil {
+		return nil, err
+	}
+	if syncParticipation < 0 || syncParticipation > 1 {
+		return nil, fmt.Errorf("invalid sync participation: %v", syncParticipation)
+	}
+	slot.SyncParticipation = float32(syncParticipation)
+
+	if execBlockNumber, err := record.getUint(false, "exec_block_number", "eth_block_number"); err != nil {
+		return nil, err
+	} else if execBlockNumber > 0 {
+		slot.EthBlockNumber = &execBlockNumber
+
+		if slot.EthBlockHash, err = record.getBytes(32, 0, "exec_block_hash", "eth_block_hash"); err != nil {
+			return nil, err
+		}
+		if slot.EthBlockExtra, err = record.getBytes(0, 32, "exec_extra_data", "eth_block_extra"); err != nil {
+			return nil, err
+		}
+		if slot.EthBlockExtra != nil {
+			slot.EthBlockExtraText = utils.GraffitiToString(slot.EthBlockExtra)
+		}
+		if slot.EthTransactionCount, err = record.getUint(false, "exec_transactions_count", "eth_transaction_count"); err != nil {
+			return nil, err
+		}
+	}
+
+	return slot, nil
+}
+
+// validateBlockChain checks that the imported canonical blocks form a consistent chain.
+// blocks are sorted by slot, parent roots of consecutive canonical blocks need to match.
+func validateBlockChain(slots []*dbtypes.Slot) []error {
+	errs := []error{}
+	canonicalBlocks := map[uint64]*dbtypes.Slot{}
+	canonicalSlots := []uint64{}
+
+	for _, slot := range slots {
+		if slot.Status != dbtypes.Canonical {
+			continue
+		}
+
+		if existing := canonicalBlocks[slot.Slot]; existing != nil {
+			errs = append(errs, fmt.Errorf("slot %v: multiple canonical blocks (0x%x, 0x%x)", slot.Slot, existing.Root, slot.Root))
+			continue
+		}
+
+		canonicalBlocks[slot.Slot] = slot
+		canonicalSlots = append(canonicalSlots, slot.Slot)
+	}
+
+	sort.Slice(canonicalSlots, func(a, b int) bool {
+		return canonicalSlots[a] < canonicalSlots[b]
+	})
+
+	for i := 1; i < len(canonicalSlots); i++ {
+		parentBlock := canonicalBlocks[canonicalSlots[i-1]]
+		block := canonicalBlocks[canonicalSlots[i]]
+		if phase0.Root(block.ParentRoot) != phase0.Root(parentBlock.Root) {
+			errs = append(errs, fmt.Errorf("slot %v: parent root 0x%x does not match canonical block 0x%x of slot %v", block.Slot, block.ParentRoot, parentBlock.Root, parentBlock.Slot))
+		}
+	}
+
+	return errs
+}
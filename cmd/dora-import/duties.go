@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// parseDutyRecord converts a proposer duty record to a slot header.
+// duties are stored as missed slots, unless a block of the assigned proposer is known for the slot.
+func parseDutyRecord(record *dumpRecord) (*dbtypes.SlotHeader, error) {
+	var err error
+	duty := &dbtypes.SlotHeader{
+		Status: dbtypes.Missing,
+	}
+
+	if duty.Slot, err = record.getUint(true, "slot"); err != nil {
+		return nil, err
+	}
+	if duty.Proposer, err = record.getUint(true, "proposer", "proposer_index", "validatorindex", "validator_index"); err != nil {
+		return nil, err
+	}
+
+	return duty, nil
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	importBatchSize = 500
+	maxPrintErrors  = 20
+)
+
+// dora-import pre-populates the explorer database with blocks and proposer duties exported from other explorers.
+// this allows showing the history of networks where no archive node is available anymore.
+func main() {
+	configPath := flag.String("config", "", "Path to the config file, if empty string defaults will be used")
+	blocksPath := flag.String("blocks", "", "Path to the blocks dump (csv or json)")
+	dutiesPath := flag.String("duties", "", "Path to the proposer duties dump (csv or json)")
+	format := flag.String("format", "", "Dump format (csv or json), detected from the file extension if empty")
+	dryRun := flag.Bool("dry-run", false, "Validate the dumps without writing to the database")
+	flag.Parse()
+
+	cfg := &types.Config{}
+	err := utils.ReadConfig(cfg, *configPath)
+	if err != nil {
+		logrus.Fatalf("error reading config file: %v", err)
+	}
+	utils.Config = cfg
+	logWriter, logger := utils.InitLogger()
+	defer logWriter.Dispose()
+
+	if *blocksPath == "" && *dutiesPath == "" {
+		logger.Fatalf("nothing to import, use -blocks and/or -duties")
+	}
+
+	blocks := []*dbtypes.Slot{}
+	duties := []*dbtypes.SlotHeader{}
+	errs := []error{}
+
+	if *blocksPath != "" {
+		records, err := loadDumpRecords(*blocksPath, *format)
+		if err != nil {
+			logger.Fatalf("error loading blocks dump: %v", err)
+		}
+
+		for _, record := range records {
+			block, err := parseBlockRecord(record)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("blocks record %v: %v", record.line, err))
+				continue
+			}
+
+			if block.Status == dbtypes.Missing {
+				duties = append(duties, &dbtypes.SlotHeader{
+					Slot:     block.Slot,
+					Proposer: block.Proposer,
+					Status:   dbtypes.Missing,
+				})
+			} else {
+				blocks = append(blocks, block)
+			}
+		}
+
+		errs = append(errs, validateBlockChain(blocks)...)
+	}
+
+	if *dutiesPath != "" {
+		records, err := loadDumpRecords(*dutiesPath, *format)
+		if err != nil {
+			logger.Fatalf("error loading duties dump: %v", err)
+		}
+
+		for _, record := range records {
+			duty, err := parseDutyRecord(record)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("duties record %v: %v", record.line, err))
+				continue
+			}
+			duties = append(duties, duty)
+		}
+	}
+
+	// drop duties that are fulfilled by imported blocks, fail on duties that mismatch the canonical proposer
+	canonicalProposers := map[uint64]uint64{}
+	importedProposals := map[uint64]map[uint64]bool{}
+	for _, block := range blocks {
+		if block.Status == dbtypes.Canonical {
+			canonicalProposers[block.Slot] = block.Proposer
+		}
+		if importedProposals[block.Slot] == nil {
+			importedProposals[block.Slot] = map[uint64]bool{}
+		}
+		importedProposals[block.Slot][block.Proposer] = true
+	}
+
+	missedSlots := []*dbtypes.SlotHeader{}
+	for _, duty := range duties {
+		if proposer, hasBlock := canonicalProposers[duty.Slot]; hasBlock && proposer != duty.Proposer {
+			errs = append(errs, fmt.Errorf("slot %v: proposer duty %v does not match canonical block proposer %v", duty.Slot, duty.Proposer, proposer))
+			continue
+		}
+		if importedProposals[duty.Slot][duty.Proposer] {
+			continue
+		}
+		missedSlots = append(missedSlots, duty)
+	}
+
+	if len(errs) > 0 {
+		for i, err := range errs {
+			if i >= maxPrintErrors {
+				logger.Errorf("... %v more errors", len(errs)-maxPrintErrors)
+				break
+			}
+			logger.Errorf("%v", err)
+		}
+		logger.Fatalf("validation failed with %v errors, nothing imported", len(errs))
+	}
+
+	logger.Infof("validated %v blocks and %v missed slots", len(blocks), len(missedSlots))
+	if *dryRun {
+		return
+	}
+
+	db.MustInitDB()
+	defer db.MustCloseDB()
+
+	err = db.ApplyEmbeddedDbSchema(-2)
+	if err != nil {
+		logger.Fatalf("error initializing db schema: %v", err)
+	}
+
+	importedBlocks, skippedBlocks, err := importBlocks(blocks)
+	if err != nil {
+		logger.Fatalf("error importing blocks: %v", err)
+	}
+	logger.Infof("imported %v blocks (%v already known)", importedBlocks, skippedBlocks)
+
+	err = importMissedSlots(missedSlots)
+	if err != nil {
+		logger.Fatalf("error importing missed slots: %v", err)
+	}
+	logger.Infof("imported %v missed slots", len(missedSlots))
+}
+
+// importBlocks writes the blocks to the database, blocks that are already known are skipped.
+func importBlocks(blocks []*dbtypes.Slot) (uint64, uint64, error) {
+	importedCount := uint64(0)
+	skippedCount := uint64(0)
+
+	for offset := 0; offset < len(blocks); offset += importBatchSize {
+		batch := blocks[offset:]
+		if len(batch) > importBatchSize {
+			batch = batch[:importBatchSize]
+		}
+
+		roots := make([][]byte, len(batch))
+		for i, block := range batch {
+			roots[i] = block.Root
+		}
+		knownBlocks := db.GetSlotsByRoots(roots)
+
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			for _, block := range batch {
+				if knownBlocks[phase0.Root(block.Root)] != nil {
+					skippedCount++
+					continue
+				}
+
+				if err := db.InsertSlot(block, tx); err != nil {
+					return fmt.Errorf("slot %v: %v", block.Slot, err)
+				}
+				importedCount++
+			}
+			return nil
+		})
+		if err != nil {
+			return importedCount, skippedCount, err
+		}
+	}
+
+	return importedCount, skippedCount, nil
+}
+
+func importMissedSlots(missedSlots []*dbtypes.SlotHeader) error {
+	for offset := 0; offset < len(missedSlots); offset += importBatchSize {
+		batch := missedSlots[offset:]
+		if len(batch) > importBatchSize {
+			batch = batch[:importBatchSize]
+		}
+
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			for _, missedSlot := range batch {
+				if err := db.InsertMissingSlot(missedSlot, tx); err != nil {
+					return fmt.Errorf("slot %v: %v", missedSlot.Slot, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
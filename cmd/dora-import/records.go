@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// dumpRecord is a single row of a data dump with lower-cased field names.
+type dumpRecord struct {
+	line   int
+	fields map[string]string
+}
+
+// loadDumpRecords reads all records of a csv or json dump.
+// json dumps may either be an array of objects, an api response with a "data" array or one object per line.
+func loadDumpRecords(path string, format string) ([]*dumpRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = "csv"
+		case ".json", ".jsonl", ".ndjson":
+			format = "json"
+		default:
+			return nil, fmt.Errorf("cannot detect dump format from file extension, use -format")
+		}
+	}
+
+	switch format {
+	case "csv":
+		return loadCsvRecords(file)
+	case "json":
+		return loadJsonRecords(file)
+	default:
+		return nil, fmt.Errorf("unknown dump format: %v", format)
+	}
+}
+
+func loadCsvRecords(reader io.Reader) ([]*dumpRecord, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading csv header: %v", err)
+	}
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+	}
+
+	records := []*dumpRecord{}
+	for {
+		row, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		record := &dumpRecord{
+			line:   line,
+			fields: make(map[string]string, len(header)),
+		}
+		for i, value := range row {
+			if i < len(header) {
+				record.fields[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func loadJsonRecords(reader io.Reader) ([]*dumpRecord, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	records := []*dumpRecord{}
+	addRecord := func(object map[string]interface{}) {
+		record := &dumpRecord{
+			line:   len(records) + 1,
+			fields: make(map[string]string, len(object)),
+		}
+		for key, value := range object {
+			if value == nil {
+				continue
+			}
+			record.fields[strings.ToLower(key)] = strings.TrimSpace(fmt.Sprintf("%v", value))
+		}
+		records = append(records, record)
+	}
+
+	for {
+		var value interface{}
+		err := decoder.Decode(&value)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// unwrap api responses ({"status": "OK", "data": [...]})
+		if object, isObject := value.(map[string]interface{}); isObject {
+			if data, hasData := object["data"]; hasData {
+				value = data
+			}
+		}
+
+		switch value := value.(type) {
+		case []interface{}:
+			for _, item := range value {
+				object, isObject := item.(map[string]interface{})
+				if !isObject {
+					return nil, fmt.Errorf("unexpected json array item: %v", item)
+				}
+				addRecord(object)
+			}
+		case map[string]interface{}:
+			addRecord(value)
+		default:
+			return nil, fmt.Errorf("unexpected json value: %v", value)
+		}
+	}
+
+	return records, nil
+}
+
+// getField returns the first non-empty value of the given field names.
+func (r *dumpRecord) getField(names ...string) string {
+	for _, name := range names {
+		if value := r.fields[name]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func (r *dumpRecord) getUint(required bool, names ...string) (uint64, error) {
+	value := r.getField(names...)
+	if value == "" {
+		if required {
+			return 0, fmt.Errorf("missing field %v", names[0])
+		}
+		return 0, nil
+	}
+
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v: %v", names[0], value)
+	}
+	return number, nil
+}
+
+func (r *dumpRecord) getFloat(names ...string) (float64, error) {
+	value := r.getField(names...)
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v: %v", names[0], value)
+	}
+	return number, nil
+}
+
+// getBytes parses a 0x-prefixed hex field. if length is > 0, the value must have exactly this length.
+func (r *dumpRecord) getBytes(length int, maxLength int, names ...string) ([]byte, error) {
+	value := r.getField(names...)
+	if value == "" {
+		return nil, nil
+	}
+
+	bytes, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v: %v", names[0], value)
+	}
+	if length > 0 && len(bytes) != length {
+		return nil, fmt.Errorf("invalid %v length: %v bytes, expected %v", names[0], len(bytes), length)
+	}
+	if maxLength > 0 && len(bytes) > maxLength {
+		return nil, fmt.Errorf("invalid %v length: %v bytes, max %v", names[0], len(bytes), maxLength)
+	}
+	return bytes, nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to the config file, if empty string defaults will be used")
+	snapshotPath := flags.String("file", "", "Path to the snapshot file")
+	force := flags.Bool("force", false, "Overwrite existing data when restoring a snapshot")
+
+	switch command {
+	case "dump", "restore":
+		flags.Parse(os.Args[2:])
+	default:
+		printUsage()
+		os.Exit(1)
+	}
+
+	if *snapshotPath == "" {
+		logrus.Fatalf("missing snapshot file path (-file)")
+	}
+
+	cfg := &types.Config{}
+	err := utils.ReadConfig(cfg, *configPath)
+	if err != nil {
+		logrus.Fatalf("error reading config file: %v", err)
+	}
+	utils.Config = cfg
+	logWriter, logger := utils.InitLogger()
+	defer logWriter.Dispose()
+
+	db.MustInitDB()
+	defer db.MustCloseDB()
+
+	switch command {
+	case "dump":
+		err = dumpSnapshot(logger, *snapshotPath)
+	case "restore":
+		err = restoreSnapshot(logger, *snapshotPath, *force)
+	}
+	if err != nil {
+		logger.Fatalf("%v failed: %v", command, err)
+	}
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: %v <command> [options]\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	fmt.Fprintf(os.Stderr, "  dump     write a snapshot of the explorer database & indexer state to a file\n")
+	fmt.Fprintf(os.Stderr, "  restore  restore a snapshot into the explorer database (the explorer must not be running)\n\n")
+	fmt.Fprintf(os.Stderr, "Options:\n")
+	fmt.Fprintf(os.Stderr, "  -config  path to the config file\n")
+	fmt.Fprintf(os.Stderr, "  -file    path to the snapshot file\n")
+	fmt.Fprintf(os.Stderr, "  -force   overwrite existing data when restoring\n")
+}
+
+func dumpSnapshot(logger logrus.FieldLogger, snapshotPath string) error {
+	file, err := os.Create(snapshotPath)
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %v", err)
+	}
+
+	header, err := db.DumpSnapshot(context.Background(), file)
+	if err != nil {
+		file.Close()
+		os.Remove(snapshotPath)
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error closing snapshot file: %v", err)
+	}
+
+	logger.Infof("snapshot written to %v (schema version %v)", snapshotPath, header.SchemaVersion)
+	return nil
+}
+
+func restoreSnapshot(logger logrus.FieldLogger, snapshotPath string, force bool) error {
+	file, err := os.Open(snapshotPath)
+	if err != nil {
+		return fmt.Errorf("error opening snapshot file: %v", err)
+	}
+	defer file.Close()
+
+	header, err := db.RestoreSnapshot(file, force)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("restored snapshot from %v (created %v by version %v, schema version %v)", snapshotPath, header.CreatedAt.Format("2006-01-02 15:04:05"), header.BuildVersion, header.SchemaVersion)
+
+	// migrate the restored data to the latest schema
+	err = db.ApplyEmbeddedDbSchema(-2)
+	if err != nil {
+		return fmt.Errorf("error applying db schema: %v", err)
+	}
+
+	return nil
+}
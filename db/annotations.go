@@ -0,0 +1,35 @@
+package db
+
+import (
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func GetAnnotations() ([]*dbtypes.Annotation, error) {
+	annotations := []*dbtypes.Annotation{}
+	err := ReaderDb.Select(&annotations, `SELECT id, start_slot, end_slot, label, description, created_at FROM annotations ORDER BY start_slot ASC, id ASC`)
+	if err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+func InsertAnnotation(annotation *dbtypes.Annotation, tx *sqlx.Tx) error {
+	err := tx.Get(&annotation.Id, `INSERT INTO annotations (start_slot, end_slot, label, description, created_at) VALUES ($1, $2, $3, $4, $5) RETURNING id`, annotation.StartSlot, annotation.EndSlot, annotation.Label, annotation.Description, annotation.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func DeleteAnnotation(id uint64, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`DELETE FROM annotations WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
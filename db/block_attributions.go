@@ -0,0 +1,68 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertBlockAttributions(attributions []*dbtypes.BlockAttribution, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO block_attributions ",
+			dbtypes.DBEngineSqlite: "INSERT OR IGNORE INTO block_attributions ",
+		}),
+		"(root, client_name, seen_time)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 3
+
+	args := make([]any, len(attributions)*fieldCount)
+	for i, attribution := range attributions {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = attribution.Root
+		args[argIdx+1] = attribution.ClientName
+		args[argIdx+2] = attribution.SeenTime
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (root, client_name) DO NOTHING",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetBlockAttributions(root []byte) []*dbtypes.BlockAttribution {
+	attributions := []*dbtypes.BlockAttribution{}
+	err := ReaderDb.Select(&attributions, `
+	SELECT root, client_name, seen_time
+	FROM block_attributions
+	WHERE root = $1
+	ORDER BY seen_time ASC, client_name ASC
+	`, root)
+	if err != nil {
+		logger.Errorf("Error while fetching block attributions: %v", err)
+		return nil
+	}
+	return attributions
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"embed"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -147,6 +148,49 @@ func MustInitDB() {
 	}
 }
 
+// CheckDbConnection opens and pings the configured database without initializing the global connections.
+// sqlite databases that do not exist yet are not created, as the explorer creates them on startup.
+func CheckDbConnection() error {
+	type checkConnection struct {
+		name   string
+		driver string
+		dsn    string
+	}
+	connections := []checkConnection{}
+
+	switch utils.Config.Database.Engine {
+	case "sqlite":
+		if _, err := os.Stat(utils.Config.Database.Sqlite.File); os.IsNotExist(err) {
+			return nil
+		}
+		connections = append(connections, checkConnection{"database", "sqlite", fmt.Sprintf("file:%s?mode=ro", utils.Config.Database.Sqlite.File)})
+	case "pgsql":
+		reader := &utils.Config.Database.Pgsql
+		connections = append(connections, checkConnection{"database", "pgx", fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", reader.Username, reader.Password, reader.Host, reader.Port, reader.Name)})
+		if writer := &utils.Config.Database.PgsqlWriter; writer.Host != "" {
+			connections = append(connections, checkConnection{"writer database", "pgx", fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", writer.Username, writer.Password, writer.Host, writer.Port, writer.Name)})
+		}
+	default:
+		return fmt.Errorf("unknown database engine type: %s", utils.Config.Database.Engine)
+	}
+
+	for _, connection := range connections {
+		dbConn, err := sqlx.Open(connection.driver, connection.dsn)
+		if err != nil {
+			return fmt.Errorf("error opening %v: %v", connection.name, err)
+		}
+
+		err = pingWithTimeout(dbConn)
+		dbConn.Close()
+
+		if err != nil {
+			return fmt.Errorf("unable to ping %v: %v", connection.name, err)
+		}
+	}
+
+	return nil
+}
+
 func MustCloseDB() {
 	err := writerDb.Close()
 	if err != nil {
@@ -158,6 +202,27 @@ func MustCloseDB() {
 	}
 }
 
+// PingWriterDb checks whether the writer database is reachable.
+func PingWriterDb() error {
+	return pingWithTimeout(writerDb)
+}
+
+// pingWithTimeout pings the database and gives up after 15 seconds.
+// see checkDbConn, ping does not time out on its own.
+func pingWithTimeout(dbConn *sqlx.DB) error {
+	pingChan := make(chan error, 1)
+	go func() {
+		pingChan <- dbConn.Ping()
+	}()
+
+	select {
+	case err := <-pingChan:
+		return err
+	case <-time.After(15 * time.Second):
+		return fmt.Errorf("timeout")
+	}
+}
+
 func RunDBTransaction(handler func(tx *sqlx.Tx) error) error {
 	if DbEngine == dbtypes.DBEngineSqlite {
 		writerMutex.Lock()
@@ -184,7 +249,7 @@ func RunDBTransaction(handler func(tx *sqlx.Tx) error) error {
 	return nil
 }
 
-func ApplyEmbeddedDbSchema(version int64) error {
+func initSchemaDialect() (string, error) {
 	var engineDialect string
 	var schemaDirectory string
 	switch DbEngine {
@@ -200,6 +265,15 @@ func ApplyEmbeddedDbSchema(version int64) error {
 		logger.Fatalf("unknown database engine")
 	}
 	if err := goose.SetDialect(engineDialect); err != nil {
+		return "", err
+	}
+
+	return schemaDirectory, nil
+}
+
+func ApplyEmbeddedDbSchema(version int64) error {
+	schemaDirectory, err := initSchemaDialect()
+	if err != nil {
 		return err
 	}
 
@@ -220,6 +294,15 @@ func ApplyEmbeddedDbSchema(version int64) error {
 	return nil
 }
 
+// GetDbSchemaVersion returns the currently applied schema migration version.
+func GetDbSchemaVersion() (int64, error) {
+	if _, err := initSchemaDialect(); err != nil {
+		return 0, err
+	}
+
+	return goose.GetDBVersion(writerDb.DB)
+}
+
 func EngineQuery(queryMap map[dbtypes.DBEngineType]string) string {
 	if queryMap[DbEngine] != "" {
 		return queryMap[DbEngine]
@@ -192,12 +192,12 @@ func GetDepositTxsFiltered(offset uint64, limit uint32, finalizedBlock uint64, f
 		filterOp = "AND"
 	}
 	if filter.MinAmount > 0 {
-		args = append(args, filter.MinAmount*utils.GWEI.Uint64())
+		args = append(args, utils.GweiFromConsensusUnits(filter.MinAmount))
 		fmt.Fprintf(&sql, " %v amount >= $%v", filterOp, len(args))
 		filterOp = "AND"
 	}
 	if filter.MaxAmount > 0 {
-		args = append(args, filter.MaxAmount*utils.GWEI.Uint64())
+		args = append(args, utils.GweiFromConsensusUnits(filter.MaxAmount))
 		fmt.Fprintf(&sql, " %v amount <= $%v", filterOp, len(args))
 		filterOp = "AND"
 	}
@@ -285,12 +285,12 @@ func GetDepositsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fil
 		filterOp = "AND"
 	}
 	if filter.MinAmount > 0 {
-		args = append(args, filter.MinAmount*utils.GWEI.Uint64())
+		args = append(args, utils.GweiFromConsensusUnits(filter.MinAmount))
 		fmt.Fprintf(&sql, " %v amount >= $%v", filterOp, len(args))
 		filterOp = "AND"
 	}
 	if filter.MaxAmount > 0 {
-		args = append(args, filter.MaxAmount*utils.GWEI.Uint64())
+		args = append(args, utils.GweiFromConsensusUnits(filter.MaxAmount))
 		fmt.Fprintf(&sql, " %v amount <= $%v", filterOp, len(args))
 		filterOp = "AND"
 	}
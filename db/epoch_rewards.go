@@ -0,0 +1,80 @@
+package db
+
+import (
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertEpochRewards(rewards *dbtypes.EpochRewards, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO epoch_rewards (
+				epoch, validator_count, attestation_rewards, attestation_penalties, proposer_rewards,
+				sync_rewards, sync_penalties, total_issuance, estimated
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (epoch) DO UPDATE SET
+				validator_count = excluded.validator_count,
+				attestation_rewards = excluded.attestation_rewards,
+				attestation_penalties = excluded.attestation_penalties,
+				proposer_rewards = excluded.proposer_rewards,
+				sync_rewards = excluded.sync_rewards,
+				sync_penalties = excluded.sync_penalties,
+				total_issuance = excluded.total_issuance,
+				estimated = excluded.estimated`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO epoch_rewards (
+				epoch, validator_count, attestation_rewards, attestation_penalties, proposer_rewards,
+				sync_rewards, sync_penalties, total_issuance, estimated
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}), rewards.Epoch, rewards.ValidatorCount, rewards.AttestationRewards, rewards.AttestationPenalties, rewards.ProposerRewards,
+		rewards.SyncRewards, rewards.SyncPenalties, rewards.TotalIssuance, rewards.Estimated)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetEpochsWithoutRewards returns the synchronized epochs in the given range that have no reward summary yet (newest first).
+func GetEpochsWithoutRewards(firstEpoch uint64, lastEpoch uint64, limit uint32) ([]*dbtypes.Epoch, error) {
+	epochs := []*dbtypes.Epoch{}
+	err := ReaderDb.Select(&epochs, `
+	SELECT
+		epochs.epoch, epochs.validator_count, epochs.validator_balance, epochs.eligible, epochs.voted_target, epochs.voted_head,
+		epochs.voted_total, epochs.block_count, epochs.sync_participation
+	FROM epochs
+	LEFT JOIN epoch_rewards ON epoch_rewards.epoch = epochs.epoch
+	WHERE epochs.epoch >= $1 AND epochs.epoch <= $2 AND epoch_rewards.epoch IS NULL
+	ORDER BY epochs.epoch DESC
+	LIMIT $3
+	`, firstEpoch, lastEpoch, limit)
+	if err != nil {
+		return nil, err
+	}
+	return epochs, nil
+}
+
+// GetEpochRewardStats returns the reward summaries of the epochs in the given range, grouped by bucketSize epochs.
+func GetEpochRewardStats(firstEpoch uint64, lastEpoch uint64, bucketSize uint64) []*dbtypes.EpochRewardStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.EpochRewardStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(epoch) AS first_epoch, MAX(epoch) AS last_epoch, COUNT(*) AS epoch_count,
+		SUM(CASE WHEN estimated THEN 1 ELSE 0 END) AS estimated_count,
+		SUM(attestation_rewards) AS attestation_rewards, SUM(attestation_penalties) AS attestation_penalties,
+		SUM(proposer_rewards) AS proposer_rewards, SUM(sync_rewards) AS sync_rewards, SUM(sync_penalties) AS sync_penalties,
+		SUM(total_issuance) AS total_issuance
+	FROM epoch_rewards
+	WHERE epoch >= $1 AND epoch <= $2
+	GROUP BY epoch / $3
+	ORDER BY first_epoch ASC
+	`, firstEpoch, lastEpoch, bucketSize)
+	if err != nil {
+		logger.Errorf("Error while fetching epoch reward stats: %v", err)
+		return nil
+	}
+	return stats
+}
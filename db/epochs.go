@@ -10,9 +10,10 @@ func InsertEpoch(epoch *dbtypes.Epoch, tx *sqlx.Tx) error {
 		dbtypes.DBEnginePgsql: `
 			INSERT INTO epochs (
 				epoch, validator_count, validator_balance, eligible, voted_target, voted_head, voted_total, block_count, orphaned_count,
-				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
-				proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, full_withdraw_count, full_withdraw_amount,
+				attester_slashing_count, proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation,
+				finality_delay, penalized_count, penalty_amount, inclusion_dist_1, inclusion_dist_2, inclusion_dist_3, inclusion_dist_late, inclusion_dist_sum
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29)
 			ON CONFLICT (epoch) DO UPDATE SET
 				validator_count = excluded.validator_count,
 				validator_balance = excluded.validator_balance,
@@ -27,21 +28,34 @@ func InsertEpoch(epoch *dbtypes.Epoch, tx *sqlx.Tx) error {
 				exit_count = excluded.exit_count, 
 				withdraw_count = excluded.withdraw_count, 
 				withdraw_amount = excluded.withdraw_amount, 
+				full_withdraw_count = excluded.full_withdraw_count,
+				full_withdraw_amount = excluded.full_withdraw_amount,
 				attester_slashing_count = excluded.attester_slashing_count, 
 				proposer_slashing_count = excluded.proposer_slashing_count, 
 				bls_change_count = excluded.bls_change_count, 
 				eth_transaction_count = excluded.eth_transaction_count, 
-				sync_participation = excluded.sync_participation`,
+				sync_participation = excluded.sync_participation,
+				finality_delay = excluded.finality_delay,
+				penalized_count = excluded.penalized_count,
+				penalty_amount = excluded.penalty_amount,
+				inclusion_dist_1 = excluded.inclusion_dist_1,
+				inclusion_dist_2 = excluded.inclusion_dist_2,
+				inclusion_dist_3 = excluded.inclusion_dist_3,
+				inclusion_dist_late = excluded.inclusion_dist_late,
+				inclusion_dist_sum = excluded.inclusion_dist_sum`,
 		dbtypes.DBEngineSqlite: `
 			INSERT OR REPLACE INTO epochs (
 				epoch, validator_count, validator_balance, eligible, voted_target, voted_head, voted_total, block_count, orphaned_count,
-				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
-				proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`,
+				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, full_withdraw_count, full_withdraw_amount,
+				attester_slashing_count, proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation,
+				finality_delay, penalized_count, penalty_amount, inclusion_dist_1, inclusion_dist_2, inclusion_dist_3, inclusion_dist_late, inclusion_dist_sum
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29)`,
 	}),
 		epoch.Epoch, epoch.ValidatorCount, epoch.ValidatorBalance, epoch.Eligible, epoch.VotedTarget, epoch.VotedHead, epoch.VotedTotal, epoch.BlockCount, epoch.OrphanedCount,
-		epoch.AttestationCount, epoch.DepositCount, epoch.ExitCount, epoch.WithdrawCount, epoch.WithdrawAmount, epoch.AttesterSlashingCount, epoch.ProposerSlashingCount,
-		epoch.BLSChangeCount, epoch.EthTransactionCount, epoch.SyncParticipation)
+		epoch.AttestationCount, epoch.DepositCount, epoch.ExitCount, epoch.WithdrawCount, epoch.WithdrawAmount, epoch.FullWithdrawCount, epoch.FullWithdrawAmount,
+		epoch.AttesterSlashingCount, epoch.ProposerSlashingCount, epoch.BLSChangeCount, epoch.EthTransactionCount, epoch.SyncParticipation,
+		epoch.FinalityDelay, epoch.PenalizedCount, epoch.PenaltyAmount, epoch.InclusionDist1, epoch.InclusionDist2, epoch.InclusionDist3,
+		epoch.InclusionDistLate, epoch.InclusionDistSum)
 	if err != nil {
 		return err
 	}
@@ -62,8 +76,9 @@ func GetEpochs(firstEpoch uint64, limit uint32) []*dbtypes.Epoch {
 	err := ReaderDb.Select(&epochs, `
 	SELECT
 		epoch, validator_count, validator_balance, eligible, voted_target, voted_head, voted_total, block_count, orphaned_count,
-		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count,
-		proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation
+		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, full_withdraw_count, full_withdraw_amount,
+		attester_slashing_count, proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation,
+		finality_delay, penalized_count, penalty_amount, inclusion_dist_1, inclusion_dist_2, inclusion_dist_3, inclusion_dist_late, inclusion_dist_sum
 	FROM epochs
 	WHERE epoch <= $1
 	ORDER BY epoch DESC
@@ -75,3 +90,137 @@ func GetEpochs(firstEpoch uint64, limit uint32) []*dbtypes.Epoch {
 	}
 	return epochs
 }
+
+// GetEpochValidatorSetStats returns the active validator count and balance of every step-th epoch in the given range.
+func GetEpochValidatorSetStats(firstEpoch uint64, lastEpoch uint64, step uint64) []*dbtypes.Epoch {
+	if step == 0 {
+		step = 1
+	}
+
+	epochs := []*dbtypes.Epoch{}
+	err := ReaderDb.Select(&epochs, `
+	SELECT
+		epoch, validator_count, validator_balance
+	FROM epochs
+	WHERE epoch >= $1 AND epoch <= $2 AND (epoch % $3 = 0 OR epoch = $2)
+	ORDER BY epoch ASC
+	`, firstEpoch, lastEpoch, step)
+	if err != nil {
+		logger.Errorf("Error while fetching epoch validator set stats: %v", err)
+		return nil
+	}
+	return epochs
+}
+
+// GetEpochWithdrawalStats returns the withdrawal aggregates of the epochs in the given range, grouped by bucketSize epochs.
+func GetEpochWithdrawalStats(firstEpoch uint64, lastEpoch uint64, bucketSize uint64) []*dbtypes.EpochWithdrawalStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.EpochWithdrawalStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(epoch) AS first_epoch, MAX(epoch) AS last_epoch, SUM(withdraw_count) AS withdraw_count, SUM(withdraw_amount) AS withdraw_amount,
+		SUM(full_withdraw_count) AS full_withdraw_count, SUM(full_withdraw_amount) AS full_withdraw_amount
+	FROM epochs
+	WHERE epoch >= $1 AND epoch <= $2
+	GROUP BY epoch / $3
+	ORDER BY first_epoch ASC
+	`, firstEpoch, lastEpoch, bucketSize)
+	if err != nil {
+		logger.Errorf("Error while fetching epoch withdrawal stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetEpochPenaltyStats returns the finality & penalty aggregates of the epochs in the given range, grouped by bucketSize epochs.
+// epochs with a finality delay above leakDelay are counted as inactivity leak epochs.
+func GetEpochPenaltyStats(firstEpoch uint64, lastEpoch uint64, bucketSize uint64, leakDelay uint64) []*dbtypes.EpochPenaltyStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.EpochPenaltyStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(epoch) AS first_epoch, MAX(epoch) AS last_epoch, COUNT(*) AS epoch_count,
+		SUM(CASE WHEN finality_delay > $4 THEN 1 ELSE 0 END) AS leak_epoch_count, MAX(finality_delay) AS max_finality_delay,
+		MAX(penalized_count) AS penalized_count, SUM(penalty_amount) AS penalty_amount
+	FROM epochs
+	WHERE epoch >= $1 AND epoch <= $2
+	GROUP BY epoch / $3
+	ORDER BY first_epoch ASC
+	`, firstEpoch, lastEpoch, bucketSize, leakDelay)
+	if err != nil {
+		logger.Errorf("Error while fetching epoch penalty stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetInactivityLeakIncidents returns the most recent ranges of consecutive epochs with a finality delay above leakDelay (newest first).
+func GetInactivityLeakIncidents(leakDelay uint64, limit uint32) []*dbtypes.InactivityLeakIncident {
+	incidents := []*dbtypes.InactivityLeakIncident{}
+	err := ReaderDb.Select(&incidents, `
+	SELECT
+		MIN(epoch) AS first_epoch, MAX(epoch) AS last_epoch, MAX(finality_delay) AS max_finality_delay,
+		MAX(penalized_count) AS penalized_count, SUM(penalty_amount) AS penalty_amount
+	FROM (
+		SELECT epoch, finality_delay, penalized_count, penalty_amount, epoch - ROW_NUMBER() OVER (ORDER BY epoch) AS incident
+		FROM epochs
+		WHERE finality_delay > $1
+	) AS leak_epochs
+	GROUP BY incident
+	ORDER BY first_epoch DESC
+	LIMIT $2
+	`, leakDelay, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching inactivity leak incidents: %v", err)
+		return nil
+	}
+	return incidents
+}
+
+// GetEpochInclusionDistanceStats returns the vote inclusion distance aggregates of the epochs in the given range, grouped by bucketSize epochs.
+// epochs without inclusion distance stats (synchronized before the distances were tracked) are skipped.
+func GetEpochInclusionDistanceStats(firstEpoch uint64, lastEpoch uint64, bucketSize uint64) []*dbtypes.EpochInclusionDistanceStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.EpochInclusionDistanceStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(epoch) AS first_epoch, MAX(epoch) AS last_epoch, COUNT(*) AS epoch_count,
+		SUM(inclusion_dist_1) AS inclusion_dist_1, SUM(inclusion_dist_2) AS inclusion_dist_2, SUM(inclusion_dist_3) AS inclusion_dist_3,
+		SUM(inclusion_dist_late) AS inclusion_dist_late, SUM(inclusion_dist_sum) AS inclusion_dist_sum
+	FROM epochs
+	WHERE epoch >= $1 AND epoch <= $2 AND inclusion_dist_sum > 0
+	GROUP BY epoch / $3
+	ORDER BY first_epoch ASC
+	`, firstEpoch, lastEpoch, bucketSize)
+	if err != nil {
+		logger.Errorf("Error while fetching epoch inclusion distance stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetEpochsWithoutValidatorStats returns the synchronized epochs before `beforeEpoch` that have been persisted
+// without (or with partial) validator stats, e.g. because no archive state was available at synchronization time.
+func GetEpochsWithoutValidatorStats(beforeEpoch uint64, limit uint32) ([]uint64, error) {
+	epochs := []uint64{}
+	err := ReaderDb.Select(&epochs, `
+	SELECT epoch
+	FROM epochs
+	WHERE epoch < $1 AND (validator_count = 0 OR eligible = 0)
+	ORDER BY epoch ASC
+	LIMIT $2
+	`, beforeEpoch, limit)
+	if err != nil {
+		return nil, err
+	}
+	return epochs, nil
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func GetLastExplorerEventSeq() uint64 {
+	var seq uint64
+	err := ReaderDb.Get(&seq, `SELECT COALESCE(MAX(seq), 0) FROM explorer_events`)
+	if err != nil {
+		logger.Errorf("Error while fetching last explorer event seq: %v", err)
+		return 0
+	}
+	return seq
+}
+
+func GetExplorerEvents(afterSeq uint64, eventTypes []string, limit uint64) []*dbtypes.ExplorerEvent {
+	var sql strings.Builder
+	args := []any{afterSeq}
+	fmt.Fprint(&sql, `SELECT seq, type, slot, time, data FROM explorer_events WHERE seq > $1`)
+
+	if len(eventTypes) > 0 {
+		fmt.Fprint(&sql, ` AND type IN (`)
+		for i, eventType := range eventTypes {
+			if i > 0 {
+				fmt.Fprint(&sql, ", ")
+			}
+			args = append(args, eventType)
+			fmt.Fprintf(&sql, "$%v", len(args))
+		}
+		fmt.Fprint(&sql, ")")
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, ` ORDER BY seq ASC LIMIT $%v`, len(args))
+
+	events := []*dbtypes.ExplorerEvent{}
+	err := ReaderDb.Select(&events, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching explorer events: %v", err)
+		return nil
+	}
+	return events
+}
+
+func InsertExplorerEvent(event *dbtypes.ExplorerEvent, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`INSERT INTO explorer_events (seq, type, slot, time, data) VALUES ($1, $2, $3, $4, $5)`, event.Seq, event.Type, event.Slot, event.Time, event.Data)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func DeleteExplorerEventsBefore(time uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM explorer_events WHERE time < $1`, time)
+	if err != nil {
+		return err
+	}
+	return nil
+}
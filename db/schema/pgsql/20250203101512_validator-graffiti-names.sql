@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_graffiti_names"
+(
+    "index" bigint NOT NULL,
+    "name" character varying(250) NOT NULL,
+    "slot" bigint NOT NULL,
+    PRIMARY KEY ("index")
+);
+
+CREATE INDEX IF NOT EXISTS "validator_graffiti_names_slot_idx"
+    ON public."validator_graffiti_names"
+    ("slot" ASC NULLS LAST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."annotations"
+(
+    "id" bigserial NOT NULL,
+    "start_slot" bigint NOT NULL,
+    "end_slot" bigint NOT NULL,
+    "label" character varying(100) NOT NULL,
+    "description" text NOT NULL,
+    "created_at" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "annotations_slots_idx"
+    ON public."annotations"
+    ("start_slot" ASC NULLS LAST, "end_slot" ASC NULLS LAST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
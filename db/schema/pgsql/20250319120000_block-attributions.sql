@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."block_attributions"
+(
+    "root" bytea NOT NULL,
+    "client_name" character varying(100) NOT NULL,
+    "seen_time" bigint NOT NULL,
+    CONSTRAINT "block_attributions_pkey" PRIMARY KEY ("root", "client_name")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
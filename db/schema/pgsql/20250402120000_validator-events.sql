@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_events"
+(
+    "validator_index" bigint NOT NULL,
+    "event_type" smallint NOT NULL,
+    "epoch" bigint NOT NULL,
+    CONSTRAINT "validator_events_pkey" PRIMARY KEY ("validator_index", "event_type")
+);
+
+CREATE INDEX IF NOT EXISTS "validator_events_epoch_idx"
+    ON public."validator_events"
+    ("epoch" ASC NULLS FIRST, "event_type" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."slots"
+ADD "eth_tx_legacy_count" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."slots"
+ADD "eth_tx_access_list_count" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."slots"
+ADD "eth_tx_dynamic_fee_count" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."slots"
+ADD "eth_tx_blob_count" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."slots"
+ADD "eth_tx_setcode_count" INT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."epochs"
+ADD "finality_delay" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."epochs"
+ADD "penalized_count" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."epochs"
+ADD "penalty_amount" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "finality_delay" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "penalized_count" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "penalty_amount" BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."epochs"
+ADD "inclusion_dist_1" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."epochs"
+ADD "inclusion_dist_2" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."epochs"
+ADD "inclusion_dist_3" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."epochs"
+ADD "inclusion_dist_late" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."epochs"
+ADD "inclusion_dist_sum" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "inclusion_dist_1" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "inclusion_dist_2" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "inclusion_dist_3" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "inclusion_dist_late" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE public."unfinalized_epochs"
+ADD "inclusion_dist_sum" BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE INDEX IF NOT EXISTS "slots_graffiti_stats_idx"
+    ON public."slots"
+    ("slot" ASC NULLS FIRST, "graffiti_text" ASC NULLS FIRST)
+    WHERE "status" = 1 AND "graffiti_text" != '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "explorer_events"
+(
+    "seq" bigint NOT NULL,
+    "type" character varying(50) NOT NULL,
+    "slot" bigint NOT NULL,
+    "time" bigint NOT NULL,
+    "data" text NOT NULL,
+    PRIMARY KEY ("seq")
+);
+
+CREATE INDEX IF NOT EXISTS "explorer_events_type_idx"
+    ON "explorer_events"
+    ("type" ASC, "seq" ASC);
+
+CREATE INDEX IF NOT EXISTS "explorer_events_time_idx"
+    ON "explorer_events"
+    ("time" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
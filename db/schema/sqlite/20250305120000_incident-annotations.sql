@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "annotations"
+(
+    "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+    "start_slot" bigint NOT NULL,
+    "end_slot" bigint NOT NULL,
+    "label" character varying(100) NOT NULL,
+    "description" text NOT NULL,
+    "created_at" bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "annotations_slots_idx"
+    ON "annotations"
+    ("start_slot" ASC, "end_slot" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "test_runs"
+(
+    "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+    "name" character varying(100) NOT NULL,
+    "source" character varying(50) NOT NULL,
+    "url" character varying(500) NOT NULL,
+    "status" character varying(20) NOT NULL,
+    "start_slot" bigint NOT NULL,
+    "end_slot" bigint NULL,
+    "created_at" bigint NOT NULL,
+    "updated_at" bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "test_runs_start_slot_idx"
+    ON "test_runs"
+    ("start_slot" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
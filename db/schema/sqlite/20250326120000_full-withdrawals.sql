@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "epochs"
+ADD "full_withdraw_count" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "epochs"
+ADD "full_withdraw_amount" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "unfinalized_epochs"
+ADD "full_withdraw_count" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "unfinalized_epochs"
+ADD "full_withdraw_amount" BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
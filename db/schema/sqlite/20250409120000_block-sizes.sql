@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "slots"
+ADD "block_size" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "attestations_size" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "payload_size" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "blob_refs_size" BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_snapshots"
+(
+    "epoch" bigint NOT NULL,
+    "dependent_root" BLOB NOT NULL,
+    "validator_count" bigint NOT NULL,
+    "snapshot" BLOB NOT NULL,
+    CONSTRAINT "validator_snapshots_pkey" PRIMARY KEY ("epoch", "dependent_root")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
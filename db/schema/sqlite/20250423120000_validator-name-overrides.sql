@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_name_overrides"
+(
+    "key" character varying(100) NOT NULL,
+    "name" character varying(250) NOT NULL,
+    "updated_at" bigint NOT NULL,
+    PRIMARY KEY ("key")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "slots"
+ADD "eth_gas_used" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "eth_base_fee" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "eth_burned_fees" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "eth_priority_fees" BIGINT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "epoch_rewards"
+(
+    "epoch" bigint NOT NULL,
+    "validator_count" bigint NOT NULL DEFAULT 0,
+    "attestation_rewards" bigint NOT NULL DEFAULT 0,
+    "attestation_penalties" bigint NOT NULL DEFAULT 0,
+    "proposer_rewards" bigint NOT NULL DEFAULT 0,
+    "sync_rewards" bigint NOT NULL DEFAULT 0,
+    "sync_penalties" bigint NOT NULL DEFAULT 0,
+    "total_issuance" bigint NOT NULL DEFAULT 0,
+    "estimated" bool NOT NULL DEFAULT FALSE,
+    CONSTRAINT "epoch_rewards_pkey" PRIMARY KEY ("epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
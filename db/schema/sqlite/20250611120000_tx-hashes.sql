@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "tx_hashes"
+(
+    "tx_hash" BLOB NOT NULL,
+    "block_root" BLOB NOT NULL,
+    "slot" bigint NOT NULL,
+    "tx_index" integer NOT NULL,
+    CONSTRAINT "tx_hashes_pkey" PRIMARY KEY ("tx_hash", "block_root")
+);
+
+CREATE INDEX IF NOT EXISTS "tx_hashes_slot_idx"
+    ON "tx_hashes"
+    ("slot" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE INDEX IF NOT EXISTS "slots_graffiti_stats_idx"
+    ON "slots"
+    ("slot" ASC, "graffiti_text" ASC)
+    WHERE "status" = 1 AND "graffiti_text" != '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
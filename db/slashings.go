@@ -15,11 +15,11 @@ func InsertSlashings(slashings []*dbtypes.Slashing, tx *sqlx.Tx) error {
 			dbtypes.DBEnginePgsql:  "INSERT INTO slashings ",
 			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO slashings ",
 		}),
-		"(slot_number, slot_index, slot_root, orphaned, validator, slasher, reason, fork_id)",
+		"(slot_number, slot_index, slot_root, orphaned, validator, slasher, reason, fork_id, whistleblower_reward)",
 		" VALUES ",
 	)
 	argIdx := 0
-	fieldCount := 8
+	fieldCount := 9
 
 	args := make([]any, len(slashings)*fieldCount)
 	for i, slashing := range slashings {
@@ -44,10 +44,11 @@ func InsertSlashings(slashings []*dbtypes.Slashing, tx *sqlx.Tx) error {
 		args[argIdx+5] = slashing.SlasherIndex
 		args[argIdx+6] = slashing.Reason
 		args[argIdx+7] = slashing.ForkId
+		args[argIdx+8] = slashing.WhistleblowerReward
 		argIdx += fieldCount
 	}
 	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
-		dbtypes.DBEnginePgsql:  " ON CONFLICT (slot_root, slot_index, validator) DO UPDATE SET orphaned = excluded.orphaned, fork_id = excluded.fork_id",
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (slot_root, slot_index, validator) DO UPDATE SET orphaned = excluded.orphaned, fork_id = excluded.fork_id, whistleblower_reward = excluded.whistleblower_reward",
 		dbtypes.DBEngineSqlite: "",
 	}))
 
@@ -65,7 +66,7 @@ func GetSlashingForValidator(validator uint64) *dbtypes.Slashing {
 	}
 	fmt.Fprint(&sql, `
 	SELECT
-		slot_number, slot_index, slot_root, orphaned, validator, slasher, reason, fork_id
+		slot_number, slot_index, slot_root, orphaned, validator, slasher, reason, fork_id, whistleblower_reward
 	FROM slashings
 	WHERE validator = $1
 	`)
@@ -84,7 +85,7 @@ func GetSlashingsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fi
 	fmt.Fprint(&sql, `
 	WITH cte AS (
 		SELECT
-			slot_number, slot_index, slot_root, orphaned, validator, slasher, reason, fork_id
+			slot_number, slot_index, slot_root, orphaned, validator, slasher, reason, fork_id, whistleblower_reward
 		FROM slashings
 	`)
 
@@ -165,7 +166,8 @@ func GetSlashingsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fi
 		0 AS validator,
 		0 AS slasher,
 		0 AS reason,
-		0 AS fork_id
+		0 AS fork_id,
+		0 AS whistleblower_reward
 	FROM cte
 	UNION ALL SELECT * FROM (
 	SELECT * FROM cte
@@ -188,3 +190,51 @@ func GetSlashingsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fi
 
 	return slashings[1:], slashings[0].SlotNumber, nil
 }
+
+// GetWhistleblowerStats returns the canonical slashings & whistleblower rewards aggregated per slasher (block proposer),
+// ordered by the number of included slashings. only slashings included in or after minSlot are counted.
+func GetWhistleblowerStats(minSlot uint64, offset uint64, limit uint32) ([]*dbtypes.WhistleblowerStats, uint64, error) {
+	args := []any{minSlot, dbtypes.ProposerSlashing, dbtypes.AttesterSlashing, limit}
+	var sql strings.Builder
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			slasher,
+			COUNT(*) AS slashing_count,
+			SUM(CASE WHEN reason = $2 THEN 1 ELSE 0 END) AS proposer_slashing_count,
+			SUM(CASE WHEN reason = $3 THEN 1 ELSE 0 END) AS attester_slashing_count,
+			SUM(whistleblower_reward) AS total_reward,
+			MAX(slot_number) AS last_slot
+		FROM slashings
+		WHERE orphaned = false AND slot_number >= $1
+		GROUP BY slasher
+	)
+	SELECT
+		0 AS slasher,
+		count(*) AS slashing_count,
+		0 AS proposer_slashing_count,
+		0 AS attester_slashing_count,
+		0 AS total_reward,
+		0 AS last_slot
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slashing_count DESC, total_reward DESC, slasher ASC
+	LIMIT $4
+	`)
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	stats := []*dbtypes.WhistleblowerStats{}
+	err := ReaderDb.Select(&stats, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching whistleblower stats: %v", err)
+		return nil, 0, err
+	}
+
+	return stats[1:], stats[0].SlashingCount, nil
+}
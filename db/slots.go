@@ -1,9 +1,12 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -19,8 +22,11 @@ func InsertSlot(slot *dbtypes.Slot, tx *sqlx.Tx) error {
 				slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 				proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-				eth_block_extra, eth_block_extra_text, sync_participation, fork_id
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
+				eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+				block_size, attestations_size, payload_size, blob_refs_size,
+				eth_tx_legacy_count, eth_tx_access_list_count, eth_tx_dynamic_fee_count, eth_tx_blob_count, eth_tx_setcode_count,
+				eth_gas_used, eth_base_fee, eth_burned_fees, eth_priority_fees, blob_count
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37)
 			ON CONFLICT (slot, root) DO UPDATE SET
 				status = excluded.status,
 				eth_block_extra = excluded.eth_block_extra,
@@ -31,13 +37,19 @@ func InsertSlot(slot *dbtypes.Slot, tx *sqlx.Tx) error {
 				slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 				proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-				eth_block_extra, eth_block_extra_text, sync_participation, fork_id
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)`,
+				eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+				block_size, attestations_size, payload_size, blob_refs_size,
+				eth_tx_legacy_count, eth_tx_access_list_count, eth_tx_dynamic_fee_count, eth_tx_blob_count, eth_tx_setcode_count,
+				eth_gas_used, eth_base_fee, eth_burned_fees, eth_priority_fees, blob_count
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37)`,
 	}),
 		slot.Slot, slot.Proposer, slot.Status, slot.Root, slot.ParentRoot, slot.StateRoot, slot.Graffiti, slot.GraffitiText,
 		slot.AttestationCount, slot.DepositCount, slot.ExitCount, slot.WithdrawCount, slot.WithdrawAmount, slot.AttesterSlashingCount,
 		slot.ProposerSlashingCount, slot.BLSChangeCount, slot.EthTransactionCount, slot.EthBlockNumber, slot.EthBlockHash,
-		slot.EthBlockExtra, slot.EthBlockExtraText, slot.SyncParticipation, slot.ForkId)
+		slot.EthBlockExtra, slot.EthBlockExtraText, slot.SyncParticipation, slot.ForkId,
+		slot.BlockSize, slot.AttestationsSize, slot.PayloadSize, slot.BlobRefsSize,
+		slot.EthTxLegacyCount, slot.EthTxAccessListCount, slot.EthTxDynamicFeeCount, slot.EthTxBlobCount, slot.EthTxSetCodeCount,
+		slot.EthGasUsed, slot.EthBaseFee, slot.EthBurnedFees, slot.EthPriorityFees, slot.BlobCount)
 	if err != nil {
 		return err
 	}
@@ -93,6 +105,9 @@ func GetSlotsRange(firstSlot uint64, lastSlot uint64, withMissing bool, withOrph
 		"attestation_count", "deposit_count", "exit_count", "withdraw_count", "withdraw_amount", "attester_slashing_count",
 		"proposer_slashing_count", "bls_change_count", "eth_transaction_count", "eth_block_number", "eth_block_hash",
 		"eth_block_extra", "eth_block_extra_text", "sync_participation", "fork_id",
+		"block_size", "attestations_size", "payload_size", "blob_refs_size",
+		"eth_tx_legacy_count", "eth_tx_access_list_count", "eth_tx_dynamic_fee_count", "eth_tx_blob_count", "eth_tx_setcode_count",
+		"eth_gas_used", "eth_base_fee", "eth_burned_fees", "eth_priority_fees", "blob_count",
 	}
 	for _, blockField := range blockFields {
 		fmt.Fprintf(&sql, ", slots.%v AS \"block.%v\"", blockField, blockField)
@@ -124,7 +139,10 @@ func GetSlotsByParentRoot(parentRoot []byte) []*dbtypes.Slot {
 		slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-		eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+		eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+		block_size, attestations_size, payload_size, blob_refs_size,
+		eth_tx_legacy_count, eth_tx_access_list_count, eth_tx_dynamic_fee_count, eth_tx_blob_count, eth_tx_setcode_count,
+		eth_gas_used, eth_base_fee, eth_burned_fees, eth_priority_fees, blob_count
 	FROM slots
 	WHERE parent_root = $1
 	ORDER BY slot DESC
@@ -143,7 +161,10 @@ func GetSlotByRoot(root []byte) *dbtypes.Slot {
 		root, slot, parent_root, state_root, status, proposer, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash,
-		eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+		eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+		block_size, attestations_size, payload_size, blob_refs_size,
+		eth_tx_legacy_count, eth_tx_access_list_count, eth_tx_dynamic_fee_count, eth_tx_blob_count, eth_tx_setcode_count,
+		eth_gas_used, eth_base_fee, eth_burned_fees, eth_priority_fees, blob_count
 	FROM slots
 	WHERE root = $1
 	`, root)
@@ -154,6 +175,83 @@ func GetSlotByRoot(root []byte) *dbtypes.Slot {
 	return &block
 }
 
+// GetSlotsByRootPrefix returns blocks with a block root starting with the given hex prefix.
+// the prefix is translated to a byte range, so the lookup can use the root index.
+func GetSlotsByRootPrefix(hexPrefix string, limit uint32) (dbtypes.SearchAheadSlotsResult, error) {
+	minRoot, maxRoot, err := getHexPrefixRange(hexPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var sql strings.Builder
+	args := []any{minRoot}
+	fmt.Fprint(&sql, `
+	SELECT slot, root, status
+	FROM slots
+	WHERE root >= $1 AND status != 0`)
+	if maxRoot != nil {
+		args = append(args, maxRoot)
+		fmt.Fprint(&sql, ` AND root < $2`)
+	}
+	args = append(args, limit)
+	fmt.Fprintf(&sql, ` ORDER BY root LIMIT $%v`, len(args))
+
+	slots := dbtypes.SearchAheadSlotsResult{}
+	err = ReaderDb.Select(&slots, sql.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+// getHexPrefixRange returns the byte range [min, max) of all values starting with the given hex prefix.
+// max is nil if the range is unbounded (prefix consists of f's only).
+func getHexPrefixRange(hexPrefix string) ([]byte, []byte, error) {
+	nibbles := []byte(strings.ToLower(hexPrefix))
+	if len(nibbles) == 0 {
+		return nil, nil, fmt.Errorf("empty prefix")
+	}
+
+	minHex := string(nibbles)
+	if len(minHex)%2 == 1 {
+		minHex += "0"
+	}
+	minValue, err := hex.DecodeString(minHex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// increment the prefix to get the exclusive upper bound
+	for len(nibbles) > 0 {
+		lastIdx := len(nibbles) - 1
+		if nibbles[lastIdx] == 'f' {
+			nibbles = nibbles[:lastIdx]
+			continue
+		}
+
+		if nibbles[lastIdx] == '9' {
+			nibbles[lastIdx] = 'a'
+		} else {
+			nibbles[lastIdx]++
+		}
+		break
+	}
+	if len(nibbles) == 0 {
+		return minValue, nil, nil
+	}
+
+	maxHex := string(nibbles)
+	if len(maxHex)%2 == 1 {
+		maxHex += "0"
+	}
+	maxValue, err := hex.DecodeString(maxHex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return minValue, maxValue, nil
+}
+
 func GetSlotsByRoots(roots [][]byte) map[phase0.Root]*dbtypes.Slot {
 	argIdx := 0
 	args := make([]any, len(roots))
@@ -169,7 +267,10 @@ func GetSlotsByRoots(roots [][]byte) map[phase0.Root]*dbtypes.Slot {
 			root, slot, parent_root, state_root, status, proposer, graffiti, graffiti_text,
 			attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 			proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash,
-			eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+			eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+			block_size, attestations_size, payload_size, blob_refs_size,
+			eth_tx_legacy_count, eth_tx_access_list_count, eth_tx_dynamic_fee_count, eth_tx_blob_count, eth_tx_setcode_count,
+			eth_gas_used, eth_base_fee, eth_burned_fees, eth_priority_fees, blob_count
 		FROM slots
 		WHERE root IN (%v)
 		ORDER BY slot DESC`,
@@ -212,7 +313,10 @@ func GetSlotsByBlockHash(blockHash []byte) []*dbtypes.Slot {
 		slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-		eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+		eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+		block_size, attestations_size, payload_size, blob_refs_size,
+		eth_tx_legacy_count, eth_tx_access_list_count, eth_tx_dynamic_fee_count, eth_tx_blob_count, eth_tx_setcode_count,
+		eth_gas_used, eth_base_fee, eth_burned_fees, eth_priority_fees, blob_count
 	FROM slots
 	WHERE eth_block_hash = $1
 	ORDER BY slot DESC
@@ -273,6 +377,9 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 		"attestation_count", "deposit_count", "exit_count", "withdraw_count", "withdraw_amount", "attester_slashing_count",
 		"proposer_slashing_count", "bls_change_count", "eth_transaction_count", "eth_block_number", "eth_block_hash",
 		"eth_block_extra", "eth_block_extra_text", "sync_participation", "fork_id",
+		"block_size", "attestations_size", "payload_size", "blob_refs_size",
+		"eth_tx_legacy_count", "eth_tx_access_list_count", "eth_tx_dynamic_fee_count", "eth_tx_blob_count", "eth_tx_setcode_count",
+		"eth_gas_used", "eth_base_fee", "eth_burned_fees", "eth_priority_fees", "blob_count",
 	}
 	for _, blockField := range blockFields {
 		fmt.Fprintf(&sql, ", slots.%v AS \"block.%v\"", blockField, blockField)
@@ -328,6 +435,29 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 		}), argIdx)
 		args = append(args, "%"+filter.ProposerName+"%")
 	}
+	if filter.HasBlockStatsFilter() {
+		fmt.Fprintf(&sql, ` AND slots.status != 0 `)
+	}
+	if filter.MinSyncParticipation != nil {
+		argIdx++
+		fmt.Fprintf(&sql, ` AND slots.sync_participation >= $%v `, argIdx)
+		args = append(args, *filter.MinSyncParticipation)
+	}
+	if filter.MaxSyncParticipation != nil {
+		argIdx++
+		fmt.Fprintf(&sql, ` AND slots.sync_participation <= $%v `, argIdx)
+		args = append(args, *filter.MaxSyncParticipation)
+	}
+	if filter.MinAttestations != nil {
+		argIdx++
+		fmt.Fprintf(&sql, ` AND slots.attestation_count >= $%v `, argIdx)
+		args = append(args, *filter.MinAttestations)
+	}
+	if filter.MaxAttestations != nil {
+		argIdx++
+		fmt.Fprintf(&sql, ` AND slots.attestation_count <= $%v `, argIdx)
+		args = append(args, *filter.MaxAttestations)
+	}
 
 	fmt.Fprintf(&sql, `	ORDER BY slots.slot DESC `)
 	fmt.Fprintf(&sql, ` LIMIT $%v OFFSET $%v `, argIdx+1, argIdx+2)
@@ -404,3 +534,470 @@ func GetSlotAssignment(slot uint64) uint64 {
 	}
 	return proposer
 }
+
+func GetSlotGraffitiTexts(minSlot uint64, maxSlot uint64, limit uint32) []*dbtypes.SlotGraffiti {
+	graffitis := []*dbtypes.SlotGraffiti{}
+	err := ReaderDb.Select(&graffitis, `
+	SELECT
+		slot, proposer, graffiti_text
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = 1 AND graffiti_text != ''
+	ORDER BY slot ASC
+	LIMIT $3
+	`, minSlot, maxSlot, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching slot graffitis: %v", err)
+		return nil
+	}
+	return graffitis
+}
+
+// GetRecentSlotGraffitiTexts returns the non-empty graffitis of canonical blocks up to maxSlot, newest first.
+func GetRecentSlotGraffitiTexts(maxSlot uint64, limit uint32) []*dbtypes.SlotGraffiti {
+	graffitis := []*dbtypes.SlotGraffiti{}
+	err := ReaderDb.Select(&graffitis, `
+	SELECT
+		slot, proposer, graffiti_text
+	FROM slots
+	WHERE slot <= $1 AND status = 1 AND graffiti_text != ''
+	ORDER BY slot DESC
+	LIMIT $2
+	`, maxSlot, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching recent slot graffitis: %v", err)
+		return nil
+	}
+	return graffitis
+}
+
+// GetGraffitiStats returns the most common graffiti texts of canonical blocks in the slot range, most used first.
+// along with the requested page, the number of distinct graffiti texts and the number of blocks with graffiti in the range is returned.
+func GetGraffitiStats(minSlot uint64, maxSlot uint64, offset uint64, limit uint32) ([]*dbtypes.GraffitiStats, uint64, uint64, error) {
+	args := []any{minSlot, maxSlot, limit}
+	var sql strings.Builder
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			graffiti_text,
+			COUNT(*) AS block_count,
+			COUNT(DISTINCT proposer) AS proposer_count,
+			MAX(slot) AS last_slot
+		FROM slots
+		WHERE slot >= $1 AND slot <= $2 AND status = 1 AND graffiti_text != ''
+		GROUP BY graffiti_text
+	)
+	SELECT
+		'' AS graffiti_text,
+		count(*) AS block_count,
+		CAST(COALESCE(SUM(block_count), 0) AS bigint) AS proposer_count,
+		0 AS last_slot
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY block_count DESC, last_slot DESC
+	LIMIT $3
+	`)
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	stats := []*dbtypes.GraffitiStats{}
+	err := ReaderDb.Select(&stats, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching graffiti stats: %v", err)
+		return nil, 0, 0, err
+	}
+
+	// the summary row holds the number of distinct graffitis (block_count) and blocks with graffiti (proposer_count)
+	return stats[1:], stats[0].BlockCount, stats[0].ProposerCount, nil
+}
+
+// SearchSlotGraffitiTexts returns the canonical blocks with a graffiti containing the search text, newest first.
+func SearchSlotGraffitiTexts(ctx context.Context, search string, offset uint64, limit uint32) ([]*dbtypes.SlotGraffiti, error) {
+	graffitis := []*dbtypes.SlotGraffiti{}
+	err := ReaderDb.SelectContext(ctx, &graffitis, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+		SELECT
+			slot, proposer, graffiti_text
+		FROM slots
+		WHERE status = 1 AND graffiti_text ilike $1
+		ORDER BY slot DESC
+		LIMIT $2 OFFSET $3
+		`,
+		dbtypes.DBEngineSqlite: `
+		SELECT
+			slot, proposer, graffiti_text
+		FROM slots
+		WHERE status = 1 AND graffiti_text LIKE $1
+		ORDER BY slot DESC
+		LIMIT $2 OFFSET $3
+		`,
+	}), "%"+search+"%", limit, offset)
+	if err != nil {
+		logger.Errorf("Error while searching slot graffitis: %v", err)
+		return nil, err
+	}
+	return graffitis, nil
+}
+
+// GetLastProposedSlots returns the slot of the latest canonical block at or before maxSlot for each of the given proposers.
+// proposers without any canonical block are not included in the result.
+func GetLastProposedSlots(proposers []uint64, maxSlot uint64) map[uint64]uint64 {
+	result := map[uint64]uint64{}
+	if len(proposers) == 0 {
+		return result
+	}
+
+	args := make([]any, len(proposers)+1)
+	plcList := make([]string, len(proposers))
+	args[0] = maxSlot
+	for i, proposer := range proposers {
+		plcList[i] = fmt.Sprintf("$%v", i+2)
+		args[i+1] = proposer
+	}
+
+	sql := fmt.Sprintf(`
+	SELECT
+		proposer, MAX(slot) AS slot
+	FROM slots
+	WHERE slot <= $1 AND status = 1 AND proposer IN (%v)
+	GROUP BY proposer
+	`, strings.Join(plcList, ", "))
+
+	proposals := []struct {
+		Proposer uint64 `db:"proposer"`
+		Slot     uint64 `db:"slot"`
+	}{}
+	err := ReaderDb.Select(&proposals, sql, args...)
+	if err != nil {
+		logger.Errorf("Error while fetching last proposed slots: %v", err)
+		return result
+	}
+
+	for _, proposal := range proposals {
+		result[proposal.Proposer] = proposal.Slot
+	}
+	return result
+}
+
+// GetSlotRangeStats aggregates the block stats of all slots in the given range (inclusive).
+// only canonical blocks are counted for the block content stats.
+func GetSlotRangeStats(firstSlot uint64, lastSlot uint64) (*dbtypes.SlotRangeStats, error) {
+	stats := &dbtypes.SlotRangeStats{}
+	err := ReaderDb.Get(stats, `
+	SELECT
+		COUNT(CASE WHEN status = 1 THEN 1 END) AS canonical_count,
+		COUNT(CASE WHEN status = 0 THEN 1 END) AS missed_count,
+		COUNT(CASE WHEN status = 2 THEN 1 END) AS orphaned_count,
+		COALESCE(SUM(CASE WHEN status = 1 THEN attestation_count ELSE 0 END), 0) AS attestation_count,
+		COALESCE(SUM(CASE WHEN status = 1 THEN deposit_count ELSE 0 END), 0) AS deposit_count,
+		COALESCE(SUM(CASE WHEN status = 1 THEN exit_count ELSE 0 END), 0) AS exit_count,
+		COALESCE(SUM(CASE WHEN status = 1 THEN proposer_slashing_count ELSE 0 END), 0) AS proposer_slashing_count,
+		COALESCE(SUM(CASE WHEN status = 1 THEN attester_slashing_count ELSE 0 END), 0) AS attester_slashing_count,
+		COALESCE(SUM(CASE WHEN status = 1 THEN eth_transaction_count ELSE 0 END), 0) AS eth_transaction_count,
+		COUNT(CASE WHEN status = 1 AND sync_participation >= 0 THEN 1 END) AS sync_participation_count,
+		COALESCE(SUM(CASE WHEN status = 1 AND sync_participation >= 0 THEN sync_participation ELSE 0 END), 0) AS sync_participation_sum
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2
+	`, firstSlot, lastSlot)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetSlotSizeStats returns the block size aggregates of the canonical blocks in the given slot range, grouped by bucketSize slots.
+// blocks without recorded size (indexed before block sizes were tracked) are excluded.
+func GetSlotSizeStats(firstSlot uint64, lastSlot uint64, bucketSize uint64) []*dbtypes.SlotSizeStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.SlotSizeStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(slot) AS first_slot, MAX(slot) AS last_slot, COUNT(*) AS block_count,
+		SUM(block_size) AS block_size_sum, MAX(block_size) AS block_size_max, SUM(attestations_size) AS attestations_size_sum,
+		SUM(payload_size) AS payload_size_sum, SUM(blob_refs_size) AS blob_refs_size_sum
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = 1 AND block_size > 0
+	GROUP BY slot / $3
+	ORDER BY first_slot ASC
+	`, firstSlot, lastSlot, bucketSize)
+	if err != nil {
+		logger.Errorf("Error while fetching slot size stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetSlotsForColumnBackfill returns blocks in [minSlot, beforeSlot) matching the given backfill condition, newest first.
+// the condition is a static sql expression defined by the indexer backfill tasks and must never contain user input.
+func GetSlotsForColumnBackfill(condition string, minSlot uint64, beforeSlot uint64, limit uint32) []*dbtypes.Slot {
+	slots := []*dbtypes.Slot{}
+	err := ReaderDb.Select(&slots, fmt.Sprintf(`
+	SELECT
+		slot, proposer, status, root, graffiti, graffiti_text, blob_refs_size
+	FROM slots
+	WHERE slot >= $1 AND slot < $2 AND status != 0 AND (%v)
+	ORDER BY slot DESC
+	LIMIT $3
+	`, condition), minSlot, beforeSlot, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching slots for column backfill: %v", err)
+		return nil
+	}
+	return slots
+}
+
+// UpdateSlotColumns updates the given columns of the block with the given root.
+// column names are defined by the indexer backfill tasks and must never contain user input.
+func UpdateSlotColumns(root []byte, columns map[string]any, tx *sqlx.Tx) error {
+	columnNames := make([]string, 0, len(columns))
+	for column := range columns {
+		columnNames = append(columnNames, column)
+	}
+	sort.Strings(columnNames)
+
+	var sql strings.Builder
+	args := make([]any, 0, len(columns)+1)
+	fmt.Fprint(&sql, `UPDATE slots SET `)
+	for idx, column := range columnNames {
+		if idx > 0 {
+			fmt.Fprint(&sql, ", ")
+		}
+		args = append(args, columns[column])
+		fmt.Fprintf(&sql, "%v = $%v", column, len(args))
+	}
+	args = append(args, root)
+	fmt.Fprintf(&sql, " WHERE root = $%v", len(args))
+
+	_, err := tx.Exec(sql.String(), args...)
+	return err
+}
+
+// UpdateOrphanedSlotsInRange marks all canonical blocks in [firstSlot, lastSlot] that are not in the given canonical roots as orphaned.
+// used to enforce the single canonical block per slot invariant when persisting a finalized epoch.
+func UpdateOrphanedSlotsInRange(firstSlot uint64, lastSlot uint64, canonicalRoots [][]byte, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	args := []any{dbtypes.Orphaned, firstSlot, lastSlot, dbtypes.Canonical}
+	fmt.Fprint(&sql, `UPDATE slots SET status = $1 WHERE slot >= $2 AND slot <= $3 AND status = $4`)
+
+	if len(canonicalRoots) > 0 {
+		fmt.Fprint(&sql, ` AND root NOT IN (`)
+		for i, root := range canonicalRoots {
+			if i > 0 {
+				fmt.Fprint(&sql, ",")
+			}
+			args = append(args, root)
+			fmt.Fprintf(&sql, "$%v", len(args))
+		}
+		fmt.Fprint(&sql, ")")
+	}
+
+	_, err := tx.Exec(sql.String(), args...)
+	return err
+}
+
+// GetDuplicateCanonicalSlots returns slot numbers below maxSlot that have more than one canonical block.
+func GetDuplicateCanonicalSlots(maxSlot uint64, limit uint32) ([]uint64, error) {
+	slots := []uint64{}
+	err := ReaderDb.Select(&slots, `
+	SELECT slot
+	FROM slots
+	WHERE slot < $1 AND status = 1
+	GROUP BY slot
+	HAVING COUNT(*) > 1
+	ORDER BY slot ASC
+	LIMIT $2
+	`, maxSlot, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+// GetOrphanedSlotsInRange returns all orphaned blocks in [firstSlot, lastSlot].
+func GetOrphanedSlotsInRange(firstSlot uint64, lastSlot uint64) ([]*dbtypes.Slot, error) {
+	slots := []*dbtypes.Slot{}
+	err := ReaderDb.Select(&slots, `
+	SELECT
+		slot, proposer, status, root
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = $3
+	ORDER BY slot ASC
+	`, firstSlot, lastSlot, dbtypes.Orphaned)
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+// UpdateSlotStatus updates the status of the block with the given root.
+func UpdateSlotStatus(root []byte, status dbtypes.SlotStatus, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`UPDATE slots SET status = $1 WHERE root = $2`, status, root)
+	return err
+}
+
+// InvalidateSlot removes the objects derived from the body of the block with the given root (deposits, exits, slashings,
+// el requests & transaction hashes). if the block is replaced by a refetched copy, the block itself is deleted too,
+// otherwise it is kept as orphaned block.
+func InvalidateSlot(root []byte, replaced bool, tx *sqlx.Tx) error {
+	for _, table := range []string{"deposits", "voluntary_exits", "slashings", "consolidation_requests", "withdrawal_requests"} {
+		if _, err := tx.Exec(fmt.Sprintf(`DELETE FROM %v WHERE slot_root = $1`, table), root); err != nil {
+			return fmt.Errorf("error deleting %v: %v", table, err)
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM tx_hashes WHERE block_root = $1`, root); err != nil {
+		return fmt.Errorf("error deleting tx_hashes: %v", err)
+	}
+
+	if replaced {
+		_, err := tx.Exec(`DELETE FROM slots WHERE root = $1`, root)
+		return err
+	}
+
+	return UpdateSlotStatus(root, dbtypes.Orphaned, tx)
+}
+
+// GetSlotOrphanStats returns the number of canonical & orphaned blocks in the given slot range, grouped by bucketSize slots.
+func GetSlotOrphanStats(firstSlot uint64, lastSlot uint64, bucketSize uint64) []*dbtypes.SlotOrphanStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.SlotOrphanStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(slot) AS first_slot, MAX(slot) AS last_slot,
+		SUM(CASE WHEN status = 1 THEN 1 ELSE 0 END) AS canonical_count,
+		SUM(CASE WHEN status = 2 THEN 1 ELSE 0 END) AS orphaned_count
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status IN (1, 2)
+	GROUP BY slot / $3
+	ORDER BY first_slot ASC
+	`, firstSlot, lastSlot, bucketSize)
+	if err != nil {
+		logger.Errorf("Error while fetching slot orphan stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetOrphanedSlotRefs returns slot, root & parent root of all orphaned blocks in the given slot range, ordered by slot.
+func GetOrphanedSlotRefs(firstSlot uint64, lastSlot uint64) []*dbtypes.Slot {
+	slots := []*dbtypes.Slot{}
+	err := ReaderDb.Select(&slots, `
+	SELECT
+		slot, root, parent_root
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = 2
+	ORDER BY slot ASC
+	`, firstSlot, lastSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching orphaned slot refs: %v", err)
+		return nil
+	}
+	return slots
+}
+
+// GetSlotTxTypeStats returns the transaction type aggregates of the canonical post-merge blocks in the given slot range, grouped by bucketSize slots.
+// blocks with transactions but without recorded type counts (indexed before transaction types were tracked) are excluded.
+func GetSlotTxTypeStats(firstSlot uint64, lastSlot uint64, bucketSize uint64) []*dbtypes.SlotTxTypeStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.SlotTxTypeStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(slot) AS first_slot, MAX(slot) AS last_slot, COUNT(*) AS block_count, SUM(eth_transaction_count) AS transaction_sum,
+		SUM(eth_tx_legacy_count) AS legacy_sum, SUM(eth_tx_access_list_count) AS access_list_sum, SUM(eth_tx_dynamic_fee_count) AS dynamic_fee_sum,
+		SUM(eth_tx_blob_count) AS blob_sum, SUM(eth_tx_setcode_count) AS setcode_sum
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = 1 AND eth_block_number IS NOT NULL AND (
+		eth_transaction_count = 0 OR
+		eth_tx_legacy_count + eth_tx_access_list_count + eth_tx_dynamic_fee_count + eth_tx_blob_count + eth_tx_setcode_count > 0
+	)
+	GROUP BY slot / $3
+	ORDER BY first_slot ASC
+	`, firstSlot, lastSlot, bucketSize)
+	if err != nil {
+		logger.Errorf("Error while fetching slot transaction type stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetSlotFeeStats returns the execution fee aggregates of the canonical post-merge blocks in the given slot range, grouped by bucketSize slots.
+// priority fees are only summed up for blocks where they have been loaded from the execution clients (see priority_block_count).
+func GetSlotFeeStats(firstSlot uint64, lastSlot uint64, bucketSize uint64) []*dbtypes.SlotFeeStats {
+	if bucketSize == 0 {
+		bucketSize = 1
+	}
+
+	stats := []*dbtypes.SlotFeeStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		MIN(slot) AS first_slot, MAX(slot) AS last_slot, COUNT(*) AS block_count, SUM(eth_gas_used) AS gas_used_sum,
+		SUM(eth_burned_fees) AS burned_fees_sum, COUNT(eth_priority_fees) AS priority_block_count,
+		COALESCE(SUM(eth_priority_fees), 0) AS priority_fees_sum
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = 1 AND eth_block_number IS NOT NULL AND eth_base_fee > 0
+	GROUP BY slot / $3
+	ORDER BY first_slot ASC
+	`, firstSlot, lastSlot, bucketSize)
+	if err != nil {
+		logger.Errorf("Error while fetching slot fee stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetSlotsWithoutPriorityFees returns canonical post-merge blocks in the given slot range (minSlot inclusive, beforeSlot exclusive)
+// that have no priority fees loaded yet (newest first).
+func GetSlotsWithoutPriorityFees(minSlot uint64, beforeSlot uint64, limit uint32) []*dbtypes.Slot {
+	slots := []*dbtypes.Slot{}
+	err := ReaderDb.Select(&slots, `
+	SELECT
+		slot, root, status, eth_block_number, eth_block_hash, eth_transaction_count, eth_base_fee, eth_burned_fees
+	FROM slots
+	WHERE slot >= $1 AND slot < $2 AND status = 1 AND eth_block_number IS NOT NULL AND eth_priority_fees IS NULL
+	ORDER BY slot DESC
+	LIMIT $3
+	`, minSlot, beforeSlot, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching slots without priority fees: %v", err)
+		return nil
+	}
+	return slots
+}
+
+// UpdateSlotPriorityFees sets the priority fees (in gwei) of the block with the given root.
+func UpdateSlotPriorityFees(root []byte, priorityFees uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`UPDATE slots SET eth_priority_fees = $1 WHERE root = $2`, priorityFees, root)
+	return err
+}
+
+// GetProposerBlobStats returns the blob inclusion aggregates per proposer of the canonical post-merge blocks in the given slot range.
+// blocks without recorded block sizes (indexed before sizes were tracked) are excluded, as their blob count is not known yet.
+func GetProposerBlobStats(firstSlot uint64, lastSlot uint64) []*dbtypes.ProposerBlobStats {
+	stats := []*dbtypes.ProposerBlobStats{}
+	err := ReaderDb.Select(&stats, `
+	SELECT
+		proposer, COUNT(*) AS block_count, SUM(CASE WHEN blob_count > 0 THEN 1 ELSE 0 END) AS blob_block_count,
+		SUM(blob_count) AS blob_count, MAX(blob_count) AS max_blob_count
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = 1 AND eth_block_number IS NOT NULL AND block_size > 0
+	GROUP BY proposer
+	`, firstSlot, lastSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching proposer blob stats: %v", err)
+		return nil
+	}
+	return stats
+}
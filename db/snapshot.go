@@ -0,0 +1,386 @@
+package db
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	snapshotFormat        = "dora-snapshot"
+	snapshotFormatVersion = 1
+	snapshotChunkSize     = 1000
+)
+
+// SnapshotHeader describes the content of an explorer snapshot.
+type SnapshotHeader struct {
+	Format        string
+	FormatVersion uint32
+	Engine        dbtypes.DBEngineType
+	SchemaVersion int64
+	BuildVersion  string
+	CreatedAt     time.Time
+}
+
+// snapshotRecord is a chunk of table rows in the snapshot stream.
+// the last record of a complete snapshot has the End flag set.
+type snapshotRecord struct {
+	Table   string
+	Columns []string
+	Rows    [][]interface{}
+	End     bool
+}
+
+func init() {
+	gob.Register(time.Time{})
+}
+
+// getSnapshotTables returns all explorer tables, excluding the schema migration table.
+func getSnapshotTables(tx *sqlx.Tx) ([]string, error) {
+	tableNames := []string{}
+	var err error
+
+	switch DbEngine {
+	case dbtypes.DBEnginePgsql:
+		err = tx.Select(&tableNames, `SELECT table_name FROM information_schema.tables WHERE table_schema = 'public' AND table_type = 'BASE TABLE' AND table_name != 'goose_db_version' ORDER BY table_name`)
+	case dbtypes.DBEngineSqlite:
+		err = tx.Select(&tableNames, `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name != 'goose_db_version' ORDER BY name`)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tableNames, nil
+}
+
+// DumpSnapshot writes a compressed snapshot of all explorer tables to the writer.
+// As the indexer persists its unfinalized state (blocks, epochs, duties & explorer state) to the database,
+// the snapshot contains everything needed to bootstrap another explorer instance without re-syncing.
+func DumpSnapshot(ctx context.Context, writer io.Writer) (*SnapshotHeader, error) {
+	schemaVersion, err := GetDbSchemaVersion()
+	if err != nil {
+		return nil, fmt.Errorf("error getting schema version: %v", err)
+	}
+
+	header := &SnapshotHeader{
+		Format:        snapshotFormat,
+		FormatVersion: snapshotFormatVersion,
+		Engine:        DbEngine,
+		SchemaVersion: schemaVersion,
+		BuildVersion:  utils.BuildVersion,
+		CreatedAt:     time.Now(),
+	}
+
+	// read all tables within a single transaction to get a consistent view
+	txOpts := &sql.TxOptions{ReadOnly: true}
+	if DbEngine == dbtypes.DBEnginePgsql {
+		txOpts.Isolation = sql.LevelRepeatableRead
+	}
+	tx, err := ReaderDb.BeginTxx(ctx, txOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error starting db transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	tableNames, err := getSnapshotTables(tx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading table names: %v", err)
+	}
+
+	gzipWriter := gzip.NewWriter(writer)
+	encoder := gob.NewEncoder(gzipWriter)
+
+	if err := encoder.Encode(header); err != nil {
+		return nil, fmt.Errorf("error writing snapshot header: %v", err)
+	}
+
+	for _, tableName := range tableNames {
+		rowCount, err := dumpSnapshotTable(ctx, tx, encoder, tableName)
+		if err != nil {
+			return nil, fmt.Errorf("error dumping table %v: %v", tableName, err)
+		}
+
+		logger.Infof("dumped table %v: %v rows", tableName, rowCount)
+	}
+
+	if err := encoder.Encode(&snapshotRecord{End: true}); err != nil {
+		return nil, fmt.Errorf("error writing snapshot trailer: %v", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("error finishing snapshot: %v", err)
+	}
+
+	return header, nil
+}
+
+func dumpSnapshotTable(ctx context.Context, tx *sqlx.Tx, encoder *gob.Encoder, tableName string) (uint64, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`SELECT * FROM "%v"`, tableName))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+
+	rowCount := uint64(0)
+	record := &snapshotRecord{
+		Table:   tableName,
+		Columns: columns,
+		Rows:    make([][]interface{}, 0, snapshotChunkSize),
+	}
+
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		valuePtrs := make([]interface{}, len(columns))
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return rowCount, err
+		}
+
+		record.Rows = append(record.Rows, values)
+		rowCount++
+
+		if len(record.Rows) >= snapshotChunkSize {
+			if err := encoder.Encode(record); err != nil {
+				return rowCount, err
+			}
+			record.Rows = record.Rows[:0]
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return rowCount, err
+	}
+
+	if len(record.Rows) > 0 {
+		if err := encoder.Encode(record); err != nil {
+			return rowCount, err
+		}
+	}
+
+	return rowCount, nil
+}
+
+// RestoreSnapshot restores a snapshot created by DumpSnapshot into the database.
+// The database schema is migrated to the schema version of the snapshot before restoring, so the snapshot
+// can be restored to a fresh database and migrated to the latest schema afterwards.
+// Restoring fails if the database schema is ahead of the snapshot or the snapshot requires a newer explorer version.
+// Existing data is only overwritten if force is set.
+func RestoreSnapshot(reader io.Reader, force bool) (*SnapshotHeader, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("error opening snapshot: %v", err)
+	}
+	defer gzipReader.Close()
+
+	decoder := gob.NewDecoder(gzipReader)
+
+	header := &SnapshotHeader{}
+	if err := decoder.Decode(header); err != nil {
+		return nil, fmt.Errorf("error reading snapshot header: %v", err)
+	}
+
+	if header.Format != snapshotFormat {
+		return nil, fmt.Errorf("invalid snapshot format: %v", header.Format)
+	}
+	if header.FormatVersion != snapshotFormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot format version: %v", header.FormatVersion)
+	}
+	if header.Engine != DbEngine {
+		return nil, fmt.Errorf("snapshot was created from a different database engine, cross-engine restores are not supported")
+	}
+
+	// bring the database schema to the snapshot schema version
+	schemaVersion, err := GetDbSchemaVersion()
+	if err != nil {
+		return nil, fmt.Errorf("error getting schema version: %v", err)
+	}
+	if schemaVersion > header.SchemaVersion {
+		return nil, fmt.Errorf("database schema version %v is newer than snapshot schema version %v, restore requires an empty database", schemaVersion, header.SchemaVersion)
+	}
+	if schemaVersion < header.SchemaVersion {
+		if err := ApplyEmbeddedDbSchema(header.SchemaVersion); err != nil {
+			return nil, fmt.Errorf("error applying schema version %v: %v", header.SchemaVersion, err)
+		}
+
+		schemaVersion, err = GetDbSchemaVersion()
+		if err != nil {
+			return nil, fmt.Errorf("error getting schema version: %v", err)
+		}
+		if schemaVersion != header.SchemaVersion {
+			return nil, fmt.Errorf("snapshot schema version %v is not supported by this explorer version (latest schema version: %v)", header.SchemaVersion, schemaVersion)
+		}
+	}
+
+	err = RunDBTransaction(func(tx *sqlx.Tx) error {
+		tableNames, err := getSnapshotTables(tx)
+		if err != nil {
+			return fmt.Errorf("error loading table names: %v", err)
+		}
+
+		knownTables := map[string]bool{}
+		for _, tableName := range tableNames {
+			knownTables[tableName] = true
+
+			hasRows := false
+			if err := tx.Get(&hasRows, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM "%v")`, tableName)); err != nil {
+				return fmt.Errorf("error checking table %v: %v", tableName, err)
+			}
+			if !hasRows {
+				continue
+			}
+			if !force {
+				return fmt.Errorf("table %v is not empty, use force to overwrite existing data", tableName)
+			}
+			if _, err := tx.Exec(fmt.Sprintf(`DELETE FROM "%v"`, tableName)); err != nil {
+				return fmt.Errorf("error clearing table %v: %v", tableName, err)
+			}
+		}
+
+		tableRows := map[string]uint64{}
+		for {
+			record := &snapshotRecord{}
+			if err := decoder.Decode(record); err != nil {
+				if err == io.EOF {
+					return fmt.Errorf("snapshot is incomplete")
+				}
+				return fmt.Errorf("error reading snapshot: %v", err)
+			}
+
+			if record.End {
+				break
+			}
+			if !knownTables[record.Table] {
+				return fmt.Errorf("snapshot table %v does not exist in database", record.Table)
+			}
+
+			if err := restoreSnapshotRows(tx, record); err != nil {
+				return fmt.Errorf("error restoring table %v: %v", record.Table, err)
+			}
+
+			tableRows[record.Table] += uint64(len(record.Rows))
+		}
+
+		if err := resetSnapshotSequences(tx); err != nil {
+			return fmt.Errorf("error resetting sequences: %v", err)
+		}
+
+		for _, tableName := range tableNames {
+			logger.Infof("restored table %v: %v rows", tableName, tableRows[tableName])
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// resetSnapshotSequences advances the sequences of all serial & identity columns (e.g. annotations.id, test_runs.id)
+// past the restored ids, as the restored rows are inserted with explicit ids and do not advance the sequences.
+// sqlite derives the next rowid from the table contents, so this is only needed for pgsql.
+func resetSnapshotSequences(tx *sqlx.Tx) error {
+	if DbEngine != dbtypes.DBEnginePgsql {
+		return nil
+	}
+
+	serialColumns := []struct {
+		Table  string `db:"table_name"`
+		Column string `db:"column_name"`
+	}{}
+	err := tx.Select(&serialColumns, `
+		SELECT table_name, column_name
+		FROM information_schema.columns
+		WHERE table_schema = 'public' AND (column_default LIKE 'nextval(%' OR is_identity = 'YES')
+		ORDER BY table_name, column_name`)
+	if err != nil {
+		return err
+	}
+
+	for _, serialColumn := range serialColumns {
+		_, err := tx.Exec(fmt.Sprintf(
+			`SELECT setval(pg_get_serial_sequence($1, $2), COALESCE((SELECT MAX("%v") FROM "%v"), 0) + 1, false)`,
+			serialColumn.Column, serialColumn.Table,
+		), fmt.Sprintf(`"%v"`, serialColumn.Table), serialColumn.Column)
+		if err != nil {
+			return fmt.Errorf("error resetting sequence of %v.%v: %v", serialColumn.Table, serialColumn.Column, err)
+		}
+	}
+
+	return nil
+}
+
+func restoreSnapshotRows(tx *sqlx.Tx, record *snapshotRecord) error {
+	columnCount := len(record.Columns)
+	if columnCount == 0 {
+		return nil
+	}
+
+	quotedColumns := make([]string, columnCount)
+	for i, column := range record.Columns {
+		quotedColumns[i] = fmt.Sprintf(`"%v"`, column)
+	}
+
+	// keep the number of query arguments below the engine limits
+	batchSize := 30000 / columnCount
+	if batchSize > 100 {
+		batchSize = 100
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for offset := 0; offset < len(record.Rows); offset += batchSize {
+		batchRows := record.Rows[offset:]
+		if len(batchRows) > batchSize {
+			batchRows = batchRows[:batchSize]
+		}
+
+		var sql strings.Builder
+		fmt.Fprintf(&sql, `INSERT INTO "%v" (%v) VALUES `, record.Table, strings.Join(quotedColumns, ", "))
+
+		args := make([]interface{}, 0, len(batchRows)*columnCount)
+		for rowIdx, row := range batchRows {
+			if len(row) != columnCount {
+				return fmt.Errorf("invalid row column count: %v, expected %v", len(row), columnCount)
+			}
+
+			if rowIdx > 0 {
+				fmt.Fprint(&sql, ", ")
+			}
+			fmt.Fprint(&sql, "(")
+			for colIdx, value := range row {
+				if colIdx > 0 {
+					fmt.Fprint(&sql, ", ")
+				}
+				args = append(args, value)
+				fmt.Fprintf(&sql, "$%v", len(args))
+			}
+			fmt.Fprint(&sql, ")")
+		}
+
+		if _, err := tx.Exec(sql.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
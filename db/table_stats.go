@@ -0,0 +1,83 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// GetTableStats returns row counts and disk usage of all explorer tables.
+// pgsql row counts are estimates from the table statistics, sqlite row counts are exact.
+func GetTableStats() ([]*dbtypes.TableStats, error) {
+	tableStats := []*dbtypes.TableStats{}
+
+	switch DbEngine {
+	case dbtypes.DBEnginePgsql:
+		err := ReaderDb.Select(&tableStats, `
+		SELECT
+			relname AS table_name,
+			GREATEST(n_live_tup, 0) AS row_count,
+			pg_table_size(relid) AS table_size,
+			pg_indexes_size(relid) AS index_size
+		FROM pg_stat_user_tables
+		WHERE schemaname = 'public'
+		ORDER BY relname`)
+		if err != nil {
+			return nil, err
+		}
+
+	case dbtypes.DBEngineSqlite:
+		tableNames := []string{}
+		err := ReaderDb.Select(&tableNames, `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+		if err != nil {
+			return nil, err
+		}
+
+		// page usage per table and index (requires the dbstat virtual table)
+		type sqliteObjectSize struct {
+			Name    string `db:"name"`
+			Table   string `db:"tbl_name"`
+			IsIndex bool   `db:"is_index"`
+			Size    uint64 `db:"size"`
+		}
+		objectSizes := []*sqliteObjectSize{}
+		err = ReaderDb.Select(&objectSizes, `
+		SELECT
+			m.name AS name,
+			m.tbl_name AS tbl_name,
+			m.type = 'index' AS is_index,
+			SUM(s.pgsize) AS size
+		FROM dbstat s
+		JOIN sqlite_master m ON m.name = s.name
+		GROUP BY m.name, m.tbl_name, m.type`)
+		if err != nil {
+			logger.Debugf("sqlite dbstat not available: %v", err)
+		}
+
+		for _, tableName := range tableNames {
+			stats := &dbtypes.TableStats{
+				Table: tableName,
+			}
+
+			err := ReaderDb.Get(&stats.RowCount, fmt.Sprintf(`SELECT COUNT(*) FROM "%v"`, tableName))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, objectSize := range objectSizes {
+				if objectSize.Table != tableName {
+					continue
+				}
+				if objectSize.IsIndex {
+					stats.IndexSize += objectSize.Size
+				} else {
+					stats.TableSize += objectSize.Size
+				}
+			}
+
+			tableStats = append(tableStats, stats)
+		}
+	}
+
+	return tableStats, nil
+}
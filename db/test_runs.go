@@ -0,0 +1,31 @@
+package db
+
+import (
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func GetTestRuns() ([]*dbtypes.TestRun, error) {
+	testRuns := []*dbtypes.TestRun{}
+	err := ReaderDb.Select(&testRuns, `SELECT id, name, source, url, status, start_slot, end_slot, created_at, updated_at FROM test_runs ORDER BY start_slot ASC, id ASC`)
+	if err != nil {
+		return nil, err
+	}
+	return testRuns, nil
+}
+
+func InsertTestRun(testRun *dbtypes.TestRun, tx *sqlx.Tx) error {
+	err := tx.Get(&testRun.Id, `INSERT INTO test_runs (name, source, url, status, start_slot, end_slot, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`, testRun.Name, testRun.Source, testRun.Url, testRun.Status, testRun.StartSlot, testRun.EndSlot, testRun.CreatedAt, testRun.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func UpdateTestRun(testRun *dbtypes.TestRun, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`UPDATE test_runs SET status = $1, end_slot = $2, updated_at = $3 WHERE id = $4`, testRun.Status, testRun.EndSlot, testRun.UpdatedAt, testRun.Id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+// max number of tx hashes inserted per statement (keeps the statement args below the engine limits)
+const txHashesInsertBatchSize = 1000
+
+func InsertTxHashes(txHashes []*dbtypes.TxHash, tx *sqlx.Tx) error {
+	for start := 0; start < len(txHashes); start += txHashesInsertBatchSize {
+		end := start + txHashesInsertBatchSize
+		if end > len(txHashes) {
+			end = len(txHashes)
+		}
+		if err := insertTxHashes(txHashes[start:end], tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertTxHashes(txHashes []*dbtypes.TxHash, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO tx_hashes ",
+			dbtypes.DBEngineSqlite: "INSERT OR IGNORE INTO tx_hashes ",
+		}),
+		"(tx_hash, block_root, slot, tx_index)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 4
+
+	args := make([]any, len(txHashes)*fieldCount)
+	for i, txHash := range txHashes {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = txHash.TxHash
+		args[argIdx+1] = txHash.BlockRoot
+		args[argIdx+2] = txHash.Slot
+		args[argIdx+3] = txHash.TxIndex
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (tx_hash, block_root) DO NOTHING",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetBlocksByTxHash returns the blocks including the given execution transaction, canonical blocks first.
+func GetBlocksByTxHash(txHash []byte) []*dbtypes.TxHashBlock {
+	blocks := []*dbtypes.TxHashBlock{}
+	err := ReaderDb.Select(&blocks, `
+	SELECT slots.slot, slots.root, slots.status, tx_hashes.tx_index
+	FROM tx_hashes
+	LEFT JOIN slots ON slots.root = tx_hashes.block_root
+	WHERE tx_hashes.tx_hash = $1 AND slots.root IS NOT NULL
+	ORDER BY slots.status ASC, slots.slot DESC
+	`, txHash)
+	if err != nil {
+		logger.Errorf("Error while fetching blocks by tx hash: %v", err)
+		return nil
+	}
+	return blocks
+}
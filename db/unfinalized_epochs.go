@@ -11,8 +11,9 @@ func InsertUnfinalizedEpoch(epoch *dbtypes.UnfinalizedEpoch, tx *sqlx.Tx) error
 			INSERT INTO unfinalized_epochs (
 				epoch, dependent_root, epoch_head_root, epoch_head_fork_id, validator_count, validator_balance, eligible, voted_target, 
 				voted_head, voted_total, block_count, orphaned_count, attestation_count, deposit_count, exit_count, withdraw_count, 
-				withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
+				withdraw_amount, full_withdraw_count, full_withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count,
+				eth_transaction_count, sync_participation, finality_delay, penalized_count, penalty_amount, inclusion_dist_1, inclusion_dist_2, inclusion_dist_3, inclusion_dist_late, inclusion_dist_sum
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)
 			ON CONFLICT (epoch, dependent_root, epoch_head_root) DO UPDATE SET
 				epoch_head_fork_id = excluded.epoch_head_fork_id,
 				validator_count = excluded.validator_count,
@@ -28,21 +29,34 @@ func InsertUnfinalizedEpoch(epoch *dbtypes.UnfinalizedEpoch, tx *sqlx.Tx) error
 				exit_count = excluded.exit_count, 
 				withdraw_count = excluded.withdraw_count, 
 				withdraw_amount = excluded.withdraw_amount, 
+				full_withdraw_count = excluded.full_withdraw_count,
+				full_withdraw_amount = excluded.full_withdraw_amount,
 				attester_slashing_count = excluded.attester_slashing_count, 
 				proposer_slashing_count = excluded.proposer_slashing_count, 
 				bls_change_count = excluded.bls_change_count, 
 				eth_transaction_count = excluded.eth_transaction_count, 
-				sync_participation = excluded.sync_participation`,
+				sync_participation = excluded.sync_participation,
+				finality_delay = excluded.finality_delay,
+				penalized_count = excluded.penalized_count,
+				penalty_amount = excluded.penalty_amount,
+				inclusion_dist_1 = excluded.inclusion_dist_1,
+				inclusion_dist_2 = excluded.inclusion_dist_2,
+				inclusion_dist_3 = excluded.inclusion_dist_3,
+				inclusion_dist_late = excluded.inclusion_dist_late,
+				inclusion_dist_sum = excluded.inclusion_dist_sum`,
 		dbtypes.DBEngineSqlite: `
 			INSERT OR REPLACE INTO unfinalized_epochs (
 				epoch, dependent_root, epoch_head_root, epoch_head_fork_id, validator_count, validator_balance, eligible, voted_target, 
 				voted_head, voted_total, block_count, orphaned_count, attestation_count, deposit_count, exit_count, withdraw_count, 
-				withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`,
+				withdraw_amount, full_withdraw_count, full_withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count,
+				eth_transaction_count, sync_participation, finality_delay, penalized_count, penalty_amount, inclusion_dist_1, inclusion_dist_2, inclusion_dist_3, inclusion_dist_late, inclusion_dist_sum
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)`,
 	}),
 		epoch.Epoch, epoch.DependentRoot, epoch.EpochHeadRoot, epoch.EpochHeadForkId, epoch.ValidatorCount, epoch.ValidatorBalance, epoch.Eligible, epoch.VotedTarget,
 		epoch.VotedHead, epoch.VotedTotal, epoch.BlockCount, epoch.OrphanedCount, epoch.AttestationCount, epoch.DepositCount, epoch.ExitCount, epoch.WithdrawCount,
-		epoch.WithdrawAmount, epoch.AttesterSlashingCount, epoch.ProposerSlashingCount, epoch.BLSChangeCount, epoch.EthTransactionCount, epoch.SyncParticipation,
+		epoch.WithdrawAmount, epoch.FullWithdrawCount, epoch.FullWithdrawAmount, epoch.AttesterSlashingCount, epoch.ProposerSlashingCount, epoch.BLSChangeCount,
+		epoch.EthTransactionCount, epoch.SyncParticipation, epoch.FinalityDelay, epoch.PenalizedCount, epoch.PenaltyAmount,
+		epoch.InclusionDist1, epoch.InclusionDist2, epoch.InclusionDist3, epoch.InclusionDistLate, epoch.InclusionDistSum,
 	)
 	if err != nil {
 		return err
@@ -55,7 +69,8 @@ func StreamUnfinalizedEpochs(epoch uint64, cb func(duty *dbtypes.UnfinalizedEpoc
 	SELECT
 		epoch, dependent_root, epoch_head_root, epoch_head_fork_id, validator_count, validator_balance, eligible, voted_target,
 		voted_head, voted_total, block_count, orphaned_count, attestation_count, deposit_count, exit_count, withdraw_count,
-		withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation
+		withdraw_amount, full_withdraw_count, full_withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count,
+		eth_transaction_count, sync_participation, finality_delay, penalized_count, penalty_amount, inclusion_dist_1, inclusion_dist_2, inclusion_dist_3, inclusion_dist_late, inclusion_dist_sum
 	FROM unfinalized_epochs
 	WHERE epoch >= $1`, epoch)
 	if err != nil {
@@ -68,7 +83,9 @@ func StreamUnfinalizedEpochs(epoch uint64, cb func(duty *dbtypes.UnfinalizedEpoc
 		err := rows.Scan(
 			&e.Epoch, &e.DependentRoot, &e.EpochHeadRoot, &e.EpochHeadForkId, &e.ValidatorCount, &e.ValidatorBalance, &e.Eligible, &e.VotedTarget,
 			&e.VotedHead, &e.VotedTotal, &e.BlockCount, &e.OrphanedCount, &e.AttestationCount, &e.DepositCount, &e.ExitCount, &e.WithdrawCount,
-			&e.WithdrawAmount, &e.AttesterSlashingCount, &e.ProposerSlashingCount, &e.BLSChangeCount, &e.EthTransactionCount, &e.SyncParticipation,
+			&e.WithdrawAmount, &e.FullWithdrawCount, &e.FullWithdrawAmount, &e.AttesterSlashingCount, &e.ProposerSlashingCount, &e.BLSChangeCount,
+			&e.EthTransactionCount, &e.SyncParticipation, &e.FinalityDelay, &e.PenalizedCount, &e.PenaltyAmount,
+			&e.InclusionDist1, &e.InclusionDist2, &e.InclusionDist3, &e.InclusionDistLate, &e.InclusionDistSum,
 		)
 		if err != nil {
 			logger.Errorf("Error while scanning unfinalized epoch: %v", err)
@@ -86,7 +103,8 @@ func GetUnfinalizedEpoch(epoch uint64, headRoot []byte) *dbtypes.UnfinalizedEpoc
 	SELECT
 		epoch, dependent_root, epoch_head_root, epoch_head_fork_id, validator_count, validator_balance, eligible, voted_target,
 		voted_head, voted_total, block_count, orphaned_count, attestation_count, deposit_count, exit_count, withdraw_count,
-		withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count, eth_transaction_count, sync_participation
+		withdraw_amount, full_withdraw_count, full_withdraw_amount, attester_slashing_count, proposer_slashing_count, bls_change_count,
+		eth_transaction_count, sync_participation, finality_delay, penalized_count, penalty_amount, inclusion_dist_1, inclusion_dist_2, inclusion_dist_3, inclusion_dist_late, inclusion_dist_sum
 	FROM unfinalized_epochs
 	WHERE epoch = $1 AND epoch_head_root = $2
 	`, epoch, headRoot)
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertValidatorEvents(validatorEvents []*dbtypes.ValidatorEvent, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO validator_events ",
+			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO validator_events ",
+		}),
+		"(validator_index, event_type, epoch)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 3
+
+	args := make([]any, len(validatorEvents)*fieldCount)
+	for i, validatorEvent := range validatorEvents {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = validatorEvent.ValidatorIndex
+		args[argIdx+1] = validatorEvent.EventType
+		args[argIdx+2] = validatorEvent.Epoch
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (validator_index, event_type) DO UPDATE SET epoch = excluded.epoch",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetValidatorEventsFiltered(offset uint64, limit uint32, filter *dbtypes.ValidatorEventFilter) ([]*dbtypes.ValidatorEvent, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			validator_index, event_type, epoch
+		FROM validator_events
+	`)
+
+	if filter.ValidatorName != "" {
+		fmt.Fprint(&sql, `
+		LEFT JOIN validator_names ON validator_names."index" = validator_events.validator_index 
+		`)
+	}
+
+	filterOp := "WHERE"
+	if filter.MinEpoch > 0 {
+		args = append(args, filter.MinEpoch)
+		fmt.Fprintf(&sql, " %v epoch >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxEpoch > 0 {
+		args = append(args, filter.MaxEpoch)
+		fmt.Fprintf(&sql, " %v epoch <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinIndex > 0 {
+		args = append(args, filter.MinIndex)
+		fmt.Fprintf(&sql, " %v validator_index >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxIndex > 0 {
+		args = append(args, filter.MaxIndex)
+		fmt.Fprintf(&sql, " %v validator_index <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.EventType != dbtypes.UnspecifiedValidatorEvent {
+		args = append(args, filter.EventType)
+		fmt.Fprintf(&sql, " %v event_type = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.ValidatorName != "" {
+		args = append(args, "%"+filter.ValidatorName+"%")
+		fmt.Fprintf(&sql, " %v ", filterOp)
+		fmt.Fprintf(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  ` validator_names.name ilike $%v `,
+			dbtypes.DBEngineSqlite: ` validator_names.name LIKE $%v `,
+		}), len(args))
+
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `) 
+	SELECT 
+		count(*) AS validator_index, 
+		0 AS event_type,
+		0 AS epoch
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY epoch DESC, event_type DESC, validator_index ASC
+	LIMIT $%v 
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	validatorEvents := []*dbtypes.ValidatorEvent{}
+	err := ReaderDb.Select(&validatorEvents, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered validator events: %v", err)
+		return nil, 0, err
+	}
+
+	return validatorEvents[1:], validatorEvents[0].ValidatorIndex, nil
+}
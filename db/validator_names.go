@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -18,6 +19,29 @@ func GetValidatorNames(minIdx uint64, maxIdx uint64) []*dbtypes.ValidatorName {
 	return names
 }
 
+// SearchValidatorNames returns up to limit validator names containing the search term (case insensitive).
+func SearchValidatorNames(ctx context.Context, search string, limit uint32) ([]*dbtypes.ValidatorName, error) {
+	names := []*dbtypes.ValidatorName{}
+	err := ReaderDb.SelectContext(ctx, &names, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			SELECT "index", "name"
+			FROM validator_names
+			WHERE "name" ILIKE $1
+			ORDER BY "index" ASC
+			LIMIT $2`,
+		dbtypes.DBEngineSqlite: `
+			SELECT "index", "name"
+			FROM validator_names
+			WHERE "name" LIKE $1
+			ORDER BY "index" ASC
+			LIMIT $2`,
+	}), "%"+search+"%", limit)
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
 func InsertValidatorNames(validatorNames []*dbtypes.ValidatorName, tx *sqlx.Tx) error {
 	var sql strings.Builder
 	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
@@ -66,3 +90,77 @@ func DeleteValidatorNames(validatorNames []uint64, tx *sqlx.Tx) error {
 	}
 	return nil
 }
+
+func GetValidatorGraffitiNames() []*dbtypes.ValidatorGraffitiName {
+	names := []*dbtypes.ValidatorGraffitiName{}
+	err := ReaderDb.Select(&names, `SELECT "index", "name", "slot" FROM validator_graffiti_names`)
+	if err != nil {
+		logger.Errorf("Error while fetching validator graffiti names: %v", err)
+		return nil
+	}
+	return names
+}
+
+func InsertValidatorGraffitiNames(graffitiNames []*dbtypes.ValidatorGraffitiName, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO validator_graffiti_names ("index", "name", "slot") VALUES `,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO validator_graffiti_names ("index", "name", "slot") VALUES `,
+	}))
+	argIdx := 0
+	args := make([]any, len(graffitiNames)*3)
+	for i, graffitiName := range graffitiNames {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3)
+		args[argIdx] = graffitiName.Index
+		args[argIdx+1] = graffitiName.Name
+		args[argIdx+2] = graffitiName.Slot
+		argIdx += 3
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  ` ON CONFLICT ("index") DO UPDATE SET name = excluded.name, slot = excluded.slot`,
+		dbtypes.DBEngineSqlite: "",
+	}))
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetValidatorNameOverrides() ([]*dbtypes.ValidatorNameOverride, error) {
+	overrides := []*dbtypes.ValidatorNameOverride{}
+	err := ReaderDb.Select(&overrides, `SELECT "key", "name", "updated_at" FROM validator_name_overrides ORDER BY "key"`)
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+func InsertValidatorNameOverride(override *dbtypes.ValidatorNameOverride, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO validator_name_overrides ("key", "name", "updated_at") VALUES ($1, $2, $3)
+			ON CONFLICT ("key") DO UPDATE SET name = excluded.name, updated_at = excluded.updated_at`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO validator_name_overrides ("key", "name", "updated_at") VALUES ($1, $2, $3)`,
+	}), override.Key, override.Name, override.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func DeleteValidatorNameOverride(key string, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`DELETE FROM validator_name_overrides WHERE "key" = $1`, key)
+	if err != nil {
+		return false, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertValidatorSnapshot(snapshot *dbtypes.ValidatorSnapshot, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO validator_snapshots (
+				epoch, dependent_root, validator_count, snapshot
+			) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (epoch, dependent_root) DO NOTHING`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR IGNORE INTO validator_snapshots (
+				epoch, dependent_root, validator_count, snapshot
+			) VALUES ($1, $2, $3, $4)`,
+	}), snapshot.Epoch, snapshot.DependentRoot, snapshot.ValidatorCount, snapshot.Snapshot)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetValidatorSnapshotsBefore returns all snapshots (one per dependent root) of the latest snapshot epoch <= epoch.
+func GetValidatorSnapshotsBefore(epoch uint64) ([]*dbtypes.ValidatorSnapshot, error) {
+	snapshots := []*dbtypes.ValidatorSnapshot{}
+	err := ReaderDb.Select(&snapshots, `
+	SELECT epoch, dependent_root, validator_count, snapshot
+	FROM validator_snapshots
+	WHERE epoch = (
+		SELECT MAX(epoch) FROM validator_snapshots WHERE epoch <= $1
+	)
+	`, epoch)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// DeleteOrphanedValidatorSnapshots deletes all snapshots of the epoch that are not based on the canonical dependent root.
+func DeleteOrphanedValidatorSnapshots(epoch uint64, dependentRoot []byte, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM validator_snapshots WHERE epoch = $1 AND dependent_root != $2`, epoch, dependentRoot)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func DeleteValidatorSnapshotsBefore(epoch uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM validator_snapshots WHERE epoch < $1`, epoch)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -10,6 +10,18 @@ type ValidatorName struct {
 	Name  string `db:"name"`
 }
 
+type ValidatorGraffitiName struct {
+	Index uint64 `db:"index"`
+	Name  string `db:"name"`
+	Slot  uint64 `db:"slot"`
+}
+
+type ValidatorNameOverride struct {
+	Key       string `db:"key"`
+	Name      string `db:"name"`
+	UpdatedAt uint64 `db:"updated_at"`
+}
+
 type SlotStatus uint8
 
 const (
@@ -48,6 +60,20 @@ type Slot struct {
 	EthBlockExtraText     string     `db:"eth_block_extra_text"`
 	SyncParticipation     float32    `db:"sync_participation"`
 	ForkId                uint64     `db:"fork_id"`
+	BlockSize             uint64     `db:"block_size"`
+	AttestationsSize      uint64     `db:"attestations_size"`
+	PayloadSize           uint64     `db:"payload_size"`
+	BlobRefsSize          uint64     `db:"blob_refs_size"`
+	EthTxLegacyCount      uint64     `db:"eth_tx_legacy_count"`
+	EthTxAccessListCount  uint64     `db:"eth_tx_access_list_count"`
+	EthTxDynamicFeeCount  uint64     `db:"eth_tx_dynamic_fee_count"`
+	EthTxBlobCount        uint64     `db:"eth_tx_blob_count"`
+	EthTxSetCodeCount     uint64     `db:"eth_tx_setcode_count"`
+	EthGasUsed            uint64     `db:"eth_gas_used"`
+	EthBaseFee            uint64     `db:"eth_base_fee"`
+	EthBurnedFees         uint64     `db:"eth_burned_fees"`
+	EthPriorityFees       *uint64    `db:"eth_priority_fees"`
+	BlobCount             uint64     `db:"blob_count"`
 }
 
 type Epoch struct {
@@ -65,11 +91,21 @@ type Epoch struct {
 	ExitCount             uint64  `db:"exit_count"`
 	WithdrawCount         uint64  `db:"withdraw_count"`
 	WithdrawAmount        uint64  `db:"withdraw_amount"`
+	FullWithdrawCount     uint64  `db:"full_withdraw_count"`
+	FullWithdrawAmount    uint64  `db:"full_withdraw_amount"`
 	AttesterSlashingCount uint64  `db:"attester_slashing_count"`
 	ProposerSlashingCount uint64  `db:"proposer_slashing_count"`
 	BLSChangeCount        uint64  `db:"bls_change_count"`
 	EthTransactionCount   uint64  `db:"eth_transaction_count"`
 	SyncParticipation     float32 `db:"sync_participation"`
+	FinalityDelay         uint64  `db:"finality_delay"`
+	PenalizedCount        uint64  `db:"penalized_count"`
+	PenaltyAmount         uint64  `db:"penalty_amount"`
+	InclusionDist1        uint64  `db:"inclusion_dist_1"`
+	InclusionDist2        uint64  `db:"inclusion_dist_2"`
+	InclusionDist3        uint64  `db:"inclusion_dist_3"`
+	InclusionDistLate     uint64  `db:"inclusion_dist_late"`
+	InclusionDistSum      uint64  `db:"inclusion_dist_sum"`
 }
 
 type OrphanedBlock struct {
@@ -128,11 +164,21 @@ type UnfinalizedEpoch struct {
 	ExitCount             uint64  `db:"exit_count"`
 	WithdrawCount         uint64  `db:"withdraw_count"`
 	WithdrawAmount        uint64  `db:"withdraw_amount"`
+	FullWithdrawCount     uint64  `db:"full_withdraw_count"`
+	FullWithdrawAmount    uint64  `db:"full_withdraw_amount"`
 	AttesterSlashingCount uint64  `db:"attester_slashing_count"`
 	ProposerSlashingCount uint64  `db:"proposer_slashing_count"`
 	BLSChangeCount        uint64  `db:"bls_change_count"`
 	EthTransactionCount   uint64  `db:"eth_transaction_count"`
 	SyncParticipation     float32 `db:"sync_participation"`
+	FinalityDelay         uint64  `db:"finality_delay"`
+	PenalizedCount        uint64  `db:"penalized_count"`
+	PenaltyAmount         uint64  `db:"penalty_amount"`
+	InclusionDist1        uint64  `db:"inclusion_dist_1"`
+	InclusionDist2        uint64  `db:"inclusion_dist_2"`
+	InclusionDist3        uint64  `db:"inclusion_dist_3"`
+	InclusionDistLate     uint64  `db:"inclusion_dist_late"`
+	InclusionDistSum      uint64  `db:"inclusion_dist_sum"`
 }
 
 type Fork struct {
@@ -241,14 +287,50 @@ const (
 )
 
 type Slashing struct {
-	SlotNumber     uint64         `db:"slot_number"`
-	SlotIndex      uint64         `db:"slot_index"`
-	SlotRoot       []byte         `db:"slot_root"`
-	Orphaned       bool           `db:"orphaned"`
-	ValidatorIndex uint64         `db:"validator"`
-	SlasherIndex   uint64         `db:"slasher"`
-	Reason         SlashingReason `db:"reason"`
-	ForkId         uint64         `db:"fork_id"`
+	SlotNumber          uint64         `db:"slot_number"`
+	SlotIndex           uint64         `db:"slot_index"`
+	SlotRoot            []byte         `db:"slot_root"`
+	Orphaned            bool           `db:"orphaned"`
+	ValidatorIndex      uint64         `db:"validator"`
+	SlasherIndex        uint64         `db:"slasher"`
+	Reason              SlashingReason `db:"reason"`
+	ForkId              uint64         `db:"fork_id"`
+	WhistleblowerReward uint64         `db:"whistleblower_reward"`
+}
+
+type ValidatorEventType uint8
+
+const (
+	UnspecifiedValidatorEvent ValidatorEventType = iota
+	ValidatorEventActivated
+	ValidatorEventExited
+	ValidatorEventSlashed
+	ValidatorEventWithdrawable
+)
+
+type ValidatorEvent struct {
+	ValidatorIndex uint64             `db:"validator_index"`
+	EventType      ValidatorEventType `db:"event_type"`
+	Epoch          uint64             `db:"epoch"`
+}
+
+type ValidatorSnapshot struct {
+	Epoch          uint64 `db:"epoch"`
+	DependentRoot  []byte `db:"dependent_root"`
+	ValidatorCount uint64 `db:"validator_count"`
+	Snapshot       []byte `db:"snapshot"`
+}
+
+type EpochRewards struct {
+	Epoch                uint64 `db:"epoch"`
+	ValidatorCount       uint64 `db:"validator_count"`
+	AttestationRewards   uint64 `db:"attestation_rewards"`
+	AttestationPenalties uint64 `db:"attestation_penalties"`
+	ProposerRewards      uint64 `db:"proposer_rewards"`
+	SyncRewards          uint64 `db:"sync_rewards"`
+	SyncPenalties        uint64 `db:"sync_penalties"`
+	TotalIssuance        int64  `db:"total_issuance"`
+	Estimated            bool   `db:"estimated"`
 }
 
 type ConsolidationRequest struct {
@@ -312,3 +394,73 @@ type WithdrawalRequestTx struct {
 	TxTarget        []byte  `db:"tx_target"`
 	DequeueBlock    uint64  `db:"dequeue_block"`
 }
+
+type ExplorerEvent struct {
+	Seq  uint64 `db:"seq"`
+	Type string `db:"type"`
+	Slot uint64 `db:"slot"`
+	Time uint64 `db:"time"`
+	Data string `db:"data"`
+}
+
+type Annotation struct {
+	Id          uint64 `db:"id"`
+	StartSlot   uint64 `db:"start_slot"`
+	EndSlot     uint64 `db:"end_slot"`
+	Label       string `db:"label"`
+	Description string `db:"description"`
+	CreatedAt   uint64 `db:"created_at"`
+}
+
+type TestRun struct {
+	Id        uint64  `db:"id"`
+	Name      string  `db:"name"`
+	Source    string  `db:"source"`
+	Url       string  `db:"url"`
+	Status    string  `db:"status"`
+	StartSlot uint64  `db:"start_slot"`
+	EndSlot   *uint64 `db:"end_slot"`
+	CreatedAt uint64  `db:"created_at"`
+	UpdatedAt uint64  `db:"updated_at"`
+}
+
+type BlockAttribution struct {
+	Root       []byte `db:"root"`
+	ClientName string `db:"client_name"`
+	SeenTime   uint64 `db:"seen_time"`
+}
+
+type TxHash struct {
+	TxHash    []byte `db:"tx_hash"`
+	BlockRoot []byte `db:"block_root"`
+	Slot      uint64 `db:"slot"`
+	TxIndex   uint64 `db:"tx_index"`
+}
+
+type TxHashBlock struct {
+	Slot    uint64     `db:"slot"`
+	Root    []byte     `db:"root"`
+	Status  SlotStatus `db:"status"`
+	TxIndex uint64     `db:"tx_index"`
+}
+
+type TableStats struct {
+	Table     string `db:"table_name"`
+	RowCount  uint64 `db:"row_count"`
+	TableSize uint64 `db:"table_size"`
+	IndexSize uint64 `db:"index_size"`
+}
+
+type SlotRangeStats struct {
+	CanonicalCount         uint64  `db:"canonical_count"`
+	MissedCount            uint64  `db:"missed_count"`
+	OrphanedCount          uint64  `db:"orphaned_count"`
+	AttestationCount       uint64  `db:"attestation_count"`
+	DepositCount           uint64  `db:"deposit_count"`
+	ExitCount              uint64  `db:"exit_count"`
+	ProposerSlashingCount  uint64  `db:"proposer_slashing_count"`
+	AttesterSlashingCount  uint64  `db:"attester_slashing_count"`
+	EthTransactionCount    uint64  `db:"eth_transaction_count"`
+	SyncParticipationCount uint64  `db:"sync_participation_count"`
+	SyncParticipationSum   float64 `db:"sync_participation_sum"`
+}
@@ -18,6 +18,127 @@ type BlockHead struct {
 	ForkId     uint64 `db:"fork_id"`
 }
 
+type SlotGraffiti struct {
+	Slot         uint64 `db:"slot"`
+	Proposer     uint64 `db:"proposer"`
+	GraffitiText string `db:"graffiti_text"`
+}
+
+type GraffitiStats struct {
+	GraffitiText  string `db:"graffiti_text"`
+	BlockCount    uint64 `db:"block_count"`
+	ProposerCount uint64 `db:"proposer_count"`
+	LastSlot      uint64 `db:"last_slot"`
+}
+
+type ProposerBlobStats struct {
+	Proposer       uint64 `db:"proposer"`
+	BlockCount     uint64 `db:"block_count"`
+	BlobBlockCount uint64 `db:"blob_block_count"`
+	BlobCount      uint64 `db:"blob_count"`
+	MaxBlobCount   uint64 `db:"max_blob_count"`
+}
+
+type EpochWithdrawalStats struct {
+	FirstEpoch         uint64 `db:"first_epoch"`
+	LastEpoch          uint64 `db:"last_epoch"`
+	WithdrawCount      uint64 `db:"withdraw_count"`
+	WithdrawAmount     uint64 `db:"withdraw_amount"`
+	FullWithdrawCount  uint64 `db:"full_withdraw_count"`
+	FullWithdrawAmount uint64 `db:"full_withdraw_amount"`
+}
+
+type WhistleblowerStats struct {
+	SlasherIndex          uint64 `db:"slasher"`
+	SlashingCount         uint64 `db:"slashing_count"`
+	ProposerSlashingCount uint64 `db:"proposer_slashing_count"`
+	AttesterSlashingCount uint64 `db:"attester_slashing_count"`
+	TotalReward           uint64 `db:"total_reward"`
+	LastSlot              uint64 `db:"last_slot"`
+}
+
+type EpochPenaltyStats struct {
+	FirstEpoch       uint64 `db:"first_epoch"`
+	LastEpoch        uint64 `db:"last_epoch"`
+	EpochCount       uint64 `db:"epoch_count"`
+	LeakEpochCount   uint64 `db:"leak_epoch_count"`
+	MaxFinalityDelay uint64 `db:"max_finality_delay"`
+	PenalizedCount   uint64 `db:"penalized_count"`
+	PenaltyAmount    uint64 `db:"penalty_amount"`
+}
+
+type EpochInclusionDistanceStats struct {
+	FirstEpoch        uint64 `db:"first_epoch"`
+	LastEpoch         uint64 `db:"last_epoch"`
+	EpochCount        uint64 `db:"epoch_count"`
+	InclusionDist1    uint64 `db:"inclusion_dist_1"`
+	InclusionDist2    uint64 `db:"inclusion_dist_2"`
+	InclusionDist3    uint64 `db:"inclusion_dist_3"`
+	InclusionDistLate uint64 `db:"inclusion_dist_late"`
+	InclusionDistSum  uint64 `db:"inclusion_dist_sum"`
+}
+
+type InactivityLeakIncident struct {
+	FirstEpoch       uint64 `db:"first_epoch"`
+	LastEpoch        uint64 `db:"last_epoch"`
+	MaxFinalityDelay uint64 `db:"max_finality_delay"`
+	PenalizedCount   uint64 `db:"penalized_count"`
+	PenaltyAmount    uint64 `db:"penalty_amount"`
+}
+
+type EpochRewardStats struct {
+	FirstEpoch           uint64 `db:"first_epoch"`
+	LastEpoch            uint64 `db:"last_epoch"`
+	EpochCount           uint64 `db:"epoch_count"`
+	EstimatedCount       uint64 `db:"estimated_count"`
+	AttestationRewards   uint64 `db:"attestation_rewards"`
+	AttestationPenalties uint64 `db:"attestation_penalties"`
+	ProposerRewards      uint64 `db:"proposer_rewards"`
+	SyncRewards          uint64 `db:"sync_rewards"`
+	SyncPenalties        uint64 `db:"sync_penalties"`
+	TotalIssuance        int64  `db:"total_issuance"`
+}
+
+type SlotSizeStats struct {
+	FirstSlot           uint64 `db:"first_slot"`
+	LastSlot            uint64 `db:"last_slot"`
+	BlockCount          uint64 `db:"block_count"`
+	BlockSizeSum        uint64 `db:"block_size_sum"`
+	BlockSizeMax        uint64 `db:"block_size_max"`
+	AttestationsSizeSum uint64 `db:"attestations_size_sum"`
+	PayloadSizeSum      uint64 `db:"payload_size_sum"`
+	BlobRefsSizeSum     uint64 `db:"blob_refs_size_sum"`
+}
+
+type SlotTxTypeStats struct {
+	FirstSlot      uint64 `db:"first_slot"`
+	LastSlot       uint64 `db:"last_slot"`
+	BlockCount     uint64 `db:"block_count"`
+	TransactionSum uint64 `db:"transaction_sum"`
+	LegacySum      uint64 `db:"legacy_sum"`
+	AccessListSum  uint64 `db:"access_list_sum"`
+	DynamicFeeSum  uint64 `db:"dynamic_fee_sum"`
+	BlobSum        uint64 `db:"blob_sum"`
+	SetCodeSum     uint64 `db:"setcode_sum"`
+}
+
+type SlotOrphanStats struct {
+	FirstSlot      uint64 `db:"first_slot"`
+	LastSlot       uint64 `db:"last_slot"`
+	CanonicalCount uint64 `db:"canonical_count"`
+	OrphanedCount  uint64 `db:"orphaned_count"`
+}
+
+type SlotFeeStats struct {
+	FirstSlot          uint64 `db:"first_slot"`
+	LastSlot           uint64 `db:"last_slot"`
+	BlockCount         uint64 `db:"block_count"`
+	GasUsedSum         uint64 `db:"gas_used_sum"`
+	BurnedFeesSum      uint64 `db:"burned_fees_sum"`
+	PriorityBlockCount uint64 `db:"priority_block_count"`
+	PriorityFeesSum    uint64 `db:"priority_fees_sum"`
+}
+
 type AssignedBlob struct {
 	Root       []byte `db:"root"`
 	Commitment []byte `db:"commitment"`
@@ -32,12 +153,38 @@ type UnfinalizedBlockFilter struct {
 }
 
 type BlockFilter struct {
-	Graffiti      string
-	ExtraData     string
-	ProposerIndex *uint64
-	ProposerName  string
-	WithOrphaned  uint8
-	WithMissing   uint8
+	Graffiti             string
+	ExtraData            string
+	ProposerIndex        *uint64
+	ProposerName         string
+	WithOrphaned         uint8
+	WithMissing          uint8
+	MinSyncParticipation *float32 // 0-1
+	MaxSyncParticipation *float32 // 0-1
+	MinAttestations      *uint64
+	MaxAttestations      *uint64
+}
+
+// HasBlockStatsFilter checks if the filter matches against block stats, which are only available for proposed blocks.
+func (f *BlockFilter) HasBlockStatsFilter() bool {
+	return f.MinSyncParticipation != nil || f.MaxSyncParticipation != nil || f.MinAttestations != nil || f.MaxAttestations != nil
+}
+
+// MatchBlockStats checks if the block stats of a proposed block match the filter.
+func (f *BlockFilter) MatchBlockStats(block *Slot) bool {
+	if f.MinSyncParticipation != nil && block.SyncParticipation < *f.MinSyncParticipation {
+		return false
+	}
+	if f.MaxSyncParticipation != nil && block.SyncParticipation > *f.MaxSyncParticipation {
+		return false
+	}
+	if f.MinAttestations != nil && block.AttestationCount < *f.MinAttestations {
+		return false
+	}
+	if f.MaxAttestations != nil && block.AttestationCount > *f.MaxAttestations {
+		return false
+	}
+	return true
 }
 
 type MevBlockFilter struct {
@@ -84,6 +231,15 @@ type VoluntaryExitFilter struct {
 	WithOrphaned  uint8
 }
 
+type ValidatorEventFilter struct {
+	MinEpoch      uint64
+	MaxEpoch      uint64
+	MinIndex      uint64
+	MaxIndex      uint64
+	ValidatorName string
+	EventType     ValidatorEventType
+}
+
 type SlashingFilter struct {
 	MinSlot       uint64
 	MaxSlot       uint64
@@ -8,6 +8,11 @@ type IndexerPruneState struct {
 	Epoch uint64 `json:"epoch"`
 }
 
+type IndexerColumnBackfillState struct {
+	Slot     uint64 `json:"slot"`
+	Complete bool   `json:"complete"`
+}
+
 type IndexerForkState struct {
 	ForkId    uint64 `json:"fork_id"`
 	Finalized uint64 `json:"finalized"`
@@ -18,3 +23,51 @@ type DepositIndexerState struct {
 	HeadBlock    uint64 `json:"head_block"`
 	DepositIndex uint64 `json:"deposit_index"`
 }
+
+type ValidatorNamesGraffitiState struct {
+	Slot uint64 `json:"slot"`
+}
+
+type IndexSummary struct {
+	Slot                    uint64               `json:"slot"`
+	Epoch                   uint64               `json:"epoch"`
+	UpdatedAt               int64                `json:"updated"`
+	Synced                  bool                 `json:"synced"`
+	FinalizedEpoch          uint64               `json:"finalized"`
+	JustifiedEpoch          uint64               `json:"justified"`
+	ValidatorEpoch          uint64               `json:"val_epoch"`
+	ActiveValidatorCount    uint64               `json:"val_active"`
+	EnteringValidatorCount  uint64               `json:"val_entering"`
+	ExitingValidatorCount   uint64               `json:"val_exiting"`
+	TotalEligibleEther      uint64               `json:"eligible"`
+	AverageValidatorBalance uint64               `json:"avg_balance"`
+	RecentEpochs            []*IndexSummaryEpoch `json:"epochs"`
+	RecentBlocks            []*IndexSummaryBlock `json:"blocks"`
+	RecentSlots             []*IndexSummarySlot  `json:"slots"`
+}
+
+type IndexSummaryEpoch struct {
+	Epoch       uint64 `json:"epoch"`
+	Eligible    uint64 `json:"eligible"`
+	VotedTarget uint64 `json:"voted_target"`
+}
+
+type IndexSummaryBlock struct {
+	Slot           uint64  `json:"slot"`
+	Proposer       uint64  `json:"proposer"`
+	Status         uint64  `json:"status"`
+	Root           []byte  `json:"root"`
+	EthBlockNumber *uint64 `json:"eth_block,omitempty"`
+}
+
+type IndexSummarySlot struct {
+	Slot       uint64 `json:"slot"`
+	Proposer   uint64 `json:"proposer"`
+	Status     uint64 `json:"status"`
+	Root       []byte `json:"root"`
+	ParentRoot []byte `json:"parent_root"`
+}
+
+type IndexerPartialDutiesState struct {
+	Epochs map[uint64]uint8 `json:"epochs"` // epoch -> missing duty types
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// annotationsTemplateFile is the template file with the shared annotation snippets
+const annotationsTemplateFile = "_layout/annotations.html"
+
+func buildAnnotationPageData(annotation *dbtypes.Annotation) *models.AnnotationPageData {
+	chainState := services.GlobalBeaconService.GetChainState()
+	return &models.AnnotationPageData{
+		Id:          annotation.Id,
+		StartSlot:   annotation.StartSlot,
+		EndSlot:     annotation.EndSlot,
+		StartEpoch:  uint64(chainState.EpochOfSlot(phase0.Slot(annotation.StartSlot))),
+		EndEpoch:    uint64(chainState.EpochOfSlot(phase0.Slot(annotation.EndSlot))),
+		Label:       annotation.Label,
+		Description: annotation.Description,
+	}
+}
+
+// getRangeAnnotations returns the annotations overlapping the given slot range (inclusive)
+func getRangeAnnotations(firstSlot uint64, lastSlot uint64) []*models.AnnotationPageData {
+	annotations := []*models.AnnotationPageData{}
+	for _, annotation := range services.GlobalBeaconService.GetAnnotations(firstSlot, lastSlot) {
+		annotations = append(annotations, buildAnnotationPageData(annotation))
+	}
+	return annotations
+}
+
+// getSlotAnnotations returns the annotations of the given slot range (inclusive) indexed by slot
+func getSlotAnnotations(firstSlot uint64, lastSlot uint64) map[uint64][]*models.AnnotationPageData {
+	pageAnnotations := map[uint64]*models.AnnotationPageData{}
+	slotAnnotations := map[uint64][]*models.AnnotationPageData{}
+	for slot, annotations := range services.GlobalBeaconService.GetAnnotationsBySlot(firstSlot, lastSlot) {
+		for _, annotation := range annotations {
+			pageAnnotation := pageAnnotations[annotation.Id]
+			if pageAnnotation == nil {
+				pageAnnotation = buildAnnotationPageData(annotation)
+				pageAnnotations[annotation.Id] = pageAnnotation
+			}
+			slotAnnotations[slot] = append(slotAnnotations[slot], pageAnnotation)
+		}
+	}
+	return slotAnnotations
+}
+
+// filterAnnotations returns the annotations that overlap with the given slot range (inclusive)
+func filterAnnotations(annotations []*models.AnnotationPageData, firstSlot uint64, lastSlot uint64) []*models.AnnotationPageData {
+	var result []*models.AnnotationPageData
+	for _, annotation := range annotations {
+		if annotation.StartSlot <= lastSlot && annotation.EndSlot >= firstSlot {
+			result = append(result, annotation)
+		}
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// AdminOnly wraps an api endpoint implementation that may only be called with the configured admin token.
+// the token is expected as bearer token in the Authorization header, admin endpoints are disabled if no token is configured.
+func AdminOnly(handler ApiHandlerFunc) ApiHandlerFunc {
+	return func(r *http.Request) (*ApiResult, error) {
+		adminToken := utils.Config.Api.AdminToken
+		if adminToken == "" {
+			return nil, ErrForbidden("admin endpoints are disabled")
+		}
+
+		authHeader := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authHeader, "Bearer ") {
+			return nil, ErrUnauthorized()
+		}
+		token := strings.TrimPrefix(authHeader, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+			return nil, ErrUnauthorized()
+		}
+
+		return handler(r)
+	}
+}
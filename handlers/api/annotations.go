@@ -0,0 +1,140 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiAnnotation is the api representation of an incident annotation.
+type ApiAnnotation struct {
+	Id          uint64 `json:"id"`
+	StartSlot   uint64 `json:"start_slot"`
+	EndSlot     uint64 `json:"end_slot"`
+	StartEpoch  uint64 `json:"start_epoch"`
+	EndEpoch    uint64 `json:"end_epoch"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	CreatedAt   uint64 `json:"created_at"`
+}
+
+// ApiCreateAnnotationRequest is the request body of the create annotation endpoint.
+// the annotated range is given either as slot range or as epoch range (inclusive).
+type ApiCreateAnnotationRequest struct {
+	StartSlot   *uint64 `json:"start_slot"`
+	EndSlot     *uint64 `json:"end_slot"`
+	StartEpoch  *uint64 `json:"start_epoch"`
+	EndEpoch    *uint64 `json:"end_epoch"`
+	Label       string  `json:"label"`
+	Description string  `json:"description"`
+}
+
+const maxAnnotationRequestSize = 16 * 1024
+
+func buildApiAnnotation(annotation *dbtypes.Annotation) *ApiAnnotation {
+	chainState := services.GlobalBeaconService.GetChainState()
+	return &ApiAnnotation{
+		Id:          annotation.Id,
+		StartSlot:   annotation.StartSlot,
+		EndSlot:     annotation.EndSlot,
+		StartEpoch:  uint64(chainState.EpochOfSlot(phase0.Slot(annotation.StartSlot))),
+		EndEpoch:    uint64(chainState.EpochOfSlot(phase0.Slot(annotation.EndSlot))),
+		Label:       annotation.Label,
+		Description: annotation.Description,
+		CreatedAt:   annotation.CreatedAt,
+	}
+}
+
+// GetAnnotations returns the incident annotations overlapping the requested slot range.
+// query args: from_slot, to_slot (both inclusive, default to the full chain)
+func GetAnnotations(r *http.Request) (*ApiResult, error) {
+	urlArgs := r.URL.Query()
+	fromSlot := uint64(0)
+	toSlot := ^uint64(0)
+	if fromArg := urlArgs.Get("from_slot"); fromArg != "" {
+		slot, err := strconv.ParseUint(fromArg, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid from_slot: %v", fromArg)
+		}
+		fromSlot = slot
+	}
+	if toArg := urlArgs.Get("to_slot"); toArg != "" {
+		slot, err := strconv.ParseUint(toArg, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid to_slot: %v", toArg)
+		}
+		toSlot = slot
+	}
+	if toSlot < fromSlot {
+		return nil, ErrBadRequest("to_slot must not be before from_slot")
+	}
+
+	annotations := []*ApiAnnotation{}
+	for _, annotation := range services.GlobalBeaconService.GetAnnotations(fromSlot, toSlot) {
+		annotations = append(annotations, buildApiAnnotation(annotation))
+	}
+
+	return &ApiResult{
+		Data: annotations,
+	}, nil
+}
+
+// CreateAnnotation stores a new incident annotation (admin only).
+func CreateAnnotation(r *http.Request) (*ApiResult, error) {
+	request := &ApiCreateAnnotationRequest{}
+	if err := decodeJsonBody(r, maxAnnotationRequestSize, request); err != nil {
+		return nil, err
+	}
+
+	var startSlot, endSlot uint64
+	switch {
+	case request.StartSlot != nil && request.EndSlot != nil && request.StartEpoch == nil && request.EndEpoch == nil:
+		startSlot = *request.StartSlot
+		endSlot = *request.EndSlot
+	case request.StartEpoch != nil && request.EndEpoch != nil && request.StartSlot == nil && request.EndSlot == nil:
+		chainState := services.GlobalBeaconService.GetChainState()
+		startSlot = uint64(chainState.EpochToSlot(phase0.Epoch(*request.StartEpoch)))
+		endSlot = uint64(chainState.EpochToSlot(phase0.Epoch(*request.EndEpoch+1))) - 1
+	default:
+		return nil, ErrBadRequest("either start_slot and end_slot or start_epoch and end_epoch must be set")
+	}
+
+	if err := services.ValidateAnnotation(startSlot, endSlot, request.Label, request.Description); err != nil {
+		return nil, ErrBadRequest("%v", err)
+	}
+
+	annotation, err := services.GlobalBeaconService.CreateAnnotation(startSlot, endSlot, request.Label, request.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiResult{
+		Data: buildApiAnnotation(annotation),
+	}, nil
+}
+
+// DeleteAnnotation removes an incident annotation (admin only).
+func DeleteAnnotation(r *http.Request) (*ApiResult, error) {
+	idArg := mux.Vars(r)["id"]
+	id, err := strconv.ParseUint(idArg, 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid annotation id: %v", idArg)
+	}
+
+	deleted, err := services.GlobalBeaconService.DeleteAnnotation(id)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, ErrNotFound("annotation %v not found", id)
+	}
+
+	return &ApiResult{
+		Data: map[string]uint64{"id": id},
+	}, nil
+}
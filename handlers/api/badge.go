@@ -0,0 +1,98 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// validator badges are embedded in external pages, so they're cached for a short time only
+const validatorBadgeCacheTimeout = 1 * time.Minute
+
+// ValidatorBadge is the cached content of a validator status badge.
+type ValidatorBadge struct {
+	Label   string `json:"label"`
+	Message string `json:"message"`
+	Color   string `json:"color"`
+	Found   bool   `json:"found"`
+}
+
+// GetValidatorBadge renders a svg status badge (active, offline, pending, exited or slashed with the current balance) of a validator.
+func GetValidatorBadge(w http.ResponseWriter, r *http.Request) {
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1); err != nil {
+		writeError(w, r, ErrRateLimited())
+		return
+	}
+
+	indexArg := mux.Vars(r)["index"]
+	index, err := strconv.ParseUint(indexArg, 10, 64)
+	if err != nil {
+		writeError(w, r, ErrBadRequest("invalid validator index: %v", indexArg))
+		return
+	}
+
+	badge := &ValidatorBadge{}
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(fmt.Sprintf("validator_badge:%v", index), true, badge, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageCall.CacheTimeout = validatorBadgeCacheTimeout
+		return buildValidatorBadge(phase0.ValidatorIndex(index))
+	})
+	if pageErr != nil {
+		writeError(w, r, pageErr)
+		return
+	}
+	if resBadge, resOk := pageRes.(*ValidatorBadge); resOk {
+		badge = resBadge
+	}
+
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%v", int(validatorBadgeCacheTimeout.Seconds())))
+	if !badge.Found {
+		w.WriteHeader(http.StatusNotFound)
+	}
+	w.Write(utils.RenderBadgeSVG(badge.Label, badge.Message, badge.Color))
+}
+
+func buildValidatorBadge(index phase0.ValidatorIndex) *ValidatorBadge {
+	badge := &ValidatorBadge{
+		Label:   fmt.Sprintf("validator %v", index),
+		Message: "unknown",
+		Color:   "#9f9f9f",
+	}
+
+	validator := services.GlobalBeaconService.GetValidatorByIndex(index, false)
+	if validator == nil || validator.Validator == nil {
+		return badge
+	}
+	badge.Found = true
+
+	statusStr := validator.Status.String()
+	switch {
+	case strings.HasSuffix(statusStr, "_slashed") || validator.Validator.Slashed:
+		badge.Message = "slashed"
+		badge.Color = "#e05d44"
+	case strings.HasPrefix(statusStr, "active_"):
+		if services.GlobalBeaconService.GetValidatorLiveness(index, 3) > 0 {
+			badge.Message = "active"
+			badge.Color = "#4c1"
+		} else {
+			badge.Message = "offline"
+			badge.Color = "#fe7d37"
+		}
+	case strings.HasPrefix(statusStr, "pending_"):
+		badge.Message = "pending"
+		badge.Color = "#007ec6"
+	default:
+		badge.Message = "exited"
+	}
+
+	badge.Message = fmt.Sprintf("%v | %v %v", badge.Message, utils.FormatFloat(utils.ConsensusUnitsFromGwei(uint64(validator.Balance)), 4), utils.GetDenomination().ConsensusSymbol)
+	return badge
+}
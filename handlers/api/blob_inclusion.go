@@ -0,0 +1,101 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// default range of the blob inclusion stats if no slot range is requested
+const blobInclusionDefaultRange = 7 * 24 * time.Hour
+
+// ApiBlobInclusion is the blob inclusion report of the blocks in the requested slot range.
+type ApiBlobInclusion struct {
+	FromSlot uint64                   `json:"from_slot"`
+	ToSlot   uint64                   `json:"to_slot"`
+	GroupBy  string                   `json:"group_by"`
+	Groups   []*ApiBlobInclusionGroup `json:"groups"`
+}
+
+// ApiBlobInclusionGroup holds the blob inclusion stats of a validator or entity.
+type ApiBlobInclusionGroup struct {
+	Group           string  `json:"group"`
+	Validators      uint64  `json:"validators"`
+	Blocks          uint64  `json:"blocks"`
+	BlobBlocks      uint64  `json:"blob_blocks"`
+	Blobs           uint64  `json:"blobs"`
+	MaxBlobs        uint64  `json:"max_blobs"`
+	BlobsPerBlock   float64 `json:"blobs_per_block"`
+	EmptyBlockShare float64 `json:"empty_block_share"`
+	ExcludingBlobs  bool    `json:"excluding_blobs"`
+}
+
+// GetBlobInclusion returns the blob inclusion stats per validator or entity of the canonical, finalized blocks in the slot range,
+// groups with the highest share of blocks without blobs first.
+// query args: from_slot & to_slot (default: last 7 days), group (entity or validator, default: entity)
+func GetBlobInclusion(r *http.Request) (*ApiResult, error) {
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	if specs == nil {
+		return nil, ErrLiveDataUnavailable()
+	}
+
+	query := r.URL.Query()
+	toSlot := chainState.CurrentSlot()
+	if toSlotArg := query.Get("to_slot"); toSlotArg != "" {
+		slot, err := strconv.ParseUint(toSlotArg, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid to_slot: %v", toSlotArg)
+		}
+		toSlot = phase0.Slot(slot)
+	}
+
+	fromSlot := phase0.Slot(0)
+	if rangeSlots := phase0.Slot(blobInclusionDefaultRange / specs.SecondsPerSlot); toSlot > rangeSlots {
+		fromSlot = toSlot - rangeSlots
+	}
+	if fromSlotArg := query.Get("from_slot"); fromSlotArg != "" {
+		slot, err := strconv.ParseUint(fromSlotArg, 10, 64)
+		if err != nil || phase0.Slot(slot) > toSlot {
+			return nil, ErrBadRequest("invalid from_slot: %v", fromSlotArg)
+		}
+		fromSlot = phase0.Slot(slot)
+	}
+
+	groupBy := query.Get("group")
+	switch groupBy {
+	case "":
+		groupBy = "entity"
+	case "entity", "validator":
+	default:
+		return nil, ErrBadRequest("invalid group: %v", groupBy)
+	}
+
+	result := &ApiBlobInclusion{
+		FromSlot: uint64(fromSlot),
+		ToSlot:   uint64(toSlot),
+		GroupBy:  groupBy,
+		Groups:   []*ApiBlobInclusionGroup{},
+	}
+	for _, stats := range services.GlobalBeaconService.GetBlobInclusionStats(fromSlot, toSlot, groupBy == "entity") {
+		result.Groups = append(result.Groups, &ApiBlobInclusionGroup{
+			Group:           stats.Group,
+			Validators:      stats.Validators,
+			Blocks:          stats.Blocks,
+			BlobBlocks:      stats.BlobBlocks,
+			Blobs:           stats.Blobs,
+			MaxBlobs:        stats.MaxBlobs,
+			BlobsPerBlock:   stats.BlobsPerBlock(),
+			EmptyBlockShare: stats.EmptyBlockShare(),
+			ExcludingBlobs:  stats.IsExcludingBlobs(),
+		})
+	}
+
+	return &ApiResult{
+		Data: result,
+	}, nil
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+const (
+	// default & max number of slots (counted back from the current slot) included in the block tree
+	blockTreeDefaultDepth = 64
+	blockTreeMaxDepth     = 1024
+)
+
+// ApiBlockTree is the recent block tree (canonical chain & orphaned forks) held by the indexer cache.
+type ApiBlockTree struct {
+	HeadRoot string              `json:"head_root"`
+	HeadSlot uint64              `json:"head_slot"`
+	FromSlot uint64              `json:"from_slot"`
+	ToSlot   uint64              `json:"to_slot"`
+	Nodes    []*ApiBlockTreeNode `json:"nodes"`
+	Edges    []*ApiBlockTreeEdge `json:"edges"`
+}
+
+// ApiBlockTreeNode is a block in the block tree.
+type ApiBlockTreeNode struct {
+	Root       string `json:"root"`
+	Slot       uint64 `json:"slot"`
+	ParentRoot string `json:"parent_root"`
+	Proposer   uint64 `json:"proposer"`
+	Canonical  bool   `json:"canonical"`
+	Head       bool   `json:"head"`
+}
+
+// ApiBlockTreeEdge links a block (from) to its parent block (to), if the parent is part of the exported tree.
+type ApiBlockTreeEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GetBlockTree returns the recent block tree as graph data (nodes & parent edges) for external visualization tools.
+// query args: depth (number of slots back from the current slot, default 64, max 1024)
+func GetBlockTree(r *http.Request) (*ApiResult, error) {
+	blockTree, err := buildBlockTree(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiResult{
+		Data: blockTree,
+	}, nil
+}
+
+// GetBlockTreeDot returns the recent block tree in the graphviz DOT format.
+// query args: depth (number of slots back from the current slot, default 64, max 1024)
+func GetBlockTreeDot(w http.ResponseWriter, r *http.Request) {
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 2); err != nil {
+		writeError(w, r, ErrRateLimited())
+		return
+	}
+
+	blockTree, err := buildBlockTree(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+	w.Write(renderBlockTreeDot(blockTree))
+}
+
+func buildBlockTree(r *http.Request) (*ApiBlockTree, error) {
+	depth := uint64(blockTreeDefaultDepth)
+	if depthArg := r.URL.Query().Get("depth"); depthArg != "" {
+		var err error
+		depth, err = strconv.ParseUint(depthArg, 10, 64)
+		if err != nil || depth == 0 {
+			return nil, ErrBadRequest("invalid depth: %v", depthArg)
+		}
+		if depth > blockTreeMaxDepth {
+			depth = blockTreeMaxDepth
+		}
+	}
+
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+	if indexer == nil {
+		return nil, ErrLiveDataUnavailable()
+	}
+	headBlock := indexer.GetCanonicalHead(nil)
+	if headBlock == nil {
+		return nil, ErrLiveDataUnavailable()
+	}
+
+	toSlot := services.GlobalBeaconService.GetChainState().CurrentSlot()
+	if toSlot < headBlock.Slot {
+		toSlot = headBlock.Slot
+	}
+	fromSlot := phase0.Slot(0)
+	if uint64(toSlot) >= depth {
+		fromSlot = toSlot - phase0.Slot(depth-1)
+	}
+
+	blockTree := &ApiBlockTree{
+		HeadRoot: headBlock.Root.String(),
+		HeadSlot: uint64(headBlock.Slot),
+		FromSlot: uint64(fromSlot),
+		ToSlot:   uint64(toSlot),
+		Nodes:    []*ApiBlockTreeNode{},
+		Edges:    []*ApiBlockTreeEdge{},
+	}
+
+	treeRoots := map[phase0.Root]bool{}
+	for slot := fromSlot; slot <= toSlot; slot++ {
+		for _, block := range indexer.GetBlocksBySlot(slot) {
+			header := block.GetHeader()
+			if header == nil {
+				continue
+			}
+
+			treeRoots[block.Root] = true
+			blockTree.Nodes = append(blockTree.Nodes, &ApiBlockTreeNode{
+				Root:       block.Root.String(),
+				Slot:       uint64(block.Slot),
+				ParentRoot: header.Message.ParentRoot.String(),
+				Proposer:   uint64(header.Message.ProposerIndex),
+				Canonical:  indexer.IsCanonicalBlockByHead(block, headBlock),
+				Head:       block.Root == headBlock.Root,
+			})
+
+			if treeRoots[header.Message.ParentRoot] {
+				blockTree.Edges = append(blockTree.Edges, &ApiBlockTreeEdge{
+					From: block.Root.String(),
+					To:   header.Message.ParentRoot.String(),
+				})
+			}
+		}
+	}
+
+	return blockTree, nil
+}
+
+// renderBlockTreeDot renders the block tree as directed graph, canonical blocks are green, orphaned blocks red and the head is highlighted.
+func renderBlockTreeDot(blockTree *ApiBlockTree) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("digraph blocktree {\n")
+	buf.WriteString("  rankdir=LR;\n")
+	buf.WriteString("  node [shape=box, style=filled, fontname=\"monospace\"];\n")
+
+	for _, node := range blockTree.Nodes {
+		fillColor := "#f8d7da"
+		if node.Canonical {
+			fillColor = "#d4edda"
+		}
+		penWidth := 1
+		if node.Head {
+			penWidth = 3
+		}
+
+		fmt.Fprintf(&buf, "  %q [label=\"slot %v\\n%v\\nproposer %v\", fillcolor=%q, penwidth=%v];\n", node.Root, node.Slot, shortBlockTreeRoot(node.Root), node.Proposer, fillColor, penWidth)
+	}
+
+	for _, edge := range blockTree.Edges {
+		fmt.Fprintf(&buf, "  %q -> %q;\n", edge.To, edge.From)
+	}
+
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+func shortBlockTreeRoot(root string) string {
+	if len(root) <= 14 {
+		return root
+	}
+	return root[:8] + ".." + root[len(root)-4:]
+}
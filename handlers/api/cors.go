@@ -0,0 +1,99 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// CorsMiddleware returns a middleware that adds the configured CORS headers to api responses and answers preflight requests.
+// The middleware is attached to the public api router and does nothing unless enabled in the config.
+// Requests (and preflight requests) targeting a route of adminRoutes never get CORS headers, so admin endpoints can only be
+// called from same-origin pages or non-browser clients.
+func CorsMiddleware(adminRoutes *mux.Router) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return corsHandler(next, adminRoutes)
+	}
+}
+
+func corsHandler(next http.Handler, adminRoutes *mux.Router) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		corsConfig := &utils.Config.Api
+		origin := r.Header.Get("Origin")
+		if !corsConfig.CorsEnabled || origin == "" || isAdminRoute(adminRoutes, r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		if !isCorsOriginAllowed(origin, corsConfig.CorsAllowedOrigins) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			allowedMethods := corsConfig.CorsAllowedMethods
+			if len(allowedMethods) == 0 {
+				allowedMethods = []string{http.MethodGet, http.MethodOptions}
+			}
+			w.Header().Set("Access-Control-Allow-Methods", strings.Join(allowedMethods, ", "))
+
+			allowedHeaders := corsConfig.CorsAllowedHeaders
+			if len(allowedHeaders) == 0 {
+				allowedHeaders = []string{"Content-Type"}
+			}
+			w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
+
+			if corsConfig.CorsMaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.FormatUint(uint64(corsConfig.CorsMaxAge), 10))
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isAdminRoute checks whether the request targets an admin route.
+// for preflight requests, the method of the preflighted request is checked.
+func isAdminRoute(adminRoutes *mux.Router, r *http.Request) bool {
+	if adminRoutes == nil {
+		return false
+	}
+
+	routeReq := r.WithContext(r.Context())
+	if r.Method == http.MethodOptions {
+		if requestMethod := r.Header.Get("Access-Control-Request-Method"); requestMethod != "" {
+			routeReq.Method = requestMethod
+		}
+	}
+
+	return adminRoutes.Match(routeReq, &mux.RouteMatch{})
+}
+
+// isCorsOriginAllowed checks the origin against the allowed origins list.
+// supports "*" for any origin and "scheme://*.domain" for any subdomain.
+func isCorsOriginAllowed(origin string, allowedOrigins []string) bool {
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+
+		if wildcardIdx := strings.Index(allowed, "://*."); wildcardIdx >= 0 {
+			scheme := allowed[:wildcardIdx+3]
+			domain := allowed[wildcardIdx+4:]
+			if strings.HasPrefix(origin, scheme) && strings.HasSuffix(strings.ToLower(origin), strings.ToLower(domain)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// encodeCursor encodes the given cursor position into an opaque cursor string.
+// cursors always point to an absolute position (eg. a slot number), so they stay stable while new data is indexed.
+func encodeCursor(position interface{}) string {
+	cursorJson, err := json.Marshal(position)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorJson)
+}
+
+// decodeCursor decodes the `cursor` query parameter into the given cursor position.
+// returns false if no cursor has been supplied.
+func decodeCursor(r *http.Request, position interface{}) (bool, error) {
+	cursorArg := r.URL.Query().Get("cursor")
+	if cursorArg == "" {
+		return false, nil
+	}
+
+	cursorJson, err := base64.RawURLEncoding.DecodeString(cursorArg)
+	if err != nil {
+		return false, ErrInvalidCursor()
+	}
+	if err := json.Unmarshal(cursorJson, position); err != nil {
+		return false, ErrInvalidCursor()
+	}
+	return true, nil
+}
+
+// parseLimit parses the `limit` query parameter and caps it to the given maximum.
+func parseLimit(r *http.Request, defaultLimit uint64, maxLimit uint64) (uint64, error) {
+	limitArg := r.URL.Query().Get("limit")
+	if limitArg == "" {
+		return defaultLimit, nil
+	}
+
+	limit, err := strconv.ParseUint(limitArg, 10, 64)
+	if err != nil || limit == 0 {
+		return 0, ErrBadRequest("invalid limit: %v", limitArg)
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	return limit, nil
+}
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiDashboard is the compact api representation of a validator dashboard, intended for widgets and mobile clients.
+type ApiDashboard struct {
+	Epoch           uint64                   `json:"epoch"`
+	Slot            uint64                   `json:"slot"`
+	Snapshot1dEpoch *uint64                  `json:"snapshot_1d_epoch,omitempty"`
+	Snapshot7dEpoch *uint64                  `json:"snapshot_7d_epoch,omitempty"`
+	Validators      []*ApiDashboardValidator `json:"validators"`
+}
+
+// ApiDashboardValidator is the compact status of a single dashboard validator.
+// optional fields are omitted when unknown to keep the payload small.
+type ApiDashboardValidator struct {
+	Index         uint64            `json:"index"`
+	Status        string            `json:"status"`
+	Balance       uint64            `json:"balance"`
+	Delta1d       *int64            `json:"delta_1d,omitempty"`
+	Delta7d       *int64            `json:"delta_7d,omitempty"`
+	LastProposal  *uint64           `json:"last_proposal,omitempty"`
+	NextDuty      *ApiDashboardDuty `json:"next_duty,omitempty"`
+	SyncCommittee bool              `json:"sync_committee,omitempty"`
+}
+
+// ApiDashboardDuty is the next upcoming duty of a dashboard validator.
+type ApiDashboardDuty struct {
+	Type string `json:"type"`
+	Slot uint64 `json:"slot"`
+}
+
+// dashboardSnapshotBalances holds the balances of a parsed validator snapshot for the balance deltas.
+// parsing a snapshot is expensive, so the balances are kept until the requested epoch changes.
+type dashboardSnapshotBalances struct {
+	requestEpoch  phase0.Epoch
+	snapshotEpoch phase0.Epoch
+	balances      []phase0.Gwei
+}
+
+var dashboardSnapshotMutex sync.Mutex
+var dashboardSnapshotCache = map[time.Duration]*dashboardSnapshotBalances{}
+
+// GetDashboard returns a condensed status of the requested validators: status, balance, balance delta over 1d/7d,
+// last proposed slot and the next upcoming duty (proposal or attestation within the current & next epoch).
+// the balance deltas are computed from the latest validator snapshots at or before the 1d/7d epochs and are omitted
+// if no snapshot is available. balances are in gwei.
+// query args: validators (comma separated indices, index ranges & pubkeys)
+func GetDashboard(r *http.Request) (*ApiResult, error) {
+	validatorsArg := r.URL.Query().Get("validators")
+	if validatorsArg == "" {
+		return nil, ErrBadRequest("no validators requested")
+	}
+
+	indices, err := services.ParseValidatorList(validatorsArg, services.GetMaxValidatorsBatchSize())
+	if err != nil {
+		return nil, ErrBadRequest("%v", err)
+	}
+	if len(indices) == 0 {
+		return nil, ErrBadRequest("no validators requested")
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	currentSlot := chainState.CurrentSlot()
+	currentEpoch := chainState.EpochOfSlot(currentSlot)
+
+	dashboard := &ApiDashboard{
+		Epoch:      uint64(currentEpoch),
+		Slot:       uint64(currentSlot),
+		Validators: make([]*ApiDashboardValidator, 0, len(indices)),
+	}
+
+	snapshot1d, err := getDashboardSnapshotBalances(24 * time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot1d != nil {
+		snapshotEpoch := uint64(snapshot1d.snapshotEpoch)
+		dashboard.Snapshot1dEpoch = &snapshotEpoch
+	}
+	snapshot7d, err := getDashboardSnapshotBalances(7 * 24 * time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot7d != nil {
+		snapshotEpoch := uint64(snapshot7d.snapshotEpoch)
+		dashboard.Snapshot7dEpoch = &snapshotEpoch
+	}
+
+	validatorIndices := make([]phase0.ValidatorIndex, 0, len(indices))
+	validatorMap := make(map[phase0.ValidatorIndex]*ApiDashboardValidator, len(indices))
+	for _, index := range indices {
+		validatorIndex := phase0.ValidatorIndex(index)
+		if validatorMap[validatorIndex] != nil {
+			continue
+		}
+
+		dashboardValidator := &ApiDashboardValidator{
+			Index:  index,
+			Status: "not_found",
+		}
+		dashboard.Validators = append(dashboard.Validators, dashboardValidator)
+
+		validator := services.GlobalBeaconService.GetValidatorByIndex(validatorIndex, true)
+		if validator == nil || validator.Validator == nil {
+			continue
+		}
+
+		dashboardValidator.Status = validator.Status.String()
+		dashboardValidator.Balance = uint64(validator.Balance)
+		dashboardValidator.Delta1d = snapshot1d.getBalanceDelta(validatorIndex, validator.Balance)
+		dashboardValidator.Delta7d = snapshot7d.getBalanceDelta(validatorIndex, validator.Balance)
+
+		validatorIndices = append(validatorIndices, validatorIndex)
+		validatorMap[validatorIndex] = dashboardValidator
+	}
+
+	for index, slot := range services.GlobalBeaconService.GetLastValidatorProposals(validatorIndices) {
+		lastProposal := uint64(slot)
+		validatorMap[index].LastProposal = &lastProposal
+	}
+
+	// upcoming duties from the epoch stats of the current & next epoch
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	setNextDuty := func(index phase0.ValidatorIndex, dutyType string, slot phase0.Slot) {
+		dashboardValidator := validatorMap[index]
+		if dashboardValidator == nil || slot <= currentSlot {
+			return
+		}
+		if dashboardValidator.NextDuty != nil && dashboardValidator.NextDuty.Slot <= uint64(slot) {
+			return
+		}
+		dashboardValidator.NextDuty = &ApiDashboardDuty{
+			Type: dutyType,
+			Slot: uint64(slot),
+		}
+	}
+
+	for epoch := currentEpoch; epoch <= currentEpoch+1; epoch++ {
+		epochStats := beaconIndexer.GetEpochStats(epoch, nil)
+		if epochStats == nil {
+			continue
+		}
+		epochStatsValues := beaconIndexer.GetEpochStatsValues(epochStats)
+		if epochStatsValues == nil {
+			continue
+		}
+
+		firstSlot := chainState.EpochToSlot(epoch)
+		for slotIndex, proposer := range epochStatsValues.ProposerDuties {
+			setNextDuty(proposer, "proposal", firstSlot+phase0.Slot(slotIndex))
+		}
+		for slotIndex, committees := range epochStatsValues.AttesterDuties {
+			for _, committee := range committees {
+				for _, activeIndex := range committee {
+					setNextDuty(epochStatsValues.ActiveIndices[activeIndex], "attestation", firstSlot+phase0.Slot(slotIndex))
+				}
+			}
+		}
+
+		if epoch == currentEpoch {
+			for _, validatorIndex := range epochStatsValues.SyncCommitteeDuties {
+				if dashboardValidator := validatorMap[validatorIndex]; dashboardValidator != nil {
+					dashboardValidator.SyncCommittee = true
+				}
+			}
+		}
+	}
+
+	return &ApiResult{
+		Data: dashboard,
+	}, nil
+}
+
+// getDashboardSnapshotBalances returns the balances of the latest validator snapshot before now-age.
+// returns nil if no snapshot is available.
+func getDashboardSnapshotBalances(age time.Duration) (*dashboardSnapshotBalances, error) {
+	chainState := services.GlobalBeaconService.GetChainState()
+	requestTime := time.Now().Add(-age)
+	if genesis := chainState.GetGenesis(); genesis == nil || requestTime.Before(genesis.GenesisTime) {
+		return nil, nil
+	}
+	requestEpoch := chainState.EpochOfSlot(chainState.TimeToSlot(requestTime))
+
+	dashboardSnapshotMutex.Lock()
+	defer dashboardSnapshotMutex.Unlock()
+
+	if cachedBalances := dashboardSnapshotCache[age]; cachedBalances != nil && cachedBalances.requestEpoch == requestEpoch {
+		return cachedBalances, nil
+	}
+
+	snapshot, err := services.GlobalBeaconService.GetBeaconIndexer().GetValidatorSnapshot(requestEpoch)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	snapshotBalances := &dashboardSnapshotBalances{
+		requestEpoch:  requestEpoch,
+		snapshotEpoch: snapshot.Epoch,
+		balances:      make([]phase0.Gwei, len(snapshot.Validators)),
+	}
+	for index := range snapshot.Validators {
+		snapshotBalances.balances[index] = snapshot.Validators[index].Balance
+	}
+
+	dashboardSnapshotCache[age] = snapshotBalances
+	return snapshotBalances, nil
+}
+
+// getBalanceDelta returns the balance change of a validator since the snapshot, or nil if the validator is not in the snapshot.
+func (snapshot *dashboardSnapshotBalances) getBalanceDelta(index phase0.ValidatorIndex, balance phase0.Gwei) *int64 {
+	if snapshot == nil || uint64(index) >= uint64(len(snapshot.balances)) {
+		return nil
+	}
+
+	delta := int64(balance) - int64(snapshot.balances[index])
+	return &delta
+}
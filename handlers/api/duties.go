@@ -0,0 +1,137 @@
+package api
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiEpochDuties is the api representation of the validator duties of an epoch.
+type ApiEpochDuties struct {
+	Epoch                   uint64                  `json:"epoch"`
+	FirstSlot               uint64                  `json:"first_slot"`
+	Finalized               bool                    `json:"finalized"`
+	ProposerDuties          []*ApiProposerDuty      `json:"proposer_duties"`
+	AttesterDutiesAvailable bool                    `json:"attester_duties_available"`
+	AttesterCommittees      []*ApiAttesterCommittee `json:"attester_committees"`
+	PartialDuties           bool                    `json:"partial_duties"`
+	SyncCommitteePeriod     *uint64                 `json:"sync_committee_period"`
+	SyncCommittee           []uint64                `json:"sync_committee"`
+}
+
+type ApiProposerDuty struct {
+	Slot          uint64  `json:"slot"`
+	Validator     *uint64 `json:"validator"`
+	ValidatorName string  `json:"validator_name"`
+	Status        string  `json:"status"`
+}
+
+type ApiAttesterCommittee struct {
+	Slot           uint64   `json:"slot"`
+	CommitteeIndex uint64   `json:"committee_index"`
+	Validators     []uint64 `json:"validators"`
+}
+
+// GetEpochDuties returns the proposer, attester and sync committee duties of an epoch.
+// proposer and sync committee duties are kept in the db for all epochs, attester committees are only
+// available as long as the epoch stats are retained (attester_duties_available indicates availability).
+// epoch stats loaded from the beacon committees endpoint lack unknown proposers & the sync committee (partial_duties).
+func GetEpochDuties(r *http.Request) (*ApiResult, error) {
+	epochArg := mux.Vars(r)["epoch"]
+	epochNum, err := strconv.ParseUint(epochArg, 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid epoch number: %v", epochArg)
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	epoch := phase0.Epoch(epochNum)
+	if epoch > chainState.CurrentEpoch() {
+		return nil, ErrBadRequest("epoch %v is in the future", epochNum)
+	}
+
+	finalizedEpoch, _ := services.GlobalBeaconService.GetBeaconIndexer().GetBlockCacheState()
+	firstSlot := chainState.EpochToSlot(epoch)
+	epochDuties := &ApiEpochDuties{
+		Epoch:              epochNum,
+		FirstSlot:          uint64(firstSlot),
+		Finalized:          epoch < finalizedEpoch,
+		ProposerDuties:     make([]*ApiProposerDuty, 0, specs.SlotsPerEpoch),
+		AttesterCommittees: []*ApiAttesterCommittee{},
+	}
+
+	// proposer duties (missed slots are stored with their proposer)
+	lastSlot := uint64(firstSlot) + specs.SlotsPerEpoch - 1
+	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(lastSlot, uint32(specs.SlotsPerEpoch), true, false)
+	for i := len(dbSlots) - 1; i >= 0; i-- {
+		dbSlot := dbSlots[i]
+		if dbSlot == nil || dbSlot.Slot < uint64(firstSlot) || dbSlot.Slot > lastSlot {
+			continue
+		}
+
+		proposerDuty := &ApiProposerDuty{
+			Slot:   dbSlot.Slot,
+			Status: getApiSlotStatus(dbSlot),
+		}
+		if dbSlot.Proposer != math.MaxInt64 {
+			proposer := dbSlot.Proposer
+			proposerDuty.Validator = &proposer
+			proposerDuty.ValidatorName = services.GlobalBeaconService.GetValidatorName(proposer)
+		}
+		epochDuties.ProposerDuties = append(epochDuties.ProposerDuties, proposerDuty)
+	}
+
+	// attester duties & sync committee from epoch stats, if still available
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	if epochStats := beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
+		epochDuties.PartialDuties = epochStats.IsPartial()
+		if epochStatsValues := beaconIndexer.GetEpochStatsValues(epochStats); epochStatsValues != nil {
+			if epochStatsValues.AttesterDuties != nil {
+				epochDuties.AttesterDutiesAvailable = true
+				for slotIndex, committees := range epochStatsValues.AttesterDuties {
+					for committeeIndex, committee := range committees {
+						validators := make([]uint64, len(committee))
+						for i, activeIndex := range committee {
+							validators[i] = uint64(epochStatsValues.ActiveIndices[activeIndex])
+						}
+
+						epochDuties.AttesterCommittees = append(epochDuties.AttesterCommittees, &ApiAttesterCommittee{
+							Slot:           uint64(firstSlot) + uint64(slotIndex),
+							CommitteeIndex: uint64(committeeIndex),
+							Validators:     validators,
+						})
+					}
+				}
+			}
+
+			if len(epochStatsValues.SyncCommitteeDuties) > 0 {
+				epochDuties.SyncCommittee = make([]uint64, len(epochStatsValues.SyncCommitteeDuties))
+				for i, validator := range epochStatsValues.SyncCommitteeDuties {
+					epochDuties.SyncCommittee[i] = uint64(validator)
+				}
+			}
+		}
+	}
+
+	if specs.IsAltairActive(epoch) {
+		syncPeriod := epochNum / specs.EpochsPerSyncCommitteePeriod
+		epochDuties.SyncCommitteePeriod = &syncPeriod
+		if len(epochDuties.SyncCommittee) == 0 {
+			epochDuties.SyncCommittee = db.GetSyncAssignmentsForPeriod(syncPeriod)
+		}
+	}
+
+	if epochDuties.SyncCommittee == nil {
+		epochDuties.SyncCommittee = []uint64{}
+	}
+
+	return &ApiResult{
+		Data: epochDuties,
+	}, nil
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiEpoch is the api representation of the epoch aggregations. vote & balance amounts are in gwei.
+type ApiEpoch struct {
+	Epoch                   uint64    `json:"epoch"`
+	FirstSlot               uint64    `json:"first_slot"`
+	Time                    time.Time `json:"time"`
+	Finalized               bool      `json:"finalized"`
+	Synchronized            bool      `json:"synchronized"`
+	ValidatorCount          uint64    `json:"validator_count"`
+	AverageValidatorBalance uint64    `json:"avg_validator_balance"`
+	EligibleAmount          uint64    `json:"eligible_amount"`
+	TargetVoted             uint64    `json:"target_voted"`
+	HeadVoted               uint64    `json:"head_voted"`
+	TotalVoted              uint64    `json:"total_voted"`
+	TargetParticipation     float64   `json:"target_participation"`
+	HeadParticipation       float64   `json:"head_participation"`
+	TotalParticipation      float64   `json:"total_participation"`
+	SyncParticipation       float64   `json:"sync_participation"`
+	ProposedCount           uint64    `json:"proposed_count"`
+	MissedCount             uint64    `json:"missed_count"`
+	ScheduledCount          uint64    `json:"scheduled_count"`
+	OrphanedCount           uint64    `json:"orphaned_count"`
+	AttestationCount        uint64    `json:"attestation_count"`
+	DepositCount            uint64    `json:"deposit_count"`
+	ExitCount               uint64    `json:"exit_count"`
+	WithdrawalCount         uint64    `json:"withdrawal_count"`
+	WithdrawalAmount        uint64    `json:"withdrawal_amount"`
+	ProposerSlashingCount   uint64    `json:"proposer_slashing_count"`
+	AttesterSlashingCount   uint64    `json:"attester_slashing_count"`
+	EthTransactionCount     uint64    `json:"eth_transaction_count"`
+	FinalityDelay           uint64    `json:"finality_delay"`
+}
+
+// GetEpoch returns the vote participation, slot counts and operation counts of an epoch as shown on the epoch page.
+// the epoch aggregations are loaded from the db (finalized epochs) or built from the block cache (unfinalized epochs).
+func GetEpoch(r *http.Request) (*ApiResult, error) {
+	epochArg := mux.Vars(r)["epoch"]
+	epochNum, err := strconv.ParseUint(epochArg, 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid epoch number: %v", epochArg)
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	epoch := phase0.Epoch(epochNum)
+	if epoch > chainState.CurrentEpoch() {
+		return nil, ErrBadRequest("epoch %v is in the future", epochNum)
+	}
+
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	firstSlot := uint64(chainState.EpochToSlot(epoch))
+	lastSlot := firstSlot + specs.SlotsPerEpoch - 1
+
+	apiEpoch := &ApiEpoch{
+		Epoch:     epochNum,
+		FirstSlot: firstSlot,
+		Time:      chainState.EpochToTime(epoch),
+		Finalized: finalizedEpoch > epoch,
+	}
+
+	for _, dbEpoch := range services.GlobalBeaconService.GetDbEpochs(epochNum, 1) {
+		if dbEpoch == nil || dbEpoch.Epoch != epochNum {
+			continue
+		}
+
+		apiEpoch.Synchronized = dbEpoch.ValidatorCount > 0
+		apiEpoch.ValidatorCount = dbEpoch.ValidatorCount
+		if dbEpoch.ValidatorCount > 0 {
+			apiEpoch.AverageValidatorBalance = dbEpoch.ValidatorBalance / dbEpoch.ValidatorCount
+		}
+		apiEpoch.EligibleAmount = dbEpoch.Eligible
+		apiEpoch.TargetVoted = dbEpoch.VotedTarget
+		apiEpoch.HeadVoted = dbEpoch.VotedHead
+		apiEpoch.TotalVoted = dbEpoch.VotedTotal
+		if dbEpoch.Eligible > 0 {
+			apiEpoch.TargetParticipation = float64(dbEpoch.VotedTarget) * 100 / float64(dbEpoch.Eligible)
+			apiEpoch.HeadParticipation = float64(dbEpoch.VotedHead) * 100 / float64(dbEpoch.Eligible)
+			apiEpoch.TotalParticipation = float64(dbEpoch.VotedTotal) * 100 / float64(dbEpoch.Eligible)
+		}
+		apiEpoch.SyncParticipation = float64(dbEpoch.SyncParticipation) * 100
+		apiEpoch.AttestationCount = dbEpoch.AttestationCount
+		apiEpoch.DepositCount = dbEpoch.DepositCount
+		apiEpoch.ExitCount = dbEpoch.ExitCount
+		apiEpoch.WithdrawalCount = dbEpoch.WithdrawCount
+		apiEpoch.WithdrawalAmount = dbEpoch.WithdrawAmount
+		apiEpoch.ProposerSlashingCount = dbEpoch.ProposerSlashingCount
+		apiEpoch.AttesterSlashingCount = dbEpoch.AttesterSlashingCount
+		apiEpoch.FinalityDelay = dbEpoch.FinalityDelay
+	}
+
+	for _, dbSlot := range services.GlobalBeaconService.GetDbBlocksForSlots(lastSlot, uint32(specs.SlotsPerEpoch), true, true) {
+		if dbSlot == nil || dbSlot.Slot < firstSlot || dbSlot.Slot > lastSlot {
+			continue
+		}
+
+		switch getApiSlotStatus(dbSlot) {
+		case "canonical":
+			apiEpoch.ProposedCount++
+			apiEpoch.EthTransactionCount += dbSlot.EthTransactionCount
+		case "orphaned":
+			apiEpoch.OrphanedCount++
+		case "scheduled":
+			apiEpoch.ScheduledCount++
+		case "missed":
+			apiEpoch.MissedCount++
+		}
+	}
+
+	return &ApiResult{
+		Data: apiEpoch,
+	}, nil
+}
+
+// ApiEpochSlots is the api representation of all slots of an epoch.
+type ApiEpochSlots struct {
+	Epoch     uint64     `json:"epoch"`
+	FirstSlot uint64     `json:"first_slot"`
+	Finalized bool       `json:"finalized"`
+	Slots     []*ApiSlot `json:"slots"`
+}
+
+// GetEpochSlots returns the status, proposer, root and basic counts of all slots of an epoch in ascending order.
+// the slots are loaded with a single range query (finalized epochs) or from the block cache (unfinalized epochs).
+// query args: with_orphaned (default 1)
+func GetEpochSlots(r *http.Request) (*ApiResult, error) {
+	epochArg := mux.Vars(r)["epoch"]
+	epochNum, err := strconv.ParseUint(epochArg, 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid epoch number: %v", epochArg)
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	epoch := phase0.Epoch(epochNum)
+	if epoch > chainState.CurrentEpoch() {
+		return nil, ErrBadRequest("epoch %v is in the future", epochNum)
+	}
+
+	withOrphaned := r.URL.Query().Get("with_orphaned") != "0"
+
+	finalizedEpoch, _ := services.GlobalBeaconService.GetBeaconIndexer().GetBlockCacheState()
+	firstSlot := uint64(chainState.EpochToSlot(epoch))
+	lastSlot := firstSlot + specs.SlotsPerEpoch - 1
+
+	epochSlots := &ApiEpochSlots{
+		Epoch:     epochNum,
+		FirstSlot: firstSlot,
+		Finalized: epoch < finalizedEpoch,
+		Slots:     make([]*ApiSlot, 0, specs.SlotsPerEpoch),
+	}
+
+	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(lastSlot, uint32(specs.SlotsPerEpoch), true, withOrphaned)
+	for _, dbSlot := range dbSlots {
+		if dbSlot == nil || dbSlot.Slot < firstSlot || dbSlot.Slot > lastSlot {
+			continue
+		}
+		epochSlots.Slots = append(epochSlots.Slots, buildApiSlot(dbSlot))
+	}
+
+	sort.SliceStable(epochSlots.Slots, func(i, j int) bool {
+		return epochSlots.Slots[i].Slot < epochSlots.Slots[j].Slot
+	})
+
+	return &ApiResult{
+		Data: epochSlots,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// eventsCursor is the position of the next page in the events list (events are listed in ascending sequence order).
+type eventsCursor struct {
+	Seq uint64 `json:"q"`
+}
+
+// GetEvents returns persisted explorer events (reorgs, orphan resolutions, slashings, missed proposals, finality incidents) for replay.
+// query args: limit, cursor, since_seq (replay events after this sequence number), types (comma separated)
+func GetEvents(r *http.Request) (*ApiResult, error) {
+	limit, err := parseLimit(r, 100, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	urlArgs := r.URL.Query()
+	cursor := eventsCursor{}
+	if sinceSeq := urlArgs.Get("since_seq"); sinceSeq != "" {
+		cursor.Seq, err = strconv.ParseUint(sinceSeq, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid since_seq: %v", sinceSeq)
+		}
+	}
+	if _, err := decodeCursor(r, &cursor); err != nil {
+		return nil, err
+	}
+
+	eventTypes, err := parseEventTypes(urlArgs.Get("types"))
+	if err != nil {
+		return nil, err
+	}
+
+	eventHub := services.GlobalBeaconService.GetEventHub()
+	events := eventHub.GetPersistedEvents(cursor.Seq, eventTypes, limit)
+
+	paging := &ApiPaging{
+		Limit: limit,
+	}
+	if uint64(len(events)) >= limit {
+		paging.NextCursor = encodeCursor(&eventsCursor{
+			Seq: events[len(events)-1].Seq,
+		})
+	}
+
+	return &ApiResult{
+		Data: &ApiEventList{
+			LastSeq: eventHub.GetLastSeq(),
+			Events:  events,
+		},
+		Paging: paging,
+	}, nil
+}
+
+type ApiEventList struct {
+	LastSeq uint64                    `json:"last_seq"`
+	Events  []*services.ExplorerEvent `json:"events"`
+}
+
+// parseEventTypes parses a comma separated list of persisted event types.
+func parseEventTypes(typesArg string) ([]string, error) {
+	if typesArg == "" {
+		return nil, nil
+	}
+
+	eventTypes := strings.Split(typesArg, ",")
+	for _, eventType := range eventTypes {
+		if !services.IsPersistedEventType(eventType) {
+			return nil, ErrBadRequest("invalid event type: %v", eventType)
+		}
+	}
+	return eventTypes, nil
+}
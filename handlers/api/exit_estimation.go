@@ -0,0 +1,74 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiExitEstimation is the api representation of the estimated exit of a validator.
+type ApiExitEstimation struct {
+	ValidatorIndex     uint64        `json:"validator_index"`
+	AlreadyExiting     bool          `json:"already_exiting"`
+	CanExit            bool          `json:"can_exit"`
+	EarliestExitSubmit uint64        `json:"earliest_exit_submit_epoch"`
+	ExitEpoch          uint64        `json:"exit_epoch"`
+	ExitTime           time.Time     `json:"exit_time"`
+	WithdrawableEpoch  uint64        `json:"withdrawable_epoch"`
+	WithdrawableTime   time.Time     `json:"withdrawable_time"`
+	Queue              *ApiExitQueue `json:"queue"`
+}
+
+type ApiExitQueue struct {
+	Epoch              uint64 `json:"epoch"`
+	ActiveCount        uint64 `json:"active_count"`
+	TotalActiveBalance uint64 `json:"total_active_balance"`
+	PendingExitCount   uint64 `json:"pending_exit_count"`
+	PendingExitBalance uint64 `json:"pending_exit_balance"`
+	MaxExitEpoch       uint64 `json:"max_exit_epoch"`
+	BalanceChurn       bool   `json:"balance_churn"`
+	ChurnLimit         uint64 `json:"churn_limit"`
+}
+
+// GetValidatorExitEstimation returns the estimated exit and withdrawable epochs of a validator if a voluntary exit was submitted now.
+// balances are in gwei, churn_limit is in gwei for balance based churn (electra) and in validators otherwise.
+func GetValidatorExitEstimation(r *http.Request) (*ApiResult, error) {
+	indexArg := mux.Vars(r)["index"]
+	validatorIndex, err := strconv.ParseUint(indexArg, 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid validator index: %v", indexArg)
+	}
+
+	estimation, err := services.GlobalBeaconService.EstimateValidatorExit(phase0.ValidatorIndex(validatorIndex))
+	if err != nil {
+		return nil, ErrNotFound("%v", err)
+	}
+
+	return &ApiResult{
+		Data: &ApiExitEstimation{
+			ValidatorIndex:     validatorIndex,
+			AlreadyExiting:     estimation.AlreadyExiting,
+			CanExit:            estimation.CanExit,
+			EarliestExitSubmit: uint64(estimation.EarliestExitSubmit),
+			ExitEpoch:          uint64(estimation.ExitEpoch),
+			ExitTime:           estimation.ExitTime,
+			WithdrawableEpoch:  uint64(estimation.WithdrawableEpoch),
+			WithdrawableTime:   estimation.WithdrawableTime,
+			Queue: &ApiExitQueue{
+				Epoch:              uint64(estimation.Queue.Epoch),
+				ActiveCount:        estimation.Queue.ActiveCount,
+				TotalActiveBalance: uint64(estimation.Queue.TotalActiveBalance),
+				PendingExitCount:   estimation.Queue.PendingExitCount,
+				PendingExitBalance: uint64(estimation.Queue.PendingExitBalance),
+				MaxExitEpoch:       uint64(estimation.Queue.MaxExitEpoch),
+				BalanceChurn:       estimation.Queue.BalanceChurn,
+				ChurnLimit:         estimation.Queue.ChurnLimit,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// parseFields returns the list of requested fields from the `fields` query parameter.
+func parseFields(r *http.Request) []string {
+	fieldsArg := r.URL.Query().Get("fields")
+	if fieldsArg == "" {
+		return nil
+	}
+
+	fields := []string{}
+	for _, field := range strings.Split(fieldsArg, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// selectFields reduces the response data to the requested top level fields.
+// list responses are reduced per item, unknown fields are rejected.
+func selectFields(data interface{}, fields []string) (interface{}, error) {
+	dataJson, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(dataJson, &decoded); err != nil {
+		return nil, err
+	}
+
+	switch value := decoded.(type) {
+	case []interface{}:
+		for idx, item := range value {
+			itemObj, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, ErrInvalidFields("field selection is not supported for this endpoint")
+			}
+			selected, err := selectObjectFields(itemObj, fields)
+			if err != nil {
+				return nil, err
+			}
+			value[idx] = selected
+		}
+		return value, nil
+	case map[string]interface{}:
+		return selectObjectFields(value, fields)
+	default:
+		return nil, ErrInvalidFields("field selection is not supported for this endpoint")
+	}
+}
+
+func selectObjectFields(obj map[string]interface{}, fields []string) (map[string]interface{}, error) {
+	selected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		value, found := obj[field]
+		if !found {
+			return nil, ErrInvalidFields("unknown field: %v", field)
+		}
+		selected[field] = value
+	}
+	return selected, nil
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiResult is returned by api endpoint implementations.
+type ApiResult struct {
+	Data   interface{}
+	Paging *ApiPaging
+}
+
+// ApiHandlerFunc is the signature of api endpoint implementations.
+// returned *ApiError values are passed to the client, all other errors are reported as internal errors.
+type ApiHandlerFunc func(r *http.Request) (*ApiResult, error)
+
+// Handler wraps an api endpoint implementation with rate limiting, field selection and the response envelope.
+func Handler(callCost uint, handler ApiHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := services.GlobalCallRateLimiter.CheckCallLimit(r, callCost); err != nil {
+			writeError(w, r, ErrRateLimited())
+			return
+		}
+
+		fields := parseFields(r)
+
+		result, err := handler(r)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		data := result.Data
+		if len(fields) > 0 {
+			data, err = selectFields(data, fields)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+		}
+
+		writeResponse(w, r, http.StatusOK, &ApiResponse{
+			Status: "OK",
+			Data:   data,
+			Paging: result.Paging,
+		})
+	}
+}
+
+// NotFound returns the error envelope for unknown api routes.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, ErrNotFound("unknown api endpoint: %v", r.URL.Path))
+}
+
+// decodeJsonBody decodes the size limited json request body into the given value.
+func decodeJsonBody(r *http.Request, maxSize int64, value interface{}) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxSize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(value); err != nil {
+		return ErrBadRequest("invalid request body: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiResponse is the common envelope for all api responses.
+type ApiResponse struct {
+	Status string      `json:"status"`
+	Data   interface{} `json:"data,omitempty"`
+	Paging *ApiPaging  `json:"paging,omitempty"`
+	Error  *ApiError   `json:"error,omitempty"`
+}
+
+// ApiPaging holds the cursor based pagination details of a list response.
+type ApiPaging struct {
+	Limit      uint64 `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ApiError is the error object returned in the error envelope.
+type ApiError struct {
+	HttpStatus int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Code, e.Message)
+}
+
+// NewApiError creates a new api error with the given http status and error code.
+func NewApiError(httpStatus int, code string, format string, args ...interface{}) *ApiError {
+	return &ApiError{
+		HttpStatus: httpStatus,
+		Code:       code,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func ErrBadRequest(format string, args ...interface{}) *ApiError {
+	return NewApiError(http.StatusBadRequest, "bad_request", format, args...)
+}
+
+func ErrInvalidCursor() *ApiError {
+	return NewApiError(http.StatusBadRequest, "invalid_cursor", "invalid or expired cursor")
+}
+
+func ErrInvalidFields(format string, args ...interface{}) *ApiError {
+	return NewApiError(http.StatusBadRequest, "invalid_fields", format, args...)
+}
+
+func ErrUnauthorized() *ApiError {
+	return NewApiError(http.StatusUnauthorized, "unauthorized", "missing or invalid admin token")
+}
+
+func ErrForbidden(format string, args ...interface{}) *ApiError {
+	return NewApiError(http.StatusForbidden, "forbidden", format, args...)
+}
+
+func ErrNotFound(format string, args ...interface{}) *ApiError {
+	return NewApiError(http.StatusNotFound, "not_found", format, args...)
+}
+
+func ErrRateLimited() *ApiError {
+	return NewApiError(http.StatusTooManyRequests, "rate_limited", "call rate limit exceeded")
+}
+
+func ErrLiveDataUnavailable() *ApiError {
+	return NewApiError(http.StatusServiceUnavailable, "live_data_unavailable", "live data unavailable: no beacon node reachable")
+}
+
+func ErrInternal() *ApiError {
+	return NewApiError(http.StatusInternalServerError, "internal_error", "internal server error")
+}
+
+// writeResponse writes the response envelope with the given http status.
+func writeResponse(w http.ResponseWriter, r *http.Request, httpStatus int, response *ApiResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		logrus.WithError(err).Errorf("error encoding api response for %v", r.URL.String())
+	}
+}
+
+// writeError writes the error envelope for the given error.
+// errors that are not api errors are logged and reported as internal errors without leaking details.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr, ok := err.(*ApiError)
+	if !ok && errors.Is(err, services.ErrLiveDataUnavailable) {
+		apiErr, ok = ErrLiveDataUnavailable(), true
+	}
+	if !ok {
+		logrus.WithError(err).Errorf("api handler error for %v", r.URL.String())
+		apiErr = ErrInternal()
+	}
+
+	writeResponse(w, r, apiErr.HttpStatus, &ApiResponse{
+		Status: "ERROR",
+		Error:  apiErr,
+	})
+}
@@ -0,0 +1,224 @@
+package api
+
+import (
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+)
+
+// max number of results per result type
+const searchResultLimit = 10
+
+// ApiSearchResults is the api representation of the search results, grouped by result type.
+type ApiSearchResults struct {
+	Query        string                        `json:"query"`
+	Epochs       []uint64                      `json:"epochs"`
+	Slots        []*ApiSearchSlotResult        `json:"slots"`
+	Validators   []*ApiSearchValidatorResult   `json:"validators"`
+	Transactions []*ApiSearchTransactionResult `json:"transactions"`
+}
+
+// ApiSearchSlotResult is a block matching the search query.
+type ApiSearchSlotResult struct {
+	Slot      uint64 `json:"slot"`
+	BlockRoot string `json:"block_root"`
+	Status    string `json:"status"`
+}
+
+// ApiSearchTransactionResult is a block including the execution transaction matching the search query.
+type ApiSearchTransactionResult struct {
+	Slot      uint64 `json:"slot"`
+	BlockRoot string `json:"block_root"`
+	Status    string `json:"status"`
+	TxIndex   uint64 `json:"tx_index"`
+}
+
+// ApiSearchValidatorResult is a validator matching the search query.
+type ApiSearchValidatorResult struct {
+	Index  uint64 `json:"index"`
+	Pubkey string `json:"pubkey"`
+	Name   string `json:"name"`
+}
+
+// GetSearch resolves the search query like the search box of the frontend, but returns all matches instead of redirecting:
+// numbers are matched against slots, epochs & validator indices, hex strings against block roots, execution transaction
+// hashes & validator pubkeys (full or prefix) and other terms against validator names.
+// query args: q
+func GetSearch(r *http.Request) (*ApiResult, error) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		return nil, ErrBadRequest("missing search query")
+	}
+
+	results := &ApiSearchResults{
+		Query:        query,
+		Epochs:       []uint64{},
+		Slots:        []*ApiSearchSlotResult{},
+		Validators:   []*ApiSearchValidatorResult{},
+		Transactions: []*ApiSearchTransactionResult{},
+	}
+
+	if number, err := strconv.ParseUint(query, 10, 64); err == nil {
+		searchNumber(results, number)
+	} else if hexQuery := strings.TrimPrefix(strings.ToLower(query), "0x"); searchLikeHex(hexQuery) {
+		searchHex(results, hexQuery)
+	} else if services.IsSearchTermAllowed(query) {
+		searchCtx, cancelSearch := services.GetSearchContext(r.Context())
+		defer cancelSearch()
+
+		names, err := db.SearchValidatorNames(searchCtx, query, searchResultLimit)
+		if err != nil {
+			logrus.Warnf("error searching validator names for %v: %v", query, err)
+		}
+		for _, name := range names {
+			results.Validators = append(results.Validators, buildApiSearchValidatorResult(phase0.ValidatorIndex(name.Index)))
+		}
+	}
+
+	return &ApiResult{
+		Data: results,
+	}, nil
+}
+
+func searchLikeHex(hexQuery string) bool {
+	if hexQuery == "" || len(hexQuery) > 96 {
+		return false
+	}
+	_, err := hex.DecodeString(hexQuery + strings.Repeat("0", len(hexQuery)%2))
+	return err == nil
+}
+
+// searchNumber matches the number against epochs, slots and validator indices.
+func searchNumber(results *ApiSearchResults, number uint64) {
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	if phase0.Epoch(number) <= chainState.CurrentEpoch() {
+		results.Epochs = append(results.Epochs, number)
+	}
+
+	for _, dbSlot := range services.GlobalBeaconService.GetDbBlocksForSlots(number, 1, false, true) {
+		if dbSlot == nil || dbSlot.Slot != number {
+			continue
+		}
+		results.Slots = append(results.Slots, buildApiSearchSlotResult(dbSlot))
+	}
+
+	validator := services.GlobalBeaconService.GetValidatorByIndex(phase0.ValidatorIndex(number), false)
+	if validator != nil && validator.Validator != nil {
+		results.Validators = append(results.Validators, buildApiSearchValidatorResult(phase0.ValidatorIndex(number)))
+	}
+}
+
+// searchHex matches the hex string against block roots, execution transaction hashes and validator pubkeys (full match or prefix).
+func searchHex(results *ApiSearchResults, hexQuery string) {
+	if len(hexQuery) == 64 {
+		blockRoot, _ := hex.DecodeString(hexQuery)
+		if dbSlot := services.GlobalBeaconService.GetDbBlockByRoot(phase0.Root(blockRoot)); dbSlot != nil {
+			results.Slots = append(results.Slots, buildApiSearchSlotResult(dbSlot))
+		}
+		for _, txBlock := range db.GetBlocksByTxHash(blockRoot) {
+			status := "canonical"
+			if txBlock.Status == dbtypes.Orphaned {
+				status = "orphaned"
+			}
+			results.Transactions = append(results.Transactions, &ApiSearchTransactionResult{
+				Slot:      txBlock.Slot,
+				BlockRoot: "0x" + hex.EncodeToString(txBlock.Root),
+				Status:    status,
+				TxIndex:   txBlock.TxIndex,
+			})
+		}
+		return
+	}
+	if len(hexQuery) == 96 {
+		pubkey, _ := hex.DecodeString(hexQuery)
+		if index, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(pubkey)); found {
+			results.Validators = append(results.Validators, buildApiSearchValidatorResult(index))
+		}
+		return
+	}
+	if !services.IsPrefixSearchAllowed(hexQuery) {
+		return
+	}
+
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+	if len(hexQuery) < 64 {
+		knownRoots := map[phase0.Root]bool{}
+		for _, cachedBlock := range indexer.GetBlocksByRootPrefix(hexQuery) {
+			header := cachedBlock.GetHeader()
+			if header == nil {
+				continue
+			}
+
+			knownRoots[cachedBlock.Root] = true
+			status := "canonical"
+			if !indexer.IsCanonicalBlock(cachedBlock, nil) {
+				status = "orphaned"
+			}
+			results.Slots = append(results.Slots, &ApiSearchSlotResult{
+				Slot:      uint64(header.Message.Slot),
+				BlockRoot: cachedBlock.Root.String(),
+				Status:    status,
+			})
+		}
+
+		dbBlocks, err := db.GetSlotsByRootPrefix(hexQuery, searchResultLimit)
+		if err != nil {
+			logrus.Warnf("error searching block root prefix %v: %v", hexQuery, err)
+		}
+		for _, dbBlock := range dbBlocks {
+			if knownRoots[phase0.Root(dbBlock.Root)] {
+				continue
+			}
+
+			status := "canonical"
+			if dbBlock.Status == dbtypes.Orphaned {
+				status = "orphaned"
+			}
+			results.Slots = append(results.Slots, &ApiSearchSlotResult{
+				Slot:      dbBlock.Slot,
+				BlockRoot: "0x" + hex.EncodeToString(dbBlock.Root),
+				Status:    status,
+			})
+		}
+
+		sort.Slice(results.Slots, func(a, b int) bool {
+			return results.Slots[a].Slot > results.Slots[b].Slot
+		})
+		if len(results.Slots) > searchResultLimit {
+			results.Slots = results.Slots[:searchResultLimit]
+		}
+	}
+
+	for _, validatorIndex := range indexer.GetValidatorIndicesByPubkeyPrefix(hexQuery, searchResultLimit) {
+		results.Validators = append(results.Validators, buildApiSearchValidatorResult(validatorIndex))
+	}
+}
+
+func buildApiSearchSlotResult(dbSlot *dbtypes.Slot) *ApiSearchSlotResult {
+	return &ApiSearchSlotResult{
+		Slot:      dbSlot.Slot,
+		BlockRoot: "0x" + hex.EncodeToString(dbSlot.Root),
+		Status:    getApiSlotStatus(dbSlot),
+	}
+}
+
+func buildApiSearchValidatorResult(index phase0.ValidatorIndex) *ApiSearchValidatorResult {
+	result := &ApiSearchValidatorResult{
+		Index: uint64(index),
+		Name:  services.GlobalBeaconService.GetValidatorName(uint64(index)),
+	}
+	if validator := services.GlobalBeaconService.GetValidatorByIndex(index, false); validator != nil && validator.Validator != nil {
+		result.Pubkey = "0x" + hex.EncodeToString(validator.Validator.PublicKey[:])
+	}
+	return result
+}
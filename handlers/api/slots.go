@@ -0,0 +1,233 @@
+package api
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiSlot is the api representation of a slot / block.
+type ApiSlot struct {
+	Slot                  uint64    `json:"slot"`
+	Epoch                 uint64    `json:"epoch"`
+	Time                  time.Time `json:"time"`
+	Status                string    `json:"status"`
+	Proposer              *uint64   `json:"proposer"`
+	ProposerName          string    `json:"proposer_name"`
+	BlockRoot             string    `json:"block_root"`
+	ParentRoot            string    `json:"parent_root"`
+	StateRoot             string    `json:"state_root"`
+	Graffiti              string    `json:"graffiti"`
+	AttestationCount      uint64    `json:"attestation_count"`
+	DepositCount          uint64    `json:"deposit_count"`
+	ExitCount             uint64    `json:"exit_count"`
+	ProposerSlashingCount uint64    `json:"proposer_slashing_count"`
+	AttesterSlashingCount uint64    `json:"attester_slashing_count"`
+	SyncParticipation     float64   `json:"sync_participation"`
+	EthTransactionCount   uint64    `json:"eth_transaction_count"`
+	EthBlockNumber        *uint64   `json:"eth_block_number"`
+	EthBlockHash          string    `json:"eth_block_hash"`
+}
+
+// slotsCursor is the position of the next page in the slots list (slots are listed in descending order).
+type slotsCursor struct {
+	Slot uint64 `json:"s"`
+}
+
+func buildApiSlot(dbSlot *dbtypes.Slot) *ApiSlot {
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	apiSlot := &ApiSlot{
+		Slot:                  dbSlot.Slot,
+		Epoch:                 uint64(chainState.EpochOfSlot(phase0.Slot(dbSlot.Slot))),
+		Time:                  chainState.SlotToTime(phase0.Slot(dbSlot.Slot)),
+		AttestationCount:      dbSlot.AttestationCount,
+		DepositCount:          dbSlot.DepositCount,
+		ExitCount:             dbSlot.ExitCount,
+		ProposerSlashingCount: dbSlot.ProposerSlashingCount,
+		AttesterSlashingCount: dbSlot.AttesterSlashingCount,
+		SyncParticipation:     float64(dbSlot.SyncParticipation),
+		EthTransactionCount:   dbSlot.EthTransactionCount,
+		EthBlockNumber:        dbSlot.EthBlockNumber,
+		Graffiti:              dbSlot.GraffitiText,
+		Status:                getApiSlotStatus(dbSlot),
+	}
+
+	if dbSlot.Proposer != math.MaxInt64 {
+		proposer := dbSlot.Proposer
+		apiSlot.Proposer = &proposer
+		apiSlot.ProposerName = services.GlobalBeaconService.GetValidatorName(proposer)
+	}
+	if len(dbSlot.Root) > 0 {
+		apiSlot.BlockRoot = "0x" + hex.EncodeToString(dbSlot.Root)
+		apiSlot.ParentRoot = "0x" + hex.EncodeToString(dbSlot.ParentRoot)
+		apiSlot.StateRoot = "0x" + hex.EncodeToString(dbSlot.StateRoot)
+	}
+	if len(dbSlot.EthBlockHash) > 0 {
+		apiSlot.EthBlockHash = "0x" + hex.EncodeToString(dbSlot.EthBlockHash)
+	}
+
+	return apiSlot
+}
+
+// getApiSlotStatus returns the api status name of a slot (canonical, orphaned, missed or scheduled).
+func getApiSlotStatus(dbSlot *dbtypes.Slot) string {
+	switch dbSlot.Status {
+	case dbtypes.Canonical:
+		return "canonical"
+	case dbtypes.Orphaned:
+		return "orphaned"
+	default:
+		if dbSlot.Slot >= uint64(services.GlobalBeaconService.GetChainState().CurrentSlot()) {
+			return "scheduled"
+		}
+		return "missed"
+	}
+}
+
+// GetSlots returns the list of slots in descending order.
+// query args: limit, cursor, with_missing (default 1), with_orphaned (default 1)
+func GetSlots(r *http.Request) (*ApiResult, error) {
+	limit, err := parseLimit(r, 50, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	cursor := slotsCursor{
+		Slot: uint64(chainState.CurrentSlot()),
+	}
+	if _, err := decodeCursor(r, &cursor); err != nil {
+		return nil, err
+	}
+
+	urlArgs := r.URL.Query()
+	withMissing := urlArgs.Get("with_missing") != "0"
+	withOrphaned := urlArgs.Get("with_orphaned") != "0"
+
+	minSlot := uint64(0)
+	if cursor.Slot+1 > limit {
+		minSlot = cursor.Slot + 1 - limit
+	}
+
+	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(cursor.Slot, uint32(limit), withMissing, withOrphaned)
+	apiSlots := make([]*ApiSlot, 0, len(dbSlots))
+	for _, dbSlot := range dbSlots {
+		if dbSlot == nil || dbSlot.Slot < minSlot || dbSlot.Slot > cursor.Slot {
+			continue
+		}
+		apiSlots = append(apiSlots, buildApiSlot(dbSlot))
+	}
+
+	paging := &ApiPaging{
+		Limit: limit,
+	}
+	if minSlot > 0 {
+		paging.NextCursor = encodeCursor(&slotsCursor{
+			Slot: minSlot - 1,
+		})
+	}
+
+	return &ApiResult{
+		Data:   apiSlots,
+		Paging: paging,
+	}, nil
+}
+
+// GetSlot returns the slot / block with the given slot number or block root.
+// for slot numbers, the canonical block is returned if available.
+func GetSlot(r *http.Request) (*ApiResult, error) {
+	slotOrRoot := mux.Vars(r)["slotOrRoot"]
+
+	var dbSlot *dbtypes.Slot
+	if strings.HasPrefix(slotOrRoot, "0x") {
+		blockRoot, err := hex.DecodeString(slotOrRoot[2:])
+		if err != nil || len(blockRoot) != 32 {
+			return nil, ErrBadRequest("invalid block root: %v", slotOrRoot)
+		}
+
+		dbSlot = services.GlobalBeaconService.GetDbBlockByRoot(phase0.Root(blockRoot))
+	} else {
+		slot, err := strconv.ParseUint(slotOrRoot, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid slot number: %v", slotOrRoot)
+		}
+
+		for _, slotBlock := range services.GlobalBeaconService.GetDbBlocksForSlots(slot, 1, true, true) {
+			if slotBlock == nil || slotBlock.Slot != slot {
+				continue
+			}
+			if dbSlot == nil || slotBlock.Status == dbtypes.Canonical {
+				dbSlot = slotBlock
+			}
+		}
+	}
+
+	if dbSlot == nil {
+		return nil, ErrNotFound("slot not found")
+	}
+
+	return &ApiResult{
+		Data: buildApiSlot(dbSlot),
+	}, nil
+}
+
+// ApiBlockReindex is the result of a block re-index.
+type ApiBlockReindex struct {
+	BlockRoot string `json:"block_root"`
+	Slot      uint64 `json:"slot"`
+	Epoch     uint64 `json:"epoch"`
+	Client    string `json:"client"`
+	Status    string `json:"status"`
+}
+
+// ReindexSlot invalidates a stored block of a finalized epoch and refetches the epoch from a healthy client (admin only).
+// the block is replaced if the client serves a block with the same root, otherwise it is kept as orphaned block.
+// query args: client (name of the client to refetch from, default: all clients in sync order)
+func ReindexSlot(r *http.Request) (*ApiResult, error) {
+	rootArg := mux.Vars(r)["root"]
+	blockRoot, err := hex.DecodeString(strings.TrimPrefix(rootArg, "0x"))
+	if err != nil || len(blockRoot) != 32 {
+		return nil, ErrBadRequest("invalid block root: %v", rootArg)
+	}
+
+	clientName := r.URL.Query().Get("client")
+	result, err := services.GlobalBeaconService.ReindexBlock(r.Context(), phase0.Root(blockRoot), clientName)
+	switch {
+	case errors.Is(err, beacon.ErrReindexBlockNotFound):
+		return nil, ErrNotFound("block %v not found", rootArg)
+	case errors.Is(err, beacon.ErrReindexNotFinalized):
+		return nil, ErrBadRequest("block %v is not finalized yet", rootArg)
+	case errors.Is(err, beacon.ErrReindexNoClient):
+		if clientName != "" {
+			return nil, ErrBadRequest("client %v is not available for the epoch of the block", clientName)
+		}
+		return nil, ErrLiveDataUnavailable()
+	case err != nil:
+		return nil, err
+	}
+
+	return &ApiResult{
+		Data: &ApiBlockReindex{
+			BlockRoot: "0x" + hex.EncodeToString(blockRoot),
+			Slot:      uint64(result.Slot),
+			Epoch:     uint64(result.Epoch),
+			Client:    result.Client,
+			Status: getApiSlotStatus(&dbtypes.Slot{
+				Slot:   uint64(result.Slot),
+				Status: result.Status,
+			}),
+		},
+	}, nil
+}
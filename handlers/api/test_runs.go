@@ -0,0 +1,179 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiTestRun is the api representation of an externally registered test run.
+type ApiTestRun struct {
+	Id        uint64  `json:"id"`
+	Name      string  `json:"name"`
+	Source    string  `json:"source"`
+	Url       string  `json:"url"`
+	Status    string  `json:"status"`
+	StartSlot uint64  `json:"start_slot"`
+	EndSlot   *uint64 `json:"end_slot"`
+	CreatedAt uint64  `json:"created_at"`
+	UpdatedAt uint64  `json:"updated_at"`
+}
+
+// ApiRegisterTestRunRequest is the request body of the register test run endpoint.
+// status defaults to "running" and start_slot to the current wall clock slot.
+type ApiRegisterTestRunRequest struct {
+	Name      string  `json:"name"`
+	Source    string  `json:"source"`
+	Url       string  `json:"url"`
+	Status    string  `json:"status"`
+	StartSlot *uint64 `json:"start_slot"`
+	EndSlot   *uint64 `json:"end_slot"`
+}
+
+// ApiUpdateTestRunRequest is the request body of the update test run endpoint.
+// end_slot defaults to the current wall clock slot when a running test run is completed.
+type ApiUpdateTestRunRequest struct {
+	Status  string  `json:"status"`
+	EndSlot *uint64 `json:"end_slot"`
+}
+
+const maxTestRunRequestSize = 16 * 1024
+
+func buildApiTestRun(testRun *dbtypes.TestRun) *ApiTestRun {
+	return &ApiTestRun{
+		Id:        testRun.Id,
+		Name:      testRun.Name,
+		Source:    testRun.Source,
+		Url:       testRun.Url,
+		Status:    testRun.Status,
+		StartSlot: testRun.StartSlot,
+		EndSlot:   testRun.EndSlot,
+		CreatedAt: testRun.CreatedAt,
+		UpdatedAt: testRun.UpdatedAt,
+	}
+}
+
+// GetTestRuns returns the registered test runs overlapping the requested slot range.
+// query args: from_slot, to_slot (both inclusive, default to the full chain), status
+func GetTestRuns(r *http.Request) (*ApiResult, error) {
+	urlArgs := r.URL.Query()
+	fromSlot := uint64(0)
+	toSlot := ^uint64(0)
+	if fromArg := urlArgs.Get("from_slot"); fromArg != "" {
+		slot, err := strconv.ParseUint(fromArg, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid from_slot: %v", fromArg)
+		}
+		fromSlot = slot
+	}
+	if toArg := urlArgs.Get("to_slot"); toArg != "" {
+		slot, err := strconv.ParseUint(toArg, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid to_slot: %v", toArg)
+		}
+		toSlot = slot
+	}
+	if toSlot < fromSlot {
+		return nil, ErrBadRequest("to_slot must not be before from_slot")
+	}
+
+	status := urlArgs.Get("status")
+	if status != "" && !services.IsTestRunStatus(status) {
+		return nil, ErrBadRequest("invalid status: %v", status)
+	}
+
+	testRuns := []*ApiTestRun{}
+	for _, testRun := range services.GlobalBeaconService.GetTestRuns(fromSlot, toSlot) {
+		if status != "" && testRun.Status != status {
+			continue
+		}
+		testRuns = append(testRuns, buildApiTestRun(testRun))
+	}
+
+	return &ApiResult{
+		Data: testRuns,
+	}, nil
+}
+
+// RegisterTestRun stores a new test run (admin only).
+func RegisterTestRun(r *http.Request) (*ApiResult, error) {
+	request := &ApiRegisterTestRunRequest{}
+	if err := decodeJsonBody(r, maxTestRunRequestSize, request); err != nil {
+		return nil, err
+	}
+
+	if request.Status == "" {
+		request.Status = services.TestRunStatusRunning
+	}
+
+	var startSlot uint64
+	if request.StartSlot != nil {
+		startSlot = *request.StartSlot
+	} else {
+		startSlot = uint64(services.GlobalBeaconService.GetChainState().CurrentSlot())
+	}
+
+	if err := services.ValidateTestRun(request.Name, request.Source, request.Url, request.Status, startSlot, request.EndSlot); err != nil {
+		return nil, ErrBadRequest("%v", err)
+	}
+
+	testRun, err := services.GlobalBeaconService.RegisterTestRun(request.Name, request.Source, request.Url, request.Status, startSlot, request.EndSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiResult{
+		Data: buildApiTestRun(testRun),
+	}, nil
+}
+
+// UpdateTestRun updates the status and end slot of a test run (admin only).
+func UpdateTestRun(r *http.Request) (*ApiResult, error) {
+	idArg := mux.Vars(r)["id"]
+	id, err := strconv.ParseUint(idArg, 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid test run id: %v", idArg)
+	}
+
+	request := &ApiUpdateTestRunRequest{}
+	if err := decodeJsonBody(r, maxTestRunRequestSize, request); err != nil {
+		return nil, err
+	}
+
+	testRun, err := services.GlobalBeaconService.GetTestRun(id)
+	if err != nil {
+		return nil, err
+	}
+	if testRun == nil {
+		return nil, ErrNotFound("test run %v not found", id)
+	}
+
+	endSlot := request.EndSlot
+	if endSlot == nil && request.Status != services.TestRunStatusRunning {
+		endSlot = testRun.EndSlot
+		if endSlot == nil {
+			currentSlot := uint64(services.GlobalBeaconService.GetChainState().CurrentSlot())
+			endSlot = &currentSlot
+		}
+	}
+
+	if err := services.ValidateTestRun(testRun.Name, testRun.Source, testRun.Url, request.Status, testRun.StartSlot, endSlot); err != nil {
+		return nil, ErrBadRequest("%v", err)
+	}
+
+	testRun, err = services.GlobalBeaconService.UpdateTestRun(id, request.Status, endSlot)
+	if err != nil {
+		return nil, err
+	}
+	if testRun == nil {
+		return nil, ErrNotFound("test run %v not found", id)
+	}
+
+	return &ApiResult{
+		Data: buildApiTestRun(testRun),
+	}, nil
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiValidatorName is the api representation of a validator name definition.
+type ApiValidatorName struct {
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	Source         string `json:"source"`
+	ValidatorCount uint64 `json:"validator_count"`
+	Overridden     bool   `json:"overridden"`
+	UpdatedAt      uint64 `json:"updated_at,omitempty"`
+}
+
+// ApiSetValidatorNameRequest is the request body of the set validator name endpoint.
+type ApiSetValidatorNameRequest struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+const maxValidatorNameRequestSize = 4 * 1024
+
+func buildApiValidatorName(rule *services.ValidatorNameRule) *ApiValidatorName {
+	return &ApiValidatorName{
+		Key:            rule.Key,
+		Name:           rule.Name,
+		Source:         rule.Source,
+		ValidatorCount: rule.ValidatorCount,
+		Overridden:     rule.Overridden,
+		UpdatedAt:      rule.UpdatedAt,
+	}
+}
+
+// GetValidatorNames returns the known validator name definitions with their provenance.
+// query args: source (config, remote, override or graffiti), limit, cursor
+func GetValidatorNames(r *http.Request) (*ApiResult, error) {
+	urlArgs := r.URL.Query()
+	source := urlArgs.Get("source")
+	switch source {
+	case "", services.ValidatorNameSourceConfig, services.ValidatorNameSourceRemote, services.ValidatorNameSourceOverride, services.ValidatorNameSourceGraffiti:
+	default:
+		return nil, ErrBadRequest("invalid source: %v", source)
+	}
+
+	limit, err := parseLimit(r, 100, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := struct {
+		Offset uint64 `json:"offset"`
+	}{}
+	if _, err := decodeCursor(r, &cursor); err != nil {
+		return nil, err
+	}
+
+	names := []*ApiValidatorName{}
+	for _, rule := range services.GlobalBeaconService.GetValidatorNameRules() {
+		if source != "" && rule.Source != source {
+			continue
+		}
+		names = append(names, buildApiValidatorName(rule))
+	}
+
+	result := &ApiResult{
+		Paging: &ApiPaging{
+			Limit: limit,
+		},
+	}
+
+	if cursor.Offset >= uint64(len(names)) {
+		result.Data = []*ApiValidatorName{}
+		return result, nil
+	}
+	names = names[cursor.Offset:]
+	if uint64(len(names)) > limit {
+		names = names[:limit]
+		cursor.Offset += limit
+		result.Paging.NextCursor = encodeCursor(cursor)
+	}
+	result.Data = names
+
+	return result, nil
+}
+
+// SetValidatorName adds or overrides a validator name at runtime (admin only).
+// the override is persisted in the db and takes precedence over configured names with the same key.
+func SetValidatorName(r *http.Request) (*ApiResult, error) {
+	request := &ApiSetValidatorNameRequest{}
+	if err := decodeJsonBody(r, maxValidatorNameRequestSize, request); err != nil {
+		return nil, err
+	}
+
+	if _, err := services.ValidateValidatorNameOverride(request.Key, request.Name); err != nil {
+		return nil, ErrBadRequest("%v", err)
+	}
+
+	key, err := services.GlobalBeaconService.SetValidatorNameOverride(request.Key, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiResult{
+		Data: &ApiSetValidatorNameRequest{
+			Key:  key,
+			Name: strings.TrimSpace(request.Name),
+		},
+	}, nil
+}
+
+// DeleteValidatorName removes a runtime validator name override (admin only).
+func DeleteValidatorName(r *http.Request) (*ApiResult, error) {
+	key := mux.Vars(r)["key"]
+	deleted, err := services.GlobalBeaconService.DeleteValidatorNameOverride(key)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, ErrNotFound("validator name override %v not found", key)
+	}
+
+	return &ApiResult{
+		Data: map[string]string{"key": key},
+	}, nil
+}
@@ -0,0 +1,303 @@
+package api
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// ApiValidatorStatusRequest is the request body of the bulk validator status endpoint.
+type ApiValidatorStatusRequest struct {
+	Indices []uint64 `json:"indices"`
+	Pubkeys []string `json:"pubkeys"`
+}
+
+// ApiValidatorStatus is the api representation of the current status of a validator.
+// unknown validators are returned with status "not_found".
+type ApiValidatorStatus struct {
+	Index            *uint64 `json:"index"`
+	Pubkey           string  `json:"pubkey"`
+	Name             string  `json:"name"`
+	Status           string  `json:"status"`
+	Balance          uint64  `json:"balance"`
+	EffectiveBalance uint64  `json:"effective_balance"`
+	Slashed          bool    `json:"slashed"`
+	ActivationEpoch  *uint64 `json:"activation_epoch"`
+	ExitEpoch        *uint64 `json:"exit_epoch"`
+}
+
+const maxValidatorStatusRequestSize = 1024 * 1024
+
+// GetValidatorsStatus returns the current status, balances and names of the requested validators in request order
+// (indices first, then pubkeys). all validators are served from the cached validator set. balances are in gwei.
+func GetValidatorsStatus(r *http.Request) (*ApiResult, error) {
+	request := &ApiValidatorStatusRequest{}
+	if err := decodeJsonBody(r, maxValidatorStatusRequestSize, request); err != nil {
+		return nil, err
+	}
+
+	requestCount := uint64(len(request.Indices) + len(request.Pubkeys))
+	if requestCount == 0 {
+		return nil, ErrBadRequest("no indices or pubkeys requested")
+	}
+	if maxBatchSize := services.GetMaxValidatorsBatchSize(); requestCount > maxBatchSize {
+		return nil, ErrBadRequest("too many validators requested (max %v)", maxBatchSize)
+	}
+
+	pubkeys := make([]phase0.BLSPubKey, len(request.Pubkeys))
+	for i, pubkeyArg := range request.Pubkeys {
+		pubkey, err := hex.DecodeString(strings.TrimPrefix(pubkeyArg, "0x"))
+		if err != nil || len(pubkey) != 48 {
+			return nil, ErrBadRequest("invalid validator pubkey: %v", pubkeyArg)
+		}
+		pubkeys[i] = phase0.BLSPubKey(pubkey)
+	}
+
+	statuses := make([]*ApiValidatorStatus, 0, requestCount)
+	for _, index := range request.Indices {
+		statuses = append(statuses, buildApiValidatorStatus(phase0.ValidatorIndex(index), nil))
+	}
+	for i := range pubkeys {
+		index, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(pubkeys[i])
+		if !found {
+			statuses = append(statuses, &ApiValidatorStatus{
+				Pubkey: "0x" + hex.EncodeToString(pubkeys[i][:]),
+				Status: "not_found",
+			})
+			continue
+		}
+		statuses = append(statuses, buildApiValidatorStatus(index, &pubkeys[i]))
+	}
+
+	return &ApiResult{
+		Data: statuses,
+	}, nil
+}
+
+// GetValidator returns the current status, balances and name of a single validator by index or pubkey.
+func GetValidator(r *http.Request) (*ApiResult, error) {
+	indexOrPubkey := mux.Vars(r)["indexOrPubkey"]
+
+	var status *ApiValidatorStatus
+	if strings.HasPrefix(indexOrPubkey, "0x") {
+		pubkeyBytes, err := hex.DecodeString(indexOrPubkey[2:])
+		if err != nil || len(pubkeyBytes) != 48 {
+			return nil, ErrBadRequest("invalid validator pubkey: %v", indexOrPubkey)
+		}
+
+		pubkey := phase0.BLSPubKey(pubkeyBytes)
+		index, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(pubkey)
+		if !found {
+			return nil, ErrNotFound("validator not found")
+		}
+		status = buildApiValidatorStatus(index, &pubkey)
+	} else {
+		index, err := strconv.ParseUint(indexOrPubkey, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid validator index: %v", indexOrPubkey)
+		}
+		status = buildApiValidatorStatus(phase0.ValidatorIndex(index), nil)
+	}
+
+	if status.Status == "not_found" {
+		return nil, ErrNotFound("validator not found")
+	}
+
+	return &ApiResult{
+		Data: status,
+	}, nil
+}
+
+func buildApiValidatorStatus(index phase0.ValidatorIndex, pubkey *phase0.BLSPubKey) *ApiValidatorStatus {
+	validatorIndex := uint64(index)
+	validator := services.GlobalBeaconService.GetValidatorByIndex(index, true)
+	if validator == nil || validator.Validator == nil {
+		status := &ApiValidatorStatus{
+			Index:  &validatorIndex,
+			Status: "not_found",
+		}
+		if pubkey != nil {
+			status.Pubkey = "0x" + hex.EncodeToString(pubkey[:])
+		}
+		return status
+	}
+
+	status := &ApiValidatorStatus{
+		Index:            &validatorIndex,
+		Pubkey:           "0x" + hex.EncodeToString(validator.Validator.PublicKey[:]),
+		Name:             services.GlobalBeaconService.GetValidatorName(validatorIndex),
+		Status:           validator.Status.String(),
+		Balance:          uint64(validator.Balance),
+		EffectiveBalance: uint64(validator.Validator.EffectiveBalance),
+		Slashed:          validator.Validator.Slashed,
+	}
+	if validator.Validator.ActivationEpoch != beacon.FarFutureEpoch {
+		activationEpoch := uint64(validator.Validator.ActivationEpoch)
+		status.ActivationEpoch = &activationEpoch
+	}
+	if validator.Validator.ExitEpoch != beacon.FarFutureEpoch {
+		exitEpoch := uint64(validator.Validator.ExitEpoch)
+		status.ExitEpoch = &exitEpoch
+	}
+
+	return status
+}
+
+// ApiValidatorDiff is the api representation of the validators that changed between two validator snapshots.
+type ApiValidatorDiff struct {
+	FromEpoch         uint64                   `json:"from_epoch"`
+	ToEpoch           uint64                   `json:"to_epoch"`
+	FromSnapshotEpoch uint64                   `json:"from_snapshot_epoch"`
+	ToSnapshotEpoch   uint64                   `json:"to_snapshot_epoch"`
+	BalanceThreshold  uint64                   `json:"balance_threshold"`
+	Validators        []*ApiValidatorDiffEntry `json:"validators"`
+}
+
+// ApiValidatorDiffEntry is the api representation of a changed validator.
+// changes lists the reasons the validator is included ("status", "balance", "credentials").
+type ApiValidatorDiffEntry struct {
+	Index                     uint64   `json:"index"`
+	Name                      string   `json:"name"`
+	Changes                   []string `json:"changes"`
+	FromStatus                string   `json:"from_status"`
+	ToStatus                  string   `json:"to_status"`
+	FromBalance               uint64   `json:"from_balance"`
+	ToBalance                 uint64   `json:"to_balance"`
+	BalanceDelta              int64    `json:"balance_delta"`
+	FromEffectiveBalance      uint64   `json:"from_effective_balance"`
+	ToEffectiveBalance        uint64   `json:"to_effective_balance"`
+	FromWithdrawalCredentials string   `json:"from_withdrawal_credentials"`
+	ToWithdrawalCredentials   string   `json:"to_withdrawal_credentials"`
+}
+
+// validatorDiffCursor is the position of the next page in the validator diff (validators are listed in ascending index order).
+type validatorDiffCursor struct {
+	Index uint64 `json:"i"`
+}
+
+// defaultValidatorDiffBalanceThreshold is the default min balance change (in gwei) for a validator to be included in the diff.
+const defaultValidatorDiffBalanceThreshold = 1000000000
+
+// GetValidatorsDiff returns the validators that changed status, balance beyond a threshold or withdrawal credentials
+// between two epochs. the diff is computed from the latest stored validator snapshots at or before the requested epochs
+// (see indexer.validatorSnapshotInterval). balances are in gwei, validators missing in the from snapshot have status "not_found".
+// query args: from_epoch, to_epoch, balance_threshold (default 1 ETH), limit, cursor
+func GetValidatorsDiff(r *http.Request) (*ApiResult, error) {
+	limit, err := parseLimit(r, 100, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	urlArgs := r.URL.Query()
+	fromEpoch, err := strconv.ParseUint(urlArgs.Get("from_epoch"), 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid from_epoch: %v", urlArgs.Get("from_epoch"))
+	}
+	toEpoch, err := strconv.ParseUint(urlArgs.Get("to_epoch"), 10, 64)
+	if err != nil {
+		return nil, ErrBadRequest("invalid to_epoch: %v", urlArgs.Get("to_epoch"))
+	}
+	if fromEpoch >= toEpoch {
+		return nil, ErrBadRequest("from_epoch must be lower than to_epoch")
+	}
+
+	balanceThreshold := uint64(defaultValidatorDiffBalanceThreshold)
+	if thresholdArg := urlArgs.Get("balance_threshold"); thresholdArg != "" {
+		balanceThreshold, err = strconv.ParseUint(thresholdArg, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest("invalid balance_threshold: %v", thresholdArg)
+		}
+	}
+
+	cursor := validatorDiffCursor{}
+	if _, err := decodeCursor(r, &cursor); err != nil {
+		return nil, err
+	}
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	fromSnapshot, err := beaconIndexer.GetValidatorSnapshot(phase0.Epoch(fromEpoch))
+	if err != nil {
+		return nil, err
+	}
+	if fromSnapshot == nil {
+		return nil, ErrNotFound("no validator snapshot available at or before epoch %v", fromEpoch)
+	}
+	toSnapshot, err := beaconIndexer.GetValidatorSnapshot(phase0.Epoch(toEpoch))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorDiff := &ApiValidatorDiff{
+		FromEpoch:         fromEpoch,
+		ToEpoch:           toEpoch,
+		FromSnapshotEpoch: uint64(fromSnapshot.Epoch),
+		ToSnapshotEpoch:   uint64(toSnapshot.Epoch),
+		BalanceThreshold:  balanceThreshold,
+		Validators:        []*ApiValidatorDiffEntry{},
+	}
+
+	paging := &ApiPaging{
+		Limit: limit,
+	}
+
+	for index := cursor.Index; index < uint64(len(toSnapshot.Validators)); index++ {
+		toEntry := &toSnapshot.Validators[index]
+		diffEntry := &ApiValidatorDiffEntry{
+			Index:                   index,
+			Changes:                 []string{},
+			FromStatus:              "not_found",
+			ToStatus:                toEntry.GetStatus(toSnapshot.Epoch).String(),
+			ToBalance:               uint64(toEntry.Balance),
+			ToEffectiveBalance:      uint64(toEntry.EffectiveBalance),
+			ToWithdrawalCredentials: "0x" + hex.EncodeToString(toEntry.WithdrawalCredentials[:]),
+		}
+
+		if index < uint64(len(fromSnapshot.Validators)) {
+			fromEntry := &fromSnapshot.Validators[index]
+			diffEntry.FromStatus = fromEntry.GetStatus(fromSnapshot.Epoch).String()
+			diffEntry.FromBalance = uint64(fromEntry.Balance)
+			diffEntry.FromEffectiveBalance = uint64(fromEntry.EffectiveBalance)
+			diffEntry.FromWithdrawalCredentials = "0x" + hex.EncodeToString(fromEntry.WithdrawalCredentials[:])
+			diffEntry.BalanceDelta = int64(toEntry.Balance) - int64(fromEntry.Balance)
+
+			if diffEntry.FromStatus != diffEntry.ToStatus {
+				diffEntry.Changes = append(diffEntry.Changes, "status")
+			}
+			if diffEntry.BalanceDelta > int64(balanceThreshold) || -diffEntry.BalanceDelta > int64(balanceThreshold) {
+				diffEntry.Changes = append(diffEntry.Changes, "balance")
+			}
+			if fromEntry.WithdrawalCredentials != toEntry.WithdrawalCredentials {
+				diffEntry.Changes = append(diffEntry.Changes, "credentials")
+			}
+		} else {
+			diffEntry.BalanceDelta = int64(toEntry.Balance)
+			diffEntry.Changes = append(diffEntry.Changes, "status")
+		}
+
+		if len(diffEntry.Changes) == 0 {
+			continue
+		}
+
+		if uint64(len(validatorDiff.Validators)) >= limit {
+			paging.NextCursor = encodeCursor(&validatorDiffCursor{
+				Index: index,
+			})
+			break
+		}
+
+		diffEntry.Name = services.GlobalBeaconService.GetValidatorName(index)
+		validatorDiff.Validators = append(validatorDiff.Validators, diffEntry)
+	}
+
+	return &ApiResult{
+		Data:   validatorDiff,
+		Paging: paging,
+	}, nil
+}
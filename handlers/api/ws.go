@@ -0,0 +1,296 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	wsWriteTimeout   = 10 * time.Second
+	wsPongTimeout    = 60 * time.Second
+	wsPingInterval   = 45 * time.Second
+	wsMaxMessageSize = 4096
+	wsEventBuffer    = 64
+	wsReplayBatch    = 100
+)
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 4096,
+	CheckOrigin:     checkWebSocketOrigin,
+}
+
+// wsSubscription is the subscription filter of a websocket client.
+// empty filter lists match all events.
+type wsSubscription struct {
+	Events    []string `json:"events"`
+	Proposers []uint64 `json:"proposers"`
+	Entities  []string `json:"entities"`
+}
+
+// wsClientMessage is a message sent by websocket clients to update their subscription.
+type wsClientMessage struct {
+	Action string `json:"action"`
+	wsSubscription
+}
+
+// wsServerMessage is a control message sent to websocket clients.
+type wsServerMessage struct {
+	Type         string          `json:"type"`
+	Subscription *wsSubscription `json:"subscription,omitempty"`
+	LastSeq      uint64          `json:"last_seq,omitempty"`
+}
+
+// WebSocket streams new canonical blocks, reorgs, finality updates and other explorer events to websocket clients.
+// The initial subscription filter is taken from the query parameters (events, proposer, entity)
+// and can be replaced by sending a {"action":"subscribe", ...} message.
+// With since_seq, persisted events after the given sequence number are replayed before streaming live events.
+func WebSocket(w http.ResponseWriter, r *http.Request) {
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1); err != nil {
+		writeError(w, r, ErrRateLimited())
+		return
+	}
+
+	subscription, err := parseWsSubscription(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	replaySeq := uint64(0)
+	sinceSeq := r.URL.Query().Get("since_seq")
+	if sinceSeq != "" {
+		replaySeq, err = strconv.ParseUint(sinceSeq, 10, 64)
+		if err != nil {
+			writeError(w, r, ErrBadRequest("invalid since_seq: %v", sinceSeq))
+			return
+		}
+	}
+
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already responded with an error
+		return
+	}
+	defer conn.Close()
+
+	eventHub := services.GlobalBeaconService.GetEventHub()
+	eventSubscription := eventHub.Subscribe(wsEventBuffer)
+	defer eventSubscription.Unsubscribe()
+
+	subscriptionChan := make(chan *wsSubscription, 1)
+	closeChan := make(chan struct{})
+	go readWsMessages(conn, subscriptionChan, closeChan)
+
+	if !writeWsMessage(conn, &wsServerMessage{Type: "subscribed", Subscription: subscription}) {
+		return
+	}
+
+	// replay persisted events, live events are buffered by the subscription in the meantime
+	if sinceSeq != "" {
+		for {
+			events := eventHub.GetPersistedEvents(replaySeq, nil, wsReplayBatch)
+			for _, event := range events {
+				replaySeq = event.Seq
+				if subscription.matches(event) && !writeWsMessage(conn, event) {
+					return
+				}
+			}
+			if len(events) < wsReplayBatch {
+				break
+			}
+		}
+
+		if !writeWsMessage(conn, &wsServerMessage{Type: "replayed", LastSeq: replaySeq}) {
+			return
+		}
+	}
+
+	pingTicker := time.NewTicker(wsPingInterval)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case <-closeChan:
+			return
+		case newSubscription := <-subscriptionChan:
+			subscription = newSubscription
+			if !writeWsMessage(conn, &wsServerMessage{Type: "subscribed", Subscription: subscription}) {
+				return
+			}
+		case event := <-eventSubscription.Channel():
+			if event.Seq != 0 && event.Seq <= replaySeq {
+				// already sent during replay
+				continue
+			}
+			if !subscription.matches(event) {
+				continue
+			}
+			if !writeWsMessage(conn, event) {
+				return
+			}
+		case <-pingTicker.C:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readWsMessages reads subscription updates from the client until the connection is closed.
+func readWsMessages(conn *websocket.Conn, subscriptionChan chan *wsSubscription, closeChan chan struct{}) {
+	defer close(closeChan)
+
+	conn.SetReadLimit(wsMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		message := wsClientMessage{}
+		if err := conn.ReadJSON(&message); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+				// ignore malformed client messages
+				continue
+			}
+			return
+		}
+
+		conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+
+		if message.Action != "subscribe" {
+			continue
+		}
+
+		subscription := message.wsSubscription
+		if err := subscription.validate(); err != nil {
+			continue
+		}
+
+		select {
+		case <-subscriptionChan:
+		default:
+		}
+		subscriptionChan <- &subscription
+	}
+}
+
+func writeWsMessage(conn *websocket.Conn, message interface{}) bool {
+	conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return conn.WriteJSON(message) == nil
+}
+
+// checkWebSocketOrigin allows non-browser clients, same-origin requests and origins allowed by the CORS config.
+func checkWebSocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if utils.Config.Api.CorsEnabled && isCorsOriginAllowed(origin, utils.Config.Api.CorsAllowedOrigins) {
+		return true
+	}
+
+	originUrl, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(originUrl.Host, r.Host)
+}
+
+func parseWsSubscription(query url.Values) (*wsSubscription, error) {
+	subscription := &wsSubscription{}
+
+	if events := query.Get("events"); events != "" {
+		subscription.Events = strings.Split(events, ",")
+	}
+
+	if proposers := query.Get("proposer"); proposers != "" {
+		for _, proposerStr := range strings.Split(proposers, ",") {
+			proposer, err := strconv.ParseUint(strings.TrimSpace(proposerStr), 10, 64)
+			if err != nil {
+				return nil, ErrBadRequest("invalid proposer index: %v", proposerStr)
+			}
+			subscription.Proposers = append(subscription.Proposers, proposer)
+		}
+	}
+
+	if entities := query.Get("entity"); entities != "" {
+		subscription.Entities = strings.Split(entities, ",")
+	}
+
+	if err := subscription.validate(); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (subscription *wsSubscription) validate() error {
+	for _, event := range subscription.Events {
+		switch event {
+		case services.EventTypeBlock, services.EventTypeReorg, services.EventTypeFinality,
+			services.EventTypeSlashing, services.EventTypeMissedProposal, services.EventTypeFinalityIncident,
+			services.EventTypeOrphanResolution:
+		default:
+			return ErrBadRequest("invalid event type: %v", event)
+		}
+	}
+	return nil
+}
+
+// matches checks if the event passes the subscription filter.
+// proposer and entity filters only apply to block events.
+func (subscription *wsSubscription) matches(event *services.ExplorerEvent) bool {
+	if subscription == nil {
+		return true
+	}
+
+	if len(subscription.Events) > 0 {
+		found := false
+		for _, eventType := range subscription.Events {
+			if eventType == event.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	blockData, isBlock := event.Data.(*services.EventBlockData)
+	if !isBlock || (len(subscription.Proposers) == 0 && len(subscription.Entities) == 0) {
+		return true
+	}
+
+	for _, proposer := range subscription.Proposers {
+		if proposer == blockData.Proposer {
+			return true
+		}
+	}
+
+	proposerName := strings.ToLower(blockData.ProposerName)
+	for _, entity := range subscription.Entities {
+		entity = strings.ToLower(strings.TrimSpace(entity))
+		if entity != "" && (proposerName == entity || strings.HasPrefix(proposerName, entity+"-") || strings.HasPrefix(proposerName, entity+" ")) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of awaiting deposits shown on the page
+const awaitingDepositsLimit = 100
+
+// AwaitingDeposits will return the "awaiting_deposits" page using a go template
+func AwaitingDeposits(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"awaiting_deposits/awaiting_deposits.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/awaiting_deposits", "Deposits Awaiting Inclusion", templateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getAwaitingDepositsPageData()
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "awaiting_deposits.go", "AwaitingDeposits", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getAwaitingDepositsPageData() (*models.AwaitingDepositsPageData, error) {
+	pageData := &models.AwaitingDepositsPageData{}
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage("awaiting_deposits", true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildAwaitingDepositsPageData()
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.AwaitingDepositsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildAwaitingDepositsPageData() (*models.AwaitingDepositsPageData, time.Duration) {
+	logrus.Debugf("awaiting deposits page called")
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	currentEpoch := chainState.CurrentEpoch()
+
+	pageData := &models.AwaitingDepositsPageData{
+		ElIndexerEnabled:    utils.Config.ExecutionApi.Endpoint != "" || len(utils.Config.ExecutionApi.Endpoints) > 0,
+		Eth1BridgeActive:    !specs.IsElectraActive(currentEpoch),
+		FollowDistance:      specs.Eth1FollowDistance,
+		FollowDistanceTime:  time.Duration(specs.Eth1FollowDistance) * specs.SecondsPerEth1Block,
+		VotingPeriodEpochs:  specs.EpochsPerEth1VotingPeriod,
+		MaxDepositsPerBlock: specs.MaxDeposits,
+		Deposits:            []*models.AwaitingDepositsPageDataDeposit{},
+	}
+	if !pageData.ElIndexerEnabled {
+		return pageData, 10 * time.Minute
+	}
+
+	pageData.NextDepositIndex = getNextDepositIndex(currentEpoch)
+
+	depositSyncState := dbtypes.DepositIndexerState{}
+	db.GetExplorerState("indexer.depositstate", &depositSyncState)
+
+	depositFilter := &dbtypes.DepositTxFilter{
+		MinIndex:     pageData.NextDepositIndex,
+		WithOrphaned: 0,
+		WithValid:    1,
+	}
+	dbDepositTxs, totalRows, err := db.GetDepositTxsFiltered(0, awaitingDepositsLimit, depositSyncState.FinalBlock, depositFilter)
+	if err != nil {
+		logrus.Warnf("error loading awaiting deposits: %v", err)
+		return pageData, 10 * time.Second
+	}
+	pageData.DepositCount = totalRows
+
+	for _, depositTx := range dbDepositTxs {
+		if depositTx.Index < pageData.NextDepositIndex || depositTx.Orphaned {
+			continue
+		}
+
+		depositData := &models.AwaitingDepositsPageDataDeposit{
+			Index:                 depositTx.Index,
+			Address:               depositTx.TxSender,
+			PublicKey:             depositTx.PublicKey,
+			Withdrawalcredentials: depositTx.WithdrawalCredentials,
+			Amount:                depositTx.Amount,
+			TxHash:                depositTx.TxHash,
+			Time:                  time.Unix(int64(depositTx.BlockTime), 0),
+			Block:                 depositTx.BlockNumber,
+			Valid:                 depositTx.ValidSignature,
+			QueuePosition:         depositTx.Index - pageData.NextDepositIndex,
+		}
+		pageData.TotalAmount += depositTx.Amount
+
+		if estimatedSlot, votableTime, ok := estimateDepositInclusionSlot(chainState, depositData.Time, depositData.QueuePosition, pageData.Eth1BridgeActive); ok {
+			depositData.HasEstimation = true
+			depositData.VotableTime = votableTime
+			depositData.EstimatedEpoch = uint64(chainState.EpochOfSlot(estimatedSlot))
+			depositData.EstimatedTime = chainState.SlotToTime(estimatedSlot)
+			depositData.Overdue = depositData.EstimatedEpoch < uint64(currentEpoch)
+		}
+
+		pageData.Deposits = append(pageData.Deposits, depositData)
+	}
+
+	slices.SortFunc(pageData.Deposits, func(a, b *models.AwaitingDepositsPageDataDeposit) int {
+		return int(a.Index) - int(b.Index)
+	})
+	pageData.ShownDepositCount = uint64(len(pageData.Deposits))
+
+	return pageData, 1 * time.Minute
+}
+
+// getNextDepositIndex returns the index of the next deposit to be included in a beacon block.
+// the deposit index of the current epoch state is combined with the deposits included in the blocks since then.
+func getNextDepositIndex(currentEpoch phase0.Epoch) uint64 {
+	nextDepositIndex := uint64(0)
+
+	if beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer(); beaconIndexer != nil {
+		stateEpochs := []phase0.Epoch{currentEpoch}
+		if currentEpoch > 0 {
+			stateEpochs = append(stateEpochs, currentEpoch-1)
+		}
+		for _, epoch := range stateEpochs {
+			if epochStats := beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
+				if epochStatsValues := epochStats.GetValues(false); epochStatsValues != nil {
+					nextDepositIndex = epochStatsValues.FirstDepositIndex
+					break
+				}
+			}
+		}
+	}
+
+	includedDeposits, _ := services.GlobalBeaconService.GetIncludedDepositsByFilter(&dbtypes.DepositFilter{WithOrphaned: 0}, 0, 10)
+	for _, deposit := range includedDeposits {
+		if deposit.Index != nil && *deposit.Index+1 > nextDepositIndex {
+			nextDepositIndex = *deposit.Index + 1
+		}
+	}
+
+	return nextDepositIndex
+}
+
+// estimateDepositInclusionSlot estimates the slot a deposit gets included in the beacon chain.
+// with the eth1 bridge, the deposit block needs to be `ETH1_FOLLOW_DISTANCE` blocks old at the start of an eth1 voting period,
+// the votes for it reach the majority halfway through the period and the deposit queue is processed with `MAX_DEPOSITS` per block.
+// after electra, deposits are included with the execution payload of the block right away.
+func estimateDepositInclusionSlot(chainState *consensus.ChainState, depositTime time.Time, queuePosition uint64, eth1Bridge bool) (phase0.Slot, time.Time, bool) {
+	specs := chainState.GetSpecs()
+
+	if !eth1Bridge {
+		return chainState.TimeToSlot(depositTime), depositTime, true
+	}
+	if specs.EpochsPerEth1VotingPeriod == 0 || specs.MaxDeposits == 0 {
+		return 0, time.Time{}, false
+	}
+
+	votableTime := depositTime.Add(time.Duration(specs.Eth1FollowDistance) * specs.SecondsPerEth1Block)
+	votableSlot := uint64(chainState.TimeToSlot(votableTime))
+
+	periodSlots := specs.EpochsPerEth1VotingPeriod * specs.SlotsPerEpoch
+	periodStart := ((votableSlot + periodSlots - 1) / periodSlots) * periodSlots
+	estimatedSlot := periodStart + periodSlots/2 + queuePosition/specs.MaxDeposits
+
+	return phase0.Slot(estimatedSlot), votableTime, true
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// max number of groups listed on the blob inclusion page, the api returns all groups
+const blobInclusionMaxGroups = 100
+
+// BlobInclusion will return the per proposer blob inclusion report page using a go template
+func BlobInclusion(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"blob_inclusion/blob_inclusion.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/blob_inclusion", "Blob Inclusion", pageTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	statsRange := urlArgs.Get("range")
+	if _, isValid := chartRanges[statsRange]; !isValid || statsRange == "all" {
+		statsRange = "7d"
+	}
+	statsGroup := urlArgs.Get("group")
+	if statsGroup != "validator" {
+		statsGroup = "entity"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getBlobInclusionPageData(statsRange, statsGroup)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "blob_inclusion.go", "BlobInclusion", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getBlobInclusionPageData(statsRange string, statsGroup string) (*models.BlobInclusionPageData, error) {
+	pageData := &models.BlobInclusionPageData{}
+	pageCacheKey := fmt.Sprintf("blob_inclusion:%v:%v", statsRange, statsGroup)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildBlobInclusionPageData(statsRange, statsGroup)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BlobInclusionPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildBlobInclusionPageData(statsRange string, statsGroup string) *models.BlobInclusionPageData {
+	logrus.Debugf("blob inclusion page called: %v, %v", statsRange, statsGroup)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.BlobInclusionPageData{
+		Range: statsRange,
+		Group: statsGroup,
+	}
+
+	firstEpoch, lastEpoch := getChartRangeEpochs(statsRange)
+	pageData.FirstSlot = uint64(chainState.EpochToSlot(phase0.Epoch(firstEpoch)))
+	pageData.LastSlot = uint64(chainState.EpochToSlot(phase0.Epoch(lastEpoch+1))) - 1
+	if specs == nil || specs.DenebForkEpoch == nil || *specs.DenebForkEpoch > lastEpoch {
+		pageData.IsPreDeneb = true
+		return pageData
+	}
+
+	groupStats := services.GlobalBeaconService.GetBlobInclusionStats(phase0.Slot(pageData.FirstSlot), phase0.Slot(pageData.LastSlot), statsGroup == "entity")
+	for _, stats := range groupStats {
+		pageData.TotalBlocks += stats.Blocks
+		pageData.TotalBlobBlocks += stats.BlobBlocks
+		pageData.TotalBlobs += stats.Blobs
+		if stats.IsExcludingBlobs() {
+			pageData.ExcludingCount++
+		}
+
+		if len(pageData.Groups) >= blobInclusionMaxGroups {
+			continue
+		}
+
+		group := &models.BlobInclusionPageDataGroup{
+			Name:            stats.Group,
+			Validators:      stats.Validators,
+			Blocks:          stats.Blocks,
+			BlobBlocks:      stats.BlobBlocks,
+			Blobs:           stats.Blobs,
+			MaxBlobs:        stats.MaxBlobs,
+			BlobsPerBlock:   stats.BlobsPerBlock(),
+			EmptyBlockShare: stats.EmptyBlockShare(),
+			ExcludingBlobs:  stats.IsExcludingBlobs(),
+		}
+
+		// validators without name are grouped by index, link them to the validator page
+		if validatorIndex, err := strconv.ParseUint(stats.Group, 10, 64); err == nil {
+			group.IsValidator = true
+			group.ValidatorIndex = validatorIndex
+			group.Name = services.GlobalBeaconService.GetValidatorName(validatorIndex)
+		}
+
+		pageData.Groups = append(pageData.Groups, group)
+	}
+	pageData.GroupCount = uint64(len(groupStats))
+
+	if pageData.TotalBlocks > 0 {
+		pageData.BlobsPerBlock = float64(pageData.TotalBlobs) / float64(pageData.TotalBlocks)
+		pageData.EmptyBlockShare = float64(pageData.TotalBlocks-pageData.TotalBlobBlocks) * 100 / float64(pageData.TotalBlocks)
+	}
+
+	return pageData
+}
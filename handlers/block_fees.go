@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of buckets shown in the chart, larger ranges are aggregated
+const blockFeesMaxPoints = 500
+
+// BlockFees will return the block fees chart page using a go template
+func BlockFees(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"block_fees/block_fees.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "blockchain", "/slots/fees", "Block Fees", pageTemplateFiles)
+
+	chartRange := r.URL.Query().Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "7d"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getBlockFeesPageData(chartRange)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "block_fees.go", "BlockFees", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getBlockFeesPageData(chartRange string) (*models.BlockFeesPageData, error) {
+	pageData := &models.BlockFeesPageData{}
+	pageCacheKey := fmt.Sprintf("block_fees:%v", chartRange)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildBlockFeesPageData(chartRange)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BlockFeesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildBlockFeesPageData(chartRange string) (*models.BlockFeesPageData, time.Duration) {
+	logrus.Debugf("block fees page called: %v", chartRange)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.BlockFeesPageData{
+		Range: chartRange,
+	}
+	firstEpoch, lastEpoch := getChartRangeEpochs(chartRange)
+	pageData.FirstSlot = uint64(chainState.EpochStartSlot(phase0.Epoch(firstEpoch)))
+	pageData.LastSlot = uint64(chainState.EpochStartSlot(phase0.Epoch(lastEpoch+1))) - 1
+	pageData.BucketSize = (pageData.LastSlot-pageData.FirstSlot)/blockFeesMaxPoints + 1
+
+	dbStats := db.GetSlotFeeStats(pageData.FirstSlot, pageData.LastSlot, pageData.BucketSize)
+	pageData.Buckets = make([]*models.BlockFeesPageDataBucket, 0, len(dbStats))
+
+	bucketSlots := make([]uint64, 0, len(dbStats))
+	avgBurnedFees := make([]float64, 0, len(dbStats))
+	avgPriorityFees := make([]float64, 0, len(dbStats))
+	for _, dbBucket := range dbStats {
+		if dbBucket.BlockCount == 0 {
+			continue
+		}
+
+		bucket := &models.BlockFeesPageDataBucket{
+			FirstSlot:          dbBucket.FirstSlot,
+			LastSlot:           dbBucket.LastSlot,
+			Time:               chainState.SlotToTime(phase0.Slot(dbBucket.FirstSlot)),
+			BlockCount:         dbBucket.BlockCount,
+			AvgGasUsed:         dbBucket.GasUsedSum / dbBucket.BlockCount,
+			BurnedFees:         dbBucket.BurnedFeesSum,
+			PriorityBlockCount: dbBucket.PriorityBlockCount,
+			PriorityFees:       dbBucket.PriorityFeesSum,
+		}
+		pageData.Buckets = append(pageData.Buckets, bucket)
+
+		pageData.BlockCount += bucket.BlockCount
+		pageData.BurnedFees += bucket.BurnedFees
+		pageData.PriorityBlockCount += bucket.PriorityBlockCount
+		pageData.PriorityFees += bucket.PriorityFees
+
+		// priority fees are only averaged over the blocks they have been loaded for
+		avgPriorityFee := float64(0)
+		if bucket.PriorityBlockCount > 0 {
+			avgPriorityFee = float64(bucket.PriorityFees) / float64(bucket.PriorityBlockCount)
+		}
+
+		bucketSlots = append(bucketSlots, bucket.FirstSlot)
+		avgBurnedFees = append(avgBurnedFees, float64(bucket.BurnedFees)/float64(bucket.BlockCount))
+		avgPriorityFees = append(avgPriorityFees, avgPriorityFee)
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	if pageData.BlockCount > 0 {
+		pageData.AvgBurnedFees = pageData.BurnedFees / pageData.BlockCount
+	}
+	if pageData.PriorityBlockCount > 0 {
+		pageData.AvgPriorityFees = pageData.PriorityFees / pageData.PriorityBlockCount
+	}
+
+	formatFee := func(value float64) string {
+		return utils.FormatETHFromGwei(uint64(value))
+	}
+	pageData.Chart = buildLineChart(bucketSlots, func(slot uint64) string {
+		return fmt.Sprintf("Slot %v", utils.FormatFloat(float64(slot), 0))
+	}, &chartSeries{
+		name:   "Burned fees",
+		color:  "#dc3545",
+		values: avgBurnedFees,
+		format: formatFee,
+	}, &chartSeries{
+		name:   "Priority fees",
+		color:  "#198754",
+		values: avgPriorityFees,
+		format: formatFee,
+	})
+
+	return pageData, 10 * time.Minute
+}
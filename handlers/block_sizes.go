@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of buckets shown in the chart, larger ranges are aggregated
+const blockSizesMaxPoints = 500
+
+// BlockSizes will return the block size chart page using a go template
+func BlockSizes(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"block_sizes/block_sizes.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "blockchain", "/slots/sizes", "Block Sizes", pageTemplateFiles)
+
+	chartRange := r.URL.Query().Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "7d"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getBlockSizesPageData(chartRange)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "block_sizes.go", "BlockSizes", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getBlockSizesPageData(chartRange string) (*models.BlockSizesPageData, error) {
+	pageData := &models.BlockSizesPageData{}
+	pageCacheKey := fmt.Sprintf("block_sizes:%v", chartRange)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildBlockSizesPageData(chartRange)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BlockSizesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildBlockSizesPageData(chartRange string) (*models.BlockSizesPageData, time.Duration) {
+	logrus.Debugf("block sizes page called: %v", chartRange)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.BlockSizesPageData{
+		Range: chartRange,
+	}
+	firstEpoch, lastEpoch := getChartRangeEpochs(chartRange)
+	pageData.FirstSlot = uint64(chainState.EpochStartSlot(phase0.Epoch(firstEpoch)))
+	pageData.LastSlot = uint64(chainState.EpochStartSlot(phase0.Epoch(lastEpoch+1))) - 1
+	pageData.BucketSize = (pageData.LastSlot-pageData.FirstSlot)/blockSizesMaxPoints + 1
+
+	dbStats := db.GetSlotSizeStats(pageData.FirstSlot, pageData.LastSlot, pageData.BucketSize)
+	pageData.Buckets = make([]*models.BlockSizesPageDataBucket, 0, len(dbStats))
+
+	var blockSizeSum, attestationsSizeSum, payloadSizeSum, blobRefsSizeSum uint64
+	bucketSlots := make([]uint64, 0, len(dbStats))
+	avgBlockSizes := make([]float64, 0, len(dbStats))
+	avgAttestationsSizes := make([]float64, 0, len(dbStats))
+	avgPayloadSizes := make([]float64, 0, len(dbStats))
+	avgBlobRefsSizes := make([]float64, 0, len(dbStats))
+	for _, dbBucket := range dbStats {
+		if dbBucket.BlockCount == 0 {
+			continue
+		}
+
+		bucket := &models.BlockSizesPageDataBucket{
+			FirstSlot:           dbBucket.FirstSlot,
+			LastSlot:            dbBucket.LastSlot,
+			Time:                chainState.SlotToTime(phase0.Slot(dbBucket.FirstSlot)),
+			BlockCount:          dbBucket.BlockCount,
+			AvgBlockSize:        dbBucket.BlockSizeSum / dbBucket.BlockCount,
+			MaxBlockSize:        dbBucket.BlockSizeMax,
+			AvgAttestationsSize: dbBucket.AttestationsSizeSum / dbBucket.BlockCount,
+			AvgPayloadSize:      dbBucket.PayloadSizeSum / dbBucket.BlockCount,
+			AvgBlobRefsSize:     dbBucket.BlobRefsSizeSum / dbBucket.BlockCount,
+		}
+		pageData.Buckets = append(pageData.Buckets, bucket)
+
+		pageData.BlockCount += bucket.BlockCount
+		if bucket.MaxBlockSize > pageData.MaxBlockSize {
+			pageData.MaxBlockSize = bucket.MaxBlockSize
+		}
+		blockSizeSum += dbBucket.BlockSizeSum
+		attestationsSizeSum += dbBucket.AttestationsSizeSum
+		payloadSizeSum += dbBucket.PayloadSizeSum
+		blobRefsSizeSum += dbBucket.BlobRefsSizeSum
+
+		bucketSlots = append(bucketSlots, bucket.FirstSlot)
+		avgBlockSizes = append(avgBlockSizes, float64(bucket.AvgBlockSize))
+		avgAttestationsSizes = append(avgAttestationsSizes, float64(bucket.AvgAttestationsSize))
+		avgPayloadSizes = append(avgPayloadSizes, float64(bucket.AvgPayloadSize))
+		avgBlobRefsSizes = append(avgBlobRefsSizes, float64(bucket.AvgBlobRefsSize))
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	if pageData.BlockCount > 0 {
+		pageData.AvgBlockSize = blockSizeSum / pageData.BlockCount
+		pageData.AvgAttestationsSize = attestationsSizeSum / pageData.BlockCount
+		pageData.AvgPayloadSize = payloadSizeSum / pageData.BlockCount
+		pageData.AvgBlobRefsSize = blobRefsSizeSum / pageData.BlockCount
+	}
+
+	formatSize := func(value float64) string {
+		return utils.FormatByteAmount(uint64(value))
+	}
+	pageData.Chart = buildLineChart(bucketSlots, func(slot uint64) string {
+		return fmt.Sprintf("Slot %v", utils.FormatFloat(float64(slot), 0))
+	}, &chartSeries{
+		name:   "Block",
+		color:  "#0d6efd",
+		values: avgBlockSizes,
+		format: formatSize,
+	}, &chartSeries{
+		name:   "Execution payload",
+		color:  "#fd7e14",
+		values: avgPayloadSizes,
+		format: formatSize,
+	}, &chartSeries{
+		name:   "Attestations",
+		color:  "#198754",
+		values: avgAttestationsSizes,
+		format: formatSize,
+	}, &chartSeries{
+		name:   "Blob references",
+		color:  "#6f42c1",
+		values: avgBlobRefsSizes,
+		format: formatSize,
+	})
+
+	return pageData, 10 * time.Minute
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+const (
+	chartWidth      = 1000
+	chartHeight     = 320
+	chartPlotLeft   = 80
+	chartPlotRight  = 920
+	chartPlotTop    = 15
+	chartPlotBottom = 285
+	chartTickCount  = 5
+)
+
+// chartRanges are the selectable time ranges of the chart pages ("all" covers the whole chain)
+var chartRanges = map[string]time.Duration{
+	"1d":  24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+	"90d": 90 * 24 * time.Hour,
+	"all": 0,
+}
+
+// getChartRangeEpochs returns the first and last epoch covered by the given chart range.
+func getChartRangeEpochs(chartRange string) (uint64, uint64) {
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	lastEpoch := uint64(chainState.CurrentEpoch())
+
+	rangeDuration := chartRanges[chartRange]
+	if rangeDuration == 0 {
+		return 0, lastEpoch
+	}
+
+	epochDuration := specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+	rangeEpochs := uint64(rangeDuration / epochDuration)
+	if rangeEpochs >= lastEpoch {
+		return 0, lastEpoch
+	}
+
+	return lastEpoch - rangeEpochs, lastEpoch
+}
+
+// chartSeries holds the values of a line chart series, one value per x value.
+type chartSeries struct {
+	name      string
+	color     string
+	values    []float64
+	rightAxis bool
+	format    func(value float64) string
+}
+
+// buildLineChart builds a server side rendered line chart with up to two y axis (left & right).
+// the x values need to be sorted ascending, each series needs to provide one value per x value.
+func buildLineChart(xValues []uint64, xFormat func(value uint64) string, series ...*chartSeries) *models.ChartData {
+	chart := &models.ChartData{
+		Width:      chartWidth,
+		Height:     chartHeight,
+		PlotLeft:   chartPlotLeft,
+		PlotRight:  chartPlotRight,
+		PlotTop:    chartPlotTop,
+		PlotBottom: chartPlotBottom,
+		Series:     []*models.ChartSeries{},
+		XTicks:     []*models.ChartTick{},
+		YTicks:     []*models.ChartTick{},
+		Y2Ticks:    []*models.ChartTick{},
+	}
+	if len(xValues) == 0 {
+		return chart
+	}
+
+	minX := float64(xValues[0])
+	maxX := float64(xValues[len(xValues)-1])
+	if maxX == minX {
+		maxX = minX + 1
+	}
+	xPos := func(value uint64) float64 {
+		return chartPlotLeft + (float64(value)-minX)/(maxX-minX)*(chartPlotRight-chartPlotLeft)
+	}
+
+	for i := 0; i <= chartTickCount; i++ {
+		value := uint64(minX + (maxX-minX)*float64(i)/chartTickCount)
+		chart.XTicks = append(chart.XTicks, &models.ChartTick{
+			Pos:   xPos(value),
+			Label: xFormat(value),
+		})
+	}
+
+	for _, axisRight := range []bool{false, true} {
+		minY := math.Inf(1)
+		maxY := math.Inf(-1)
+		var axisFormat func(value float64) string
+		for _, s := range series {
+			if s.rightAxis != axisRight {
+				continue
+			}
+			if axisFormat == nil {
+				axisFormat = s.format
+			}
+			for _, value := range s.values {
+				minY = math.Min(minY, value)
+				maxY = math.Max(maxY, value)
+			}
+		}
+		if axisFormat == nil || math.IsInf(minY, 1) {
+			continue
+		}
+
+		// add some padding, so the lines do not stick to the plot border
+		padding := (maxY - minY) * 0.05
+		if padding == 0 {
+			padding = math.Max(math.Abs(maxY)*0.05, 1)
+		}
+		if minY >= 0 {
+			minY = math.Max(minY-padding, 0)
+		} else {
+			minY -= padding
+		}
+		maxY += padding
+
+		yPos := func(value float64) float64 {
+			return chartPlotBottom - (value-minY)/(maxY-minY)*(chartPlotBottom-chartPlotTop)
+		}
+
+		ticks := make([]*models.ChartTick, 0, chartTickCount+1)
+		for i := 0; i <= chartTickCount; i++ {
+			value := minY + (maxY-minY)*float64(i)/chartTickCount
+			ticks = append(ticks, &models.ChartTick{
+				Pos:   yPos(value),
+				Label: axisFormat(value),
+			})
+		}
+		if axisRight {
+			chart.Y2Ticks = ticks
+		} else {
+			chart.YTicks = ticks
+		}
+
+		for _, s := range series {
+			if s.rightAxis != axisRight {
+				continue
+			}
+
+			points := make([]string, 0, len(s.values))
+			for i, value := range s.values {
+				points = append(points, fmt.Sprintf("%.1f,%.1f", xPos(xValues[i]), yPos(value)))
+			}
+
+			chartSeries := &models.ChartSeries{
+				Name:      s.name,
+				Color:     s.color,
+				Points:    strings.Join(points, " "),
+				RightAxis: s.rightAxis,
+			}
+			if len(s.values) > 0 {
+				chartSeries.LastValue = s.format(s.values[len(s.values)-1])
+			}
+			chart.Series = append(chart.Series, chartSeries)
+		}
+	}
+
+	return chart
+}
@@ -36,7 +36,7 @@ func ClientsCL(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "clients_cl.go", "Consensus clients", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "clients_cl.go", "Consensus clients", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -343,6 +343,26 @@ func buildCLClientsPageData() (*models.ClientsCLPageData, time.Duration) {
 			resClient.LastError = lastError.Error()
 		}
 
+		rpcStats := client.GetRPCClient().GetRPCStats()
+		resClient.RpcRequests = rpcStats.Requests
+		resClient.RpcErrors = rpcStats.Errors
+		if rpcStats.Requests > 0 {
+			resClient.RpcAvgLatency = uint64(rpcStats.TotalDuration.Milliseconds()) / rpcStats.Requests
+		}
+		for _, endpointStats := range rpcStats.Endpoints {
+			avgLatency := uint64(endpointStats.TotalDuration.Milliseconds()) / endpointStats.Requests
+			if resClient.RpcSlowestEndpoint == "" || avgLatency > resClient.RpcSlowestLatency {
+				resClient.RpcSlowestEndpoint = endpointStats.Endpoint
+				resClient.RpcSlowestLatency = avgLatency
+			}
+		}
+		if rpcStats.LastError != nil {
+			resClient.RpcLastError = rpcStats.LastError.Error()
+			resClient.RpcLastErrorCall = rpcStats.LastErrorCall
+			resClient.RpcLastErrorTime = rpcStats.LastErrorTime
+		}
+		resClient.RpcApiFallbacks = client.GetRPCClient().GetAPIFallbacks()
+
 		pageData.Clients = append(pageData.Clients, resClient)
 
 	}
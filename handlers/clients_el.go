@@ -34,7 +34,7 @@ func ClientsEl(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "clients_el.go", "Execution clients", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "clients_el.go", "Execution clients", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
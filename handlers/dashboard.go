@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// Dashboard will return the validator dashboard page using a go template.
+// the validators are passed via the "validators" url argument (indices, index ranges & pubkeys) and the page
+// links a signed share token that renders the same dashboard read-only via /dashboard/{token}.
+func Dashboard(w http.ResponseWriter, r *http.Request) {
+	validatorsArg := strings.TrimSpace(r.URL.Query().Get("validators"))
+	pageData := &models.DashboardPageData{
+		ValidatorsArg: validatorsArg,
+		MaxValidators: services.GetMaxValidatorsBatchSize(),
+	}
+
+	if validatorsArg != "" {
+		indices, err := services.ParseValidatorList(validatorsArg, pageData.MaxValidators)
+		if err != nil {
+			pageData.Error = err.Error()
+		} else if len(indices) > 0 {
+			pageData.ShareToken = utils.EncodeValidatorShareToken(indices)
+		}
+	}
+
+	handleDashboardPage(w, r, pageData)
+}
+
+// SharedDashboard will return the read-only validator dashboard for a share token using a go template
+func SharedDashboard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	pageData := &models.DashboardPageData{
+		ShareMode:     true,
+		MaxValidators: services.GetMaxValidatorsBatchSize(),
+	}
+
+	if _, err := utils.DecodeValidatorShareToken(vars["token"], pageData.MaxValidators); err != nil {
+		pageData.Error = fmt.Sprintf("This dashboard link is not valid: %v", err)
+	} else {
+		pageData.ShareToken = vars["token"]
+	}
+
+	handleDashboardPage(w, r, pageData)
+}
+
+func handleDashboardPage(w http.ResponseWriter, r *http.Request, pageData *models.DashboardPageData) {
+	var templateFiles = append(layoutTemplateFiles,
+		"dashboard/dashboard.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/dashboard", "Validator Dashboard", templateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil && pageData.ShareToken != "" {
+		pageData, pageError = getDashboardPageData(pageData)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	data.Data = pageData
+
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "dashboard.go", "Dashboard", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+// getDashboardPageData loads the validator data of the share token from the page cache.
+// the cached page data is shared between requests, so the request specific fields are applied to a copy.
+func getDashboardPageData(request *models.DashboardPageData) (*models.DashboardPageData, error) {
+	pageData := &models.DashboardPageData{}
+	pageCacheKey := fmt.Sprintf("dashboard:%v", request.ShareToken)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildDashboardPageData(request.ShareToken, request.MaxValidators)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.DashboardPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		resCopy := *resData
+		pageData = &resCopy
+	}
+	pageData.ShareMode = request.ShareMode
+	pageData.ValidatorsArg = request.ValidatorsArg
+	return pageData, pageErr
+}
+
+func buildDashboardPageData(shareToken string, maxValidators uint64) *models.DashboardPageData {
+	logrus.Debugf("dashboard page called: %v", shareToken)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.DashboardPageData{
+		ShareToken:    shareToken,
+		MaxValidators: maxValidators,
+	}
+
+	indices, err := utils.DecodeValidatorShareToken(shareToken, maxValidators)
+	if err != nil {
+		pageData.Error = err.Error()
+		return pageData
+	}
+
+	pageData.Validators = make([]*models.DashboardPageDataValidator, 0, len(indices))
+	for _, index := range indices {
+		validatorData := &models.DashboardPageDataValidator{
+			Index: index,
+			Name:  services.GlobalBeaconService.GetValidatorName(index),
+		}
+		pageData.Validators = append(pageData.Validators, validatorData)
+		pageData.ValidatorCount++
+
+		validator := services.GlobalBeaconService.GetValidatorByIndex(phase0.ValidatorIndex(index), true)
+		if validator == nil {
+			validatorData.State = "Unknown"
+			continue
+		}
+
+		validatorData.Found = true
+		validatorData.Balance = uint64(validator.Balance)
+		validatorData.EffectiveBalance = uint64(validator.Validator.EffectiveBalance)
+		pageData.TotalBalance += validatorData.Balance
+		pageData.TotalEffectiveBalance += validatorData.EffectiveBalance
+
+		if strings.HasPrefix(validator.Status.String(), "pending") {
+			validatorData.State = "Pending"
+			pageData.PendingCount++
+		} else if validator.Status == v1.ValidatorStateActiveOngoing {
+			validatorData.State = "Active"
+			validatorData.ShowUpcheck = true
+			pageData.ActiveCount++
+		} else if validator.Status == v1.ValidatorStateActiveExiting {
+			validatorData.State = "Exiting"
+			validatorData.ShowUpcheck = true
+			pageData.ActiveCount++
+		} else if validator.Status == v1.ValidatorStateActiveSlashed {
+			validatorData.State = "Slashed"
+			validatorData.ShowUpcheck = true
+			pageData.ActiveCount++
+			pageData.SlashedCount++
+		} else if validator.Status == v1.ValidatorStateExitedUnslashed {
+			validatorData.State = "Exited"
+			pageData.ExitedCount++
+		} else if validator.Status == v1.ValidatorStateExitedSlashed {
+			validatorData.State = "Slashed"
+			pageData.ExitedCount++
+			pageData.SlashedCount++
+		} else {
+			validatorData.State = validator.Status.String()
+			pageData.ExitedCount++
+		}
+
+		if validatorData.ShowUpcheck {
+			validatorData.UpcheckActivity = uint8(services.GlobalBeaconService.GetValidatorLiveness(validator.Index, 3))
+			validatorData.UpcheckMaximum = uint8(3)
+			if validatorData.UpcheckActivity > 0 {
+				pageData.OnlineCount++
+			}
+		}
+
+		if validator.Validator.ActivationEpoch < beacon.FarFutureEpoch {
+			validatorData.ShowActivation = true
+			validatorData.ActivationEpoch = uint64(validator.Validator.ActivationEpoch)
+			validatorData.ActivationTs = chainState.EpochToTime(validator.Validator.ActivationEpoch)
+		}
+		if validator.Validator.ExitEpoch < beacon.FarFutureEpoch {
+			validatorData.ShowExit = true
+			validatorData.ExitEpoch = uint64(validator.Validator.ExitEpoch)
+			validatorData.ExitTs = chainState.EpochToTime(validator.Validator.ExitEpoch)
+		}
+	}
+
+	return pageData
+}
@@ -28,7 +28,7 @@ func DebugCache(w http.ResponseWriter, r *http.Request) {
 	data := InitPageData(w, r, "blockchain", "/debug_cache", "Debug Cache", debugCacheTemplateFiles)
 	data.Data = pageData
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "debug_cache.go", "Debug Cache", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "debug_cache.go", "Debug Cache", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DebugDatabase will return the database table sizes & row counts page
+func DebugDatabase(w http.ResponseWriter, r *http.Request) {
+	var debugDatabaseTemplateFiles = append(layoutTemplateFiles,
+		"debug_database/debug_database.html",
+	)
+	var pageTemplate = templates.GetTemplate(debugDatabaseTemplateFiles...)
+
+	if !utils.Config.Frontend.Pprof {
+		handlePageError(w, r, errors.New("debug pages are not enabled"))
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/debug/database", "Debug Database", debugDatabaseTemplateFiles)
+	data.Data = buildDebugDatabasePageData()
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "debug_database.go", "Debug Database", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildDebugDatabasePageData() *models.DebugDatabasePageData {
+	pageData := &models.DebugDatabasePageData{
+		Tables: []*models.DebugDatabasePageTableData{},
+	}
+
+	tableStats, lastUpdate, collectTime, err := services.GlobalDbStatsService.GetTableStats()
+	if err != nil {
+		pageData.Error = err.Error()
+	}
+	pageData.LastUpdate = lastUpdate
+	pageData.CollectTime = uint64(collectTime.Milliseconds())
+
+	for _, stats := range tableStats {
+		pageData.Tables = append(pageData.Tables, &models.DebugDatabasePageTableData{
+			Name:      stats.Table,
+			RowCount:  stats.RowCount,
+			TableSize: stats.TableSize,
+			IndexSize: stats.IndexSize,
+			TotalSize: stats.TableSize + stats.IndexSize,
+		})
+		pageData.TotalRows += stats.RowCount
+		pageData.TotalTableSize += stats.TableSize
+		pageData.TotalIndexSize += stats.IndexSize
+	}
+
+	sort.Slice(pageData.Tables, func(a, b int) bool {
+		return pageData.Tables[a].TotalSize > pageData.Tables[b].TotalSize
+	})
+
+	return pageData
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DebugIntegrity will return the data integrity report page (proposer duty verification results)
+func DebugIntegrity(w http.ResponseWriter, r *http.Request) {
+	var debugIntegrityTemplateFiles = append(layoutTemplateFiles,
+		"debug_integrity/debug_integrity.html",
+	)
+	var pageTemplate = templates.GetTemplate(debugIntegrityTemplateFiles...)
+
+	if !utils.Config.Frontend.Pprof {
+		handlePageError(w, r, errors.New("debug pages are not enabled"))
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/debug/integrity", "Integrity Report", debugIntegrityTemplateFiles)
+	data.Data = buildDebugIntegrityPageData()
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "debug_integrity.go", "Integrity Report", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildDebugIntegrityPageData() *models.DebugIntegrityPageData {
+	pageData := &models.DebugIntegrityPageData{
+		Epochs:     []*models.DebugIntegrityPageEpochData{},
+		Mismatches: []*models.DebugIntegrityPageMismatchData{},
+	}
+
+	epochReports, mismatches := services.GlobalBeaconService.GetDutyVerifier().GetReport()
+
+	for _, report := range epochReports {
+		pageData.Epochs = append(pageData.Epochs, &models.DebugIntegrityPageEpochData{
+			Epoch:         uint64(report.Epoch),
+			DependentRoot: report.DependentRoot[:],
+			CheckedBlocks: report.CheckedBlocks,
+			Mismatches:    report.Mismatches,
+			DutiesMissing: report.DutiesMissing,
+			VerifiedAt:    report.VerifiedAt,
+		})
+		if !report.DutiesMissing {
+			pageData.CheckedEpochs++
+		}
+		pageData.CheckedBlocks += report.CheckedBlocks
+		pageData.MismatchCount += report.Mismatches
+	}
+
+	for _, mismatch := range mismatches {
+		pageData.Mismatches = append(pageData.Mismatches, &models.DebugIntegrityPageMismatchData{
+			Slot:                 uint64(mismatch.Slot),
+			BlockRoot:            mismatch.BlockRoot[:],
+			ExpectedProposer:     uint64(mismatch.ExpectedProposer),
+			ExpectedProposerName: services.GlobalBeaconService.GetValidatorName(uint64(mismatch.ExpectedProposer)),
+			ActualProposer:       uint64(mismatch.ActualProposer),
+			ActualProposerName:   services.GlobalBeaconService.GetValidatorName(uint64(mismatch.ActualProposer)),
+			DetectedAt:           mismatch.DetectedAt,
+		})
+	}
+
+	return pageData
+}
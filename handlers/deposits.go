@@ -48,7 +48,7 @@ func Deposits(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "deposits.go", "Deposits", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "deposits.go", "Deposits", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
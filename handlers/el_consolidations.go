@@ -95,7 +95,7 @@ func ElConsolidations(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "el_consolidations.go", "Consolidation Requests", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "el_consolidations.go", "Consolidation Requests", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -170,9 +170,7 @@ func buildFilteredElConsolidationsPageData(pageIdx uint64, pageSize uint64, minS
 		pageData.IsDefaultPage = true
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pageData.PageSize = pageSize
 	pageData.TotalPages = pageIdx
 	pageData.CurrentPageIndex = pageIdx
@@ -89,7 +89,7 @@ func ElWithdrawals(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "el_withdrawals.go", "ElWithdrawals", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "el_withdrawals.go", "ElWithdrawals", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -156,9 +156,7 @@ func buildFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot
 		pageData.IsDefaultPage = true
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pageData.PageSize = pageSize
 	pageData.TotalPages = pageIdx
 	pageData.CurrentPageIndex = pageIdx
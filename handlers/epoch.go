@@ -15,6 +15,7 @@ import (
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
 	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
 )
 
 // Epoch will return the main "epoch" page using a go template
@@ -50,7 +51,7 @@ func Epoch(w http.ResponseWriter, r *http.Request) {
 	if pageData == nil {
 		data := InitPageData(w, r, "blockchain", "/epoch", fmt.Sprintf("Epoch %v", epoch), notfoundTemplateFiles)
 		w.Header().Set("Content-Type", "text/html")
-		if handleTemplateError(w, r, "slot.go", "Slot", "blockSlot", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+		if handleTemplateError(w, r, "slot.go", "Slot", "blockSlot", executeLayoutTemplate(w, r, templates.GetTemplate(notfoundTemplateFiles...), data)) != nil {
 			return // an error has occurred and was processed
 		}
 		return
@@ -59,7 +60,7 @@ func Epoch(w http.ResponseWriter, r *http.Request) {
 	data := InitPageData(w, r, "blockchain", "/epoch", fmt.Sprintf("Epoch %v", epoch), epochTemplateFiles)
 	data.Data = pageData
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "epoch.go", "Epoch", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "epoch.go", "Epoch", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -116,6 +117,8 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 		Ts:            chainState.SlotToTime(chainState.EpochToSlot(phase0.Epoch(epoch))),
 		Synchronized:  syncedEpoch,
 		Finalized:     finalizedEpoch > phase0.Epoch(epoch),
+
+		SyncCommitteeActive: chainState.GetSpecs().IsAltairActive(phase0.Epoch(epoch)),
 	}
 
 	dbEpochs := services.GlobalBeaconService.GetDbEpochs(epoch, 1)
@@ -132,7 +135,16 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 		pageData.TargetVoted = dbEpoch.VotedTarget
 		pageData.HeadVoted = dbEpoch.VotedHead
 		pageData.TotalVoted = dbEpoch.VotedTotal
+		if !pageData.Finalized && dbEpoch.VotedTotal == 0 && !services.GlobalBeaconService.IsEpochInVoteWindow(phase0.Epoch(epoch)) {
+			// epoch is outside the unfinalized vote window, votes have not been aggregated
+			pageData.VotesUnavailable = true
+			pageData.VoteWindowEpochs = uint64(utils.Config.Indexer.UnfinalizedVoteEpochs)
+		}
 		pageData.SyncParticipation = float64(dbEpoch.SyncParticipation) * 100
+		pageData.FinalityDelay = dbEpoch.FinalityDelay
+		pageData.InactivityLeak = specs.IsInactivityLeak(dbEpoch.FinalityDelay)
+		pageData.PenalizedCount = dbEpoch.PenalizedCount
+		pageData.PenaltyAmount = dbEpoch.PenaltyAmount
 		pageData.ValidatorCount = dbEpoch.ValidatorCount
 		if dbEpoch.ValidatorCount > 0 {
 			pageData.AverageValidatorBalance = dbEpoch.ValidatorBalance / dbEpoch.ValidatorCount
@@ -146,6 +158,36 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 		}
 	}
 
+	if !pageData.VotesUnavailable {
+		pageData.Votes = buildEpochPageVotes(phase0.Epoch(epoch), dbEpoch)
+	}
+
+	if epochStats != nil {
+		if epochStatsValues := beaconIndexer.GetEpochStatsValues(epochStats); epochStatsValues != nil {
+			pageData.RandaoMix = epochStatsValues.RandaoMix[:]
+		}
+	}
+
+	// preview next epoch proposers while the epoch is in progress
+	if epoch == uint64(currentEpoch) {
+		if proposerPreview := beaconIndexer.GetProposerPreview(phase0.Epoch(epoch + 1)); proposerPreview != nil {
+			pageData.NextEpochPreview = &models.EpochPageProposerPreview{
+				Epoch:     epoch + 1,
+				RandaoMix: proposerPreview.RandaoMix[:],
+				Slots:     make([]*models.EpochPageProposerPreviewSlot, len(proposerPreview.ProposerDuties)),
+			}
+			for slotIdx, proposer := range proposerPreview.ProposerDuties {
+				slot := chainState.EpochToSlot(phase0.Epoch(epoch+1)) + phase0.Slot(slotIdx)
+				pageData.NextEpochPreview.Slots[slotIdx] = &models.EpochPageProposerPreviewSlot{
+					Slot:         uint64(slot),
+					Ts:           chainState.SlotToTime(slot),
+					Proposer:     uint64(proposer),
+					ProposerName: services.GlobalBeaconService.GetValidatorName(uint64(proposer)),
+				}
+			}
+		}
+	}
+
 	// load slots
 	pageData.Slots = make([]*models.EpochPageDataSlot, 0)
 	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(uint64(lastSlot), uint32(specs.SlotsPerEpoch), true, true)
@@ -163,6 +205,7 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 				pageData.OrphanedCount++
 			case dbtypes.Canonical:
 				pageData.CanonicalCount++
+				pageData.EthTransactionCount += dbSlot.EthTransactionCount
 			case dbtypes.Missing:
 				pageData.MissedCount++
 			}
@@ -209,3 +252,38 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 	}
 	return pageData, cacheTimeout
 }
+
+// buildEpochPageVotes builds the vote breakdown of an epoch.
+// the inclusion distances are taken from the epoch aggregation, the split by inclusion epoch is only available
+// from the live vote aggregation of unfinalized epochs.
+func buildEpochPageVotes(epoch phase0.Epoch, dbEpoch *dbtypes.Epoch) *models.EpochPageVotes {
+	votes := &models.EpochPageVotes{}
+
+	if dbEpoch != nil {
+		votes.InclusionDistances = []uint64{dbEpoch.InclusionDist1, dbEpoch.InclusionDist2, dbEpoch.InclusionDist3, dbEpoch.InclusionDistLate}
+		if inclusionCount := dbEpoch.InclusionDist1 + dbEpoch.InclusionDist2 + dbEpoch.InclusionDist3 + dbEpoch.InclusionDistLate; inclusionCount > 0 {
+			votes.AvgInclusionDistance = float64(dbEpoch.InclusionDistSum) / float64(inclusionCount)
+		}
+	}
+
+	if epochVotes := services.GlobalBeaconService.GetEpochVotes(epoch); epochVotes != nil {
+		votes.Live = true
+		votes.AmountIsCount = epochVotes.AmountIsCount
+		votes.CurrentEpoch = &models.EpochPageVoteAmounts{
+			TargetVoted: uint64(epochVotes.CurrentEpoch.TargetVoteAmount),
+			HeadVoted:   uint64(epochVotes.CurrentEpoch.HeadVoteAmount),
+			TotalVoted:  uint64(epochVotes.CurrentEpoch.TotalVoteAmount),
+		}
+		votes.NextEpoch = &models.EpochPageVoteAmounts{
+			TargetVoted: uint64(epochVotes.NextEpoch.TargetVoteAmount),
+			HeadVoted:   uint64(epochVotes.NextEpoch.HeadVoteAmount),
+			TotalVoted:  uint64(epochVotes.NextEpoch.TotalVoteAmount),
+		}
+	}
+
+	if !votes.Live && votes.AvgInclusionDistance == 0 {
+		return nil
+	}
+
+	return votes
+}
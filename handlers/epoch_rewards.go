@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of buckets shown in the chart when grouping by epoch, larger ranges are aggregated
+const epochRewardsMaxPoints = 500
+
+// EpochRewards will return the epoch rewards & issuance chart page using a go template
+func EpochRewards(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"epoch_rewards/epoch_rewards.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/rewards", "Epoch Rewards", pageTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	chartRange := urlArgs.Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "30d"
+	}
+	chartGroup := urlArgs.Get("group")
+	if chartGroup != "day" {
+		chartGroup = "epoch"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getEpochRewardsPageData(chartRange, chartGroup)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "epoch_rewards.go", "EpochRewards", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getEpochRewardsPageData(chartRange string, chartGroup string) (*models.EpochRewardsPageData, error) {
+	pageData := &models.EpochRewardsPageData{}
+	pageCacheKey := fmt.Sprintf("epoch_rewards:%v:%v", chartRange, chartGroup)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildEpochRewardsPageData(chartRange, chartGroup)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.EpochRewardsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildEpochRewardsPageData(chartRange string, chartGroup string) (*models.EpochRewardsPageData, time.Duration) {
+	logrus.Debugf("epoch rewards page called: %v, %v", chartRange, chartGroup)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.EpochRewardsPageData{
+		Range: chartRange,
+		Group: chartGroup,
+	}
+	pageData.FirstEpoch, pageData.LastEpoch = getChartRangeEpochs(chartRange)
+
+	if chartGroup == "day" {
+		epochDuration := specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+		pageData.BucketSize = uint64(24 * time.Hour / epochDuration)
+		if pageData.BucketSize == 0 {
+			pageData.BucketSize = 1
+		}
+	} else {
+		pageData.BucketSize = (pageData.LastEpoch-pageData.FirstEpoch)/epochRewardsMaxPoints + 1
+	}
+
+	dbStats := db.GetEpochRewardStats(pageData.FirstEpoch, pageData.LastEpoch, pageData.BucketSize)
+	pageData.Buckets = make([]*models.EpochRewardsPageDataBucket, 0, len(dbStats))
+
+	totalIssuance := int64(0)
+	bucketEpochs := make([]uint64, 0, len(dbStats))
+	attestationAmounts := make([]float64, 0, len(dbStats))
+	proposerAmounts := make([]float64, 0, len(dbStats))
+	syncAmounts := make([]float64, 0, len(dbStats))
+	issuanceAmounts := make([]float64, 0, len(dbStats))
+	for _, dbBucket := range dbStats {
+		bucket := &models.EpochRewardsPageDataBucket{
+			FirstEpoch:           dbBucket.FirstEpoch,
+			LastEpoch:            dbBucket.LastEpoch,
+			Time:                 chainState.EpochToTime(phase0.Epoch(dbBucket.FirstEpoch)),
+			EpochCount:           dbBucket.EpochCount,
+			EstimatedCount:       dbBucket.EstimatedCount,
+			AttestationRewards:   dbBucket.AttestationRewards,
+			AttestationPenalties: dbBucket.AttestationPenalties,
+			ProposerRewards:      dbBucket.ProposerRewards,
+			SyncRewards:          dbBucket.SyncRewards,
+			SyncPenalties:        dbBucket.SyncPenalties,
+		}
+		bucket.Issuance, bucket.IssuanceNegative = splitSignedGwei(dbBucket.TotalIssuance)
+		pageData.Buckets = append(pageData.Buckets, bucket)
+
+		pageData.EpochCount += bucket.EpochCount
+		pageData.EstimatedCount += bucket.EstimatedCount
+		pageData.AttestationRewards += bucket.AttestationRewards
+		pageData.AttestationPenalties += bucket.AttestationPenalties
+		pageData.ProposerRewards += bucket.ProposerRewards
+		pageData.SyncRewards += bucket.SyncRewards
+		pageData.SyncPenalties += bucket.SyncPenalties
+		totalIssuance += dbBucket.TotalIssuance
+
+		bucketEpochs = append(bucketEpochs, bucket.FirstEpoch)
+		attestationAmounts = append(attestationAmounts, utils.ConsensusUnitsFromGwei(bucket.AttestationRewards)-utils.ConsensusUnitsFromGwei(bucket.AttestationPenalties))
+		proposerAmounts = append(proposerAmounts, utils.ConsensusUnitsFromGwei(bucket.ProposerRewards))
+		syncAmounts = append(syncAmounts, utils.ConsensusUnitsFromGwei(bucket.SyncRewards)-utils.ConsensusUnitsFromGwei(bucket.SyncPenalties))
+		issuanceAmount := utils.ConsensusUnitsFromGwei(bucket.Issuance)
+		if bucket.IssuanceNegative {
+			issuanceAmount = -issuanceAmount
+		}
+		issuanceAmounts = append(issuanceAmounts, issuanceAmount)
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+	pageData.Issuance, pageData.IssuanceNegative = splitSignedGwei(totalIssuance)
+
+	formatEthValue := func(value float64) string {
+		return fmt.Sprintf("%v %v", utils.FormatFloat(value, 4), utils.GetDenomination().ConsensusSymbol)
+	}
+	pageData.Chart = buildLineChart(bucketEpochs, func(epoch uint64) string {
+		if chartGroup == "day" {
+			return chainState.EpochToTime(phase0.Epoch(epoch)).UTC().Format("2006-01-02")
+		}
+		return fmt.Sprintf("Epoch %v", utils.FormatFloat(float64(epoch), 0))
+	}, &chartSeries{
+		name:   "Net issuance",
+		color:  "#0d6efd",
+		values: issuanceAmounts,
+		format: formatEthValue,
+	}, &chartSeries{
+		name:   "Attestation rewards",
+		color:  "#198754",
+		values: attestationAmounts,
+		format: formatEthValue,
+	}, &chartSeries{
+		name:   "Proposer rewards",
+		color:  "#fd7e14",
+		values: proposerAmounts,
+		format: formatEthValue,
+	}, &chartSeries{
+		name:   "Sync committee rewards",
+		color:  "#6f42c1",
+		values: syncAmounts,
+		format: formatEthValue,
+	})
+
+	return pageData, 5 * time.Minute
+}
+
+// splitSignedGwei splits a signed gwei amount into its absolute value and sign for the templates.
+func splitSignedGwei(amount int64) (uint64, bool) {
+	if amount < 0 {
+		return uint64(-amount), true
+	}
+	return uint64(amount), false
+}
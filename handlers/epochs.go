@@ -19,6 +19,8 @@ func Epochs(w http.ResponseWriter, r *http.Request) {
 	var indexTemplateFiles = append(layoutTemplateFiles,
 		"epochs/epochs.html",
 		"_svg/professor.html",
+		annotationsTemplateFile,
+		testRunsTemplateFile,
 	)
 
 	var pageTemplate = templates.GetTemplate(indexTemplateFiles...)
@@ -44,14 +46,14 @@ func Epochs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "epochs.go", "Epochs", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "epochs.go", "Epochs", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
 
 func getEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPageData, error) {
 	pageData := &models.EpochsPageData{}
-	pageCacheKey := fmt.Sprintf("epochs:%v:%v", firstEpoch, pageSize)
+	pageCacheKey := fmt.Sprintf("epochs:%v:%v:%v:%v", firstEpoch, pageSize, services.GlobalBeaconService.GetAnnotationsCacheKey(), services.GlobalBeaconService.GetTestRunsCacheKey())
 	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
 		pageData, cacheTimeout := buildEpochsPageData(firstEpoch, pageSize)
 		pageCall.CacheTimeout = cacheTimeout
@@ -78,9 +80,7 @@ func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPage
 		firstEpoch = uint64(currentEpoch)
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pagesBefore := (firstEpoch + 1) / pageSize
 	if ((firstEpoch + 1) % pageSize) > 0 {
 		pagesBefore++
@@ -106,6 +106,14 @@ func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPage
 	epochLimit := pageSize
 
 	// load epochs
+	var firstAnnotationEpoch uint64
+	if firstEpoch >= epochLimit {
+		firstAnnotationEpoch = firstEpoch - epochLimit + 1
+	}
+	pageFirstSlot := uint64(chainState.EpochToSlot(phase0.Epoch(firstAnnotationEpoch)))
+	pageLastSlot := uint64(chainState.EpochToSlot(phase0.Epoch(firstEpoch+1))) - 1
+	annotations := getRangeAnnotations(pageFirstSlot, pageLastSlot)
+	testRuns := getRangeTestRuns(pageFirstSlot, pageLastSlot)
 	pageData.Epochs = make([]*models.EpochsPageDataEpoch, 0)
 	dbEpochs := services.GlobalBeaconService.GetDbEpochs(uint64(firstEpoch), uint32(epochLimit))
 	dbIdx := 0
@@ -125,6 +133,10 @@ func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPage
 			Finalized: finalized,
 			Justified: int64(justifiedEpoch) > epochIdx,
 		}
+		epochFirstSlot := uint64(chainState.EpochToSlot(phase0.Epoch(epoch)))
+		epochLastSlot := epochFirstSlot + chainState.GetSpecs().SlotsPerEpoch - 1
+		epochData.Annotations = filterAnnotations(annotations, epochFirstSlot, epochLastSlot)
+		epochData.TestRuns = filterTestRuns(testRuns, epochFirstSlot, epochLastSlot)
 		if dbIdx < dbCnt && dbEpochs[dbIdx] != nil && dbEpochs[dbIdx].Epoch == epoch {
 			dbEpoch := dbEpochs[dbIdx]
 			dbIdx++
@@ -140,6 +152,7 @@ func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPage
 			epochData.TargetVoted = dbEpoch.VotedTarget
 			epochData.HeadVoted = dbEpoch.VotedHead
 			epochData.TotalVoted = dbEpoch.VotedTotal
+			epochData.VotesUnavailable = !finalized && dbEpoch.VotedTotal == 0 && !services.GlobalBeaconService.IsEpochInVoteWindow(phase0.Epoch(epoch))
 			if dbEpoch.Eligible > 0 {
 				epochData.TargetVoteParticipation = float64(dbEpoch.VotedTarget) * 100.0 / float64(dbEpoch.Eligible)
 				epochData.HeadVoteParticipation = float64(dbEpoch.VotedHead) * 100.0 / float64(dbEpoch.Eligible)
@@ -69,6 +69,11 @@ func handleHTTPError(err error, handler func(http.ResponseWriter, *http.Request)
 }
 
 func NotFound(w http.ResponseWriter, r *http.Request) {
+	if wantsPageJson(w, r) {
+		writePageJsonError(w, http.StatusNotFound, "not found")
+		return
+	}
+
 	templateFiles := append(layoutTemplateFiles, "_layout/404.html")
 	notFoundTemplate := templates.GetTemplate(templateFiles...)
 	w.Header().Set("Content-Type", "text/html")
@@ -82,10 +87,21 @@ func NotFound(w http.ResponseWriter, r *http.Request) {
 }
 
 func handlePageError(w http.ResponseWriter, r *http.Request, pageError error) {
+	// pages that need a beacon node while all nodes are down are reported as temporarily unavailable
+	httpStatus := http.StatusInternalServerError
+	if errors.Is(pageError, services.ErrLiveDataUnavailable) {
+		httpStatus = http.StatusServiceUnavailable
+	}
+
+	if wantsPageJson(w, r) {
+		writePageJsonError(w, httpStatus, pageError.Error())
+		return
+	}
+
 	templateFiles := append(layoutTemplateFiles, "_layout/500.html")
 	notFoundTemplate := templates.GetTemplate(templateFiles...)
 	w.Header().Set("Content-Type", "text/html")
-	w.WriteHeader(http.StatusInternalServerError)
+	w.WriteHeader(httpStatus)
 	data := InitPageData(w, r, "blockchain", r.URL.Path, "Internal Error", templateFiles)
 	errData := &models.ErrorPageData{
 		CallTime: time.Now(),
@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ranges available for the graffiti leaderboard
+var graffitiRanges = []string{"1d", "7d"}
+
+// Graffiti will return the graffiti search & statistics page using a go template
+func Graffiti(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"graffiti/graffiti.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "blockchain", "/graffiti", "Graffiti", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+	search := strings.TrimSpace(urlArgs.Get("q"))
+	statsRange := urlArgs.Get("range")
+	if !isGraffitiRange(statsRange) {
+		statsRange = "7d"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		if search != "" {
+			// search results depend on user input, so they are not cached
+			data.Data = buildGraffitiSearchPageData(r.Context(), search, pageIdx, pageSize)
+		} else {
+			data.Data, pageError = getGraffitiPageData(pageIdx, pageSize, statsRange)
+		}
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "graffiti.go", "Graffiti", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func isGraffitiRange(statsRange string) bool {
+	for _, graffitiRange := range graffitiRanges {
+		if graffitiRange == statsRange {
+			return true
+		}
+	}
+	return false
+}
+
+func getGraffitiPageData(pageIdx uint64, pageSize uint64, statsRange string) (*models.GraffitiPageData, error) {
+	pageData := &models.GraffitiPageData{}
+	pageCacheKey := fmt.Sprintf("graffiti:%v:%v:%v", pageIdx, pageSize, statsRange)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildGraffitiPageData(pageIdx, pageSize, statsRange)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.GraffitiPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildGraffitiPageData(pageIdx uint64, pageSize uint64, statsRange string) *models.GraffitiPageData {
+	logrus.Debugf("graffiti page called: %v:%v [%v]", pageIdx, pageSize, statsRange)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.GraffitiPageData{
+		Range: statsRange,
+	}
+
+	pageSize = services.LimitPageSize(pageSize)
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	firstEpoch, lastEpoch := getChartRangeEpochs(statsRange)
+	minSlot := uint64(chainState.EpochToSlot(phase0.Epoch(firstEpoch)))
+	maxSlot := uint64(chainState.EpochToSlot(phase0.Epoch(lastEpoch+1))) - 1
+
+	offset := (pageIdx - 1) * pageSize
+	if !services.IsListOffsetAllowed(offset) {
+		return pageData
+	}
+
+	dbStats, totalGraffitis, totalBlocks, err := db.GetGraffitiStats(minSlot, maxSlot, offset, uint32(pageSize))
+	if err != nil {
+		return pageData
+	}
+
+	for idx, dbEntry := range dbStats {
+		// the filtered graffiti is shown, blocked graffitis keep their rank but are not listed
+		graffitiText, isVisible := services.GlobalGraffitiFilter.FilterGraffiti(dbEntry.GraffitiText)
+		if !isVisible {
+			continue
+		}
+
+		entry := &models.GraffitiPageDataStat{
+			Rank:          offset + uint64(idx) + 1,
+			Graffiti:      graffitiText,
+			BlockCount:    dbEntry.BlockCount,
+			ProposerCount: dbEntry.ProposerCount,
+			LastSlot:      dbEntry.LastSlot,
+			LastTime:      chainState.SlotToTime(phase0.Slot(dbEntry.LastSlot)),
+		}
+		if totalBlocks > 0 {
+			entry.Share = float64(dbEntry.BlockCount) * 100 / float64(totalBlocks)
+		}
+		pageData.Leaderboard = append(pageData.Leaderboard, entry)
+	}
+	pageData.LeaderboardCount = uint64(len(pageData.Leaderboard))
+	pageData.TotalGraffitis = totalGraffitis
+	pageData.TotalBlocks = totalBlocks
+
+	pageData.TotalPages = totalGraffitis / pageSize
+	if totalGraffitis%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/graffiti?range=%v&c=%v", statsRange, pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/graffiti?range=%v&c=%v&p=%v", statsRange, pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/graffiti?range=%v&c=%v&p=%v", statsRange, pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/graffiti?range=%v&c=%v&p=%v", statsRange, pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
+
+func buildGraffitiSearchPageData(ctx context.Context, search string, pageIdx uint64, pageSize uint64) *models.GraffitiPageData {
+	logrus.Debugf("graffiti search called: %v:%v [%v]", pageIdx, pageSize, search)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.GraffitiPageData{
+		Search:   search,
+		IsSearch: true,
+	}
+
+	pageSize = services.LimitPageSize(pageSize)
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	searchLink := fmt.Sprintf("/graffiti?q=%v&c=%v", url.QueryEscape(search), pageData.PageSize)
+	pageData.FirstPageLink = searchLink
+	pageData.PrevPageLink = fmt.Sprintf("%v&p=%v", searchLink, pageData.PrevPageIndex)
+
+	if !services.IsSearchTermAllowed(search) {
+		pageData.SearchTooShort = true
+		return pageData
+	}
+
+	offset := (pageIdx - 1) * pageSize
+	if !services.IsListOffsetAllowed(offset) {
+		return pageData
+	}
+
+	searchCtx, cancelSearch := services.GetSearchContext(ctx)
+	defer cancelSearch()
+
+	// load one more result to check for a next page, as counting all matches is too expensive
+	dbGraffitis, err := db.SearchSlotGraffitiTexts(searchCtx, search, offset, uint32(pageSize+1))
+	if err != nil {
+		return pageData
+	}
+	if uint64(len(dbGraffitis)) > pageSize {
+		dbGraffitis = dbGraffitis[:pageSize]
+		pageData.TotalPages = pageIdx + 1
+		pageData.NextPageIndex = pageIdx + 1
+		pageData.NextPageLink = fmt.Sprintf("%v&p=%v", searchLink, pageData.NextPageIndex)
+	}
+
+	for _, graffiti := range dbGraffitis {
+		graffitiText, isVisible := services.GlobalGraffitiFilter.FilterGraffiti(graffiti.GraffitiText)
+		if !isVisible {
+			continue
+		}
+
+		pageData.SearchResults = append(pageData.SearchResults, &models.GraffitiPageDataEntry{
+			Slot:         graffiti.Slot,
+			Time:         chainState.SlotToTime(phase0.Slot(graffiti.Slot)),
+			Proposer:     graffiti.Proposer,
+			ProposerName: services.GlobalBeaconService.GetValidatorName(graffiti.Proposer),
+			Graffiti:     graffitiText,
+		})
+	}
+	pageData.SearchResultCount = uint64(len(pageData.SearchResults))
+
+	return pageData
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// max number of graffitis shown on the wall
+const graffitiWallMaxEntries = 100
+
+// GraffitiWall will return the graffiti wall page using a go template
+func GraffitiWall(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"graffiti_wall/graffiti_wall.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "blockchain", "/graffiti/wall", "Graffiti Wall", pageTemplateFiles)
+
+	// the wall polls for new entries with the highest slot it has seen
+	var sinceSlot uint64
+	if r.URL.Query().Has("since") {
+		sinceSlot, _ = strconv.ParseUint(r.URL.Query().Get("since"), 10, 64)
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getGraffitiWallPageData(sinceSlot)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "graffiti_wall.go", "GraffitiWall", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getGraffitiWallPageData(sinceSlot uint64) (*models.GraffitiWallPageData, error) {
+	pageData := &models.GraffitiWallPageData{}
+	pageCacheKey := "graffiti_wall"
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildGraffitiWallPageData()
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.GraffitiWallPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+
+	if pageErr == nil && sinceSlot > 0 {
+		// the cached model is shared, so the filtered result is returned as a copy
+		filteredData := &models.GraffitiWallPageData{
+			LastSlot:       pageData.LastSlot,
+			SecondsPerSlot: pageData.SecondsPerSlot,
+			Entries:        make([]*models.GraffitiWallPageDataEntry, 0),
+		}
+		for _, entry := range pageData.Entries {
+			if entry.Slot <= sinceSlot {
+				break
+			}
+			filteredData.Entries = append(filteredData.Entries, entry)
+		}
+		filteredData.EntryCount = uint64(len(filteredData.Entries))
+		pageData = filteredData
+	}
+
+	return pageData, pageErr
+}
+
+func buildGraffitiWallPageData() (*models.GraffitiWallPageData, time.Duration) {
+	logrus.Debugf("graffiti wall page called")
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.GraffitiWallPageData{
+		Entries: make([]*models.GraffitiWallPageDataEntry, 0, graffitiWallMaxEntries),
+	}
+
+	// load more graffitis than shown, as some of them might get filtered
+	graffitis := services.GlobalBeaconService.GetRecentGraffitis(graffitiWallMaxEntries * 2)
+	for _, graffiti := range graffitis {
+		if graffiti.Slot > pageData.LastSlot {
+			pageData.LastSlot = graffiti.Slot
+		}
+
+		graffitiText, isVisible := services.GlobalGraffitiFilter.FilterGraffiti(graffiti.GraffitiText)
+		if !isVisible {
+			continue
+		}
+
+		pageData.Entries = append(pageData.Entries, &models.GraffitiWallPageDataEntry{
+			Slot:         graffiti.Slot,
+			Time:         chainState.SlotToTime(phase0.Slot(graffiti.Slot)),
+			Proposer:     graffiti.Proposer,
+			ProposerName: services.GlobalBeaconService.GetValidatorName(graffiti.Proposer),
+			Graffiti:     graffitiText,
+		})
+		if len(pageData.Entries) >= graffitiWallMaxEntries {
+			break
+		}
+	}
+	pageData.EntryCount = uint64(len(pageData.Entries))
+
+	// new graffitis appear with every slot, so the page is only cached for half a slot
+	pageData.SecondsPerSlot = 12
+	if specs := chainState.GetSpecs(); specs != nil && specs.SecondsPerSlot >= time.Second {
+		pageData.SecondsPerSlot = uint64(specs.SecondsPerSlot.Seconds())
+	}
+	return pageData, time.Duration(pageData.SecondsPerSlot) * time.Second / 2
+}
@@ -0,0 +1,111 @@
+// Package handlertest provides a harness to render the frontend & api handlers against fixture data.
+// the harness replaces the global beacon service with a fixture based fake (see services/fakebeacon),
+// so handlers can be exercised without client pools or indexers. handlers that read details directly from the database
+// need a database, see Harness.UseSqliteDatabase.
+package handlertest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pressly/goose/v3"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/services/fakebeacon"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// Harness renders handlers against a fake beacon service.
+type Harness struct {
+	Beacon *fakebeacon.BeaconService
+	Router *mux.Router
+}
+
+// NewHarness sets up the global services for the given fake beacon service and returns a harness with an empty router.
+// a minimal frontend config is applied if no config has been loaded.
+func NewHarness(beaconService *fakebeacon.BeaconService) (*Harness, error) {
+	if utils.Config == nil {
+		utils.Config = DefaultConfig()
+	}
+
+	services.GlobalBeaconService = beaconService
+
+	if err := services.StartThemeService(); err != nil {
+		return nil, err
+	}
+
+	harness := &Harness{
+		Beacon: beaconService,
+		Router: mux.NewRouter(),
+	}
+	if err := harness.ResetCache(); err != nil {
+		return nil, err
+	}
+
+	if specs := beaconService.GetChainState().GetSpecs(); specs != nil {
+		utils.ApplyChainDenomination(specs.ConfigName)
+		utils.InitLinkBuilder(specs.ConfigName)
+	}
+
+	return harness, nil
+}
+
+// DefaultConfig returns the minimal config used by the harness if no config has been loaded.
+func DefaultConfig() *types.Config {
+	config := &types.Config{}
+	config.Frontend.Enabled = true
+	config.Frontend.SiteName = "Dora Test"
+	config.Frontend.PageCallTimeout = 10 * time.Second
+	config.BeaconApi.LocalCacheSize = 10
+	return config
+}
+
+// UseSqliteDatabase initializes a sqlite database with the embedded schema in the given directory.
+// needed for handlers that read additional details (e.g. block attributions or sync assignments) directly from the database.
+func (h *Harness) UseSqliteDatabase(dir string) error {
+	utils.Config.Database.Engine = "sqlite"
+	utils.Config.Database.Sqlite.File = path.Join(dir, "dora-test.sqlite")
+	db.MustInitDB()
+
+	goose.SetLogger(goose.NopLogger())
+	return db.ApplyEmbeddedDbSchema(-2)
+}
+
+// ResetCache replaces the frontend page cache, so changed fixtures are picked up by cached pages.
+func (h *Harness) ResetCache() error {
+	services.GlobalFrontendCache = nil
+	return services.StartFrontendCache()
+}
+
+// Handle registers a handler for the given route pattern (gorilla/mux syntax, e.g. "/slot/{slotOrHash}").
+func (h *Harness) Handle(pattern string, handler http.HandlerFunc, methods ...string) {
+	route := h.Router.HandleFunc(pattern, handler)
+	if len(methods) > 0 {
+		route.Methods(methods...)
+	}
+}
+
+// Get serves a GET request for the given target (path & query) through the registered routes.
+func (h *Harness) Get(target string) *httptest.ResponseRecorder {
+	return h.Do(httptest.NewRequest(http.MethodGet, target, nil))
+}
+
+// Post serves a POST request with the given content type & body through the registered routes.
+func (h *Harness) Post(target string, contentType string, body io.Reader) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, body)
+	req.Header.Set("Content-Type", contentType)
+	return h.Do(req)
+}
+
+// Do serves the given request through the registered routes and returns the recorded response.
+func (h *Harness) Do(req *http.Request) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	h.Router.ServeHTTP(recorder, req)
+	return recorder
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// max number of buckets shown in the chart when grouping by epoch, larger ranges are aggregated
+	inactivityLeaksMaxPoints = 500
+
+	// max number of unfinalized epochs loaded from the indexer cache
+	inactivityLeaksMaxUnfinalized = 256
+
+	// max number of incidents shown in the incidents table
+	inactivityLeaksMaxIncidents = 25
+)
+
+// InactivityLeaks will return the penalties & inactivity leaks page using a go template
+func InactivityLeaks(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"inactivity_leaks/inactivity_leaks.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/penalties", "Penalties & Inactivity Leaks", pageTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	chartRange := urlArgs.Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "30d"
+	}
+	chartGroup := urlArgs.Get("group")
+	if chartGroup != "day" {
+		chartGroup = "epoch"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getInactivityLeaksPageData(chartRange, chartGroup)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "inactivity_leaks.go", "InactivityLeaks", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getInactivityLeaksPageData(chartRange string, chartGroup string) (*models.InactivityLeaksPageData, error) {
+	pageData := &models.InactivityLeaksPageData{}
+	pageCacheKey := fmt.Sprintf("inactivity_leaks:%v:%v", chartRange, chartGroup)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildInactivityLeaksPageData(chartRange, chartGroup)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.InactivityLeaksPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildInactivityLeaksPageData(chartRange string, chartGroup string) (*models.InactivityLeaksPageData, time.Duration) {
+	logrus.Debugf("inactivity leaks page called: %v, %v", chartRange, chartGroup)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.InactivityLeaksPageData{
+		Range:         chartRange,
+		Group:         chartGroup,
+		LeakThreshold: specs.MinEpochsToInactivityPenalty,
+	}
+	if pageData.LeakThreshold == 0 {
+		pageData.LeakThreshold = 4
+	}
+	pageData.FirstEpoch, pageData.LastEpoch = getChartRangeEpochs(chartRange)
+
+	if chartGroup == "day" {
+		epochDuration := specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+		pageData.BucketSize = uint64(24 * time.Hour / epochDuration)
+		if pageData.BucketSize == 0 {
+			pageData.BucketSize = 1
+		}
+	} else {
+		pageData.BucketSize = (pageData.LastEpoch-pageData.FirstEpoch)/inactivityLeaksMaxPoints + 1
+	}
+
+	// current finality status
+	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
+	currentEpoch := chainState.CurrentEpoch()
+	pageData.CurrentEpoch = uint64(currentEpoch)
+	pageData.FinalizedEpoch = uint64(finalizedEpoch)
+	if currentEpoch > finalizedEpoch+1 {
+		pageData.FinalityDelay = uint64(currentEpoch - finalizedEpoch - 1)
+	}
+	pageData.InactivityLeak = specs.IsInactivityLeak(pageData.FinalityDelay)
+
+	// finalized epochs from the db
+	dbStats := db.GetEpochPenaltyStats(pageData.FirstEpoch, pageData.LastEpoch, pageData.BucketSize, pageData.LeakThreshold)
+	pageData.Buckets = make([]*models.InactivityLeaksPageDataBucket, 0, len(dbStats)+1)
+	for _, dbBucket := range dbStats {
+		pageData.Buckets = append(pageData.Buckets, &models.InactivityLeaksPageDataBucket{
+			FirstEpoch:       dbBucket.FirstEpoch,
+			LastEpoch:        dbBucket.LastEpoch,
+			Time:             chainState.EpochToTime(phase0.Epoch(dbBucket.FirstEpoch)),
+			EpochCount:       dbBucket.EpochCount,
+			LeakEpochCount:   dbBucket.LeakEpochCount,
+			MaxFinalityDelay: dbBucket.MaxFinalityDelay,
+			PenalizedCount:   dbBucket.PenalizedCount,
+			PenaltyAmount:    dbBucket.PenaltyAmount,
+		})
+	}
+
+	// unfinalized epochs from the indexer cache, to include an ongoing incident
+	unfinalizedEpochs := getInactivityLeaksUnfinalizedEpochs(pageData, finalizedEpoch, currentEpoch)
+	for _, dbEpoch := range unfinalizedEpochs {
+		var bucket *models.InactivityLeaksPageDataBucket
+		if bucketCount := len(pageData.Buckets); bucketCount > 0 && pageData.Buckets[bucketCount-1].FirstEpoch/pageData.BucketSize == dbEpoch.Epoch/pageData.BucketSize {
+			bucket = pageData.Buckets[bucketCount-1]
+		} else {
+			bucket = &models.InactivityLeaksPageDataBucket{
+				FirstEpoch: dbEpoch.Epoch,
+				Time:       chainState.EpochToTime(phase0.Epoch(dbEpoch.Epoch)),
+			}
+			pageData.Buckets = append(pageData.Buckets, bucket)
+		}
+
+		bucket.LastEpoch = dbEpoch.Epoch
+		bucket.EpochCount++
+		bucket.Unfinalized = true
+		if dbEpoch.FinalityDelay > pageData.LeakThreshold {
+			bucket.LeakEpochCount++
+		}
+		if dbEpoch.FinalityDelay > bucket.MaxFinalityDelay {
+			bucket.MaxFinalityDelay = dbEpoch.FinalityDelay
+		}
+		if dbEpoch.PenalizedCount > bucket.PenalizedCount {
+			bucket.PenalizedCount = dbEpoch.PenalizedCount
+		}
+		bucket.PenaltyAmount += dbEpoch.PenaltyAmount
+	}
+
+	bucketEpochs := make([]uint64, 0, len(pageData.Buckets))
+	penaltyAmounts := make([]float64, 0, len(pageData.Buckets))
+	penalizedCounts := make([]float64, 0, len(pageData.Buckets))
+	for _, bucket := range pageData.Buckets {
+		pageData.EpochCount += bucket.EpochCount
+		pageData.LeakEpochCount += bucket.LeakEpochCount
+		pageData.PenaltyAmount += bucket.PenaltyAmount
+
+		bucketEpochs = append(bucketEpochs, bucket.FirstEpoch)
+		penaltyAmounts = append(penaltyAmounts, utils.ConsensusUnitsFromGwei(bucket.PenaltyAmount))
+		penalizedCounts = append(penalizedCounts, float64(bucket.PenalizedCount))
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	pageData.Chart = buildLineChart(bucketEpochs, func(epoch uint64) string {
+		if chartGroup == "day" {
+			return chainState.EpochToTime(phase0.Epoch(epoch)).UTC().Format("2006-01-02")
+		}
+		return fmt.Sprintf("Epoch %v", utils.FormatFloat(float64(epoch), 0))
+	}, &chartSeries{
+		name:   "Balance drain",
+		color:  "#dc3545",
+		values: penaltyAmounts,
+		format: func(value float64) string {
+			return fmt.Sprintf("%v %v", utils.FormatFloat(value, 4), utils.GetDenomination().ConsensusSymbol)
+		},
+	}, &chartSeries{
+		name:      "Penalized validators",
+		color:     "#fd7e14",
+		values:    penalizedCounts,
+		rightAxis: true,
+		format: func(value float64) string {
+			return utils.FormatFloat(value, 0)
+		},
+	})
+
+	// incidents
+	pageData.Incidents = buildInactivityLeakIncidents(pageData, unfinalizedEpochs)
+	pageData.IncidentCount = uint64(len(pageData.Incidents))
+
+	cacheTimeout := 5 * time.Minute
+	if pageData.FinalityDelay > 0 {
+		cacheTimeout = 1 * time.Minute
+	}
+	return pageData, cacheTimeout
+}
+
+// getInactivityLeaksUnfinalizedEpochs returns the unfinalized epochs within the page range (oldest first).
+func getInactivityLeaksUnfinalizedEpochs(pageData *models.InactivityLeaksPageData, finalizedEpoch phase0.Epoch, currentEpoch phase0.Epoch) []*dbtypes.Epoch {
+	if currentEpoch < finalizedEpoch || uint64(currentEpoch) < pageData.FirstEpoch {
+		return nil
+	}
+
+	firstEpoch := finalizedEpoch
+	if uint64(firstEpoch) < pageData.FirstEpoch {
+		firstEpoch = phase0.Epoch(pageData.FirstEpoch)
+	}
+	if currentEpoch-firstEpoch >= inactivityLeaksMaxUnfinalized {
+		firstEpoch = currentEpoch - inactivityLeaksMaxUnfinalized + 1
+	}
+
+	dbEpochs := services.GlobalBeaconService.GetDbEpochs(uint64(currentEpoch), uint32(currentEpoch-firstEpoch+1))
+	epochs := make([]*dbtypes.Epoch, 0, len(dbEpochs))
+	for idx := len(dbEpochs) - 1; idx >= 0; idx-- {
+		if dbEpochs[idx] == nil {
+			continue
+		}
+		epochs = append(epochs, dbEpochs[idx])
+	}
+	return epochs
+}
+
+// buildInactivityLeakIncidents returns the most recent inactivity leak incidents (newest first).
+// an ongoing incident is built from the unfinalized epochs and merged with the finalized part of the incident from the db.
+func buildInactivityLeakIncidents(pageData *models.InactivityLeaksPageData, unfinalizedEpochs []*dbtypes.Epoch) []*models.InactivityLeaksPageDataIncident {
+	chainState := services.GlobalBeaconService.GetChainState()
+	incidents := []*models.InactivityLeaksPageDataIncident{}
+
+	var ongoingIncident *models.InactivityLeaksPageDataIncident
+	if pageData.InactivityLeak {
+		for idx := len(unfinalizedEpochs) - 1; idx >= 0; idx-- {
+			dbEpoch := unfinalizedEpochs[idx]
+			if dbEpoch.FinalityDelay <= pageData.LeakThreshold {
+				break
+			}
+
+			if ongoingIncident == nil {
+				ongoingIncident = &models.InactivityLeaksPageDataIncident{
+					LastEpoch: dbEpoch.Epoch,
+					Ongoing:   true,
+				}
+			}
+			ongoingIncident.FirstEpoch = dbEpoch.Epoch
+			if dbEpoch.FinalityDelay > ongoingIncident.MaxFinalityDelay {
+				ongoingIncident.MaxFinalityDelay = dbEpoch.FinalityDelay
+			}
+			if dbEpoch.PenalizedCount > ongoingIncident.PenalizedCount {
+				ongoingIncident.PenalizedCount = dbEpoch.PenalizedCount
+			}
+			ongoingIncident.PenaltyAmount += dbEpoch.PenaltyAmount
+		}
+	}
+
+	for idx, dbIncident := range db.GetInactivityLeakIncidents(pageData.LeakThreshold, inactivityLeaksMaxIncidents) {
+		if idx == 0 && ongoingIncident != nil && dbIncident.LastEpoch+1 >= ongoingIncident.FirstEpoch {
+			// finalized part of the ongoing incident
+			if dbIncident.FirstEpoch < ongoingIncident.FirstEpoch {
+				ongoingIncident.FirstEpoch = dbIncident.FirstEpoch
+			}
+			if dbIncident.MaxFinalityDelay > ongoingIncident.MaxFinalityDelay {
+				ongoingIncident.MaxFinalityDelay = dbIncident.MaxFinalityDelay
+			}
+			if dbIncident.PenalizedCount > ongoingIncident.PenalizedCount {
+				ongoingIncident.PenalizedCount = dbIncident.PenalizedCount
+			}
+			ongoingIncident.PenaltyAmount += dbIncident.PenaltyAmount
+			continue
+		}
+
+		incidents = append(incidents, &models.InactivityLeaksPageDataIncident{
+			FirstEpoch:       dbIncident.FirstEpoch,
+			LastEpoch:        dbIncident.LastEpoch,
+			MaxFinalityDelay: dbIncident.MaxFinalityDelay,
+			PenalizedCount:   dbIncident.PenalizedCount,
+			PenaltyAmount:    dbIncident.PenaltyAmount,
+		})
+	}
+
+	if ongoingIncident != nil {
+		incidents = append([]*models.InactivityLeaksPageDataIncident{ongoingIncident}, incidents...)
+	}
+
+	for _, incident := range incidents {
+		incident.StartTime = chainState.EpochToTime(phase0.Epoch(incident.FirstEpoch))
+		incident.EndTime = chainState.EpochToTime(phase0.Epoch(incident.LastEpoch + 1))
+		incident.EpochCount = incident.LastEpoch - incident.FirstEpoch + 1
+	}
+
+	return incidents
+}
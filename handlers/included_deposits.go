@@ -83,7 +83,7 @@ func IncludedDeposits(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -142,9 +142,7 @@ func buildFilteredIncludedDepositsPageData(pageIdx uint64, pageSize uint64, minI
 		pageData.IsDefaultPage = true
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pageData.PageSize = pageSize
 	pageData.TotalPages = pageIdx
 	pageData.CurrentPageIndex = pageIdx
@@ -0,0 +1,304 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// max number of buckets shown in the chart when grouping by epoch, larger ranges are aggregated
+	inclusionDistanceMaxPoints = 500
+
+	// max number of unfinalized epochs loaded from the indexer cache
+	inclusionDistanceMaxUnfinalized = 256
+
+	// number of epochs before & after an annotation that are compared to detect regressions
+	inclusionDistanceCompareEpochs = 32
+
+	// relative increase of the average inclusion distance after an annotation that is flagged as regression
+	inclusionDistanceRegressionThreshold = 0.1
+
+	// max number of annotations checked for regressions
+	inclusionDistanceMaxReleases = 25
+)
+
+// inclusionDistanceLabels are the labels of the tracked inclusion distance buckets
+var inclusionDistanceLabels = [4]string{"1 slot", "2 slots", "3 slots", "4+ slots"}
+
+// InclusionDistance will return the attestation inclusion distance chart page using a go template
+func InclusionDistance(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"inclusion_distance/inclusion_distance.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/inclusion_distance", "Inclusion Distance", pageTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	chartRange := urlArgs.Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "7d"
+	}
+	chartGroup := urlArgs.Get("group")
+	if chartGroup != "day" {
+		chartGroup = "epoch"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getInclusionDistancePageData(chartRange, chartGroup)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "inclusion_distance.go", "InclusionDistance", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getInclusionDistancePageData(chartRange string, chartGroup string) (*models.InclusionDistancePageData, error) {
+	pageData := &models.InclusionDistancePageData{}
+	pageCacheKey := fmt.Sprintf("inclusion_distance:%v:%v:%v", chartRange, chartGroup, services.GlobalBeaconService.GetAnnotationsCacheKey())
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildInclusionDistancePageData(chartRange, chartGroup)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.InclusionDistancePageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildInclusionDistancePageData(chartRange string, chartGroup string) (*models.InclusionDistancePageData, time.Duration) {
+	logrus.Debugf("inclusion distance page called: %v, %v", chartRange, chartGroup)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.InclusionDistancePageData{
+		Range:               chartRange,
+		Group:               chartGroup,
+		CompareEpochs:       inclusionDistanceCompareEpochs,
+		RegressionThreshold: inclusionDistanceRegressionThreshold * 100,
+	}
+	pageData.FirstEpoch, pageData.LastEpoch = getChartRangeEpochs(chartRange)
+
+	if chartGroup == "day" {
+		epochDuration := specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+		pageData.BucketSize = uint64(24 * time.Hour / epochDuration)
+		if pageData.BucketSize == 0 {
+			pageData.BucketSize = 1
+		}
+	} else {
+		pageData.BucketSize = (pageData.LastEpoch-pageData.FirstEpoch)/inclusionDistanceMaxPoints + 1
+	}
+
+	// finalized epochs from the db
+	dbStats := db.GetEpochInclusionDistanceStats(pageData.FirstEpoch, pageData.LastEpoch, pageData.BucketSize)
+	pageData.Buckets = make([]*models.InclusionDistancePageDataBucket, 0, len(dbStats)+1)
+	for _, dbBucket := range dbStats {
+		pageData.Buckets = append(pageData.Buckets, &models.InclusionDistancePageDataBucket{
+			FirstEpoch:  dbBucket.FirstEpoch,
+			LastEpoch:   dbBucket.LastEpoch,
+			Time:        chainState.EpochToTime(phase0.Epoch(dbBucket.FirstEpoch)),
+			EpochCount:  dbBucket.EpochCount,
+			Distances:   [4]uint64{dbBucket.InclusionDist1, dbBucket.InclusionDist2, dbBucket.InclusionDist3, dbBucket.InclusionDistLate},
+			DistanceSum: dbBucket.InclusionDistSum,
+		})
+	}
+
+	// unfinalized epochs from the indexer cache
+	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
+	for _, dbEpoch := range getInclusionDistanceUnfinalizedEpochs(pageData.FirstEpoch, finalizedEpoch, chainState.CurrentEpoch()) {
+		if dbEpoch.InclusionDistSum == 0 {
+			continue
+		}
+
+		var bucket *models.InclusionDistancePageDataBucket
+		if bucketCount := len(pageData.Buckets); bucketCount > 0 && pageData.Buckets[bucketCount-1].FirstEpoch/pageData.BucketSize == dbEpoch.Epoch/pageData.BucketSize {
+			bucket = pageData.Buckets[bucketCount-1]
+		} else {
+			bucket = &models.InclusionDistancePageDataBucket{
+				FirstEpoch: dbEpoch.Epoch,
+				Time:       chainState.EpochToTime(phase0.Epoch(dbEpoch.Epoch)),
+			}
+			pageData.Buckets = append(pageData.Buckets, bucket)
+		}
+
+		bucket.LastEpoch = dbEpoch.Epoch
+		bucket.EpochCount++
+		bucket.Unfinalized = true
+		bucket.Distances[0] += dbEpoch.InclusionDist1
+		bucket.Distances[1] += dbEpoch.InclusionDist2
+		bucket.Distances[2] += dbEpoch.InclusionDist3
+		bucket.Distances[3] += dbEpoch.InclusionDistLate
+		bucket.DistanceSum += dbEpoch.InclusionDistSum
+	}
+
+	totalDistances := [4]uint64{}
+	totalDistanceSum := uint64(0)
+	bucketEpochs := make([]uint64, 0, len(pageData.Buckets))
+	avgDistances := make([]float64, 0, len(pageData.Buckets))
+	optimalShares := make([]float64, 0, len(pageData.Buckets))
+	for _, bucket := range pageData.Buckets {
+		for idx, voteCount := range bucket.Distances {
+			bucket.VoteCount += voteCount
+			totalDistances[idx] += voteCount
+		}
+		if bucket.VoteCount > 0 {
+			bucket.AverageDistance = float64(bucket.DistanceSum) / float64(bucket.VoteCount)
+		}
+		pageData.EpochCount += bucket.EpochCount
+		pageData.VoteCount += bucket.VoteCount
+		totalDistanceSum += bucket.DistanceSum
+
+		optimalShare := float64(0)
+		if bucket.VoteCount > 0 {
+			optimalShare = float64(bucket.Distances[0]) * 100 / float64(bucket.VoteCount)
+		}
+		bucketEpochs = append(bucketEpochs, bucket.FirstEpoch)
+		avgDistances = append(avgDistances, bucket.AverageDistance)
+		optimalShares = append(optimalShares, optimalShare)
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	pageData.Distances = make([]*models.InclusionDistancePageDataDistribution, len(totalDistances))
+	for idx, voteCount := range totalDistances {
+		distribution := &models.InclusionDistancePageDataDistribution{
+			Label:     inclusionDistanceLabels[idx],
+			VoteCount: voteCount,
+		}
+		if pageData.VoteCount > 0 {
+			distribution.Percent = float64(voteCount) * 100 / float64(pageData.VoteCount)
+		}
+		pageData.Distances[idx] = distribution
+	}
+	if pageData.VoteCount > 0 {
+		pageData.AverageDistance = float64(totalDistanceSum) / float64(pageData.VoteCount)
+	}
+
+	pageData.Chart = buildLineChart(bucketEpochs, func(epoch uint64) string {
+		if chartGroup == "day" {
+			return chainState.EpochToTime(phase0.Epoch(epoch)).UTC().Format("2006-01-02")
+		}
+		return fmt.Sprintf("Epoch %v", utils.FormatFloat(float64(epoch), 0))
+	}, &chartSeries{
+		name:   "Avg. inclusion distance",
+		color:  "#0d6efd",
+		values: avgDistances,
+		format: func(value float64) string {
+			return fmt.Sprintf("%v slots", utils.FormatFloat(value, 3))
+		},
+	}, &chartSeries{
+		name:      "Included in next slot",
+		color:     "#198754",
+		values:    optimalShares,
+		rightAxis: true,
+		format: func(value float64) string {
+			return fmt.Sprintf("%v%%", utils.FormatFloat(value, 2))
+		},
+	})
+
+	pageData.Releases = buildInclusionDistanceReleases(pageData, finalizedEpoch)
+	pageData.ReleaseCount = uint64(len(pageData.Releases))
+
+	return pageData, 5 * time.Minute
+}
+
+// getInclusionDistanceUnfinalizedEpochs returns the unfinalized epochs from firstEpoch on (oldest first).
+func getInclusionDistanceUnfinalizedEpochs(firstEpoch uint64, finalizedEpoch phase0.Epoch, currentEpoch phase0.Epoch) []*dbtypes.Epoch {
+	if currentEpoch < finalizedEpoch || uint64(currentEpoch) < firstEpoch {
+		return nil
+	}
+
+	startEpoch := finalizedEpoch
+	if uint64(startEpoch) < firstEpoch {
+		startEpoch = phase0.Epoch(firstEpoch)
+	}
+	if currentEpoch-startEpoch >= inclusionDistanceMaxUnfinalized {
+		startEpoch = currentEpoch - inclusionDistanceMaxUnfinalized + 1
+	}
+
+	dbEpochs := services.GlobalBeaconService.GetDbEpochs(uint64(currentEpoch), uint32(currentEpoch-startEpoch+1))
+	epochs := make([]*dbtypes.Epoch, 0, len(dbEpochs))
+	for idx := len(dbEpochs) - 1; idx >= 0; idx-- {
+		if dbEpochs[idx] == nil {
+			continue
+		}
+		epochs = append(epochs, dbEpochs[idx])
+	}
+	return epochs
+}
+
+// buildInclusionDistanceReleases compares the average inclusion distance of the finalized epochs before & after each annotation
+// in the page range (e.g. client releases rolled out on a devnet) and flags annotations followed by an increased distance (newest first).
+func buildInclusionDistanceReleases(pageData *models.InclusionDistancePageData, finalizedEpoch phase0.Epoch) []*models.InclusionDistancePageDataRelease {
+	chainState := services.GlobalBeaconService.GetChainState()
+	firstSlot := uint64(chainState.EpochToSlot(phase0.Epoch(pageData.FirstEpoch)))
+	lastSlot := uint64(chainState.EpochToSlot(phase0.Epoch(pageData.LastEpoch+1))) - 1
+
+	getAverageDistance := func(firstEpoch uint64, lastEpoch uint64) (float64, uint64) {
+		voteCount := uint64(0)
+		distanceSum := uint64(0)
+		for _, dbBucket := range db.GetEpochInclusionDistanceStats(firstEpoch, lastEpoch, inclusionDistanceCompareEpochs) {
+			voteCount += dbBucket.InclusionDist1 + dbBucket.InclusionDist2 + dbBucket.InclusionDist3 + dbBucket.InclusionDistLate
+			distanceSum += dbBucket.InclusionDistSum
+		}
+		if voteCount == 0 {
+			return 0, 0
+		}
+		return float64(distanceSum) / float64(voteCount), voteCount
+	}
+
+	releases := []*models.InclusionDistancePageDataRelease{}
+	annotations := getRangeAnnotations(firstSlot, lastSlot)
+	for idx := len(annotations) - 1; idx >= 0 && len(releases) < inclusionDistanceMaxReleases; idx-- {
+		annotation := annotations[idx]
+		if annotation.StartEpoch < inclusionDistanceCompareEpochs {
+			continue
+		}
+
+		release := &models.InclusionDistancePageDataRelease{
+			Annotation: annotation,
+			Epoch:      annotation.StartEpoch,
+			Time:       chainState.EpochToTime(phase0.Epoch(annotation.StartEpoch)),
+			Pending:    annotation.StartEpoch+inclusionDistanceCompareEpochs > uint64(finalizedEpoch),
+		}
+
+		beforeAverage, beforeVotes := getAverageDistance(annotation.StartEpoch-inclusionDistanceCompareEpochs, annotation.StartEpoch-1)
+		afterAverage, afterVotes := getAverageDistance(annotation.StartEpoch, annotation.StartEpoch+inclusionDistanceCompareEpochs-1)
+		if beforeVotes == 0 {
+			continue
+		}
+
+		release.BeforeAverage = beforeAverage
+		release.AfterAverage = afterAverage
+		if afterVotes > 0 {
+			release.Change = (afterAverage - beforeAverage) * 100 / beforeAverage
+			release.Regression = !release.Pending && afterAverage > beforeAverage*(1+inclusionDistanceRegressionThreshold)
+		}
+
+		releases = append(releases, release)
+	}
+
+	return releases
+}
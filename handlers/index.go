@@ -8,13 +8,10 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 	"time"
 
-	v1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
@@ -28,6 +25,7 @@ func Index(w http.ResponseWriter, r *http.Request) {
 	var indexTemplateFiles = append(layoutTemplateFiles,
 		"index/index.html",
 		"index/networkOverview.html",
+		"index/chainHeads.html",
 		"index/recentBlocks.html",
 		"index/recentEpochs.html",
 		"index/recentSlots.html",
@@ -47,7 +45,7 @@ func Index(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "index.go", "Index", "", indexTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "index.go", "Index", "", executeLayoutTemplate(w, r, indexTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -92,10 +90,6 @@ func getIndexPageData() (*models.IndexPageData, error) {
 func buildIndexPageData() (*models.IndexPageData, time.Duration) {
 	logrus.Debugf("index page called")
 
-	recentEpochCount := 7
-	recentBlockCount := 7
-	recentSlotsCount := 16
-
 	// network overview
 	chainState := services.GlobalBeaconService.GetChainState()
 	specs := chainState.GetSpecs()
@@ -103,22 +97,19 @@ func buildIndexPageData() (*models.IndexPageData, time.Duration) {
 	currentSlot := chainState.CurrentSlot()
 	currentSlotIndex := chainState.SlotToSlotIndex(currentSlot) + 1
 
-	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
-	justifiedEpoch, _ := chainState.GetJustifiedCheckpoint()
-
-	syncState := dbtypes.IndexerSyncState{}
-	db.GetExplorerState("indexer.syncstate", &syncState)
-	var isSynced bool
-	if finalizedEpoch >= 1 {
-		isSynced = syncState.Epoch >= uint64(finalizedEpoch-1)
-	} else {
-		isSynced = true
+	// validator counts, finality & recent chain data come from the precomputed summary,
+	// which is refreshed once per slot by the index summary service
+	summary := services.GlobalIndexSummaryService.GetSummary()
+	if summary == nil {
+		summary = services.BuildIndexSummary()
 	}
+	finalizedEpoch := phase0.Epoch(summary.FinalizedEpoch)
+	justifiedEpoch := phase0.Epoch(summary.JustifiedEpoch)
 
 	pageData := &models.IndexPageData{
 		NetworkName:           specs.ConfigName,
 		DepositContract:       common.Address(specs.DepositContractAddress).String(),
-		ShowSyncingMessage:    !isSynced,
+		ShowSyncingMessage:    !summary.Synced,
 		SlotsPerEpoch:         specs.SlotsPerEpoch,
 		CurrentEpoch:          uint64(currentEpoch),
 		CurrentFinalizedEpoch: int64(finalizedEpoch) - 1,
@@ -131,25 +122,11 @@ func buildIndexPageData() (*models.IndexPageData, time.Duration) {
 		pageData.NetworkName = utils.Config.Chain.DisplayName
 	}
 
-	currentValidatorSet := services.GlobalBeaconService.GetCachedValidatorSet(true)
-	if currentValidatorSet != nil {
-		for _, validator := range currentValidatorSet {
-			if strings.HasPrefix(validator.Status.String(), "active") {
-				pageData.ActiveValidatorCount++
-				pageData.TotalEligibleEther += uint64(validator.Validator.EffectiveBalance)
-				pageData.AverageValidatorBalance += uint64(validator.Balance)
-			}
-			if validator.Status == v1.ValidatorStatePendingQueued {
-				pageData.EnteringValidatorCount++
-			}
-			if validator.Status == v1.ValidatorStateActiveExiting {
-				pageData.ExitingValidatorCount++
-			}
-		}
-		if pageData.AverageValidatorBalance > 0 {
-			pageData.AverageValidatorBalance = pageData.AverageValidatorBalance / pageData.ActiveValidatorCount
-		}
-	}
+	pageData.ActiveValidatorCount = summary.ActiveValidatorCount
+	pageData.EnteringValidatorCount = summary.EnteringValidatorCount
+	pageData.ExitingValidatorCount = summary.ExitingValidatorCount
+	pageData.TotalEligibleEther = summary.TotalEligibleEther
+	pageData.AverageValidatorBalance = summary.AverageValidatorBalance
 
 	pageData.ValidatorsPerEpoch = chainState.GetValidatorChurnLimit(pageData.ActiveValidatorCount)
 	pageData.ValidatorsPerDay = pageData.ValidatorsPerEpoch * 225
@@ -218,28 +195,48 @@ func buildIndexPageData() (*models.IndexPageData, time.Duration) {
 	}
 
 	// load recent epochs
-	buildIndexPageRecentEpochsData(pageData, currentEpoch, finalizedEpoch, justifiedEpoch, recentEpochCount)
+	buildIndexPageRecentEpochsData(pageData, summary, finalizedEpoch, justifiedEpoch)
 
 	// load recent blocks
-	buildIndexPageRecentBlocksData(pageData, recentBlockCount)
+	buildIndexPageRecentBlocksData(pageData, summary)
 
 	// load recent slots
-	buildIndexPageRecentSlotsData(pageData, currentSlot, recentSlotsCount)
+	buildIndexPageRecentSlotsData(pageData, summary)
+
+	// load competing chain heads
+	buildIndexPageChainHeadsData(pageData)
 
 	return pageData, 12 * time.Second
 }
 
-func buildIndexPageRecentEpochsData(pageData *models.IndexPageData, currentEpoch phase0.Epoch, finalizedEpoch phase0.Epoch, justifiedEpoch phase0.Epoch, recentEpochCount int) {
+func buildIndexPageChainHeadsData(pageData *models.IndexPageData) {
+	pageData.ChainHeads = make([]*models.IndexPageDataChainHead, 0)
+
+	for _, chainHead := range services.GlobalBeaconService.GetChainHeads() {
+		headModel := &models.IndexPageDataChainHead{
+			Slot:      uint64(chainHead.Slot),
+			Root:      chainHead.Root[:],
+			Canonical: chainHead.Canonical,
+			Votes:     uint64(chainHead.AggregatedVotes),
+			Clients:   make([]string, len(chainHead.Clients)),
+		}
+		if len(chainHead.PerEpochVotingPercent) > 0 {
+			headModel.VotingPercent = chainHead.PerEpochVotingPercent[len(chainHead.PerEpochVotingPercent)-1]
+		}
+		for idx, client := range chainHead.Clients {
+			headModel.Clients[idx] = client.GetClient().GetName()
+		}
+		pageData.ChainHeads = append(pageData.ChainHeads, headModel)
+	}
+	pageData.ChainHeadCount = uint64(len(pageData.ChainHeads))
+}
+
+func buildIndexPageRecentEpochsData(pageData *models.IndexPageData, summary *dbtypes.IndexSummary, finalizedEpoch phase0.Epoch, justifiedEpoch phase0.Epoch) {
 	pageData.RecentEpochs = make([]*models.IndexPageDataEpochs, 0)
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
-	epochsData := services.GlobalBeaconService.GetDbEpochs(uint64(currentEpoch), uint32(recentEpochCount))
-	for i := 0; i < len(epochsData); i++ {
-		epochData := epochsData[i]
-		if epochData == nil {
-			continue
-		}
+	for _, epochData := range summary.RecentEpochs {
 		voteParticipation := float64(1)
 		if epochData.Eligible > 0 {
 			voteParticipation = float64(epochData.VotedTarget) * 100.0 / float64(epochData.Eligible)
@@ -257,32 +254,19 @@ func buildIndexPageRecentEpochsData(pageData *models.IndexPageData, currentEpoch
 	pageData.RecentEpochCount = uint64(len(pageData.RecentEpochs))
 }
 
-func buildIndexPageRecentBlocksData(pageData *models.IndexPageData, recentBlockCount int) {
+func buildIndexPageRecentBlocksData(pageData *models.IndexPageData, summary *dbtypes.IndexSummary) {
 	pageData.RecentBlocks = make([]*models.IndexPageDataBlocks, 0)
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
-	blocksData := services.GlobalBeaconService.GetDbBlocksByFilter(&dbtypes.BlockFilter{
-		WithOrphaned: 0,
-		WithMissing:  0,
-	}, 0, uint32(recentBlockCount), 0)
-	limit := len(blocksData)
-	if limit > recentBlockCount {
-		limit = recentBlockCount
-	}
-
-	for i := 0; i < limit; i++ {
-		blockData := blocksData[i].Block
-		if blockData == nil {
-			continue
-		}
+	for _, blockData := range summary.RecentBlocks {
 		blockModel := &models.IndexPageDataBlocks{
 			Epoch:        uint64(chainState.EpochOfSlot(phase0.Slot(blockData.Slot))),
 			Slot:         blockData.Slot,
 			Ts:           chainState.SlotToTime(phase0.Slot(blockData.Slot)),
 			Proposer:     blockData.Proposer,
 			ProposerName: services.GlobalBeaconService.GetValidatorName(blockData.Proposer),
-			Status:       uint64(blockData.Status),
+			Status:       blockData.Status,
 			BlockRoot:    blockData.Root,
 		}
 		if blockData.EthBlockNumber != nil {
@@ -297,51 +281,28 @@ func buildIndexPageRecentBlocksData(pageData *models.IndexPageData, recentBlockC
 	pageData.RecentBlockCount = uint64(len(pageData.RecentBlocks))
 }
 
-func buildIndexPageRecentSlotsData(pageData *models.IndexPageData, firstSlot phase0.Slot, slotLimit int) {
-	var lastSlot uint64
-	if uint64(firstSlot) >= uint64(slotLimit) {
-		lastSlot = uint64(firstSlot) - uint64(slotLimit)
-	} else {
-		lastSlot = 0
-	}
-
+func buildIndexPageRecentSlotsData(pageData *models.IndexPageData, summary *dbtypes.IndexSummary) {
 	chainState := services.GlobalBeaconService.GetChainState()
 
-	// load slots
 	pageData.RecentSlots = make([]*models.IndexPageDataSlots, 0)
-	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(uint64(firstSlot), uint32(slotLimit), true, true)
-	dbIdx := 0
-	dbCnt := len(dbSlots)
-	blockCount := uint64(0)
 	openForks := map[int][]byte{}
 	maxOpenFork := 0
-	for slotIdx := int64(firstSlot); slotIdx >= int64(lastSlot); slotIdx-- {
-		slot := uint64(slotIdx)
-		for dbIdx < dbCnt && dbSlots[dbIdx] != nil && dbSlots[dbIdx].Slot == slot {
-			dbSlot := dbSlots[dbIdx]
-			dbIdx++
-
-			slotData := &models.IndexPageDataSlots{
-				Slot:         slot,
-				Epoch:        uint64(chainState.EpochOfSlot(phase0.Slot(dbSlot.Slot))),
-				Ts:           chainState.SlotToTime(phase0.Slot(slot)),
-				Status:       uint64(dbSlot.Status),
-				Proposer:     dbSlot.Proposer,
-				ProposerName: services.GlobalBeaconService.GetValidatorName(dbSlot.Proposer),
-				BlockRoot:    dbSlot.Root,
-				ParentRoot:   dbSlot.ParentRoot,
-				ForkGraph:    make([]*models.IndexPageDataForkGraph, 0),
-			}
-			pageData.RecentSlots = append(pageData.RecentSlots, slotData)
-			blockCount++
-			buildIndexPageSlotGraph(slotData, &maxOpenFork, openForks)
-
-			if blockCount >= uint64(slotLimit) {
-				break
-			}
+	for _, dbSlot := range summary.RecentSlots {
+		slotData := &models.IndexPageDataSlots{
+			Slot:         dbSlot.Slot,
+			Epoch:        uint64(chainState.EpochOfSlot(phase0.Slot(dbSlot.Slot))),
+			Ts:           chainState.SlotToTime(phase0.Slot(dbSlot.Slot)),
+			Status:       dbSlot.Status,
+			Proposer:     dbSlot.Proposer,
+			ProposerName: services.GlobalBeaconService.GetValidatorName(dbSlot.Proposer),
+			BlockRoot:    dbSlot.Root,
+			ParentRoot:   dbSlot.ParentRoot,
+			ForkGraph:    make([]*models.IndexPageDataForkGraph, 0),
 		}
+		pageData.RecentSlots = append(pageData.RecentSlots, slotData)
+		buildIndexPageSlotGraph(slotData, &maxOpenFork, openForks)
 	}
-	pageData.RecentSlotCount = uint64(blockCount)
+	pageData.RecentSlotCount = uint64(len(pageData.RecentSlots))
 	pageData.ForkTreeWidth = (maxOpenFork * 20) + 20
 }
 
@@ -86,7 +86,7 @@ func InitiatedDeposits(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -145,9 +145,7 @@ func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, add
 		pageData.IsDefaultPage = true
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pageData.PageSize = pageSize
 	pageData.TotalPages = pageIdx
 	pageData.CurrentPageIndex = pageIdx
@@ -167,6 +165,10 @@ func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, add
 	}
 
 	offset := (pageIdx - 1) * pageSize
+	if !services.IsListOffsetAllowed(offset) {
+		return pageData
+	}
+
 	depositSyncState := dbtypes.DepositIndexerState{}
 	db.GetExplorerState("indexer.depositstate", &depositSyncState)
 
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -81,7 +82,7 @@ func MevBlocks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "mev_blocks.go", "MevBlocks", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "mev_blocks.go", "MevBlocks", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -183,9 +184,7 @@ func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uin
 		pageData.IsDefaultPage = true
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pageData.PageSize = pageSize
 	pageData.TotalPages = pageIdx
 	pageData.CurrentPageIndex = pageIdx
@@ -205,6 +204,10 @@ func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uin
 	}
 
 	offset := (pageIdx - 1) * pageSize
+	if !services.IsListOffsetAllowed(offset) {
+		return pageData
+	}
+
 	dbMevBlocks, totalRows, err := db.GetMevBlocksFiltered(offset, uint32(pageSize), mevBlockFilter)
 	if err != nil {
 		panic(err)
@@ -226,7 +229,7 @@ func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uin
 			FeeRecipient:   mevBlock.FeeRecipient,
 			TxCount:        mevBlock.TxCount,
 			GasUsed:        mevBlock.GasUsed,
-			BlockValue:     mevBlock.BlockValueGwei,
+			BlockValue:     new(big.Int).SetBytes(mevBlock.BlockValue),
 		}
 
 		for _, relay := range utils.Config.MevIndexer.Relays {
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/execution"
+	execrpc "github.com/ethpandaops/dora/clients/execution/rpc"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// Network will return the network info page (bootnodes, genesis & fork metadata) using a go template
+func Network(w http.ResponseWriter, r *http.Request) {
+	var networkTemplateFiles = append(layoutTemplateFiles,
+		"network/network.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(networkTemplateFiles...)
+	data := InitPageData(w, r, "clients", "/network", "Network Info", networkTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getNetworkPageData()
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "network.go", "Network", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getNetworkPageData() (*models.NetworkPageData, error) {
+	pageData := &models.NetworkPageData{}
+	pageCacheKey := "network"
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildNetworkPageData()
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.NetworkPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildNetworkPageData() (*models.NetworkPageData, time.Duration) {
+	logrus.Debugf("network page called")
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	currentEpoch := chainState.CurrentEpoch()
+
+	pageData := &models.NetworkPageData{
+		NetworkName:        specs.ConfigName,
+		ConfigName:         specs.ConfigName,
+		PresetBase:         specs.PresetBase,
+		SecondsPerSlot:     uint64(specs.SecondsPerSlot.Seconds()),
+		SlotsPerEpoch:      specs.SlotsPerEpoch,
+		GenesisForkVersion: fmt.Sprintf("0x%x", specs.GenesisForkVersion[:]),
+		DepositContract:    common.Address(specs.DepositContractAddress).String(),
+		DepositChainID:     specs.DepositChainId,
+		CurrentEpoch:       uint64(currentEpoch),
+		ClBootnodes:        utils.Config.Chain.ClBootnodes,
+		ElBootnodes:        utils.Config.Chain.ElBootnodes,
+		ClNodes:            []*models.NetworkPageDataNode{},
+		ElNodes:            []*models.NetworkPageDataNode{},
+		ShowNodes:          utils.Config.Frontend.ShowSensitivePeerInfos,
+	}
+	if utils.Config.Chain.DisplayName != "" {
+		pageData.NetworkName = utils.Config.Chain.DisplayName
+	}
+	if pageData.ClBootnodes == nil {
+		pageData.ClBootnodes = []string{}
+	}
+	if pageData.ElBootnodes == nil {
+		pageData.ElBootnodes = []string{}
+	}
+
+	var genesisValidatorsRoot phase0.Root
+	networkGenesis, _ := services.GlobalBeaconService.GetGenesis()
+	if networkGenesis != nil {
+		genesisValidatorsRoot = networkGenesis.GenesisValidatorsRoot
+		pageData.GenesisTime = networkGenesis.GenesisTime
+		pageData.GenesisTimestamp = uint64(networkGenesis.GenesisTime.Unix())
+		pageData.GenesisForkVersion = fmt.Sprintf("0x%x", networkGenesis.GenesisForkVersion[:])
+	}
+	pageData.GenesisValidatorsRoot = fmt.Sprintf("0x%x", genesisValidatorsRoot[:])
+
+	// forks with their p2p fork digests
+	pageData.Forks = []*models.NetworkPageDataFork{
+		buildNetworkPageFork("Phase0", 0, specs.GenesisForkVersion, genesisValidatorsRoot, chainState),
+	}
+	networkForks := []struct {
+		name    string
+		epoch   *uint64
+		version phase0.Version
+	}{
+		{"Altair", specs.AltairForkEpoch, specs.AltairForkVersion},
+		{"Bellatrix", specs.BellatrixForkEpoch, specs.BellatrixForkVersion},
+		{"Capella", specs.CapellaForkEpoch, specs.CapellaForkVersion},
+		{"Deneb", specs.DenebForkEpoch, specs.DenebForkVersion},
+		{"Electra", specs.ElectraForkEpoch, specs.ElectraForkVersion},
+		{"eip7594", specs.Eip7594ForkEpoch, specs.Eip7594ForkVersion},
+	}
+	for _, fork := range networkForks {
+		if fork.epoch == nil || *fork.epoch >= uint64(18446744073709551615) {
+			continue
+		}
+		pageData.Forks = append(pageData.Forks, buildNetworkPageFork(fork.name, *fork.epoch, fork.version, genesisValidatorsRoot, chainState))
+	}
+	for _, fork := range pageData.Forks {
+		if fork.Epoch <= uint64(currentEpoch) {
+			fork.Active = true
+			pageData.CurrentForkDigest = fork.Digest
+		}
+	}
+
+	// records of the connected nodes, these reveal the node ips and are only shown with sensitive peer infos enabled
+	if pageData.ShowNodes {
+		for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+			nodeIdentity := client.GetNodeIdentity()
+			if nodeIdentity == nil || nodeIdentity.Enr == "" {
+				continue
+			}
+			pageData.ClNodes = append(pageData.ClNodes, &models.NetworkPageDataNode{
+				Name:    client.GetName(),
+				Version: client.GetVersion(),
+				Record:  nodeIdentity.Enr,
+			})
+		}
+		for _, client := range services.GlobalBeaconService.GetExecutionClients() {
+			nodeInfo := client.GetNodeInfo()
+			if nodeInfo == nil || nodeInfo.Enode == "" {
+				continue
+			}
+			pageData.ElNodes = append(pageData.ElNodes, &models.NetworkPageDataNode{
+				Name:    client.GetName(),
+				Version: client.GetVersion(),
+				Record:  nodeInfo.Enode,
+			})
+		}
+	}
+
+	buildNetworkPageExecutionGenesis(pageData)
+
+	return pageData, 10 * time.Minute
+}
+
+func buildNetworkPageFork(name string, epoch uint64, version phase0.Version, genesisValidatorsRoot phase0.Root, chainState *consensus.ChainState) *models.NetworkPageDataFork {
+	forkModel := &models.NetworkPageDataFork{
+		Name:    name,
+		Epoch:   epoch,
+		Time:    chainState.EpochToTime(phase0.Epoch(epoch)),
+		Version: fmt.Sprintf("0x%x", version[:]),
+	}
+
+	// compute_fork_digest: first 4 bytes of hash_tree_root(ForkData(version, genesis_validators_root))
+	forkData := &phase0.ForkData{
+		CurrentVersion:        version,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	forkDataRoot, err := forkData.HashTreeRoot()
+	if err == nil {
+		forkModel.Digest = fmt.Sprintf("0x%x", forkDataRoot[:4])
+	}
+
+	return forkModel
+}
+
+// buildNetworkPageExecutionGenesis loads the execution layer chain id & genesis block hash from the first online execution client.
+func buildNetworkPageExecutionGenesis(pageData *models.NetworkPageData) {
+	for _, client := range services.GlobalBeaconService.GetExecutionClients() {
+		if client.GetStatus() != execution.ClientStatusOnline {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		genesisHeader, err := client.GetRPCClient().GetHeaderByNumber(ctx, 0)
+		var chainSpec *execrpc.ChainSpec
+		if err == nil {
+			chainSpec, err = client.GetRPCClient().GetChainSpec(ctx)
+		}
+		cancel()
+
+		if err != nil {
+			logrus.Warnf("failed loading execution genesis from %v: %v", client.GetName(), err)
+			continue
+		}
+
+		pageData.ExecutionGenesisHash = genesisHeader.Hash().String()
+		pageData.ExecutionChainID = chainSpec.ChainID
+		return
+	}
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of buckets shown in the chart with per epoch grouping, larger ranges are aggregated
+const orphanRatesMaxPoints = 500
+
+// number of slots before the chart range that are loaded to resolve the depth of reorgs crossing the range start
+const orphanRatesReorgLookback = 64
+
+// OrphanRates will return the orphan rate & reorg depth chart page using a go template
+func OrphanRates(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"orphan_rates/orphan_rates.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "blockchain", "/slots/orphans", "Orphan Rates", pageTemplateFiles)
+
+	chartRange := r.URL.Query().Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "7d"
+	}
+	grouping := r.URL.Query().Get("group")
+	if grouping != "day" {
+		grouping = "epoch"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getOrphanRatesPageData(chartRange, grouping)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "orphan_rates.go", "OrphanRates", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getOrphanRatesPageData(chartRange string, grouping string) (*models.OrphanRatesPageData, error) {
+	pageData := &models.OrphanRatesPageData{}
+	pageCacheKey := fmt.Sprintf("orphan_rates:%v:%v", chartRange, grouping)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildOrphanRatesPageData(chartRange, grouping)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.OrphanRatesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildOrphanRatesPageData(chartRange string, grouping string) (*models.OrphanRatesPageData, time.Duration) {
+	logrus.Debugf("orphan rates page called: %v (%v)", chartRange, grouping)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.OrphanRatesPageData{
+		Range:    chartRange,
+		Grouping: grouping,
+	}
+	pageData.FirstEpoch, pageData.LastEpoch = getChartRangeEpochs(chartRange)
+
+	// bucket size in epochs, buckets are aligned to genesis so they stay stable between page loads
+	if grouping == "day" {
+		epochDuration := specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+		pageData.BucketSize = uint64(24 * time.Hour / epochDuration)
+		pageData.BucketLabel = "day"
+	} else {
+		pageData.BucketSize = (pageData.LastEpoch-pageData.FirstEpoch)/orphanRatesMaxPoints + 1
+		pageData.BucketLabel = "epoch"
+	}
+	if pageData.BucketSize == 0 {
+		pageData.BucketSize = 1
+	}
+
+	bucketSlots := pageData.BucketSize * specs.SlotsPerEpoch
+	firstSlot := uint64(chainState.EpochStartSlot(phase0.Epoch(pageData.FirstEpoch)))
+	lastSlot := uint64(chainState.EpochStartSlot(phase0.Epoch(pageData.LastEpoch+1))) - 1
+
+	// reorg depth = length of the orphaned branch in blocks, resolved via the parent roots of the orphaned blocks
+	lookbackSlot := uint64(0)
+	if firstSlot > orphanRatesReorgLookback {
+		lookbackSlot = firstSlot - orphanRatesReorgLookback
+	}
+	orphanDepths := map[string]uint64{}
+	bucketDepths := map[uint64]uint64{}
+	for _, orphanedSlot := range db.GetOrphanedSlotRefs(lookbackSlot, lastSlot) {
+		depth := orphanDepths[string(orphanedSlot.ParentRoot)] + 1
+		orphanDepths[string(orphanedSlot.Root)] = depth
+
+		if orphanedSlot.Slot < firstSlot {
+			continue
+		}
+		bucketIdx := orphanedSlot.Slot / bucketSlots
+		if depth > bucketDepths[bucketIdx] {
+			bucketDepths[bucketIdx] = depth
+		}
+	}
+
+	dbStats := db.GetSlotOrphanStats(firstSlot, lastSlot, bucketSlots)
+	pageData.Buckets = make([]*models.OrphanRatesPageDataBucket, 0, len(dbStats))
+
+	bucketEpochs := make([]uint64, 0, len(dbStats))
+	orphanRates := make([]float64, 0, len(dbStats))
+	reorgDepths := make([]float64, 0, len(dbStats))
+	for _, dbBucket := range dbStats {
+		blockCount := dbBucket.CanonicalCount + dbBucket.OrphanedCount
+		if blockCount == 0 {
+			continue
+		}
+
+		bucketIdx := dbBucket.FirstSlot / bucketSlots
+		bucket := &models.OrphanRatesPageDataBucket{
+			FirstEpoch:     uint64(chainState.EpochOfSlot(phase0.Slot(dbBucket.FirstSlot))),
+			LastEpoch:      uint64(chainState.EpochOfSlot(phase0.Slot(dbBucket.LastSlot))),
+			Time:           chainState.SlotToTime(phase0.Slot(dbBucket.FirstSlot)),
+			CanonicalCount: dbBucket.CanonicalCount,
+			OrphanedCount:  dbBucket.OrphanedCount,
+			OrphanRate:     float64(dbBucket.OrphanedCount) * 100 / float64(blockCount),
+			MaxReorgDepth:  bucketDepths[bucketIdx],
+		}
+		pageData.Buckets = append(pageData.Buckets, bucket)
+
+		pageData.CanonicalCount += bucket.CanonicalCount
+		pageData.OrphanedCount += bucket.OrphanedCount
+		if bucket.MaxReorgDepth > pageData.MaxReorgDepth {
+			pageData.MaxReorgDepth = bucket.MaxReorgDepth
+		}
+
+		bucketEpochs = append(bucketEpochs, bucket.FirstEpoch)
+		orphanRates = append(orphanRates, bucket.OrphanRate)
+		reorgDepths = append(reorgDepths, float64(bucket.MaxReorgDepth))
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	if blockCount := pageData.CanonicalCount + pageData.OrphanedCount; blockCount > 0 {
+		pageData.OrphanRate = float64(pageData.OrphanedCount) * 100 / float64(blockCount)
+	}
+
+	pageData.Chart = buildLineChart(bucketEpochs, func(epoch uint64) string {
+		return fmt.Sprintf("Epoch %v", utils.FormatFloat(float64(epoch), 0))
+	}, &chartSeries{
+		name:   "Orphan rate",
+		color:  "#dc3545",
+		values: orphanRates,
+		format: func(value float64) string {
+			return fmt.Sprintf("%.2f%%", value)
+		},
+	}, &chartSeries{
+		name:      "Max reorg depth",
+		color:     "#6f42c1",
+		values:    reorgDepths,
+		rightAxis: true,
+		format: func(value float64) string {
+			return fmt.Sprintf("%.0f", value)
+		},
+	})
+
+	return pageData, 10 * time.Minute
+}
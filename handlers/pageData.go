@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	logger "github.com/sirupsen/logrus"
 
+	"github.com/ethpandaops/dora/clients/consensus"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/types"
 	"github.com/ethpandaops/dora/utils"
@@ -56,6 +57,22 @@ func InitPageData(w http.ResponseWriter, r *http.Request, active, path, title st
 		MainMenuItems:    createMenuItems(active),
 	}
 
+	theme := services.GlobalThemeService.GetTheme()
+	data.Theme = &types.PageTheme{
+		PrimaryColor:      theme.PrimaryColor,
+		PrimaryColorRgb:   utils.ThemeColorToRgb(theme.PrimaryColor),
+		SecondaryColor:    theme.SecondaryColor,
+		SecondaryColorRgb: utils.ThemeColorToRgb(theme.SecondaryColor),
+		Favicon:           "/favicon.ico",
+		FooterText:        theme.FooterText,
+	}
+	if theme.Favicon != "" {
+		data.Theme.Favicon = theme.Favicon
+	}
+	if theme.Logo != "" {
+		data.ExplorerLogo = theme.Logo
+	}
+
 	chainState := services.GlobalBeaconService.GetChainState()
 	if specs := chainState.GetSpecs(); specs != nil {
 		data.IsReady = true
@@ -64,6 +81,25 @@ func InitPageData(w http.ResponseWriter, r *http.Request, active, path, title st
 		data.ChainGenesisTimestamp = uint64(chainState.GetGenesis().GenesisTime.Unix())
 		data.DepositContract = common.BytesToAddress(specs.DepositContractAddress).String()
 		data.Mainnet = specs.ConfigName == "mainnet"
+
+		if !services.GlobalBeaconService.IsLiveDataAvailable() {
+			data.LiveDataUnavailable = true
+		}
+
+		if clockDrift := chainState.GetClockDrift(); clockDrift != nil && clockDrift.IsSkewed {
+			data.ClockSkewed = true
+			data.ClockDrift = formatClockDrift(clockDrift)
+			data.ClockCorrected = chainState.GetClockOffset() != 0
+		}
+
+		// the beacon indexer is not available with alternative data backends
+		if beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer(); beaconIndexer != nil && beaconIndexer.IsSurvivalMode() {
+			finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
+			data.SurvivalMode = true
+			data.CurrentEpoch = uint64(chainState.CurrentEpoch())
+			data.LatestFinalizedEpoch = uint64(finalizedEpoch)
+			data.FinalizationDelay = data.CurrentEpoch - data.LatestFinalizedEpoch
+		}
 	}
 
 	if utils.Config.Frontend.SiteDescription != "" {
@@ -87,6 +123,23 @@ func InitPageData(w http.ResponseWriter, r *http.Request, active, path, title st
 	return data
 }
 
+// formatClockDrift formats the drift of the explorer host clock, the node time offset is preferred over the slot offset.
+func formatClockDrift(clockDrift *consensus.ClockDrift) string {
+	if !clockDrift.HasOffset {
+		slotOffset := clockDrift.SlotOffset
+		if slotOffset < 0 {
+			return fmt.Sprintf("%v slots ahead of", -slotOffset)
+		}
+		return fmt.Sprintf("%v slots behind", slotOffset)
+	}
+
+	offset := clockDrift.Offset.Round(100 * time.Millisecond)
+	if offset < 0 {
+		return fmt.Sprintf("%v ahead of", -offset)
+	}
+	return fmt.Sprintf("%v behind", offset)
+}
+
 func createMenuItems(active string) []types.MainMenuItem {
 	hiddenFor := []string{"confirmation", "login", "register"}
 
@@ -119,6 +172,36 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/slots",
 				Icon:  "fa-cube",
 			},
+			{
+				Label: "Block Sizes",
+				Path:  "/slots/sizes",
+				Icon:  "fa-weight-hanging",
+			},
+			{
+				Label: "Transaction Types",
+				Path:  "/slots/tx_types",
+				Icon:  "fa-layer-group",
+			},
+			{
+				Label: "Block Fees",
+				Path:  "/slots/fees",
+				Icon:  "fa-fire",
+			},
+			{
+				Label: "Orphan Rates",
+				Path:  "/slots/orphans",
+				Icon:  "fa-code-fork",
+			},
+			{
+				Label: "Graffiti Wall",
+				Path:  "/graffiti/wall",
+				Icon:  "fa-spray-can",
+			},
+			{
+				Label: "Graffiti Stats",
+				Path:  "/graffiti",
+				Icon:  "fa-ranking-star",
+			},
 		},
 	})
 	if len(utils.Config.MevIndexer.Relays) > 0 {
@@ -155,6 +238,12 @@ func createMenuItems(active string) []types.MainMenuItem {
 		Icon:  "fa-code-fork",
 	})
 
+	clientLinks = append(clientLinks, types.NavigationLink{
+		Label: "Network Info",
+		Path:  "/network",
+		Icon:  "fa-network-wired",
+	})
+
 	clientsMenu = append(clientsMenu, types.NavigationGroup{
 		Links: clientLinks,
 	})
@@ -166,11 +255,61 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/validators",
 				Icon:  "fa-table",
 			},
+			{
+				Label: "Validator Dashboard",
+				Path:  "/dashboard",
+				Icon:  "fa-gauge",
+			},
 			{
 				Label: "Validator Activity",
 				Path:  "/validators/activity",
 				Icon:  "fa-tachometer",
 			},
+			{
+				Label: "Client Performance",
+				Path:  "/validators/client_performance",
+				Icon:  "fa-ranking-star",
+			},
+			{
+				Label: "Head Vote Accuracy",
+				Path:  "/validators/head_votes",
+				Icon:  "fa-crosshairs",
+			},
+			{
+				Label: "Inclusion Distance",
+				Path:  "/validators/inclusion_distance",
+				Icon:  "fa-stopwatch",
+			},
+			{
+				Label: "Blob Inclusion",
+				Path:  "/validators/blob_inclusion",
+				Icon:  "fa-droplet",
+			},
+			{
+				Label: "Balance Distribution",
+				Path:  "/validators/balances",
+				Icon:  "fa-scale-balanced",
+			},
+			{
+				Label: "Validator Set Growth",
+				Path:  "/validators/set_growth",
+				Icon:  "fa-chart-line",
+			},
+			{
+				Label: "Withdrawal Throughput",
+				Path:  "/validators/withdrawal_throughput",
+				Icon:  "fa-chart-area",
+			},
+			{
+				Label: "Epoch Rewards",
+				Path:  "/validators/rewards",
+				Icon:  "fa-coins",
+			},
+			{
+				Label: "Penalties & Leaks",
+				Path:  "/validators/penalties",
+				Icon:  "fa-faucet-drip",
+			},
 		},
 	})
 	validatorMenu = append(validatorMenu, types.NavigationGroup{
@@ -190,6 +329,21 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/validators/slashings",
 				Icon:  "fa-user-slash",
 			},
+			{
+				Label: "Whistleblowers",
+				Path:  "/validators/whistleblowers",
+				Icon:  "fa-trophy",
+			},
+			{
+				Label: "Validator Events",
+				Path:  "/validators/events",
+				Icon:  "fa-timeline",
+			},
+			{
+				Label: "Validator Names",
+				Path:  "/validators/names",
+				Icon:  "fa-tags",
+			},
 		},
 	})
 
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/types"
+)
+
+type pageJsonContextKey struct{}
+
+// PageJsonHandler enables the json variant of the frontend pages for requests with a ".json" path suffix.
+// the suffix is stripped before routing, so the regular page handler serves the request and returns the page model
+// instead of the rendered html (see wantsPageJson).
+func PageJsonHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, ".json") && !strings.HasPrefix(r.URL.Path, "/api/") {
+			r.URL.Path = strings.TrimSuffix(r.URL.Path, ".json")
+			if r.URL.Path == "" {
+				r.URL.Path = "/"
+			}
+			r.URL.RawPath = ""
+			r = r.WithContext(context.WithValue(r.Context(), pageJsonContextKey{}, true))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// wantsPageJson checks if the client requested the json variant of a page, either via ".json" path suffix
+// or via an Accept header that prefers json over html.
+// responses negotiated via the Accept header are marked with "Vary: Accept", so shared caches keep the variants apart.
+func wantsPageJson(w http.ResponseWriter, r *http.Request) bool {
+	if isJson, _ := r.Context().Value(pageJsonContextKey{}).(bool); isJson {
+		return true
+	}
+
+	addVaryHeader(w, "Accept")
+
+	accept := r.Header.Get("Accept")
+	return strings.Contains(accept, "application/json") && !strings.Contains(accept, "text/html")
+}
+
+// executeLayoutTemplate renders the page layout, or returns the page model as json if requested by the client.
+func executeLayoutTemplate(w http.ResponseWriter, r *http.Request, pageTemplate *template.Template, data *types.PageData) error {
+	if !wantsPageJson(w, r) {
+		return pageTemplate.ExecuteTemplate(w, "layout", data)
+	}
+
+	if _, isEmpty := data.Data.(*types.Empty); isEmpty || data.Data == nil {
+		// pages without model are the not found variants of the page
+		writePageJsonError(w, http.StatusNotFound, "not found")
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(data.Data)
+}
+
+// addVaryHeader adds the request header to the Vary response header, unless it's already listed.
+func addVaryHeader(w http.ResponseWriter, header string) {
+	for _, value := range w.Header().Values("Vary") {
+		for _, field := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(field), header) {
+				return
+			}
+		}
+	}
+
+	w.Header().Add("Vary", header)
+}
+
+func writePageJsonError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(map[string]string{
+		"error": message,
+	})
+	if err != nil {
+		logrus.Errorf("error writing page json error: %v", err)
+	}
+}
@@ -0,0 +1,248 @@
+package handlers_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/handlers"
+	"github.com/ethpandaops/dora/handlers/handlertest"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/services/fakebeacon"
+)
+
+var testBlockRoot = phase0.Root{0x12, 0x34, 0x56, 0x78}
+
+// newTestHarness creates a harness with a single phase0 block at slot 5 and routes for the tested pages.
+func newTestHarness(t *testing.T) *handlertest.Harness {
+	t.Helper()
+
+	specs := &consensus.ChainSpec{
+		ConfigName:             "test",
+		PresetBase:             "mainnet",
+		SecondsPerSlot:         12 * time.Second,
+		SlotsPerEpoch:          32,
+		DepositContractAddress: make([]byte, 20),
+	}
+	beaconService := fakebeacon.NewBeaconService(specs, time.Now().Add(-10*32*12*time.Second))
+
+	graffiti := [32]byte{}
+	copy(graffiti[:], "dora handler test")
+	block := &phase0.SignedBeaconBlock{
+		Message: &phase0.BeaconBlock{
+			Slot:          5,
+			ProposerIndex: 3,
+			ParentRoot:    phase0.Root{0x01},
+			StateRoot:     phase0.Root{0x02},
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti: graffiti,
+			},
+		},
+	}
+	beaconService.Blocks = append(beaconService.Blocks, &services.CombinedBlockResponse{
+		Root: testBlockRoot,
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:          block.Message.Slot,
+				ProposerIndex: block.Message.ProposerIndex,
+				ParentRoot:    block.Message.ParentRoot,
+				StateRoot:     block.Message.StateRoot,
+			},
+		},
+		Block: &spec.VersionedSignedBeaconBlock{
+			Version: spec.DataVersionPhase0,
+			Phase0:  block,
+		},
+	})
+	beaconService.DbSlots = append(beaconService.DbSlots, &dbtypes.Slot{
+		Slot:         5,
+		Proposer:     3,
+		Status:       dbtypes.Canonical,
+		Root:         testBlockRoot[:],
+		ParentRoot:   block.Message.ParentRoot[:],
+		StateRoot:    block.Message.StateRoot[:],
+		Graffiti:     graffiti[:],
+		GraffitiText: "dora handler test",
+	})
+
+	harness, err := handlertest.NewHarness(beaconService)
+	if err != nil {
+		t.Fatalf("failed creating harness: %v", err)
+	}
+	if err := harness.UseSqliteDatabase(t.TempDir()); err != nil {
+		t.Fatalf("failed initializing database: %v", err)
+	}
+
+	harness.Handle("/", handlers.Index, http.MethodGet)
+	harness.Handle("/epoch/{epoch}", handlers.Epoch, http.MethodGet)
+	harness.Handle("/slot/{slotOrHash}", handlers.Slot, http.MethodGet)
+	harness.Handle("/slot/{root}/raw", handlers.SlotRaw, http.MethodGet)
+
+	return harness
+}
+
+func TestIndexPage(t *testing.T) {
+	harness := newTestHarness(t)
+
+	res := harness.Get("/")
+	if res.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %v: %v", res.Code, res.Body.String())
+	}
+	if !strings.Contains(res.Body.String(), "Dora Test") {
+		t.Errorf("index page does not contain the site name")
+	}
+}
+
+func TestEpochPage(t *testing.T) {
+	harness := newTestHarness(t)
+
+	res := harness.Get("/epoch/0")
+	if res.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %v: %v", res.Code, res.Body.String())
+	}
+	if !strings.Contains(res.Body.String(), `<a href="/slot/5">5</a>`) {
+		t.Errorf("epoch page does not contain the block of slot 5")
+	}
+}
+
+func TestEpochPageVotes(t *testing.T) {
+	harness := newTestHarness(t)
+	beaconService := harness.Beacon
+
+	beaconService.DbEpochs = append(beaconService.DbEpochs, &dbtypes.Epoch{
+		Epoch:             0,
+		ValidatorCount:    64,
+		ValidatorBalance:  64 * 32000000000,
+		Eligible:          64 * 32000000000,
+		VotedTarget:       48 * 32000000000,
+		VotedHead:         40 * 32000000000,
+		VotedTotal:        60 * 32000000000,
+		InclusionDist1:    50,
+		InclusionDist2:    6,
+		InclusionDist3:    3,
+		InclusionDistLate: 1,
+		InclusionDistSum:  50 + 12 + 9 + 6,
+	})
+
+	epochVotes := &beacon.EpochVotes{}
+	epochVotes.CurrentEpoch.TargetVoteAmount = 40 * 32000000000
+	epochVotes.CurrentEpoch.TotalVoteAmount = 50 * 32000000000
+	epochVotes.NextEpoch.TargetVoteAmount = 8 * 32000000000
+	epochVotes.NextEpoch.TotalVoteAmount = 10 * 32000000000
+	beaconService.EpochVotes = map[phase0.Epoch]*beacon.EpochVotes{0: epochVotes}
+
+	res := harness.Get("/epoch/0")
+	if res.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %v: %v", res.Code, res.Body.String())
+	}
+
+	body := res.Body.String()
+	for _, contains := range []string{"Included in Epoch 0:", "Included in Epoch 1:", "4+:", "avg. 1.28 slots"} {
+		if !strings.Contains(body, contains) {
+			t.Errorf("epoch page does not contain %q", contains)
+		}
+	}
+}
+
+func TestSlotPage(t *testing.T) {
+	harness := newTestHarness(t)
+
+	tests := []struct {
+		name     string
+		target   string
+		contains string
+	}{
+		{"by slot", "/slot/5", "dora handler test"},
+		{"by root", "/slot/0x" + hex.EncodeToString(testBlockRoot[:]), "dora handler test"},
+		{"missed slot", "/slot/6", "Missed"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := harness.Get(test.target)
+			if res.Code != http.StatusOK {
+				t.Fatalf("unexpected status code %v: %v", res.Code, res.Body.String())
+			}
+			if !strings.Contains(res.Body.String(), test.contains) {
+				t.Errorf("slot page does not contain %q", test.contains)
+			}
+		})
+	}
+}
+
+func TestSlotRawJson(t *testing.T) {
+	harness := newTestHarness(t)
+
+	rawUrl := "/slot/0x" + hex.EncodeToString(testBlockRoot[:]) + "/raw"
+
+	res := harness.Get(rawUrl)
+	if res.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %v: %v", res.Code, res.Body.String())
+	}
+	if !strings.Contains(res.Body.String(), "Signed beacon block (phase0)") {
+		t.Errorf("raw block page does not contain the block version")
+	}
+
+	res = harness.Get(rawUrl + "?download")
+	if res.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %v: %v", res.Code, res.Body.String())
+	}
+
+	rawBlock := struct {
+		Version string `json:"version"`
+		Data    struct {
+			Message struct {
+				Slot string `json:"slot"`
+			} `json:"message"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(res.Body.Bytes(), &rawBlock); err != nil {
+		t.Fatalf("failed parsing raw block json: %v", err)
+	}
+	if rawBlock.Version != "phase0" || rawBlock.Data.Message.Slot != "5" {
+		t.Errorf("unexpected raw block: version %q, slot %q", rawBlock.Version, rawBlock.Data.Message.Slot)
+	}
+}
+
+func TestPageJsonNegotiation(t *testing.T) {
+	harness := newTestHarness(t)
+
+	tests := []struct {
+		name        string
+		target      string
+		accept      string
+		contentType string
+	}{
+		{"html", "/epoch/0", "text/html,application/xhtml+xml", "text/html"},
+		{"json", "/epoch/0", "application/json", "application/json"},
+		{"not found json", "/slot/0x" + strings.Repeat("ab", 32), "application/json", "application/json"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, test.target, nil)
+			req.Header.Set("Accept", test.accept)
+			res := harness.Do(req)
+
+			if contentType := res.Header().Get("Content-Type"); !strings.HasPrefix(contentType, test.contentType) {
+				t.Errorf("unexpected content type %q", contentType)
+			}
+			if vary := res.Header().Values("Vary"); len(vary) != 1 || vary[0] != "Accept" {
+				t.Errorf("expected single Vary: Accept header, got %v", vary)
+			}
+		})
+	}
+}
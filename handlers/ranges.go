@@ -0,0 +1,354 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+const (
+	maxRangeEpochs = 10000 // max number of epochs in a range view
+	rangeListLimit = 1000  // max number of slots / epochs listed on range views
+)
+
+// parseRangeVars parses the {from}-{to} range of the request, the range is normalized to from <= to.
+func parseRangeVars(r *http.Request) (uint64, uint64, error) {
+	vars := mux.Vars(r)
+	from, err := strconv.ParseUint(vars["from"], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range start: %v", vars["from"])
+	}
+	to, err := strconv.ParseUint(vars["to"], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range end: %v", vars["to"])
+	}
+	if from > to {
+		from, to = to, from
+	}
+	return from, to, nil
+}
+
+// SlotsRange will return the "slots range" page with aggregated stats for a range of slots
+func SlotsRange(w http.ResponseWriter, r *http.Request) {
+	var rangeTemplateFiles = append(layoutTemplateFiles,
+		"slots/range.html",
+		"slots/range_stats.html",
+		annotationsTemplateFile,
+		testRunsTemplateFile,
+	)
+
+	var pageTemplate = templates.GetTemplate(rangeTemplateFiles...)
+
+	firstSlot, lastSlot, pageError := parseRangeVars(r)
+	if pageError == nil && lastSlot-firstSlot >= maxRangeEpochs*services.GlobalBeaconService.GetChainState().GetSpecs().SlotsPerEpoch {
+		pageError = fmt.Errorf("slot range too large (max %v epochs)", maxRangeEpochs)
+	}
+	if pageError == nil {
+		pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	}
+
+	var pageData *models.SlotsRangePageData
+	if pageError == nil {
+		pageData, pageError = getSlotsRangePageData(firstSlot, lastSlot)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slots %v - %v", firstSlot, lastSlot), rangeTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "ranges.go", "SlotsRange", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getSlotsRangePageData(firstSlot uint64, lastSlot uint64) (*models.SlotsRangePageData, error) {
+	pageData := &models.SlotsRangePageData{}
+	pageCacheKey := fmt.Sprintf("slots_range:%v:%v:%v:%v", firstSlot, lastSlot, services.GlobalBeaconService.GetAnnotationsCacheKey(), services.GlobalBeaconService.GetTestRunsCacheKey())
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout, err := buildSlotsRangePageData(firstSlot, lastSlot)
+		if err != nil {
+			pageCall.CacheTimeout = -1
+			return err
+		}
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		if resErr, isErr := pageRes.(error); isErr {
+			return nil, resErr
+		}
+		resData, resOk := pageRes.(*models.SlotsRangePageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildSlotsRangePageData(firstSlot uint64, lastSlot uint64) (*models.SlotsRangePageData, time.Duration, error) {
+	logrus.Debugf("slots range page called: %v-%v", firstSlot, lastSlot)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.SlotsRangePageData{
+		FirstSlot:  firstSlot,
+		LastSlot:   lastSlot,
+		FirstEpoch: uint64(chainState.EpochOfSlot(phase0.Slot(firstSlot))),
+		LastEpoch:  uint64(chainState.EpochOfSlot(phase0.Slot(lastSlot))),
+		StartTime:  chainState.SlotToTime(phase0.Slot(firstSlot)),
+		EndTime:    chainState.SlotToTime(phase0.Slot(lastSlot + 1)),
+		ListLimit:  rangeListLimit,
+	}
+
+	slotStats, err := services.GlobalBeaconService.GetSlotRangeStats(firstSlot, lastSlot)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error aggregating slot stats: %v", err)
+	}
+	pageData.Stats = buildRangePageSlotStats(slotStats)
+	pageData.Annotations = getRangeAnnotations(firstSlot, lastSlot)
+	pageData.TestRuns = getRangeTestRuns(firstSlot, lastSlot)
+
+	// list the most recent slots of the range
+	currentSlot := uint64(chainState.CurrentSlot())
+	listFirstSlot := lastSlot
+	if listFirstSlot > currentSlot {
+		listFirstSlot = currentSlot
+	}
+	if listFirstSlot >= firstSlot {
+		listCount := listFirstSlot - firstSlot
+		if listCount >= rangeListLimit {
+			listCount = rangeListLimit - 1
+			pageData.ListTruncated = true
+		}
+
+		finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+		pageData.Slots = make([]*models.SlotsPageDataSlot, 0)
+		for _, dbSlot := range services.GlobalBeaconService.GetDbBlocksForSlots(listFirstSlot, uint32(listCount), true, true) {
+			if dbSlot.Slot < firstSlot || dbSlot.Slot > listFirstSlot {
+				continue
+			}
+
+			slotEpoch := chainState.EpochOfSlot(phase0.Slot(dbSlot.Slot))
+			slotData := &models.SlotsPageDataSlot{
+				Slot:                  dbSlot.Slot,
+				Epoch:                 uint64(slotEpoch),
+				Ts:                    chainState.SlotToTime(phase0.Slot(dbSlot.Slot)),
+				Finalized:             finalizedEpoch > 0 && finalizedEpoch >= slotEpoch,
+				Status:                uint8(dbSlot.Status),
+				Scheduled:             dbSlot.Slot >= currentSlot && dbSlot.Status == dbtypes.Missing,
+				Synchronized:          dbSlot.SyncParticipation != -1,
+				Proposer:              dbSlot.Proposer,
+				ProposerName:          services.GlobalBeaconService.GetValidatorName(dbSlot.Proposer),
+				AttestationCount:      dbSlot.AttestationCount,
+				DepositCount:          dbSlot.DepositCount,
+				ExitCount:             dbSlot.ExitCount,
+				ProposerSlashingCount: dbSlot.ProposerSlashingCount,
+				AttesterSlashingCount: dbSlot.AttesterSlashingCount,
+				SyncParticipation:     float64(dbSlot.SyncParticipation) * 100,
+				EthTransactionCount:   dbSlot.EthTransactionCount,
+				Graffiti:              dbSlot.Graffiti,
+				BlockRoot:             dbSlot.Root,
+				Annotations:           filterAnnotations(pageData.Annotations, dbSlot.Slot, dbSlot.Slot),
+				TestRuns:              filterTestRuns(pageData.TestRuns, dbSlot.Slot, dbSlot.Slot),
+			}
+			pageData.Slots = append(pageData.Slots, slotData)
+		}
+	}
+
+	return pageData, getRangeCacheTimeout(pageData.LastEpoch), nil
+}
+
+// EpochsRange will return the "epochs range" page with aggregated stats for a range of epochs
+func EpochsRange(w http.ResponseWriter, r *http.Request) {
+	var rangeTemplateFiles = append(layoutTemplateFiles,
+		"epochs/range.html",
+		"slots/range_stats.html",
+		annotationsTemplateFile,
+		testRunsTemplateFile,
+	)
+
+	var pageTemplate = templates.GetTemplate(rangeTemplateFiles...)
+
+	firstEpoch, lastEpoch, pageError := parseRangeVars(r)
+	if pageError == nil && lastEpoch-firstEpoch >= maxRangeEpochs {
+		pageError = fmt.Errorf("epoch range too large (max %v epochs)", maxRangeEpochs)
+	}
+	if pageError == nil {
+		pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	}
+
+	var pageData *models.EpochsRangePageData
+	if pageError == nil {
+		pageData, pageError = getEpochsRangePageData(firstEpoch, lastEpoch)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/epochs", fmt.Sprintf("Epochs %v - %v", firstEpoch, lastEpoch), rangeTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "ranges.go", "EpochsRange", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getEpochsRangePageData(firstEpoch uint64, lastEpoch uint64) (*models.EpochsRangePageData, error) {
+	pageData := &models.EpochsRangePageData{}
+	pageCacheKey := fmt.Sprintf("epochs_range:%v:%v:%v:%v", firstEpoch, lastEpoch, services.GlobalBeaconService.GetAnnotationsCacheKey(), services.GlobalBeaconService.GetTestRunsCacheKey())
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout, err := buildEpochsRangePageData(firstEpoch, lastEpoch)
+		if err != nil {
+			pageCall.CacheTimeout = -1
+			return err
+		}
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		if resErr, isErr := pageRes.(error); isErr {
+			return nil, resErr
+		}
+		resData, resOk := pageRes.(*models.EpochsRangePageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildEpochsRangePageData(firstEpoch uint64, lastEpoch uint64) (*models.EpochsRangePageData, time.Duration, error) {
+	logrus.Debugf("epochs range page called: %v-%v", firstEpoch, lastEpoch)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.EpochsRangePageData{
+		FirstEpoch: firstEpoch,
+		LastEpoch:  lastEpoch,
+		FirstSlot:  uint64(chainState.EpochToSlot(phase0.Epoch(firstEpoch))),
+		LastSlot:   uint64(chainState.EpochToSlot(phase0.Epoch(lastEpoch))) + specs.SlotsPerEpoch - 1,
+		StartTime:  chainState.EpochToTime(phase0.Epoch(firstEpoch)),
+		EndTime:    chainState.EpochToTime(phase0.Epoch(lastEpoch + 1)),
+		ListLimit:  rangeListLimit,
+	}
+
+	slotStats, err := services.GlobalBeaconService.GetSlotRangeStats(pageData.FirstSlot, pageData.LastSlot)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error aggregating slot stats: %v", err)
+	}
+	pageData.Stats = buildRangePageSlotStats(slotStats)
+	pageData.Annotations = getRangeAnnotations(pageData.FirstSlot, pageData.LastSlot)
+	pageData.TestRuns = getRangeTestRuns(pageData.FirstSlot, pageData.LastSlot)
+
+	epochStats, dbEpochs := services.GlobalBeaconService.GetEpochRangeStats(firstEpoch, lastEpoch)
+	pageData.EpochCount = epochStats.EpochCount
+	pageData.SynchronizedCount = epochStats.SynchronizedCount
+	pageData.VotesUnavailableCount = epochStats.VotesUnavailableCount
+	pageData.EligibleEther = epochStats.Eligible
+	pageData.TargetVoted = epochStats.VotedTarget
+	pageData.HeadVoted = epochStats.VotedHead
+	pageData.TotalVoted = epochStats.VotedTotal
+	if epochStats.Eligible > 0 {
+		pageData.TargetParticipation = float64(epochStats.VotedTarget) * 100.0 / float64(epochStats.Eligible)
+		pageData.HeadParticipation = float64(epochStats.VotedHead) * 100.0 / float64(epochStats.Eligible)
+		pageData.TotalParticipation = float64(epochStats.VotedTotal) * 100.0 / float64(epochStats.Eligible)
+	}
+
+	// list the most recent epochs of the range
+	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
+	justifiedEpoch, _ := chainState.GetJustifiedCheckpoint()
+	pageData.Epochs = make([]*models.EpochsPageDataEpoch, 0)
+	for _, dbEpoch := range dbEpochs {
+		if dbEpoch == nil || dbEpoch.Epoch < firstEpoch || dbEpoch.Epoch > lastEpoch {
+			continue
+		}
+		if len(pageData.Epochs) >= rangeListLimit {
+			pageData.ListTruncated = true
+			break
+		}
+
+		finalized := uint64(finalizedEpoch) > dbEpoch.Epoch
+		epochData := &models.EpochsPageDataEpoch{
+			Epoch:                 dbEpoch.Epoch,
+			Ts:                    chainState.EpochToTime(phase0.Epoch(dbEpoch.Epoch)),
+			Finalized:             finalized,
+			Justified:             uint64(justifiedEpoch) > dbEpoch.Epoch,
+			Synchronized:          dbEpoch.ValidatorCount > 0,
+			CanonicalBlockCount:   uint64(dbEpoch.BlockCount),
+			OrphanedBlockCount:    uint64(dbEpoch.OrphanedCount),
+			AttestationCount:      dbEpoch.AttestationCount,
+			DepositCount:          dbEpoch.DepositCount,
+			ExitCount:             dbEpoch.ExitCount,
+			ProposerSlashingCount: dbEpoch.ProposerSlashingCount,
+			AttesterSlashingCount: dbEpoch.AttesterSlashingCount,
+			EligibleEther:         dbEpoch.Eligible,
+			TargetVoted:           dbEpoch.VotedTarget,
+			HeadVoted:             dbEpoch.VotedHead,
+			TotalVoted:            dbEpoch.VotedTotal,
+			EthTransactionCount:   dbEpoch.EthTransactionCount,
+		}
+		epochFirstSlot := uint64(chainState.EpochToSlot(phase0.Epoch(dbEpoch.Epoch)))
+		epochLastSlot := epochFirstSlot + specs.SlotsPerEpoch - 1
+		epochData.Annotations = filterAnnotations(pageData.Annotations, epochFirstSlot, epochLastSlot)
+		epochData.TestRuns = filterTestRuns(pageData.TestRuns, epochFirstSlot, epochLastSlot)
+		epochData.VotesUnavailable = !finalized && dbEpoch.VotedTotal == 0 && !services.GlobalBeaconService.IsEpochInVoteWindow(phase0.Epoch(dbEpoch.Epoch))
+		if dbEpoch.Eligible > 0 {
+			epochData.TargetVoteParticipation = float64(dbEpoch.VotedTarget) * 100.0 / float64(dbEpoch.Eligible)
+			epochData.HeadVoteParticipation = float64(dbEpoch.VotedHead) * 100.0 / float64(dbEpoch.Eligible)
+			epochData.TotalVoteParticipation = float64(dbEpoch.VotedTotal) * 100.0 / float64(dbEpoch.Eligible)
+		}
+		pageData.Epochs = append(pageData.Epochs, epochData)
+	}
+
+	return pageData, getRangeCacheTimeout(lastEpoch), nil
+}
+
+func buildRangePageSlotStats(slotStats *dbtypes.SlotRangeStats) *models.RangePageDataSlotStats {
+	stats := &models.RangePageDataSlotStats{
+		SlotCount:             slotStats.CanonicalCount + slotStats.MissedCount,
+		CanonicalCount:        slotStats.CanonicalCount,
+		MissedCount:           slotStats.MissedCount,
+		OrphanedCount:         slotStats.OrphanedCount,
+		AttestationCount:      slotStats.AttestationCount,
+		DepositCount:          slotStats.DepositCount,
+		ExitCount:             slotStats.ExitCount,
+		ProposerSlashingCount: slotStats.ProposerSlashingCount,
+		AttesterSlashingCount: slotStats.AttesterSlashingCount,
+		EthTransactionCount:   slotStats.EthTransactionCount,
+	}
+
+	if stats.SlotCount > 0 {
+		stats.MissedPercent = float64(stats.MissedCount) * 100.0 / float64(stats.SlotCount)
+		stats.OrphanedPercent = float64(stats.OrphanedCount) * 100.0 / float64(stats.SlotCount)
+	}
+	if slotStats.SyncParticipationCount > 0 {
+		stats.HasSyncParticipation = true
+		stats.SyncParticipation = slotStats.SyncParticipationSum * 100.0 / float64(slotStats.SyncParticipationCount)
+	}
+
+	return stats
+}
+
+// getRangeCacheTimeout returns the page cache timeout for range views ending with the given epoch.
+func getRangeCacheTimeout(lastEpoch uint64) time.Duration {
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	if lastEpoch < uint64(finalizedEpoch) {
+		return 30 * time.Minute
+	}
+	return 12 * time.Second
+}
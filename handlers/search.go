@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -69,11 +70,143 @@ func Search(w http.ResponseWriter, r *http.Request) {
 				}
 				return
 			}
+
+			// execution transaction hash, resolved to the including block
+			if txBlocks := db.GetBlocksByTxHash(blockHash); len(txBlocks) > 0 {
+				if txBlocks[0].Status == dbtypes.Orphaned {
+					http.Redirect(w, r, fmt.Sprintf("/slot/0x%x", txBlocks[0].Root), http.StatusMovedPermanently)
+				} else {
+					http.Redirect(w, r, fmt.Sprintf("/slot/%v", txBlocks[0].Slot), http.StatusMovedPermanently)
+				}
+				return
+			}
+		}
+	}
+
+	if searchLikeRE.MatchString(hashQuery) && services.IsPrefixSearchAllowed(hashQuery) {
+		blocks, validators := searchHexPrefix(hashQuery, 10)
+		if len(blocks)+len(validators) == 1 {
+			if len(blocks) == 1 {
+				if blocks[0].Orphaned {
+					http.Redirect(w, r, fmt.Sprintf("/slot/0x%x", blocks[0].Root), http.StatusMovedPermanently)
+				} else {
+					http.Redirect(w, r, fmt.Sprintf("/slot/%v", blocks[0].Slot), http.StatusMovedPermanently)
+				}
+			} else {
+				http.Redirect(w, r, fmt.Sprintf("/validator/%v", validators[0].Index), http.StatusMovedPermanently)
+			}
+			return
+		} else if len(blocks)+len(validators) > 1 {
+			var resultsTemplateFiles = append(layoutTemplateFiles,
+				"search/results.html",
+			)
+
+			w.Header().Set("Content-Type", "text/html")
+			data := InitPageData(w, r, "search", "/search", fmt.Sprintf("Search: %v", searchQuery), resultsTemplateFiles)
+			data.Data = &models.SearchResultsPageData{
+				Query:      searchQuery,
+				Blocks:     blocks,
+				Validators: validators,
+			}
+			if handleTemplateError(w, r, "search.go", "Search", "", executeLayoutTemplate(w, r, templates.GetTemplate(resultsTemplateFiles...), data)) != nil {
+				return // an error has occurred and was processed
+			}
+			return
+		}
+	}
+
+	if services.IsSearchTermAllowed(searchQuery) {
+		if redirectUrl := searchNameOrGraffiti(r, searchQuery); redirectUrl != "" {
+			http.Redirect(w, r, redirectUrl, http.StatusMovedPermanently)
+			return
 		}
 	}
 
+	w.Header().Set("Content-Type", "text/html")
+	data := InitPageData(w, r, "search", "/search", fmt.Sprintf("Search: %v", searchQuery), notfoundTemplateFiles)
+	if handleTemplateError(w, r, "search.go", "Search", "", executeLayoutTemplate(w, r, templates.GetTemplate(notfoundTemplateFiles...), data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+// searchHexPrefix looks up blocks with a matching block root prefix and validators with a matching pubkey prefix.
+// returns up to limit results per type, sorted by slot / validator index.
+func searchHexPrefix(hexPrefix string, limit int) ([]*models.SearchBlockResult, []*models.SearchValidatorResult) {
+	blocks := []*models.SearchBlockResult{}
+	validators := []*models.SearchValidatorResult{}
+
+	if len(hexPrefix) <= 64 {
+		indexer := services.GlobalBeaconService.GetBeaconIndexer()
+		knownRoots := map[phase0.Root]bool{}
+
+		for _, cachedBlock := range indexer.GetBlocksByRootPrefix(hexPrefix) {
+			header := cachedBlock.GetHeader()
+			if header == nil {
+				continue
+			}
+
+			knownRoots[cachedBlock.Root] = true
+			blocks = append(blocks, &models.SearchBlockResult{
+				Slot:     uint64(header.Message.Slot),
+				Root:     cachedBlock.Root,
+				Orphaned: !indexer.IsCanonicalBlock(cachedBlock, nil),
+			})
+		}
+
+		dbBlocks, err := db.GetSlotsByRootPrefix(hexPrefix, uint32(limit))
+		if err != nil {
+			logrus.Warnf("error searching block root prefix %v: %v", hexPrefix, err)
+		}
+		for _, dbBlock := range dbBlocks {
+			if knownRoots[phase0.Root(dbBlock.Root)] {
+				continue
+			}
+
+			blocks = append(blocks, &models.SearchBlockResult{
+				Slot:     dbBlock.Slot,
+				Root:     phase0.Root(dbBlock.Root),
+				Orphaned: dbBlock.Status == dbtypes.Orphaned,
+			})
+		}
+
+		sort.Slice(blocks, func(a, b int) bool {
+			return blocks[a].Slot > blocks[b].Slot
+		})
+		if len(blocks) > limit {
+			blocks = blocks[:limit]
+		}
+	}
+
+	if len(hexPrefix) <= 96 {
+		for _, validatorIndex := range services.GlobalBeaconService.GetBeaconIndexer().GetValidatorIndicesByPubkeyPrefix(hexPrefix, limit) {
+			validator := services.GlobalBeaconService.GetValidatorByIndex(validatorIndex, false)
+			if validator == nil {
+				continue
+			}
+
+			validators = append(validators, &models.SearchValidatorResult{
+				Index:  uint64(validatorIndex),
+				Name:   services.GlobalBeaconService.GetValidatorName(uint64(validatorIndex)),
+				Pubkey: validator.Validator.PublicKey[:],
+			})
+		}
+
+		sort.Slice(validators, func(a, b int) bool {
+			return validators[a].Index < validators[b].Index
+		})
+	}
+
+	return blocks, validators
+}
+
+// searchNameOrGraffiti looks up validator names & graffitis containing the search query.
+// substring searches are expensive, so they are aborted after the configured search timeout.
+func searchNameOrGraffiti(r *http.Request, searchQuery string) string {
+	searchCtx, cancelSearch := services.GetSearchContext(r.Context())
+	defer cancelSearch()
+
 	names := &dbtypes.SearchNameResult{}
-	err = db.ReaderDb.Get(names, db.EngineQuery(map[dbtypes.DBEngineType]string{
+	err := db.ReaderDb.GetContext(searchCtx, names, db.EngineQuery(map[dbtypes.DBEngineType]string{
 		dbtypes.DBEnginePgsql: `
 			SELECT name
 			FROM validator_names
@@ -86,12 +219,11 @@ func Search(w http.ResponseWriter, r *http.Request) {
 			LIMIT 1`,
 	}), "%"+searchQuery+"%")
 	if err == nil {
-		http.Redirect(w, r, "/slots/filtered?f&f.missing=1&f.orphaned=1&f.pname="+searchQuery, http.StatusMovedPermanently)
-		return
+		return "/slots/filtered?f&f.missing=1&f.orphaned=1&f.pname=" + searchQuery
 	}
 
 	graffiti := &dbtypes.SearchGraffitiResult{}
-	err = db.ReaderDb.Get(graffiti, db.EngineQuery(map[dbtypes.DBEngineType]string{
+	err = db.ReaderDb.GetContext(searchCtx, graffiti, db.EngineQuery(map[dbtypes.DBEngineType]string{
 		dbtypes.DBEnginePgsql: `
 			SELECT graffiti
 			FROM slots
@@ -104,15 +236,10 @@ func Search(w http.ResponseWriter, r *http.Request) {
 			LIMIT 1`,
 	}), "%"+searchQuery+"%")
 	if err == nil {
-		http.Redirect(w, r, "/slots/filtered?f&f.missing=1&f.orphaned=1&f.graffiti="+searchQuery, http.StatusMovedPermanently)
-		return
+		return "/slots/filtered?f&f.missing=1&f.orphaned=1&f.graffiti=" + searchQuery
 	}
 
-	w.Header().Set("Content-Type", "text/html")
-	data := InitPageData(w, r, "search", "/search", fmt.Sprintf("Search: %v", searchQuery), notfoundTemplateFiles)
-	if handleTemplateError(w, r, "search.go", "Search", "", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
-		return // an error has occurred and was processed
-	}
+	return ""
 }
 
 // SearchAhead handles responses for the frontend search boxes
@@ -235,6 +362,42 @@ func SearchAhead(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 		}
+
+		if result == nil && len(search) < 64 && services.IsPrefixSearchAllowed(search) {
+			blocks, _ := searchHexPrefix(search, 10)
+			model := make([]models.SearchAheadSlotsResult, len(blocks))
+			for idx, block := range blocks {
+				model[idx] = models.SearchAheadSlotsResult{
+					Slot:     fmt.Sprintf("%v", block.Slot),
+					Root:     block.Root,
+					Orphaned: block.Orphaned,
+				}
+			}
+			result = model
+		}
+	case "validators":
+		if len(search) == 0 || len(search) > 96 {
+			break
+		}
+		if !searchLikeRE.MatchString(search) || !services.IsPrefixSearchAllowed(search) {
+			break
+		}
+
+		indices := indexer.GetValidatorIndicesByPubkeyPrefix(search, 10)
+		model := make([]models.SearchAheadValidatorResult, 0, len(indices))
+		for _, validatorIndex := range indices {
+			validator := services.GlobalBeaconService.GetValidatorByIndex(validatorIndex, false)
+			if validator == nil {
+				continue
+			}
+
+			model = append(model, models.SearchAheadValidatorResult{
+				Index:  fmt.Sprintf("%v", uint64(validatorIndex)),
+				Name:   utils.FormatGraffitiString(services.GlobalBeaconService.GetValidatorName(uint64(validatorIndex))),
+				Pubkey: fmt.Sprintf("0x%x", validator.Validator.PublicKey[:]),
+			})
+		}
+		result = model
 	case "execblocks":
 		if len(search) == 0 {
 			break
@@ -329,8 +492,14 @@ func SearchAhead(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 	case "graffiti":
+		if !services.IsSearchTermAllowed(search) {
+			break
+		}
+		searchCtx, cancelSearch := services.GetSearchContext(r.Context())
+		defer cancelSearch()
+
 		graffiti := &dbtypes.SearchAheadGraffitiResult{}
-		err = db.ReaderDb.Select(graffiti, db.EngineQuery(map[dbtypes.DBEngineType]string{
+		err = db.ReaderDb.SelectContext(searchCtx, graffiti, db.EngineQuery(map[dbtypes.DBEngineType]string{
 			dbtypes.DBEnginePgsql: `
 				SELECT graffiti, count(*) as count
 				FROM slots
@@ -357,8 +526,14 @@ func SearchAhead(w http.ResponseWriter, r *http.Request) {
 			result = model
 		}
 	case "valname":
+		if !services.IsSearchTermAllowed(search) {
+			break
+		}
+		searchCtx, cancelSearch := services.GetSearchContext(r.Context())
+		defer cancelSearch()
+
 		names := &dbtypes.SearchAheadValidatorNameResult{}
-		err = db.ReaderDb.Select(names, db.EngineQuery(map[dbtypes.DBEngineType]string{
+		err = db.ReaderDb.SelectContext(searchCtx, names, db.EngineQuery(map[dbtypes.DBEngineType]string{
 			dbtypes.DBEnginePgsql: `
 				SELECT name, count(*) as count
 				FROM validator_names
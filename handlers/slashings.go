@@ -86,7 +86,7 @@ func Slashings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "slashings.go", "Slashings", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "slashings.go", "Slashings", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -149,9 +149,7 @@ func buildFilteredSlashingsPageData(pageIdx uint64, pageSize uint64, minSlot uin
 		pageData.IsDefaultPage = true
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pageData.PageSize = pageSize
 	pageData.TotalPages = pageIdx
 	pageData.CurrentPageIndex = pageIdx
@@ -186,6 +184,7 @@ func buildFilteredSlashingsPageData(pageIdx uint64, pageSize uint64, minSlot uin
 			ValidatorName:   services.GlobalBeaconService.GetValidatorName(slashing.ValidatorIndex),
 			SlasherIndex:    slashing.SlasherIndex,
 			SlasherName:     services.GlobalBeaconService.GetValidatorName(slashing.SlasherIndex),
+			SlasherReward:   slashing.WhistleblowerReward,
 			ValidatorStatus: "",
 		}
 
@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/indexer/beacon"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
@@ -47,6 +48,7 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 		"slot/deposit_requests.html",
 		"slot/withdrawal_requests.html",
 		"slot/consolidation_requests.html",
+		"slot/tab_loader.html",
 	)
 	var notfoundTemplateFiles = append(layoutTemplateFiles,
 		"slot/notfound.html",
@@ -62,7 +64,7 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 		if err != nil || blockSlot >= 2147483648 { // block slot must be lower then max int4
 			data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slot %v", slotOrHash), notfoundTemplateFiles)
 			w.Header().Set("Content-Type", "text/html")
-			if handleTemplateError(w, r, "slot.go", "Slot", "blockSlot", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			if handleTemplateError(w, r, "slot.go", "Slot", "blockSlot", executeLayoutTemplate(w, r, templates.GetTemplate(notfoundTemplateFiles...), data)) != nil {
 				return // an error has occurred and was processed
 			}
 			return
@@ -82,10 +84,16 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if pageData == nil {
+		if !services.GlobalBeaconService.IsLiveDataAvailable() {
+			// the block might just not be loadable without a beacon node
+			handlePageError(w, r, services.ErrLiveDataUnavailable)
+			return
+		}
+
 		data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slot %v", slotOrHash), notfoundTemplateFiles)
 		data.Data = "slot"
 		w.Header().Set("Content-Type", "text/html")
-		if handleTemplateError(w, r, "slot.go", "Slot", "notFound", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+		if handleTemplateError(w, r, "slot.go", "Slot", "notFound", executeLayoutTemplate(w, r, templates.GetTemplate(notfoundTemplateFiles...), data)) != nil {
 			return // an error has occurred and was processed
 		}
 		return
@@ -120,7 +128,7 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 	data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slot %v", slotOrHash), slotTemplateFiles)
 	data.Data = pageData
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "index.go", "Slot", "", template.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "index.go", "Slot", "", executeLayoutTemplate(w, r, template, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -160,6 +168,257 @@ func SlotBlob(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// depth from which objects & arrays are collapsed in the raw block tab
+const slotRawCollapseDepth = 4
+
+// SlotRaw handles responses for the raw json tab of the slot page
+func SlotRaw(w http.ResponseWriter, r *http.Request) {
+	var rawTemplateFiles = []string{
+		"slot/raw.html",
+	}
+
+	vars := mux.Vars(r)
+	blockRoot, err := hex.DecodeString(strings.Replace(vars["root"], "0x", "", -1))
+	if err != nil || len(blockRoot) != 32 {
+		http.Error(w, "Invalid block root", http.StatusBadRequest)
+		return
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+
+	blockData, err := services.GlobalBeaconService.GetSlotDetailsByBlockroot(r.Context(), phase0.Root(blockRoot))
+	if err != nil {
+		logrus.WithError(err).Error("error loading block for raw json")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+		return
+	}
+	if blockData == nil || blockData.Block == nil {
+		http.Error(w, "Block not found", http.StatusNotFound)
+		return
+	}
+
+	blockJson, err := services.GlobalBeaconService.GetBeaconIndexer().GetBlockJson(blockData.Block)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding raw block json")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+		return
+	}
+
+	// same envelope as returned by the beacon node block api
+	rawJson, err := json.MarshalIndent(struct {
+		Version string          `json:"version"`
+		Data    json.RawMessage `json:"data"`
+	}{
+		Version: blockData.Block.Version.String(),
+		Data:    blockJson,
+	}, "", "  ")
+	if err != nil {
+		logrus.WithError(err).Error("error encoding raw block json")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+		return
+	}
+
+	blockSlot, _ := blockData.Block.Slot()
+	slot := uint64(blockSlot)
+	if r.URL.Query().Has("download") {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"block-%v-0x%x.json\"", slot, blockRoot))
+		w.Write(rawJson)
+		return
+	}
+
+	rawDecoder := json.NewDecoder(bytes.NewReader(rawJson))
+	rawDecoder.UseNumber()
+	rawTree, err := buildSlotRawJsonNode(rawDecoder, "", false, 0)
+	if err != nil {
+		logrus.WithError(err).Error("error parsing raw block json")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+		return
+	}
+
+	rawData := &models.SlotPageRawBlock{
+		Slot:      slot,
+		BlockRoot: blockRoot,
+		Version:   blockData.Block.Version.String(),
+		Json:      string(rawJson),
+		Tree:      rawTree,
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	handleTemplateError(w, r, "slot.go", "SlotRaw", "", templates.GetTemplate(rawTemplateFiles...).ExecuteTemplate(w, "slot_raw", rawData))
+}
+
+// buildSlotRawJsonNode reads the next json value from the decoder and converts it to a display tree, preserving the key order.
+func buildSlotRawJsonNode(decoder *json.Decoder, key string, keyIsIndex bool, depth int) (*models.SlotPageRawNode, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &models.SlotPageRawNode{
+		Key:        key,
+		KeyIsIndex: keyIsIndex,
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		node.IsObject = value == '{'
+		node.IsArray = value == '['
+		node.Collapsed = depth >= slotRawCollapseDepth
+		node.Children = []*models.SlotPageRawNode{}
+
+		for idx := 0; decoder.More(); idx++ {
+			childKey := strconv.Itoa(idx)
+			if node.IsObject {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				childKey, _ = keyToken.(string)
+			}
+
+			childNode, err := buildSlotRawJsonNode(decoder, childKey, node.IsArray, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, childNode)
+		}
+
+		// closing delimiter
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+	case string:
+		// html escaping is done by the template
+		valueJson := &bytes.Buffer{}
+		valueEncoder := json.NewEncoder(valueJson)
+		valueEncoder.SetEscapeHTML(false)
+		valueEncoder.Encode(value)
+		node.Value = strings.TrimSuffix(valueJson.String(), "\n")
+		node.ValueType = "string"
+	case json.Number:
+		node.Value = value.String()
+		node.ValueType = "number"
+	case bool:
+		node.Value = strconv.FormatBool(value)
+		node.ValueType = "bool"
+	case nil:
+		node.Value = "null"
+		node.ValueType = "null"
+	}
+
+	return node, nil
+}
+
+const (
+	// number of attestations & blobs per page, only the first page is embedded in the slot page,
+	// further pages are loaded by the tabs on demand to keep the page size of huge blocks reasonable.
+	slotPageAttestationsPageSize = 64
+	slotPageBlobsPageSize        = 32
+)
+
+// SlotAttestations handles responses for the lazy loaded pages of the attestations tab
+func SlotAttestations(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = []string{
+		"slot/attestations.html",
+		"slot/tab_loader.html",
+	}
+
+	blockData, pageIdx := getSlotTabPageBlock(w, r)
+	if blockData == nil {
+		return
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	epoch := chainState.EpochOfSlot(blockData.Header.Message.Slot)
+
+	var epochStatsValues *beacon.EpochStatsValues
+	if epoch >= finalizedEpoch {
+		beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+		if epochStats := beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
+			epochStatsValues = beaconIndexer.GetEpochStatsValues(epochStats)
+		}
+	}
+
+	attestations, _ := blockData.Block.Attestations()
+	offset := pageIdx * slotPageAttestationsPageSize
+	pageData := &models.SlotPageAttestationsPage{
+		Attestations: getSlotPageAttestations(blockData, epochStatsValues, int(offset), slotPageAttestationsPageSize),
+	}
+	if uint64(len(attestations)) > offset+slotPageAttestationsPageSize {
+		pageData.NextPage = pageIdx + 1
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	handleTemplateError(w, r, "slot.go", "SlotAttestations", "", templates.GetTemplate(pageTemplateFiles...).ExecuteTemplate(w, "slot_attestations_page", pageData))
+}
+
+// SlotBlobs handles responses for the lazy loaded pages of the blob sidecars tab
+func SlotBlobs(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = []string{
+		"slot/blobs.html",
+		"slot/tab_loader.html",
+	}
+
+	blockData, pageIdx := getSlotTabPageBlock(w, r)
+	if blockData == nil {
+		return
+	}
+
+	blobKzgCommitments, _ := blockData.Block.BlobKZGCommitments()
+	offset := pageIdx * slotPageBlobsPageSize
+	pageData := &models.SlotPageBlobsPage{
+		Blobs: getSlotPageBlobs(blobKzgCommitments, int(offset), slotPageBlobsPageSize),
+	}
+	if uint64(len(blobKzgCommitments)) > offset+slotPageBlobsPageSize {
+		pageData.NextPage = pageIdx + 1
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	handleTemplateError(w, r, "slot.go", "SlotBlobs", "", templates.GetTemplate(pageTemplateFiles...).ExecuteTemplate(w, "slot_blobs_page", pageData))
+}
+
+// getSlotTabPageBlock loads the block & requested page index for the lazy loaded slot page tabs.
+// returns nil if the request has already been answered with an error.
+func getSlotTabPageBlock(w http.ResponseWriter, r *http.Request) (*services.CombinedBlockResponse, uint64) {
+	vars := mux.Vars(r)
+	blockRoot, err := hex.DecodeString(strings.Replace(vars["root"], "0x", "", -1))
+	if err != nil || len(blockRoot) != 32 {
+		http.Error(w, "Invalid block root", http.StatusBadRequest)
+		return nil, 0
+	}
+
+	pageIdx, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
+	if err != nil || pageIdx > math.MaxInt32 {
+		http.Error(w, "Invalid page", http.StatusBadRequest)
+		return nil, 0
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		handlePageError(w, r, err)
+		return nil, 0
+	}
+
+	blockData, err := services.GlobalBeaconService.GetSlotDetailsByBlockroot(r.Context(), phase0.Root(blockRoot))
+	if err != nil {
+		logrus.WithError(err).Error("error loading block for slot tab page")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+		return nil, 0
+	}
+	if blockData == nil || blockData.Block == nil {
+		http.Error(w, "Block not found", http.StatusNotFound)
+		return nil, 0
+	}
+
+	return blockData, pageIdx
+}
+
 func getSlotPageData(blockSlot int64, blockRoot []byte) (*models.SlotPageData, error) {
 	pageData := &models.SlotPageData{}
 	pageCacheKey := fmt.Sprintf("slot:%v:%x", blockSlot, blockRoot)
@@ -223,7 +482,7 @@ func buildSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (
 	if chainState.EpochOfSlot(slot) >= finalizedEpoch {
 		beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
 		if epochStats := beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
-			epochStatsValues = epochStats.GetOrLoadValues(beaconIndexer, true, false)
+			epochStatsValues = beaconIndexer.GetEpochStatsValues(epochStats)
 		}
 	}
 
@@ -255,6 +514,9 @@ func buildSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (
 			pageData.Proposer = db.GetSlotAssignment(uint64(slot))
 		}
 		pageData.ProposerName = services.GlobalBeaconService.GetValidatorName(pageData.Proposer)
+		if !pageData.Future && slot > 0 {
+			pageData.MissedDuties = getSlotPageMissedDuties(slot, pageData.Proposer, epochStatsValues)
+		}
 	} else {
 		if blockData.Orphaned {
 			pageData.Status = uint16(models.SlotStatusOrphaned)
@@ -265,6 +527,40 @@ func buildSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (
 		pageData.ProposerName = services.GlobalBeaconService.GetValidatorName(pageData.Proposer)
 		pageData.Block = getSlotPageBlockData(blockData, epochStatsValues)
 
+		// list all known blocks for this slot
+		slotBlocks := services.GlobalBeaconService.GetSlotBlockCandidates(slot)
+		if len(slotBlocks) > 1 {
+			pageData.SlotBlocks = make([]*models.SlotPageSlotBlock, len(slotBlocks))
+			for idx, slotBlock := range slotBlocks {
+				pageData.SlotBlocks[idx] = &models.SlotPageSlotBlock{
+					BlockRoot:    slotBlock.Root[:],
+					Status:       uint16(slotBlock.Status),
+					Proposer:     slotBlock.Proposer,
+					ProposerName: services.GlobalBeaconService.GetValidatorName(slotBlock.Proposer),
+					Selected:     slotBlock.Root == blockData.Root,
+				}
+			}
+
+			pageData.Badges = append(pageData.Badges, &models.SlotPageBlockBadge{
+				Title:       "Multiple Blocks",
+				Icon:        "fa-code-branch",
+				Description: fmt.Sprintf("%v blocks are known for this slot", len(slotBlocks)),
+				ClassName:   "text-bg-info",
+			})
+		}
+
+		// check execution fees (priority fees are loaded from the el receipts of finalized blocks)
+		if pageData.Block.ExecutionData != nil {
+			// burned fees are calculated from the exact base fee, the indexed values are clamped to the db column range
+			if baseFeePerGas := pageData.Block.ExecutionData.BaseFeePerGas; baseFeePerGas != nil {
+				pageData.Block.ExecutionData.BurnedFees = new(big.Int).Mul(baseFeePerGas, new(big.Int).SetUint64(pageData.Block.ExecutionData.GasUsed))
+			}
+			if dbSlot := db.GetSlotByRoot(blockData.Root[:]); dbSlot != nil && dbSlot.EthPriorityFees != nil {
+				pageData.Block.ExecutionData.HasPriorityFees = true
+				pageData.Block.ExecutionData.PriorityFees = new(big.Int).Mul(new(big.Int).SetUint64(*dbSlot.EthPriorityFees), utils.GWEI)
+			}
+		}
+
 		// check mev block
 		if pageData.Block.ExecutionData != nil {
 			mevBlock := db.GetMevBlockByBlockHash(pageData.Block.ExecutionData.BlockHash)
@@ -290,6 +586,81 @@ func buildSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (
 	return pageData, cacheTimeout
 }
 
+// getSlotPageMissedDuties collects the duties that were scheduled for a missed slot and looks up blocks
+// that have been proposed for the slot on a non-canonical fork.
+func getSlotPageMissedDuties(slot phase0.Slot, proposer uint64, epochStatsValues *beacon.EpochStatsValues) *models.SlotPageMissedDuties {
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	epoch := chainState.EpochOfSlot(slot)
+
+	missedDuties := &models.SlotPageMissedDuties{
+		ForkBlocks: []*models.SlotPageForkBlock{},
+	}
+
+	// attestation committees
+	if epochStatsValues != nil && epochStatsValues.AttesterDuties != nil {
+		if slotIndex := int(chainState.SlotToSlotIndex(slot)); slotIndex < len(epochStatsValues.AttesterDuties) {
+			committees := epochStatsValues.AttesterDuties[slotIndex]
+			missedDuties.CommitteeCount = uint64(len(committees))
+			for _, committee := range committees {
+				missedDuties.AttesterCount += uint64(len(committee))
+			}
+		}
+	} else if dbEpochs := db.GetEpochs(uint64(epoch), 1); len(dbEpochs) > 0 && dbEpochs[0].Epoch == uint64(epoch) {
+		committeeCount := dbEpochs[0].ValidatorCount / specs.SlotsPerEpoch / specs.TargetCommitteeSize
+		if committeeCount > specs.MaxCommitteesPerSlot {
+			committeeCount = specs.MaxCommitteesPerSlot
+		}
+		if committeeCount < 1 {
+			committeeCount = 1
+		}
+		missedDuties.CommitteeCount = committeeCount
+	}
+
+	// sync committee
+	if specs.IsAltairActive(epoch) {
+		var syncAssignments []uint64
+		if epochStatsValues != nil {
+			syncAssignments = make([]uint64, len(epochStatsValues.SyncCommitteeDuties))
+			for j := 0; j < len(epochStatsValues.SyncCommitteeDuties); j++ {
+				syncAssignments[j] = uint64(epochStatsValues.SyncCommitteeDuties[j])
+			}
+		}
+		if len(syncAssignments) == 0 {
+			syncPeriod := uint64(epoch) / specs.EpochsPerSyncCommitteePeriod
+			syncAssignments = db.GetSyncAssignmentsForPeriod(syncPeriod)
+		}
+
+		missedDuties.SyncCommittee = make([]types.NamedValidator, len(syncAssignments))
+		for idx, vidx := range syncAssignments {
+			missedDuties.SyncCommittee[idx] = types.NamedValidator{
+				Index: vidx,
+				Name:  services.GlobalBeaconService.GetValidatorName(vidx),
+			}
+		}
+	}
+
+	// blocks for this slot on other forks
+	for _, slotBlock := range services.GlobalBeaconService.GetSlotBlockCandidates(slot) {
+		if slotBlock.Status != dbtypes.Orphaned {
+			continue
+		}
+
+		forkBlock := &models.SlotPageForkBlock{
+			BlockRoot:    slotBlock.Root[:],
+			Proposer:     slotBlock.Proposer,
+			ProposerName: services.GlobalBeaconService.GetValidatorName(slotBlock.Proposer),
+			ByProposer:   slotBlock.Proposer == proposer,
+		}
+		if forkBlock.ByProposer {
+			missedDuties.ProposerForkBlock = true
+		}
+		missedDuties.ForkBlocks = append(missedDuties.ForkBlocks, forkBlock)
+	}
+
+	return missedDuties
+}
+
 func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsValues *beacon.EpochStatsValues) *models.SlotPageBlockData {
 	chainState := services.GlobalBeaconService.GetChainState()
 	specs := chainState.GetSpecs()
@@ -325,128 +696,33 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 		SlashingsCount:         uint64(len(proposerSlashings)) + uint64(len(attesterSlashings)),
 	}
 
-	epoch := chainState.EpochOfSlot(blockData.Header.Message.Slot)
-	assignmentsMap := make(map[phase0.Epoch]*beacon.EpochStatsValues)
-	assignmentsLoaded := make(map[phase0.Epoch]bool)
-	assignmentsMap[epoch] = epochStatsValues
-	assignmentsLoaded[epoch] = true
-
-	pageData.Attestations = make([]*models.SlotPageAttestation, pageData.AttestationsCount)
-	for i, attVersioned := range attestations {
-		attData, _ := attVersioned.Data()
-		if attData == nil {
-			continue
-		}
-
-		attSignature, err := attVersioned.Signature()
-		if err != nil {
-			continue
-		}
-
-		attAggregationBits, err := attVersioned.AggregationBits()
-		if err != nil {
-			continue
-		}
-
-		attEpoch := chainState.EpochOfSlot(attData.Slot)
-		if !assignmentsLoaded[attEpoch] { // get epoch duties from cache
-			beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
-			if epochStats := beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
-				epochStatsValues := epochStats.GetOrLoadValues(beaconIndexer, true, false)
-
-				assignmentsMap[attEpoch] = epochStatsValues
-				assignmentsLoaded[attEpoch] = true
-			}
-		}
-
-		attPageData := models.SlotPageAttestation{
-			Slot:            uint64(attData.Slot),
-			AggregationBits: attAggregationBits,
-			Signature:       attSignature[:],
-			BeaconBlockRoot: attData.BeaconBlockRoot[:],
-			SourceEpoch:     uint64(attData.Source.Epoch),
-			SourceRoot:      attData.Source.Root[:],
-			TargetEpoch:     uint64(attData.Target.Epoch),
-			TargetRoot:      attData.Target.Root[:],
-		}
-
-		var attAssignments []uint64
-		includedValidators := []uint64{}
-
-		if attVersioned.Version >= spec.DataVersionElectra {
-			// EIP-7549 attestation
-			attAssignments = []uint64{}
-			attPageData.CommitteeIndex = []uint64{}
-
-			committeeBits, err := attVersioned.CommitteeBits()
-			if err != nil {
-				continue
-			}
-
-			attBitsOffset := uint64(0)
-			for _, committee := range committeeBits.BitIndices() {
-				if uint64(committee) >= specs.MaxCommitteesPerSlot {
-					continue
-				}
-
-				attPageData.CommitteeIndex = append(attPageData.CommitteeIndex, uint64(committee))
-				if assignmentsMap[attEpoch] != nil {
-					slotIndex := int(chainState.SlotToSlotIndex(attData.Slot))
-					committeeAssignments := assignmentsMap[attEpoch].AttesterDuties[slotIndex][uint64(committee)]
-					if len(committeeAssignments) == 0 {
-						break
-					}
-
-					committeeAssignmentsInt := make([]uint64, 0)
-					for j := 0; j < len(committeeAssignments); j++ {
-						if attAggregationBits.BitAt(attBitsOffset + uint64(j)) {
-							includedValidators = append(includedValidators, uint64(committeeAssignments[j]))
-						}
-						committeeAssignmentsInt = append(committeeAssignmentsInt, uint64(committeeAssignments[j]))
-					}
-
-					attBitsOffset += uint64(len(committeeAssignments))
-					attAssignments = append(attAssignments, committeeAssignmentsInt...)
-				}
-			}
-		} else {
-			// pre-electra attestation
-			if assignmentsMap[attEpoch] != nil {
-				slotIndex := int(chainState.SlotToSlotIndex(attData.Slot))
-				committeeAssignments := assignmentsMap[attEpoch].AttesterDuties[slotIndex][uint64(attData.Index)]
-				committeeAssignmentsInt := make([]uint64, 0)
-				for j := 0; j < len(committeeAssignments); j++ {
-					if attAggregationBits.BitAt(uint64(j)) {
-						includedValidators = append(includedValidators, uint64(committeeAssignments[j]))
-					}
-					committeeAssignmentsInt = append(committeeAssignmentsInt, uint64(committeeAssignments[j]))
-				}
-
-				attAssignments = committeeAssignmentsInt
-			} else {
-				attAssignments = []uint64{}
-			}
+	if blockSizes, err := services.GlobalBeaconService.GetBeaconIndexer().GetBlockSszSizes(blockData.Block); err == nil {
+		pageData.BlockSize = blockSizes.Total
+		pageData.AttestationsSize = blockSizes.Attestations
+		pageData.PayloadSize = blockSizes.Payload
+		pageData.BlobRefsSize = blockSizes.BlobCommitments
+	}
 
-			attPageData.CommitteeIndex = []uint64{uint64(attData.Index)}
-		}
+	epoch := chainState.EpochOfSlot(blockData.Header.Message.Slot)
 
-		attPageData.Validators = make([]types.NamedValidator, len(attAssignments))
-		for j := 0; j < len(attAssignments); j++ {
-			attPageData.Validators[j] = types.NamedValidator{
-				Index: attAssignments[j],
-				Name:  services.GlobalBeaconService.GetValidatorName(attAssignments[j]),
-			}
+	// vote deduplication stats (only available for blocks in the unfinalized cache)
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	cachedBlock := beaconIndexer.GetBlockByRoot(blockData.Root)
+	if cachedBlock != nil {
+		attestationVotes := beaconIndexer.GetBlockAttestationVotes(cachedBlock)
+		pageData.AttestationVotesAvailable = attestationVotes != nil
+		for _, attVotes := range attestationVotes {
+			pageData.AttestationIncludedBits += attVotes.IncludedBits
+			pageData.AttestationNewVotes += attVotes.NewVotes
 		}
+	}
 
-		attPageData.IncludedValidators = make([]types.NamedValidator, len(includedValidators))
-		for j := 0; j < len(includedValidators); j++ {
-			attPageData.IncludedValidators[j] = types.NamedValidator{
-				Index: includedValidators[j],
-				Name:  services.GlobalBeaconService.GetValidatorName(includedValidators[j]),
-			}
-		}
+	pageData.SeenBy = getSlotPageSeenBy(cachedBlock, blockData.Root)
 
-		pageData.Attestations[i] = &attPageData
+	// only the first page of attestations is embedded, further pages are loaded via SlotAttestations
+	pageData.Attestations = getSlotPageAttestations(blockData, epochStatsValues, 0, slotPageAttestationsPageSize)
+	if pageData.AttestationsCount > slotPageAttestationsPageSize {
+		pageData.AttestationsNextPage = 1
 	}
 
 	pageData.Deposits = make([]*models.SlotPageDeposit, pageData.DepositsCount)
@@ -551,7 +827,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 		}
 	}
 
-	if specs.AltairForkEpoch != nil && uint64(epoch) >= *specs.AltairForkEpoch && syncAggregate != nil {
+	if specs.IsAltairActive(epoch) && syncAggregate != nil {
 		pageData.SyncAggregateBits = syncAggregate.SyncCommitteeBits
 		pageData.SyncAggregateSignature = syncAggregate.SyncCommitteeSignature[:]
 		var syncAssignments []uint64
@@ -581,7 +857,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 		pageData.SyncAggParticipation = utils.SyncCommitteeParticipation(pageData.SyncAggregateBits, specs.SyncCommitteeSize)
 	}
 
-	if specs.BellatrixForkEpoch != nil && uint64(epoch) >= *specs.BellatrixForkEpoch {
+	if specs.IsBellatrixActive(epoch) {
 		switch blockData.Block.Version {
 		case spec.DataVersionBellatrix:
 			if blockData.Block.Bellatrix == nil {
@@ -605,7 +881,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 				Timestamp:     uint64(executionPayload.Timestamp),
 				Time:          time.Unix(int64(executionPayload.Timestamp), 0),
 				ExtraData:     executionPayload.ExtraData,
-				BaseFeePerGas: baseFeePerGas.Uint64(),
+				BaseFeePerGas: baseFeePerGas,
 				BlockHash:     executionPayload.BlockHash[:],
 				BlockNumber:   uint64(executionPayload.BlockNumber),
 			}
@@ -632,7 +908,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 				Timestamp:     uint64(executionPayload.Timestamp),
 				Time:          time.Unix(int64(executionPayload.Timestamp), 0),
 				ExtraData:     executionPayload.ExtraData,
-				BaseFeePerGas: baseFeePerGas.Uint64(),
+				BaseFeePerGas: baseFeePerGas,
 				BlockHash:     executionPayload.BlockHash[:],
 				BlockNumber:   uint64(executionPayload.BlockNumber),
 			}
@@ -654,7 +930,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 				Timestamp:     uint64(executionPayload.Timestamp),
 				Time:          time.Unix(int64(executionPayload.Timestamp), 0),
 				ExtraData:     executionPayload.ExtraData,
-				BaseFeePerGas: executionPayload.BaseFeePerGas.Uint64(),
+				BaseFeePerGas: executionPayload.BaseFeePerGas.ToBig(),
 				BlockHash:     executionPayload.BlockHash[:],
 				BlockNumber:   uint64(executionPayload.BlockNumber),
 			}
@@ -676,7 +952,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 				Timestamp:     uint64(executionPayload.Timestamp),
 				Time:          time.Unix(int64(executionPayload.Timestamp), 0),
 				ExtraData:     executionPayload.ExtraData,
-				BaseFeePerGas: executionPayload.BaseFeePerGas.Uint64(),
+				BaseFeePerGas: executionPayload.BaseFeePerGas.ToBig(),
 				BlockHash:     executionPayload.BlockHash[:],
 				BlockNumber:   uint64(executionPayload.BlockNumber),
 			}
@@ -712,13 +988,9 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 
 	if specs.DenebForkEpoch != nil && uint64(epoch) >= *specs.DenebForkEpoch {
 		pageData.BlobsCount = uint64(len(blobKzgCommitments))
-		pageData.Blobs = make([]*models.SlotPageBlob, pageData.BlobsCount)
-		for i := range blobKzgCommitments {
-			blobData := &models.SlotPageBlob{
-				Index:         uint64(i),
-				KzgCommitment: blobKzgCommitments[i][:],
-			}
-			pageData.Blobs[i] = blobData
+		pageData.Blobs = getSlotPageBlobs(blobKzgCommitments, 0, slotPageBlobsPageSize)
+		if pageData.BlobsCount > slotPageBlobsPageSize {
+			pageData.BlobsNextPage = 1
 		}
 	}
 
@@ -734,6 +1006,242 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 	return pageData
 }
 
+// getSlotPageAttestations builds the attestation details for a page of attestations included in the block.
+func getSlotPageAttestations(blockData *services.CombinedBlockResponse, epochStatsValues *beacon.EpochStatsValues, offset int, limit int) []*models.SlotPageAttestation {
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	attestations, _ := blockData.Block.Attestations()
+
+	epoch := chainState.EpochOfSlot(blockData.Header.Message.Slot)
+	assignmentsMap := make(map[phase0.Epoch]*beacon.EpochStatsValues)
+	assignmentsLoaded := make(map[phase0.Epoch]bool)
+	assignmentsMap[epoch] = epochStatsValues
+	assignmentsLoaded[epoch] = true
+
+	// vote deduplication stats (only available for blocks in the unfinalized cache)
+	var attestationVotes map[int]*beacon.EpochVotesAttestation
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	if cachedBlock := beaconIndexer.GetBlockByRoot(blockData.Root); cachedBlock != nil {
+		attestationVotes = beaconIndexer.GetBlockAttestationVotes(cachedBlock)
+	}
+
+	headVoteChecks := map[slotPageHeadVoteKey]*slotPageHeadVoteCheck{}
+
+	pageAttestations := []*models.SlotPageAttestation{}
+	for i := offset; i < len(attestations) && i < offset+limit; i++ {
+		attVersioned := attestations[i]
+		attData, _ := attVersioned.Data()
+		if attData == nil {
+			continue
+		}
+
+		attSignature, err := attVersioned.Signature()
+		if err != nil {
+			continue
+		}
+
+		attAggregationBits, err := attVersioned.AggregationBits()
+		if err != nil {
+			continue
+		}
+
+		attEpoch := chainState.EpochOfSlot(attData.Slot)
+		if !assignmentsLoaded[attEpoch] { // get epoch duties from cache
+			if epochStats := beaconIndexer.GetEpochStats(attEpoch, nil); epochStats != nil {
+				epochStatsValues := beaconIndexer.GetEpochStatsValues(epochStats)
+
+				assignmentsMap[attEpoch] = epochStatsValues
+				assignmentsLoaded[attEpoch] = true
+			}
+		}
+
+		attPageData := models.SlotPageAttestation{
+			Index:           uint64(i),
+			Slot:            uint64(attData.Slot),
+			AggregationBits: attAggregationBits,
+			Signature:       attSignature[:],
+			BeaconBlockRoot: attData.BeaconBlockRoot[:],
+			SourceEpoch:     uint64(attData.Source.Epoch),
+			SourceRoot:      attData.Source.Root[:],
+			TargetEpoch:     uint64(attData.Target.Epoch),
+			TargetRoot:      attData.Target.Root[:],
+		}
+
+		attPageData.Committees = []*models.SlotPageAttestationCommittee{}
+		attPageData.Validators = []types.NamedValidator{}
+		attPageData.IncludedValidators = []types.NamedValidator{}
+		attPageData.MissingValidators = []types.NamedValidator{}
+
+		// resolves the assigned validators of a committee and checks their inclusion in the aggregation bits
+		addCommittee := func(committeeIndex uint64, aggregationBitsOffset uint64) uint64 {
+			attPageData.CommitteeIndex = append(attPageData.CommitteeIndex, committeeIndex)
+
+			epochStatsValues := assignmentsMap[attEpoch]
+			if epochStatsValues == nil || epochStatsValues.AttesterDuties == nil {
+				return 0
+			}
+
+			slotIndex := int(chainState.SlotToSlotIndex(attData.Slot))
+			if slotIndex >= len(epochStatsValues.AttesterDuties) || committeeIndex >= uint64(len(epochStatsValues.AttesterDuties[slotIndex])) {
+				return 0
+			}
+
+			committeeAssignments := epochStatsValues.AttesterDuties[slotIndex][committeeIndex]
+			committeeData := &models.SlotPageAttestationCommittee{
+				Index: committeeIndex,
+				Size:  uint64(len(committeeAssignments)),
+			}
+
+			for j, activeIndex := range committeeAssignments {
+				validatorIndex := uint64(epochStatsValues.ActiveIndices[activeIndex])
+				namedValidator := types.NamedValidator{
+					Index: validatorIndex,
+					Name:  services.GlobalBeaconService.GetValidatorName(validatorIndex),
+				}
+
+				attPageData.Validators = append(attPageData.Validators, namedValidator)
+				if attAggregationBits.BitAt(aggregationBitsOffset + uint64(j)) {
+					committeeData.IncludedCount++
+					attPageData.IncludedValidators = append(attPageData.IncludedValidators, namedValidator)
+				} else {
+					committeeData.MissingValidators = append(committeeData.MissingValidators, namedValidator)
+					attPageData.MissingValidators = append(attPageData.MissingValidators, namedValidator)
+				}
+			}
+
+			if committeeData.Size > 0 {
+				committeeData.Participation = float64(committeeData.IncludedCount) * 100 / float64(committeeData.Size)
+			}
+
+			attPageData.Committees = append(attPageData.Committees, committeeData)
+			return committeeData.Size
+		}
+
+		if attVersioned.Version >= spec.DataVersionElectra {
+			// EIP-7549 attestation
+			attPageData.CommitteeIndex = []uint64{}
+
+			committeeBits, err := attVersioned.CommitteeBits()
+			if err != nil {
+				continue
+			}
+
+			attBitsOffset := uint64(0)
+			for _, committee := range committeeBits.BitIndices() {
+				if uint64(committee) >= specs.MaxCommitteesPerSlot {
+					continue
+				}
+
+				attBitsOffset += addCommittee(uint64(committee), attBitsOffset)
+			}
+		} else {
+			// pre-electra attestation
+			addCommittee(uint64(attData.Index), 0)
+		}
+
+		if len(attPageData.Validators) > 0 {
+			attPageData.Participation = float64(len(attPageData.IncludedValidators)) * 100 / float64(len(attPageData.Validators))
+		}
+
+		if attVotes := attestationVotes[i]; attVotes != nil {
+			attPageData.VotesAvailable = true
+			attPageData.IncludedBits = attVotes.IncludedBits
+			attPageData.NewVotes = attVotes.NewVotes
+		}
+
+		headVoteKey := slotPageHeadVoteKey{root: attData.BeaconBlockRoot, slot: attData.Slot}
+		headVoteCheck := headVoteChecks[headVoteKey]
+		if headVoteCheck == nil {
+			headVoteCheck = getSlotPageHeadVoteCheck(attData.BeaconBlockRoot, attData.Slot)
+			headVoteChecks[headVoteKey] = headVoteCheck
+		}
+		attPageData.BeaconBlockFound = headVoteCheck.found
+		attPageData.BeaconBlockSlot = headVoteCheck.slot
+		attPageData.HeadVoteCanonical = headVoteCheck.canonical
+
+		pageAttestations = append(pageAttestations, &attPageData)
+	}
+
+	return pageAttestations
+}
+
+// getSlotPageBlobs builds the blob sidecar references for a page of blob commitments included in the block.
+func getSlotPageBlobs(blobKzgCommitments []deneb.KZGCommitment, offset int, limit int) []*models.SlotPageBlob {
+	pageBlobs := []*models.SlotPageBlob{}
+	for i := offset; i < len(blobKzgCommitments) && i < offset+limit; i++ {
+		pageBlobs = append(pageBlobs, &models.SlotPageBlob{
+			Index:         uint64(i),
+			KzgCommitment: blobKzgCommitments[i][:],
+		})
+	}
+	return pageBlobs
+}
+
+type slotPageHeadVoteKey struct {
+	root phase0.Root
+	slot phase0.Slot
+}
+
+type slotPageHeadVoteCheck struct {
+	found     bool
+	slot      uint64
+	canonical bool
+}
+
+// getSlotPageHeadVoteCheck resolves the block an attestation votes for and checks whether the head vote matches the canonical chain.
+// the head vote is canonical if the voted block is canonical and there is no later canonical block up to the attested slot.
+func getSlotPageHeadVoteCheck(blockRoot phase0.Root, attSlot phase0.Slot) *slotPageHeadVoteCheck {
+	check := &slotPageHeadVoteCheck{}
+
+	votedBlock := services.GlobalBeaconService.GetDbBlockByRoot(blockRoot)
+	if votedBlock == nil {
+		return check
+	}
+
+	check.found = true
+	check.slot = votedBlock.Slot
+	if votedBlock.Status != dbtypes.Canonical {
+		return check
+	}
+
+	check.canonical = true
+	if uint64(attSlot) > votedBlock.Slot {
+		for _, block := range services.GlobalBeaconService.GetDbBlocksForSlots(uint64(attSlot), uint32(uint64(attSlot)-votedBlock.Slot), false, false) {
+			if block.Slot > votedBlock.Slot && block.Status == dbtypes.Canonical {
+				check.canonical = false
+				break
+			}
+		}
+	}
+
+	return check
+}
+
+// getSlotPageSeenBy returns the clients that served or announced the block.
+// attributions are taken from the block cache and fall back to the persisted attributions in the db.
+func getSlotPageSeenBy(cachedBlock *beacon.Block, blockRoot phase0.Root) []*models.SlotPageSeenBy {
+	seenBy := []*models.SlotPageSeenBy{}
+	if cachedBlock != nil {
+		for _, attribution := range cachedBlock.GetSeenByAttributions() {
+			seenBy = append(seenBy, &models.SlotPageSeenBy{
+				Name: attribution.Client.GetClient().GetName(),
+				Time: attribution.Time,
+			})
+		}
+	}
+
+	if len(seenBy) == 0 {
+		for _, attribution := range db.GetBlockAttributions(blockRoot[:]) {
+			seenBy = append(seenBy, &models.SlotPageSeenBy{
+				Name: attribution.ClientName,
+				Time: time.UnixMilli(int64(attribution.SeenTime)),
+			})
+		}
+	}
+
+	return seenBy
+}
+
 func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []bellatrix.Transaction) {
 	pageData.Transactions = make([]*models.SlotPageTransaction, 0)
 	sigLookupBytes := []types.TxSignatureBytes{}
@@ -749,18 +1257,33 @@ func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []b
 		}
 
 		txHash := tx.Hash()
-		txValue, _ := tx.Value().Float64()
-		ethFloat, _ := utils.ETH.Float64()
-		txValue = txValue / ethFloat
-
 		txData := &models.SlotPageTransaction{
-			Index: uint64(idx),
-			Hash:  txHash[:],
-			Value: txValue,
-			Data:  tx.Data(),
-			Type:  uint64(tx.Type()),
+			Index:    uint64(idx),
+			Hash:     txHash[:],
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+			Type:     uint64(tx.Type()),
+			Nonce:    tx.Nonce(),
+			GasLimit: tx.Gas(),
 		}
 		txData.DataLen = uint64(len(txData.Data))
+		if txData.DataLen > 16 {
+			txData.DataPrefix = txData.Data[0:16]
+		} else {
+			txData.DataPrefix = txData.Data
+		}
+
+		gweiFloat, _ := utils.GWEI.Float64()
+		switch tx.Type() {
+		case ethtypes.LegacyTxType, ethtypes.AccessListTxType:
+			gasPrice, _ := tx.GasPrice().Float64()
+			txData.GasPrice = gasPrice / gweiFloat
+		default:
+			maxFee, _ := tx.GasFeeCap().Float64()
+			maxPriority, _ := tx.GasTipCap().Float64()
+			txData.MaxFee = maxFee / gweiFloat
+			txData.MaxPriority = maxPriority / gweiFloat
+		}
 		txFrom, err := ethtypes.Sender(ethtypes.NewPragueSigner(tx.ChainId()), &tx)
 		if err != nil {
 			txData.From = "unknown"
@@ -795,6 +1318,16 @@ func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []b
 	}
 	pageData.TransactionsCount = uint64(len(tranactions))
 
+	txTypeCounts := utils.CountTransactionTypes(tranactions)
+	pageData.TransactionTypes = &models.SlotPageTxTypeCounts{
+		Legacy:     txTypeCounts.Legacy,
+		AccessList: txTypeCounts.AccessList,
+		DynamicFee: txTypeCounts.DynamicFee,
+		Blob:       txTypeCounts.Blob,
+		SetCode:    txTypeCounts.SetCode,
+		Unknown:    txTypeCounts.Unknown,
+	}
+
 	if len(sigLookupBytes) > 0 {
 		sigLookups := services.GlobalTxSignaturesService.LookupSignatures(sigLookupBytes)
 		for _, sigLookup := range sigLookups {
@@ -21,6 +21,8 @@ func Slots(w http.ResponseWriter, r *http.Request) {
 	var slotsTemplateFiles = append(layoutTemplateFiles,
 		"slots/slots.html",
 		"_svg/professor.html",
+		annotationsTemplateFile,
+		testRunsTemplateFile,
 	)
 
 	var pageTemplate = templates.GetTemplate(slotsTemplateFiles...)
@@ -46,14 +48,14 @@ func Slots(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "slots.go", "Slots", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "slots.go", "Slots", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
 
 func getSlotsPageData(firstSlot uint64, pageSize uint64) (*models.SlotsPageData, error) {
 	pageData := &models.SlotsPageData{}
-	pageCacheKey := fmt.Sprintf("slots:%v:%v", firstSlot, pageSize)
+	pageCacheKey := fmt.Sprintf("slots:%v:%v:%v:%v", firstSlot, pageSize, services.GlobalBeaconService.GetAnnotationsCacheKey(), services.GlobalBeaconService.GetTestRunsCacheKey())
 	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
 		pageData, cacheTimeout := buildSlotsPageData(firstSlot, pageSize)
 		pageCall.CacheTimeout = cacheTimeout
@@ -85,9 +87,7 @@ func buildSlotsPageData(firstSlot uint64, pageSize uint64) (*models.SlotsPageDat
 		firstSlot = uint64(maxSlot)
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pagesBefore := (firstSlot + 1) / pageSize
 	if ((firstSlot + 1) % pageSize) > 0 {
 		pagesBefore++
@@ -121,6 +121,8 @@ func buildSlotsPageData(firstSlot uint64, pageSize uint64) (*models.SlotsPageDat
 	firstEpoch := chainState.EpochOfSlot(phase0.Slot(firstSlot))
 
 	// load slots
+	slotAnnotations := getSlotAnnotations(lastSlot, firstSlot)
+	testRuns := getRangeTestRuns(lastSlot, firstSlot)
 	pageData.Slots = make([]*models.SlotsPageDataSlot, 0)
 	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(firstSlot, uint32(pageSize), true, true)
 	dbIdx := 0
@@ -163,6 +165,8 @@ func buildSlotsPageData(firstSlot uint64, pageSize uint64) (*models.SlotsPageDat
 				BlockRoot:             dbSlot.Root,
 				ParentRoot:            dbSlot.ParentRoot,
 				ForkGraph:             make([]*models.SlotsPageDataForkGraph, 0),
+				Annotations:           slotAnnotations[slot],
+				TestRuns:              filterTestRuns(testRuns, slot, slot),
 			}
 			if dbSlot.EthBlockNumber != nil {
 				slotData.WithEthBlock = true
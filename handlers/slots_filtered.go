@@ -46,6 +46,10 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 	var pname string
 	var withOrphaned uint64
 	var withMissing uint64
+	var minSync string
+	var maxSync string
+	var minAtt string
+	var maxAtt string
 
 	if urlArgs.Has("f") {
 		if urlArgs.Has("f.graffiti") {
@@ -66,6 +70,18 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 		if urlArgs.Has("f.missing") {
 			withMissing, _ = strconv.ParseUint(urlArgs.Get("f.missing"), 10, 64)
 		}
+		if urlArgs.Has("f.minsync") {
+			minSync = urlArgs.Get("f.minsync")
+		}
+		if urlArgs.Has("f.maxsync") {
+			maxSync = urlArgs.Get("f.maxsync")
+		}
+		if urlArgs.Has("f.minatt") {
+			minAtt = urlArgs.Get("f.minatt")
+		}
+		if urlArgs.Has("f.maxatt") {
+			maxAtt = urlArgs.Get("f.maxatt")
+		}
 	} else {
 		withOrphaned = 1
 		withMissing = 1
@@ -73,23 +89,23 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, uint8(withOrphaned), uint8(withMissing), displayColumns)
+		data.Data, pageError = getFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, uint8(withOrphaned), uint8(withMissing), minSync, maxSync, minAtt, maxAtt, displayColumns)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
 		return
 	}
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
 
-func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) (*models.SlotsFilteredPageData, error) {
+func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, minSync string, maxSync string, minAtt string, maxAtt string, displayColumns string) (*models.SlotsFilteredPageData, error) {
 	pageData := &models.SlotsFilteredPageData{}
-	pageCacheKey := fmt.Sprintf("slots_filtered:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, displayColumns)
+	pageCacheKey := fmt.Sprintf("slots_filtered:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, minSync, maxSync, minAtt, maxAtt, displayColumns)
 	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, displayColumns)
+		return buildFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, minSync, maxSync, minAtt, maxAtt, displayColumns)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.SlotsFilteredPageData)
@@ -101,7 +117,7 @@ func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string,
 	return pageData, pageErr
 }
 
-func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) *models.SlotsFilteredPageData {
+func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, minSync string, maxSync string, minAtt string, maxAtt string, displayColumns string) *models.SlotsFilteredPageData {
 	chainState := services.GlobalBeaconService.GetChainState()
 	filterArgs := url.Values{}
 	if graffiti != "" {
@@ -122,6 +138,18 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 	if withMissing != 0 {
 		filterArgs.Add("f.missing", fmt.Sprintf("%v", withMissing))
 	}
+	if minSync != "" {
+		filterArgs.Add("f.minsync", minSync)
+	}
+	if maxSync != "" {
+		filterArgs.Add("f.maxsync", maxSync)
+	}
+	if minAtt != "" {
+		filterArgs.Add("f.minatt", minAtt)
+	}
+	if maxAtt != "" {
+		filterArgs.Add("f.maxatt", maxAtt)
+	}
 
 	displayMap := map[uint64]bool{}
 	if displayColumns != "" {
@@ -171,6 +199,10 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 		FilterProposerName: pname,
 		FilterWithOrphaned: withOrphaned,
 		FilterWithMissing:  withMissing,
+		FilterMinSync:      minSync,
+		FilterMaxSync:      maxSync,
+		FilterMinAtt:       minAtt,
+		FilterMaxAtt:       maxAtt,
 
 		DisplayEpoch:        displayMap[1],
 		DisplaySlot:         displayMap[2],
@@ -191,9 +223,7 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 		pageData.IsDefaultPage = true
 	}
 
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	pageSize = services.LimitPageSize(pageSize)
 	pageData.PageSize = pageSize
 	pageData.TotalPages = pageIdx + 1
 	pageData.CurrentPageIndex = pageIdx + 1
@@ -220,6 +250,29 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 		pidx, _ := strconv.ParseUint(proposer, 10, 64)
 		blockFilter.ProposerIndex = &pidx
 	}
+	// sync participation is filtered in percent
+	if minSync != "" {
+		if syncPercent, err := strconv.ParseFloat(minSync, 32); err == nil {
+			minSyncParticipation := float32(syncPercent / 100)
+			blockFilter.MinSyncParticipation = &minSyncParticipation
+		}
+	}
+	if maxSync != "" {
+		if syncPercent, err := strconv.ParseFloat(maxSync, 32); err == nil {
+			maxSyncParticipation := float32(syncPercent / 100)
+			blockFilter.MaxSyncParticipation = &maxSyncParticipation
+		}
+	}
+	if minAtt != "" {
+		if attCount, err := strconv.ParseUint(minAtt, 10, 64); err == nil {
+			blockFilter.MinAttestations = &attCount
+		}
+	}
+	if maxAtt != "" {
+		if attCount, err := strconv.ParseUint(maxAtt, 10, 64); err == nil {
+			blockFilter.MaxAttestations = &attCount
+		}
+	}
 
 	withScheduledCount := chainState.GetSpecs().SlotsPerEpoch - uint64(chainState.SlotToSlotIndex(currentSlot)) - 1
 	if withScheduledCount > 16 {
@@ -49,7 +49,7 @@ func SubmitConsolidation(w http.ResponseWriter, r *http.Request) {
 	if pageData == nil {
 		data := InitPageData(w, r, "blockchain", "/submit_consolidation", "Submit Consolidation", submitConsolidationTemplateFiles)
 		w.Header().Set("Content-Type", "text/html")
-		if handleTemplateError(w, r, "submit_consolidation.go", "Submit Consolidation", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		if handleTemplateError(w, r, "submit_consolidation.go", "Submit Consolidation", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 			return // an error has occurred and was processed
 		}
 		return
@@ -58,7 +58,7 @@ func SubmitConsolidation(w http.ResponseWriter, r *http.Request) {
 	data := InitPageData(w, r, "blockchain", "/submit_consolidation", "Submit Consolidation", submitConsolidationTemplateFiles)
 	data.Data = pageData
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "submit_consolidation.go", "Submit Consolidation", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "submit_consolidation.go", "Submit Consolidation", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -52,7 +52,7 @@ func SubmitDeposit(w http.ResponseWriter, r *http.Request) {
 	if pageData == nil {
 		data := InitPageData(w, r, "blockchain", "/submit_deposit", "Submit Deposit", submitDepositTemplateFiles)
 		w.Header().Set("Content-Type", "text/html")
-		if handleTemplateError(w, r, "submit_deposit.go", "Submit Deposit", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		if handleTemplateError(w, r, "submit_deposit.go", "Submit Deposit", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 			return // an error has occurred and was processed
 		}
 		return
@@ -61,7 +61,7 @@ func SubmitDeposit(w http.ResponseWriter, r *http.Request) {
 	data := InitPageData(w, r, "blockchain", "/submit_deposit", "Submit Deposit", submitDepositTemplateFiles)
 	data.Data = pageData
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "submit_deposit.go", "Submit Deposit", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "submit_deposit.go", "Submit Deposit", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -49,7 +49,7 @@ func SubmitWithdrawal(w http.ResponseWriter, r *http.Request) {
 	if pageData == nil {
 		data := InitPageData(w, r, "blockchain", "/submit_withdrawal", "Submit Withdrawals & Exits", submitWithdrawalTemplateFiles)
 		w.Header().Set("Content-Type", "text/html")
-		if handleTemplateError(w, r, "submit_withdrawal.go", "Submit Withdrawals & Exits", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		if handleTemplateError(w, r, "submit_withdrawal.go", "Submit Withdrawals & Exits", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 			return // an error has occurred and was processed
 		}
 		return
@@ -58,7 +58,7 @@ func SubmitWithdrawal(w http.ResponseWriter, r *http.Request) {
 	data := InitPageData(w, r, "blockchain", "/submit_withdrawal", "Submit Withdrawals & Exits", submitWithdrawalTemplateFiles)
 	data.Data = pageData
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "submit_withdrawal.go", "Submit Withdrawals & Exits", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "submit_withdrawal.go", "Submit Withdrawals & Exits", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// testRunsTemplateFile is the template file with the shared test run snippets
+const testRunsTemplateFile = "_layout/test_runs.html"
+
+func buildTestRunPageData(testRun *dbtypes.TestRun) *models.TestRunPageData {
+	pageData := &models.TestRunPageData{
+		Id:        testRun.Id,
+		Name:      testRun.Name,
+		Source:    testRun.Source,
+		Url:       testRun.Url,
+		Status:    testRun.Status,
+		StartSlot: testRun.StartSlot,
+	}
+	if testRun.EndSlot != nil {
+		pageData.EndSlot = *testRun.EndSlot
+	} else {
+		pageData.Running = true
+		pageData.EndSlot = uint64(services.GlobalBeaconService.GetChainState().CurrentSlot())
+	}
+	return pageData
+}
+
+// getRangeTestRuns returns the test runs overlapping the given slot range (inclusive)
+func getRangeTestRuns(firstSlot uint64, lastSlot uint64) []*models.TestRunPageData {
+	testRuns := []*models.TestRunPageData{}
+	for _, testRun := range services.GlobalBeaconService.GetTestRuns(firstSlot, lastSlot) {
+		testRuns = append(testRuns, buildTestRunPageData(testRun))
+	}
+	return testRuns
+}
+
+// filterTestRuns returns the test runs that overlap with the given slot range (inclusive)
+func filterTestRuns(testRuns []*models.TestRunPageData, firstSlot uint64, lastSlot uint64) []*models.TestRunPageData {
+	var result []*models.TestRunPageData
+	for _, testRun := range testRuns {
+		if testRun.StartSlot <= lastSlot && (testRun.Running || testRun.EndSlot >= firstSlot) {
+			result = append(result, testRun)
+		}
+	}
+	return result
+}
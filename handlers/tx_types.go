@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of buckets shown in the chart, larger ranges are aggregated
+const txTypesMaxPoints = 500
+
+// TxTypes will return the transaction types chart page using a go template
+func TxTypes(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"tx_types/tx_types.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "blockchain", "/slots/tx_types", "Transaction Types", pageTemplateFiles)
+
+	chartRange := r.URL.Query().Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "7d"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getTxTypesPageData(chartRange)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "tx_types.go", "TxTypes", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getTxTypesPageData(chartRange string) (*models.TxTypesPageData, error) {
+	pageData := &models.TxTypesPageData{}
+	pageCacheKey := fmt.Sprintf("tx_types:%v", chartRange)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildTxTypesPageData(chartRange)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.TxTypesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildTxTypesPageData(chartRange string) (*models.TxTypesPageData, time.Duration) {
+	logrus.Debugf("tx types page called: %v", chartRange)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.TxTypesPageData{
+		Range: chartRange,
+	}
+	firstEpoch, lastEpoch := getChartRangeEpochs(chartRange)
+	pageData.FirstSlot = uint64(chainState.EpochStartSlot(phase0.Epoch(firstEpoch)))
+	pageData.LastSlot = uint64(chainState.EpochStartSlot(phase0.Epoch(lastEpoch+1))) - 1
+	pageData.BucketSize = (pageData.LastSlot-pageData.FirstSlot)/txTypesMaxPoints + 1
+
+	dbStats := db.GetSlotTxTypeStats(pageData.FirstSlot, pageData.LastSlot, pageData.BucketSize)
+	pageData.Buckets = make([]*models.TxTypesPageDataBucket, 0, len(dbStats))
+
+	bucketSlots := make([]uint64, 0, len(dbStats))
+	avgLegacy := make([]float64, 0, len(dbStats))
+	avgAccessList := make([]float64, 0, len(dbStats))
+	avgDynamicFee := make([]float64, 0, len(dbStats))
+	avgBlob := make([]float64, 0, len(dbStats))
+	avgSetCode := make([]float64, 0, len(dbStats))
+	for _, dbBucket := range dbStats {
+		if dbBucket.BlockCount == 0 {
+			continue
+		}
+
+		bucket := &models.TxTypesPageDataBucket{
+			FirstSlot:        dbBucket.FirstSlot,
+			LastSlot:         dbBucket.LastSlot,
+			Time:             chainState.SlotToTime(phase0.Slot(dbBucket.FirstSlot)),
+			BlockCount:       dbBucket.BlockCount,
+			TransactionCount: dbBucket.TransactionSum,
+			LegacyCount:      dbBucket.LegacySum,
+			AccessListCount:  dbBucket.AccessListSum,
+			DynamicFeeCount:  dbBucket.DynamicFeeSum,
+			BlobCount:        dbBucket.BlobSum,
+			SetCodeCount:     dbBucket.SetCodeSum,
+		}
+		pageData.Buckets = append(pageData.Buckets, bucket)
+
+		pageData.BlockCount += bucket.BlockCount
+		pageData.TransactionCount += bucket.TransactionCount
+		pageData.LegacyCount += bucket.LegacyCount
+		pageData.AccessListCount += bucket.AccessListCount
+		pageData.DynamicFeeCount += bucket.DynamicFeeCount
+		pageData.BlobCount += bucket.BlobCount
+		pageData.SetCodeCount += bucket.SetCodeCount
+
+		blockCount := float64(bucket.BlockCount)
+		bucketSlots = append(bucketSlots, bucket.FirstSlot)
+		avgLegacy = append(avgLegacy, float64(bucket.LegacyCount)/blockCount)
+		avgAccessList = append(avgAccessList, float64(bucket.AccessListCount)/blockCount)
+		avgDynamicFee = append(avgDynamicFee, float64(bucket.DynamicFeeCount)/blockCount)
+		avgBlob = append(avgBlob, float64(bucket.BlobCount)/blockCount)
+		avgSetCode = append(avgSetCode, float64(bucket.SetCodeCount)/blockCount)
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	if pageData.TransactionCount > 0 {
+		txCount := float64(pageData.TransactionCount)
+		pageData.LegacyShare = float64(pageData.LegacyCount) * 100 / txCount
+		pageData.AccessListShare = float64(pageData.AccessListCount) * 100 / txCount
+		pageData.DynamicFeeShare = float64(pageData.DynamicFeeCount) * 100 / txCount
+		pageData.BlobShare = float64(pageData.BlobCount) * 100 / txCount
+		pageData.SetCodeShare = float64(pageData.SetCodeCount) * 100 / txCount
+	}
+
+	formatCount := func(value float64) string {
+		return utils.FormatFloat(value, 2)
+	}
+	pageData.Chart = buildLineChart(bucketSlots, func(slot uint64) string {
+		return fmt.Sprintf("Slot %v", utils.FormatFloat(float64(slot), 0))
+	}, &chartSeries{
+		name:   "EIP-1559",
+		color:  "#0d6efd",
+		values: avgDynamicFee,
+		format: formatCount,
+	}, &chartSeries{
+		name:   "Legacy",
+		color:  "#6c757d",
+		values: avgLegacy,
+		format: formatCount,
+	}, &chartSeries{
+		name:   "Access list",
+		color:  "#198754",
+		values: avgAccessList,
+		format: formatCount,
+	}, &chartSeries{
+		name:   "Blob",
+		color:  "#6f42c1",
+		values: avgBlob,
+		format: formatCount,
+	}, &chartSeries{
+		name:   "Set code",
+		color:  "#fd7e14",
+		values: avgSetCode,
+		format: formatCount,
+	})
+
+	return pageData, 10 * time.Minute
+}
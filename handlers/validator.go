@@ -64,7 +64,7 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 	if validator == nil {
 		data := InitPageData(w, r, "blockchain", "/validator", "Validator not found", notfoundTemplateFiles)
 		w.Header().Set("Content-Type", "text/html")
-		handleTemplateError(w, r, "validator.go", "Validator", "", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data))
+		handleTemplateError(w, r, "validator.go", "Validator", "", executeLayoutTemplate(w, r, templates.GetTemplate(notfoundTemplateFiles...), data))
 		return
 	}
 
@@ -88,7 +88,7 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 		// return the selected tab content only (lazy loaded)
 		handleTemplateError(w, r, "validators.go", "Validators", "", pageTemplate.ExecuteTemplate(w, "lazyPage", data.Data))
 	} else {
-		handleTemplateError(w, r, "validators.go", "Validators", "", pageTemplate.ExecuteTemplate(w, "layout", data))
+		handleTemplateError(w, r, "validators.go", "Validators", "", executeLayoutTemplate(w, r, pageTemplate, data))
 	}
 }
 
@@ -170,6 +170,35 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 		pageData.ExitEpoch = uint64(validator.Validator.ExitEpoch)
 		pageData.ExitTs = chainState.EpochToTime(validator.Validator.ExitEpoch)
 	}
+	if validator.Status == v1.ValidatorStateActiveOngoing {
+		// estimate exit if a voluntary exit was submitted now
+		exitEstimation, err := services.GlobalBeaconService.EstimateValidatorExit(validator.Index)
+		if err == nil {
+			pageData.ShowExitEstimation = true
+			pageData.ExitEstimationCanExit = exitEstimation.CanExit
+			pageData.ExitEstimationSubmitEpoch = uint64(exitEstimation.EarliestExitSubmit)
+			pageData.ExitEstimationEpoch = uint64(exitEstimation.ExitEpoch)
+			pageData.ExitEstimationTs = exitEstimation.ExitTime
+			pageData.ExitEstimationWithdrawableEpoch = uint64(exitEstimation.WithdrawableEpoch)
+			pageData.ExitEstimationWithdrawableTs = exitEstimation.WithdrawableTime
+			pageData.ExitEstimationQueueCount = exitEstimation.Queue.PendingExitCount
+			pageData.ExitEstimationQueueBalance = uint64(exitEstimation.Queue.PendingExitBalance)
+			pageData.ExitEstimationBalanceChurn = exitEstimation.Queue.BalanceChurn
+			pageData.ExitEstimationChurnLimit = exitEstimation.Queue.ChurnLimit
+		}
+	}
+	// aggregate included deposits (initial deposit & top-ups)
+	depositSummary := services.GlobalBeaconService.GetValidatorDepositSummary(validator.Validator.PublicKey[:])
+	if depositSummary.DepositCount > 0 {
+		pageData.ShowDepositSummary = true
+		pageData.DepositCount = depositSummary.DepositCount
+		pageData.DepositTotalAmount = uint64(depositSummary.TotalAmount)
+		pageData.DepositInitialAmount = uint64(depositSummary.InitialAmount)
+		pageData.DepositInitialSlot = depositSummary.InitialSlot
+		pageData.DepositTopUpCount = depositSummary.TopUpCount
+		pageData.DepositTopUpAmount = uint64(depositSummary.TopUpAmount)
+		pageData.DepositLastTopUpSlot = depositSummary.LastTopUpSlot
+	}
 	if validator.Validator.WithdrawalCredentials[0] == 0x01 || validator.Validator.WithdrawalCredentials[0] == 0x02 {
 		pageData.ShowWithdrawAddress = true
 		pageData.WithdrawAddress = validator.Validator.WithdrawalCredentials[12:]
@@ -674,5 +703,29 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 		}
 	}
 
+	// project slashing penalties for slashed validators that are not withdrawable yet
+	if validator.Validator.Slashed && validator.Validator.WithdrawableEpoch > chainState.CurrentEpoch() {
+		var slashedEpoch *phase0.Epoch
+		if pageData.ExitReasonSlashing {
+			epoch := chainState.EpochOfSlot(phase0.Slot(pageData.ExitReasonSlot))
+			slashedEpoch = &epoch
+		}
+
+		penaltyEstimation, err := services.GlobalBeaconService.EstimateSlashingPenalty(validator.Index, slashedEpoch)
+		if err == nil {
+			pageData.ShowSlashingPenalty = true
+			pageData.SlashingPenaltyInitial = uint64(penaltyEstimation.InitialPenalty)
+			pageData.SlashingPenaltyCorrelation = uint64(penaltyEstimation.CorrelationPenalty)
+			pageData.SlashingPenaltyCorrelationEpoch = uint64(penaltyEstimation.CorrelationEpoch)
+			pageData.SlashingPenaltyCorrelationTs = penaltyEstimation.CorrelationTime
+			pageData.SlashingPenaltyCorrelationApplied = penaltyEstimation.CorrelationApplied
+			pageData.SlashingPenaltySlashingsCount = penaltyEstimation.SlashingsCount
+			pageData.SlashingPenaltySlashingsBalance = uint64(penaltyEstimation.SlashingsBalance)
+			pageData.SlashingPenaltyMultiplier = penaltyEstimation.Multiplier
+			pageData.SlashingPenaltyWithdrawableEpoch = uint64(penaltyEstimation.WithdrawableEpoch)
+			pageData.SlashingPenaltyWithdrawableTs = penaltyEstimation.WithdrawableTime
+		}
+	}
+
 	return pageData, 10 * time.Minute
 }
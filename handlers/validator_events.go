@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/sirupsen/logrus"
+)
+
+// ValidatorEvents will return the filtered "validator_events" page using a go template
+func ValidatorEvents(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"validator_events/validator_events.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/events", "Validator Events", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	var minEpoch uint64
+	var maxEpoch uint64
+	var minIndex uint64
+	var maxIndex uint64
+	var vname string
+	var eventType uint64
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.mine") {
+			minEpoch, _ = strconv.ParseUint(urlArgs.Get("f.mine"), 10, 64)
+		}
+		if urlArgs.Has("f.maxe") {
+			maxEpoch, _ = strconv.ParseUint(urlArgs.Get("f.maxe"), 10, 64)
+		}
+		if urlArgs.Has("f.mini") {
+			minIndex, _ = strconv.ParseUint(urlArgs.Get("f.mini"), 10, 64)
+		}
+		if urlArgs.Has("f.maxi") {
+			maxIndex, _ = strconv.ParseUint(urlArgs.Get("f.maxi"), 10, 64)
+		}
+		if urlArgs.Has("f.vname") {
+			vname = urlArgs.Get("f.vname")
+		}
+		if urlArgs.Has("f.type") {
+			eventType, _ = strconv.ParseUint(urlArgs.Get("f.type"), 10, 8)
+		}
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredValidatorEventsPageData(pageIdx, pageSize, minEpoch, maxEpoch, minIndex, maxIndex, vname, uint8(eventType))
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validator_events.go", "ValidatorEvents", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getFilteredValidatorEventsPageData(pageIdx uint64, pageSize uint64, minEpoch uint64, maxEpoch uint64, minIndex uint64, maxIndex uint64, vname string, eventType uint8) (*models.ValidatorEventsPageData, error) {
+	pageData := &models.ValidatorEventsPageData{}
+	pageCacheKey := fmt.Sprintf("validator_events:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minEpoch, maxEpoch, minIndex, maxIndex, vname, eventType)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredValidatorEventsPageData(pageIdx, pageSize, minEpoch, maxEpoch, minIndex, maxIndex, vname, eventType)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ValidatorEventsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildFilteredValidatorEventsPageData(pageIdx uint64, pageSize uint64, minEpoch uint64, maxEpoch uint64, minIndex uint64, maxIndex uint64, vname string, eventType uint8) *models.ValidatorEventsPageData {
+	filterArgs := url.Values{}
+	if minEpoch != 0 {
+		filterArgs.Add("f.mine", fmt.Sprintf("%v", minEpoch))
+	}
+	if maxEpoch != 0 {
+		filterArgs.Add("f.maxe", fmt.Sprintf("%v", maxEpoch))
+	}
+	if minIndex != 0 {
+		filterArgs.Add("f.mini", fmt.Sprintf("%v", minIndex))
+	}
+	if maxIndex != 0 {
+		filterArgs.Add("f.maxi", fmt.Sprintf("%v", maxIndex))
+	}
+	if vname != "" {
+		filterArgs.Add("f.vname", vname)
+	}
+	if eventType != 0 {
+		filterArgs.Add("f.type", fmt.Sprintf("%v", eventType))
+	}
+
+	pageData := &models.ValidatorEventsPageData{
+		FilterMinEpoch:      minEpoch,
+		FilterMaxEpoch:      maxEpoch,
+		FilterMinIndex:      minIndex,
+		FilterMaxIndex:      maxIndex,
+		FilterValidatorName: vname,
+		FilterEventType:     eventType,
+	}
+	logrus.Debugf("validator_events page called: %v:%v [%v,%v,%v,%v,%v,%v]", pageIdx, pageSize, minEpoch, maxEpoch, minIndex, maxIndex, vname, eventType)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	pageSize = services.LimitPageSize(pageSize)
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// load validator events
+	validatorEventFilter := &dbtypes.ValidatorEventFilter{
+		MinEpoch:      minEpoch,
+		MaxEpoch:      maxEpoch,
+		MinIndex:      minIndex,
+		MaxIndex:      maxIndex,
+		ValidatorName: vname,
+		EventType:     dbtypes.ValidatorEventType(eventType),
+	}
+
+	dbValidatorEvents, totalRows := services.GlobalBeaconService.GetValidatorEventsByFilter(validatorEventFilter, pageIdx-1, uint32(pageSize))
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	currentEpoch := uint64(chainState.CurrentEpoch())
+
+	for _, validatorEvent := range dbValidatorEvents {
+		pageData.Events = append(pageData.Events, &models.ValidatorEventsPageDataEvent{
+			Epoch:          validatorEvent.Epoch,
+			Time:           chainState.EpochToTime(phase0.Epoch(validatorEvent.Epoch)),
+			IsFuture:       validatorEvent.Epoch > currentEpoch,
+			EventType:      uint8(validatorEvent.EventType),
+			ValidatorIndex: validatorEvent.ValidatorIndex,
+			ValidatorName:  services.GlobalBeaconService.GetValidatorName(validatorEvent.ValidatorIndex),
+		})
+	}
+	pageData.EventCount = uint64(len(pageData.Events))
+
+	if pageData.EventCount > 0 {
+		pageData.FirstEpoch = pageData.Events[0].Epoch
+		pageData.LastEpoch = pageData.Events[pageData.EventCount-1].Epoch
+	}
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/validators/events?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/validators/events?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/validators/events?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/validators/events?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/sirupsen/logrus"
+)
+
+// ValidatorNames will return the filtered "validator_names" page using a go template
+func ValidatorNames(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"validator_names/validator_names.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/names", "Validator Names", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	var name string
+	var source string
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.name") {
+			name = urlArgs.Get("f.name")
+		}
+		if urlArgs.Has("f.source") {
+			source = urlArgs.Get("f.source")
+		}
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		// names can be changed at runtime, so the page is built from the in-memory names without caching
+		data.Data = buildFilteredValidatorNamesPageData(pageIdx, pageSize, name, source)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validator_names.go", "ValidatorNames", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildFilteredValidatorNamesPageData(pageIdx uint64, pageSize uint64, name string, source string) *models.ValidatorNamesPageData {
+	filterArgs := url.Values{}
+	if name != "" {
+		filterArgs.Add("f.name", name)
+	}
+	if source != "" {
+		filterArgs.Add("f.source", source)
+	}
+
+	pageData := &models.ValidatorNamesPageData{
+		FilterName:   name,
+		FilterSource: source,
+	}
+	logrus.Debugf("validator_names page called: %v:%v [%v,%v]", pageIdx, pageSize, name, source)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	pageSize = services.LimitPageSize(pageSize)
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// filter validator names
+	nameFilter := strings.ToLower(name)
+	totalRows := uint64(0)
+	firstIdx := (pageIdx - 1) * pageSize
+	for _, rule := range services.GlobalBeaconService.GetValidatorNameRules() {
+		if source != "" && rule.Source != source {
+			continue
+		}
+		if nameFilter != "" && !strings.Contains(strings.ToLower(rule.Name), nameFilter) && !strings.Contains(strings.ToLower(rule.Key), nameFilter) {
+			continue
+		}
+
+		totalRows++
+		if totalRows <= firstIdx || totalRows > firstIdx+pageSize {
+			continue
+		}
+
+		nameData := &models.ValidatorNamesPageDataName{
+			Key:            rule.Key,
+			Name:           rule.Name,
+			Source:         rule.Source,
+			ValidatorCount: rule.ValidatorCount,
+			IsIndexKey:     !strings.Contains(rule.Key, ":"),
+			Overridden:     rule.Overridden,
+		}
+		if rule.UpdatedAt > 0 {
+			nameData.HasUpdatedAt = true
+			nameData.UpdatedAt = time.Unix(int64(rule.UpdatedAt), 0)
+		}
+		pageData.Names = append(pageData.Names, nameData)
+	}
+	pageData.NameCount = uint64(len(pageData.Names))
+	pageData.TotalRows = totalRows
+
+	if pageData.NameCount > 0 {
+		pageData.FirstName = firstIdx + 1
+		pageData.LastName = firstIdx + pageData.NameCount
+	}
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/validators/names?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/validators/names?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/validators/names?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/validators/names?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
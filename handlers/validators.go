@@ -40,16 +40,15 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 	if urlArgs.Has("c") {
 		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
 	}
-	if urlArgs.Has("json") && pageSize > 10000 {
-		pageSize = 10000
-	} else if !urlArgs.Has("json") && pageSize > 1000 {
-		pageSize = 1000
+	if maxPageSize := services.GetMaxValidatorsPageSize(urlArgs.Has("json")); pageSize > maxPageSize {
+		pageSize = maxPageSize
 	}
 
 	var filterPubKey string
 	var filterIndex string
 	var filterName string
 	var filterStatus string
+	var filterState string
 	if urlArgs.Has("f") {
 		if urlArgs.Has("f.pubkey") {
 			filterPubKey = urlArgs.Get("f.pubkey")
@@ -63,6 +62,9 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 		if urlArgs.Has("f.status") {
 			filterStatus = strings.Join(urlArgs["f.status"], ",")
 		}
+		if urlArgs.Has("f.state") {
+			filterState = strings.Join(urlArgs["f.state"], ",")
+		}
 	}
 	var sortOrder string
 	if urlArgs.Has("o") {
@@ -72,7 +74,7 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getValidatorsPageData(firstIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
+		data.Data, pageError = getValidatorsPageData(firstIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus, filterState)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -89,16 +91,16 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "validators.go", "Validators", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+	if handleTemplateError(w, r, "validators.go", "Validators", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
 		return // an error has occurred and was processed
 	}
 }
 
-func getValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder string, filterPubKey string, filterIndex string, filterName string, filterStatus string) (*models.ValidatorsPageData, error) {
+func getValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder string, filterPubKey string, filterIndex string, filterName string, filterStatus string, filterState string) (*models.ValidatorsPageData, error) {
 	pageData := &models.ValidatorsPageData{}
-	pageCacheKey := fmt.Sprintf("validators:%v:%v:%v:%v:%v:%v:%v", firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
+	pageCacheKey := fmt.Sprintf("validators:%v:%v:%v:%v:%v:%v:%v:%v", firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus, filterState)
 	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildValidatorsPageData(firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
+		pageData, cacheTimeout := buildValidatorsPageData(firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus, filterState)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -112,30 +114,28 @@ func getValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder string
 	return pageData, pageErr
 }
 
-func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder string, filterPubKey string, filterIndex string, filterName string, filterStatus string) (*models.ValidatorsPageData, time.Duration) {
-	logrus.Debugf("validators page called: %v:%v:%v:%v:%v:%v:%v", firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
+func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder string, filterPubKey string, filterIndex string, filterName string, filterStatus string, filterState string) (*models.ValidatorsPageData, time.Duration) {
+	logrus.Debugf("validators page called: %v:%v:%v:%v:%v:%v:%v:%v", firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus, filterState)
 	pageData := &models.ValidatorsPageData{}
 	cacheTime := 10 * time.Minute
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
-	// get latest validator set
-	var validatorSet []*v1.Validator
-	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorSet(true)
-	if validatorSetRsp == nil {
+	// get validator set snapshot of the current epoch
+	validatorSnapshot := services.GlobalBeaconService.GetValidatorSetSnapshot()
+	if validatorSnapshot == nil {
 		cacheTime = 5 * time.Minute
-		validatorSet = []*v1.Validator{}
-	} else {
-		validatorSet = validatorSetRsp
+		validatorSnapshot = services.NewValidatorSetSnapshot(chainState.CurrentEpoch(), nil)
 	}
 
-	// get status options
-	statusMap := map[v1.ValidatorState]uint64{}
-	for _, val := range validatorSet {
-		statusMap[val.Status]++
+	if sortOrder == "" {
+		sortOrder = "index"
 	}
+	validatorSet := validatorSnapshot.GetSortedValidators(sortOrder)
+
+	// get status options
 	pageData.FilterStatusOpts = make([]models.ValidatorsPageDataStatusOption, 0)
-	for status, count := range statusMap {
+	for status, count := range validatorSnapshot.StatusCounts {
 		pageData.FilterStatusOpts = append(pageData.FilterStatusOpts, models.ValidatorsPageDataStatusOption{
 			Status: status.String(),
 			Count:  count,
@@ -144,12 +144,20 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 	sort.Slice(pageData.FilterStatusOpts, func(a, b int) bool {
 		return strings.Compare(pageData.FilterStatusOpts[a].Status, pageData.FilterStatusOpts[b].Status) < 0
 	})
+	pageData.FilterStateOpts = make([]models.ValidatorsPageDataStatusOption, 0, len(services.ValidatorStateGroups))
+	for _, state := range services.ValidatorStateGroups {
+		pageData.FilterStateOpts = append(pageData.FilterStateOpts, models.ValidatorsPageDataStatusOption{
+			Status: state,
+			Count:  validatorSnapshot.StateCounts[state],
+		})
+	}
 
 	filterArgs := url.Values{}
-	if filterPubKey != "" || filterIndex != "" || filterName != "" || filterStatus != "" {
+	if filterPubKey != "" || filterIndex != "" || filterName != "" || filterStatus != "" || filterState != "" {
 		var filterPubKeyVal []byte
 		var filterIndexVal uint64
 		var filterStatusVal []string
+		var filterStateVal []string
 
 		if filterPubKey != "" {
 			filterArgs.Add("f.pubkey", filterPubKey)
@@ -166,6 +174,10 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 			filterArgs.Add("f.status", filterStatus)
 			filterStatusVal = strings.Split(filterStatus, ",")
 		}
+		if filterState != "" {
+			filterArgs.Add("f.state", filterState)
+			filterStateVal = strings.Split(filterState, ",")
+		}
 
 		// apply filter
 		filteredValidatorSet := make([]*v1.Validator, 0)
@@ -185,6 +197,9 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 			if filterStatus != "" && !utils.SliceContains(filterStatusVal, val.Status.String()) {
 				continue
 			}
+			if filterState != "" && !utils.SliceContains(filterStateVal, services.GetValidatorStateGroup(val)) {
+				continue
+			}
 			filteredValidatorSet = append(filteredValidatorSet, val)
 		}
 		validatorSet = filteredValidatorSet
@@ -193,61 +208,11 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 	pageData.FilterIndex = filterIndex
 	pageData.FilterName = filterName
 	pageData.FilterStatus = filterStatus
+	pageData.FilterState = filterState
 
-	// apply sort order
 	validatorSetLen := len(validatorSet)
-	if sortOrder == "" {
-		sortOrder = "index"
-	}
-
-	sortedValidatorSet := make([]*v1.Validator, validatorSetLen)
-	copy(sortedValidatorSet, validatorSet)
-
-	switch sortOrder {
-	case "index":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Index < sortedValidatorSet[b].Index
-		})
-		pageData.IsDefaultSorting = true
-	case "index-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Index > sortedValidatorSet[b].Index
-		})
-	case "pubkey":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return bytes.Compare(sortedValidatorSet[a].Validator.PublicKey[:], sortedValidatorSet[b].Validator.PublicKey[:]) < 0
-		})
-	case "pubkey-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return bytes.Compare(sortedValidatorSet[a].Validator.PublicKey[:], sortedValidatorSet[b].Validator.PublicKey[:]) > 0
-		})
-	case "balance":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Balance < sortedValidatorSet[b].Balance
-		})
-	case "balance-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Balance > sortedValidatorSet[b].Balance
-		})
-	case "activation":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ActivationEpoch < sortedValidatorSet[b].Validator.ActivationEpoch
-		})
-	case "activation-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ActivationEpoch > sortedValidatorSet[b].Validator.ActivationEpoch
-		})
-	case "exit":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ExitEpoch < sortedValidatorSet[b].Validator.ExitEpoch
-		})
-	case "exit-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ExitEpoch > sortedValidatorSet[b].Validator.ExitEpoch
-		})
-	}
-	validatorSet = sortedValidatorSet
 	pageData.Sorting = sortOrder
+	pageData.IsDefaultSorting = sortOrder == "index"
 
 	totalValidatorCount := uint64(validatorSetLen)
 	if firstValIdx == 0 {
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// histogram bucket boundaries as fraction of MAX_EFFECTIVE_BALANCE, denser around the max effective balance
+// where leaking & penalized validators show up first
+var validatorsBalancesBucketBounds = []float64{0, 0.5, 0.75, 0.875, 0.9375, 0.96875, 0.9921875, 1, 1.0078125, 1.03125, 2, 8, 32, 64}
+
+// ValidatorsBalances will return the validator balance distribution page using a go template
+func ValidatorsBalances(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"validators_balances/validators_balances.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/balances", "Balance Distribution", pageTemplateFiles)
+
+	var topCount uint64 = 25
+	if r.URL.Query().Has("n") {
+		topCount, _ = strconv.ParseUint(r.URL.Query().Get("n"), 10, 64)
+	}
+	if topCount == 0 {
+		topCount = 25
+	} else if topCount > 100 {
+		topCount = 100
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getValidatorsBalancesPageData(topCount)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validators_balances.go", "ValidatorsBalances", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getValidatorsBalancesPageData(topCount uint64) (*models.ValidatorsBalancesPageData, error) {
+	pageData := &models.ValidatorsBalancesPageData{}
+	pageCacheKey := fmt.Sprintf("validators_balances:%v", topCount)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(processingPage *services.FrontendCacheProcessingPage) interface{} {
+		// the cached validator set is refreshed once per epoch
+		specs := services.GlobalBeaconService.GetChainState().GetSpecs()
+		processingPage.CacheTimeout = specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+		return buildValidatorsBalancesPageData(topCount)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ValidatorsBalancesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildValidatorsBalancesPageData(topCount uint64) *models.ValidatorsBalancesPageData {
+	logrus.Debugf("validators_balances page called: %v", topCount)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.ValidatorsBalancesPageData{
+		TopCount:    topCount,
+		Epoch:       uint64(chainState.CurrentEpoch()),
+		RefreshTime: time.Now(),
+	}
+
+	// only active validators are included, exited validators with zero balance would dominate the bottom lists
+	activeValidators := []*v1.Validator{}
+	for _, validator := range services.GlobalBeaconService.GetCachedValidatorSet(true) {
+		if validator == nil || validator.Validator == nil || !validator.Status.IsActive() {
+			continue
+		}
+		activeValidators = append(activeValidators, validator)
+
+		pageData.TotalBalance += uint64(validator.Balance)
+		pageData.TotalEffectiveBalance += uint64(validator.Validator.EffectiveBalance)
+		if validator.Balance < validator.Validator.EffectiveBalance {
+			pageData.BelowEffectiveCount++
+		}
+	}
+	pageData.ActiveCount = uint64(len(activeValidators))
+	if pageData.ActiveCount == 0 {
+		return pageData
+	}
+	pageData.AvgBalance = pageData.TotalBalance / pageData.ActiveCount
+
+	// histogram
+	maxEffectiveBalance := float64(specs.MaxEffectiveBalance)
+	buckets := make([]*models.ValidatorsBalancesPageDataBucket, len(validatorsBalancesBucketBounds))
+	for i, bound := range validatorsBalancesBucketBounds {
+		bucket := &models.ValidatorsBalancesPageDataBucket{
+			MinBalance:      uint64(bound * maxEffectiveBalance),
+			MaxBalance:      math.MaxUint64,
+			BelowMaxBalance: bound < 1,
+		}
+		if i+1 < len(validatorsBalancesBucketBounds) {
+			bucket.MaxBalance = uint64(validatorsBalancesBucketBounds[i+1] * maxEffectiveBalance)
+			bucket.Label = fmt.Sprintf("%v - %v", formatValidatorsBalancesAmount(bucket.MinBalance), formatValidatorsBalancesAmount(bucket.MaxBalance))
+		} else {
+			bucket.Label = fmt.Sprintf(">= %v", formatValidatorsBalancesAmount(bucket.MinBalance))
+		}
+		buckets[i] = bucket
+	}
+
+	getBucket := func(balance uint64) *models.ValidatorsBalancesPageDataBucket {
+		idx := sort.Search(len(buckets), func(i int) bool {
+			return buckets[i].MaxBalance > balance
+		})
+		return buckets[idx]
+	}
+
+	maxCount := uint64(0)
+	for _, validator := range activeValidators {
+		balanceBucket := getBucket(uint64(validator.Balance))
+		balanceBucket.BalanceCount++
+		effectiveBucket := getBucket(uint64(validator.Validator.EffectiveBalance))
+		effectiveBucket.EffectiveCount++
+
+		if balanceBucket.BalanceCount > maxCount {
+			maxCount = balanceBucket.BalanceCount
+		}
+		if effectiveBucket.EffectiveCount > maxCount {
+			maxCount = effectiveBucket.EffectiveCount
+		}
+	}
+
+	// skip empty buckets at both ends of the histogram
+	firstBucket, lastBucket := len(buckets), -1
+	for i, bucket := range buckets {
+		if bucket.BalanceCount == 0 && bucket.EffectiveCount == 0 {
+			continue
+		}
+		if i < firstBucket {
+			firstBucket = i
+		}
+		lastBucket = i
+	}
+	for _, bucket := range buckets[firstBucket : lastBucket+1] {
+		bucket.BalanceWidth = float64(bucket.BalanceCount) * 100 / float64(maxCount)
+		bucket.EffectiveWidth = float64(bucket.EffectiveCount) * 100 / float64(maxCount)
+		pageData.Buckets = append(pageData.Buckets, bucket)
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	// top & bottom lists, ties are resolved by validator index
+	sort.Slice(activeValidators, func(a, b int) bool {
+		if activeValidators[a].Balance != activeValidators[b].Balance {
+			return activeValidators[a].Balance > activeValidators[b].Balance
+		}
+		return activeValidators[a].Index < activeValidators[b].Index
+	})
+	pageData.TopBalance = getValidatorsBalancesList(activeValidators, topCount, false)
+	pageData.BottomBalance = getValidatorsBalancesList(activeValidators, topCount, true)
+
+	sort.Slice(activeValidators, func(a, b int) bool {
+		if activeValidators[a].Validator.EffectiveBalance != activeValidators[b].Validator.EffectiveBalance {
+			return activeValidators[a].Validator.EffectiveBalance > activeValidators[b].Validator.EffectiveBalance
+		}
+		if activeValidators[a].Balance != activeValidators[b].Balance {
+			return activeValidators[a].Balance > activeValidators[b].Balance
+		}
+		return activeValidators[a].Index < activeValidators[b].Index
+	})
+	pageData.TopEffective = getValidatorsBalancesList(activeValidators, topCount, false)
+	pageData.BottomEffective = getValidatorsBalancesList(activeValidators, topCount, true)
+
+	return pageData
+}
+
+// getValidatorsBalancesList returns the first (or last, in reverse order) count validators of the sorted list.
+func getValidatorsBalancesList(validators []*v1.Validator, count uint64, fromEnd bool) []*models.ValidatorsBalancesPageDataValidator {
+	if uint64(len(validators)) < count {
+		count = uint64(len(validators))
+	}
+
+	list := make([]*models.ValidatorsBalancesPageDataValidator, 0, count)
+	for i := uint64(0); i < count; i++ {
+		validator := validators[i]
+		if fromEnd {
+			validator = validators[uint64(len(validators))-1-i]
+		}
+
+		list = append(list, &models.ValidatorsBalancesPageDataValidator{
+			Index:            uint64(validator.Index),
+			Name:             services.GlobalBeaconService.GetValidatorName(uint64(validator.Index)),
+			Balance:          uint64(validator.Balance),
+			EffectiveBalance: uint64(validator.Validator.EffectiveBalance),
+			State:            validator.Status.String(),
+		})
+	}
+
+	return list
+}
+
+// formatValidatorsBalancesAmount formats a bucket bound without trailing zeros (e.g. "31.75").
+func formatValidatorsBalancesAmount(gwei uint64) string {
+	amount := utils.FormatConsensusAmount(gwei, 4)
+	if strings.Contains(amount, ".") {
+		amount = strings.TrimRight(strings.TrimRight(amount, "0"), ".")
+	}
+	return amount
+}
@@ -0,0 +1,335 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+// ValidatorsClientPerformance will return the client pair performance report using a go template
+func ValidatorsClientPerformance(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"validators_client_performance/validators_client_performance.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/client_performance", "Client Performance", pageTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	var epochs uint64 = 6
+	if urlArgs.Has("epochs") {
+		epochs, _ = strconv.ParseUint(urlArgs.Get("epochs"), 10, 64)
+	}
+
+	var sortOrder string
+	if urlArgs.Has("o") {
+		sortOrder = urlArgs.Get("o")
+	}
+	if sortOrder == "" {
+		sortOrder = "group"
+	}
+
+	var groupBy uint64 = 1
+	if urlArgs.Has("group") {
+		groupBy, _ = strconv.ParseUint(urlArgs.Get("group"), 10, 64)
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getValidatorsClientPerformancePageData(epochs, sortOrder, groupBy)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validators_client_performance.go", "ValidatorsClientPerformance", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getValidatorsClientPerformancePageData(epochs uint64, sortOrder string, groupBy uint64) (*models.ValidatorsClientPerformancePageData, error) {
+	pageData := &models.ValidatorsClientPerformancePageData{}
+	pageCacheKey := fmt.Sprintf("validators_client_performance:%v:%v:%v", epochs, sortOrder, groupBy)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(processingPage *services.FrontendCacheProcessingPage) interface{} {
+		processingPage.CacheTimeout = 1 * time.Minute
+		return buildValidatorsClientPerformancePageData(epochs, sortOrder, groupBy)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ValidatorsClientPerformancePageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+// getClientPairName strips the node numbering from a validator name, so "lighthouse-geth-1" and "lighthouse-geth-2" end up in the same group.
+func getClientPairName(name string) string {
+	nameParts := strings.Split(name, "-")
+	pairParts := make([]string, 0, len(nameParts))
+	for _, part := range nameParts {
+		if _, err := strconv.ParseUint(part, 10, 64); err == nil {
+			continue
+		}
+		pairParts = append(pairParts, part)
+	}
+	return strings.Join(pairParts, "-")
+}
+
+func buildValidatorsClientPerformancePageData(epochs uint64, sortOrder string, groupBy uint64) *models.ValidatorsClientPerformancePageData {
+	if epochs == 0 {
+		epochs = 1
+	} else if epochs > 225 {
+		epochs = 225
+	}
+
+	pageData := &models.ValidatorsClientPerformancePageData{
+		ViewOptionGroupBy: groupBy,
+		ViewOptionEpochs:  epochs,
+		Sorting:           sortOrder,
+	}
+	logrus.Debugf("validators_client_performance page called: %v [%v]", epochs, groupBy)
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+
+	// the current epoch is still in progress, so the report covers the epochs before
+	currentEpoch := chainState.CurrentEpoch()
+	if currentEpoch == 0 {
+		return pageData
+	}
+	lastEpoch := currentEpoch - 1
+	firstEpoch := phase0.Epoch(0)
+	if uint64(lastEpoch)+1 > epochs {
+		firstEpoch = lastEpoch + 1 - phase0.Epoch(epochs)
+	}
+	pageData.FirstEpoch = uint64(firstEpoch)
+	pageData.LastEpoch = uint64(lastEpoch)
+
+	// group validators
+	validatorGroupMap := map[string]*models.ValidatorsClientPerformancePageDataGroup{}
+	validatorGroups := map[phase0.ValidatorIndex]*models.ValidatorsClientPerformancePageDataGroup{}
+	validatorSet := services.GlobalBeaconService.GetCachedValidatorSet(false)
+
+	_, oldestActivityEpoch := beaconIndexer.GetValidatorActivity(0)
+	activityEpoch := firstEpoch
+	if oldestActivityEpoch > activityEpoch {
+		activityEpoch = oldestActivityEpoch
+	}
+	pageData.FirstActivityEpoch = uint64(activityEpoch)
+
+	for vIdx, validator := range validatorSet {
+		groupName := services.GlobalBeaconService.GetValidatorName(uint64(vIdx))
+		if groupBy == 1 {
+			groupName = getClientPairName(groupName)
+		}
+		groupKey := strings.ToLower(groupName)
+
+		validatorGroup := validatorGroupMap[groupKey]
+		if validatorGroup == nil {
+			validatorGroup = &models.ValidatorsClientPerformancePageDataGroup{
+				Group:      groupName,
+				GroupLower: groupKey,
+			}
+			validatorGroupMap[groupKey] = validatorGroup
+		}
+
+		validatorGroups[phase0.ValidatorIndex(vIdx)] = validatorGroup
+		validatorGroup.Validators++
+
+		if validator.Validator == nil {
+			continue
+		}
+		if strings.HasPrefix(validator.Status.String(), "active_") {
+			validatorGroup.Activated++
+		}
+
+		// attestation performance (inclusion, timeliness & vote correctness) from the in-memory activity cache
+		activeFrom := validator.Validator.ActivationEpoch
+		if activeFrom < activityEpoch {
+			activeFrom = activityEpoch
+		}
+		activeTo := validator.Validator.ExitEpoch
+		if activeTo > lastEpoch+1 {
+			activeTo = lastEpoch + 1
+		}
+		if activeTo <= activeFrom {
+			continue
+		}
+		validatorGroup.AttestationsExpected += uint64(activeTo - activeFrom)
+
+		validatorActivity, _ := beaconIndexer.GetValidatorActivity(phase0.ValidatorIndex(vIdx))
+		lastVoteEpoch := phase0.Epoch(math.MaxInt64)
+		for _, activity := range validatorActivity {
+			voteEpoch := chainState.EpochOfSlot(activity.VoteBlock.Slot - phase0.Slot(activity.VoteDelay))
+			if voteEpoch < activeFrom || voteEpoch >= activeTo || voteEpoch == lastVoteEpoch {
+				continue
+			}
+			lastVoteEpoch = voteEpoch
+
+			validatorGroup.AttestationsIncluded++
+			validatorGroup.AttestationAvgDelay += float64(activity.VoteDelay)
+			if activity.VoteDelay <= 1 {
+				validatorGroup.AttestationsTimely++
+			}
+			if activity.VoteFlags&beacon.ValidatorVoteTargetCorrect != 0 {
+				validatorGroup.AttestationsTarget++
+			}
+			if activity.VoteFlags&beacon.ValidatorVoteHeadCorrect != 0 {
+				validatorGroup.AttestationsHead++
+			}
+		}
+	}
+
+	// proposal & sync committee performance from the blocks in range
+	_, prunedEpoch := beaconIndexer.GetBlockCacheState()
+	syncEpoch := firstEpoch
+	if prunedEpoch > syncEpoch {
+		syncEpoch = prunedEpoch
+	}
+	pageData.FirstSyncEpoch = uint64(syncEpoch)
+
+	slotCount := uint64(chainState.EpochToSlot(lastEpoch+1) - chainState.EpochToSlot(firstEpoch))
+	dbBlocks := services.GlobalBeaconService.GetDbBlocksForSlots(uint64(chainState.EpochToSlot(lastEpoch+1)-1), uint32(slotCount), true, true)
+
+	syncCommitteeEpoch := phase0.Epoch(math.MaxInt64)
+	var syncCommittee []phase0.ValidatorIndex
+	for _, dbBlock := range dbBlocks {
+		if dbBlock.Slot < uint64(chainState.EpochToSlot(firstEpoch)) {
+			continue
+		}
+
+		proposerGroup := validatorGroups[phase0.ValidatorIndex(dbBlock.Proposer)]
+		if proposerGroup != nil {
+			switch dbBlock.Status {
+			case dbtypes.Canonical:
+				proposerGroup.ProposedBlocks++
+			case dbtypes.Missing:
+				proposerGroup.MissedBlocks++
+			case dbtypes.Orphaned:
+				proposerGroup.OrphanedBlocks++
+			}
+		}
+
+		if dbBlock.Status != dbtypes.Canonical || dbBlock.Slot < uint64(chainState.EpochToSlot(syncEpoch)) {
+			continue
+		}
+
+		block := beaconIndexer.GetBlockByRoot(phase0.Root(dbBlock.Root))
+		if block == nil {
+			continue
+		}
+		blockBody := block.GetBlock()
+		if blockBody == nil {
+			continue
+		}
+		syncAggregate, _ := blockBody.SyncAggregate()
+		if syncAggregate == nil {
+			continue
+		}
+
+		blockEpoch := chainState.EpochOfSlot(block.Slot)
+		if blockEpoch != syncCommitteeEpoch {
+			syncCommitteeEpoch = blockEpoch
+			syncCommittee = nil
+			if epochStats := beaconIndexer.GetEpochStats(blockEpoch, nil); epochStats != nil {
+				if epochStatsValues := epochStats.GetValues(false); epochStatsValues != nil {
+					syncCommittee = epochStatsValues.SyncCommitteeDuties
+				}
+			}
+		}
+
+		for i, member := range syncCommittee {
+			memberGroup := validatorGroups[member]
+			if memberGroup == nil {
+				continue
+			}
+			memberGroup.SyncExpected++
+			if utils.BitAtVector(syncAggregate.SyncCommitteeBits, i) {
+				memberGroup.SyncIncluded++
+			}
+		}
+	}
+
+	// calculate rates
+	for _, validatorGroup := range validatorGroupMap {
+		proposals := validatorGroup.ProposedBlocks + validatorGroup.MissedBlocks + validatorGroup.OrphanedBlocks
+		if proposals > 0 {
+			validatorGroup.ProposalRate = float64(validatorGroup.ProposedBlocks) / float64(proposals)
+		}
+		if validatorGroup.AttestationsExpected > 0 {
+			validatorGroup.AttestationRate = float64(validatorGroup.AttestationsIncluded) / float64(validatorGroup.AttestationsExpected)
+		}
+		if validatorGroup.AttestationsIncluded > 0 {
+			validatorGroup.AttestationTimely = float64(validatorGroup.AttestationsTimely) / float64(validatorGroup.AttestationsIncluded)
+			validatorGroup.AttestationTarget = float64(validatorGroup.AttestationsTarget) / float64(validatorGroup.AttestationsIncluded)
+			validatorGroup.AttestationHead = float64(validatorGroup.AttestationsHead) / float64(validatorGroup.AttestationsIncluded)
+			validatorGroup.AttestationAvgDelay = validatorGroup.AttestationAvgDelay / float64(validatorGroup.AttestationsIncluded)
+		}
+		if validatorGroup.SyncExpected > 0 {
+			validatorGroup.SyncRate = float64(validatorGroup.SyncIncluded) / float64(validatorGroup.SyncExpected)
+		}
+	}
+
+	// sort groups
+	groups := maps.Values(validatorGroupMap)
+	switch sortOrder {
+	case "group-d":
+		sort.Slice(groups, func(a, b int) bool {
+			return strings.Compare(groups[a].GroupLower, groups[b].GroupLower) > 0
+		})
+	case "proposals":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].ProposalRate < groups[b].ProposalRate
+		})
+	case "proposals-d":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].ProposalRate > groups[b].ProposalRate
+		})
+	case "attestations":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].AttestationRate < groups[b].AttestationRate
+		})
+	case "attestations-d":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].AttestationRate > groups[b].AttestationRate
+		})
+	case "sync":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].SyncRate < groups[b].SyncRate
+		})
+	case "sync-d":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].SyncRate > groups[b].SyncRate
+		})
+	default:
+		pageData.Sorting = "group"
+		sort.Slice(groups, func(a, b int) bool {
+			return strings.Compare(groups[a].GroupLower, groups[b].GroupLower) < 0
+		})
+	}
+
+	pageData.Groups = groups
+	pageData.GroupCount = uint64(len(groups))
+	pageData.ViewLink = fmt.Sprintf("/validators/client_performance?group=%v&epochs=%v", groupBy, epochs)
+
+	return pageData
+}
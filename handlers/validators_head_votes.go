@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ValidatorsHeadVotes will return the head vote accuracy report per client entity using a go template
+func ValidatorsHeadVotes(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"validators_head_votes/validators_head_votes.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/head_votes", "Head Vote Accuracy", pageTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	var epochs uint64 = 12
+	if urlArgs.Has("epochs") {
+		epochs, _ = strconv.ParseUint(urlArgs.Get("epochs"), 10, 64)
+	}
+
+	var sortOrder string
+	if urlArgs.Has("o") {
+		sortOrder = urlArgs.Get("o")
+	}
+	if sortOrder == "" {
+		sortOrder = "group"
+	}
+
+	var groupBy uint64 = 1
+	if urlArgs.Has("group") {
+		groupBy, _ = strconv.ParseUint(urlArgs.Get("group"), 10, 64)
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getValidatorsHeadVotesPageData(epochs, sortOrder, groupBy)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validators_head_votes.go", "ValidatorsHeadVotes", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getValidatorsHeadVotesPageData(epochs uint64, sortOrder string, groupBy uint64) (*models.ValidatorsHeadVotesPageData, error) {
+	pageData := &models.ValidatorsHeadVotesPageData{}
+	pageCacheKey := fmt.Sprintf("validators_head_votes:%v:%v:%v", epochs, sortOrder, groupBy)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(processingPage *services.FrontendCacheProcessingPage) interface{} {
+		// the report is extended once per epoch
+		processingPage.CacheTimeout = services.GlobalBeaconService.GetChainState().GetSpecs().SecondsPerSlot
+		return buildValidatorsHeadVotesPageData(epochs, sortOrder, groupBy)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ValidatorsHeadVotesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildValidatorsHeadVotesPageData(epochs uint64, sortOrder string, groupBy uint64) *models.ValidatorsHeadVotesPageData {
+	if epochs == 0 {
+		epochs = 1
+	} else if epochs > 225 {
+		epochs = 225
+	}
+
+	pageData := &models.ValidatorsHeadVotesPageData{
+		ViewOptionGroupBy: groupBy,
+		ViewOptionEpochs:  epochs,
+		Sorting:           sortOrder,
+		ReportEnabled:     services.GlobalHeadVoteReportService != nil,
+		Epochs:            []uint64{},
+	}
+	logrus.Debugf("validators_head_votes page called: %v [%v]", epochs, groupBy)
+
+	// votes are reported once the canonical chain of the epoch & its inclusion window has settled
+	currentEpoch := services.GlobalBeaconService.GetChainState().CurrentEpoch()
+	if currentEpoch < 2 {
+		return pageData
+	}
+	lastEpoch := currentEpoch - 2
+	firstEpoch := phase0.Epoch(0)
+	if uint64(lastEpoch)+1 > epochs {
+		firstEpoch = lastEpoch + 1 - phase0.Epoch(epochs)
+	}
+	pageData.FirstEpoch = uint64(firstEpoch)
+	pageData.LastEpoch = uint64(lastEpoch)
+
+	reportEpochs := services.GlobalHeadVoteReportService.GetEpochs(firstEpoch, lastEpoch)
+	groupMap := map[string]*models.ValidatorsHeadVotesPageDataGroup{}
+	for epochIdx, reportEpoch := range reportEpochs {
+		pageData.Epochs = append(pageData.Epochs, uint64(reportEpoch.Epoch))
+
+		for entityName, entityStats := range reportEpoch.Entities {
+			groupName := entityName
+			if groupBy == 1 {
+				groupName = getClientPairName(groupName)
+			}
+			groupKey := strings.ToLower(groupName)
+
+			group := groupMap[groupKey]
+			if group == nil {
+				group = &models.ValidatorsHeadVotesPageDataGroup{
+					Group:      groupName,
+					GroupLower: groupKey,
+					Epochs:     make([]*models.ValidatorsHeadVotesPageDataEpoch, len(reportEpochs)),
+				}
+				groupMap[groupKey] = group
+			}
+
+			groupEpoch := group.Epochs[epochIdx]
+			if groupEpoch == nil {
+				groupEpoch = &models.ValidatorsHeadVotesPageDataEpoch{
+					Epoch: uint64(reportEpoch.Epoch),
+				}
+				group.Epochs[epochIdx] = groupEpoch
+			}
+
+			groupEpoch.Expected += entityStats.Expected
+			groupEpoch.Included += entityStats.Included
+			groupEpoch.Correct += entityStats.Correct
+			group.Expected += entityStats.Expected
+			group.Included += entityStats.Included
+			group.Correct += entityStats.Correct
+		}
+	}
+	pageData.EpochCount = uint64(len(pageData.Epochs))
+
+	// calculate rates, epochs without duties of the group are kept as empty cells
+	for _, group := range groupMap {
+		if group.Included > 0 {
+			group.Accuracy = float64(group.Correct) / float64(group.Included)
+		}
+		if group.Expected > 0 {
+			group.Participation = float64(group.Included) / float64(group.Expected)
+		}
+		for epochIdx, groupEpoch := range group.Epochs {
+			if groupEpoch == nil {
+				group.Epochs[epochIdx] = &models.ValidatorsHeadVotesPageDataEpoch{
+					Epoch: pageData.Epochs[epochIdx],
+				}
+				continue
+			}
+			if groupEpoch.Included > 0 {
+				groupEpoch.Accuracy = float64(groupEpoch.Correct) / float64(groupEpoch.Included)
+			}
+		}
+	}
+
+	// sort groups
+	groups := maps.Values(groupMap)
+	switch sortOrder {
+	case "group-d":
+		sort.Slice(groups, func(a, b int) bool {
+			return strings.Compare(groups[a].GroupLower, groups[b].GroupLower) > 0
+		})
+	case "accuracy":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].Accuracy < groups[b].Accuracy
+		})
+	case "accuracy-d":
+		sort.Slice(groups, func(a, b int) bool {
+			return groups[a].Accuracy > groups[b].Accuracy
+		})
+	default:
+		pageData.Sorting = "group"
+		sort.Slice(groups, func(a, b int) bool {
+			return strings.Compare(groups[a].GroupLower, groups[b].GroupLower) < 0
+		})
+	}
+
+	pageData.Groups = groups
+	pageData.GroupCount = uint64(len(groups))
+	pageData.ViewLink = fmt.Sprintf("/validators/head_votes?group=%v&epochs=%v", groupBy, epochs)
+
+	return pageData
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of epochs shown in the chart, larger ranges are sampled
+const validatorsSetGrowthMaxPoints = 500
+
+// ValidatorsSetGrowth will return the validator set growth chart page using a go template
+func ValidatorsSetGrowth(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"validators_set_growth/validators_set_growth.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/set_growth", "Validator Set Growth", pageTemplateFiles)
+
+	chartRange := r.URL.Query().Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "30d"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getValidatorsSetGrowthPageData(chartRange)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validators_set_growth.go", "ValidatorsSetGrowth", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getValidatorsSetGrowthPageData(chartRange string) (*models.ValidatorsSetGrowthPageData, error) {
+	pageData := &models.ValidatorsSetGrowthPageData{}
+	pageCacheKey := fmt.Sprintf("validators_set_growth:%v", chartRange)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildValidatorsSetGrowthPageData(chartRange)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ValidatorsSetGrowthPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildValidatorsSetGrowthPageData(chartRange string) (*models.ValidatorsSetGrowthPageData, time.Duration) {
+	logrus.Debugf("validators set growth page called: %v", chartRange)
+	pageData := &models.ValidatorsSetGrowthPageData{
+		Range: chartRange,
+	}
+	pageData.FirstEpoch, pageData.LastEpoch = getChartRangeEpochs(chartRange)
+
+	pageData.EpochStep = (pageData.LastEpoch-pageData.FirstEpoch)/validatorsSetGrowthMaxPoints + 1
+
+	dbEpochs := db.GetEpochValidatorSetStats(pageData.FirstEpoch, pageData.LastEpoch, pageData.EpochStep)
+	pageData.Epochs = make([]*models.ValidatorsSetGrowthPageDataEpoch, 0, len(dbEpochs))
+
+	epochNumbers := make([]uint64, 0, len(dbEpochs))
+	validatorCounts := make([]float64, 0, len(dbEpochs))
+	effectiveBalances := make([]float64, 0, len(dbEpochs))
+	for _, dbEpoch := range dbEpochs {
+		if dbEpoch.ValidatorCount == 0 {
+			// epoch stats not available
+			continue
+		}
+
+		pageData.Epochs = append(pageData.Epochs, &models.ValidatorsSetGrowthPageDataEpoch{
+			Epoch:            dbEpoch.Epoch,
+			ValidatorCount:   dbEpoch.ValidatorCount,
+			EffectiveBalance: dbEpoch.ValidatorBalance,
+		})
+
+		epochNumbers = append(epochNumbers, dbEpoch.Epoch)
+		validatorCounts = append(validatorCounts, float64(dbEpoch.ValidatorCount))
+		effectiveBalances = append(effectiveBalances, utils.ConsensusUnitsFromGwei(dbEpoch.ValidatorBalance))
+	}
+	pageData.EpochCount = uint64(len(pageData.Epochs))
+
+	pageData.Chart = buildLineChart(epochNumbers, func(epoch uint64) string {
+		return fmt.Sprintf("Epoch %v", utils.FormatFloat(float64(epoch), 0))
+	}, &chartSeries{
+		name:   "Active validators",
+		color:  "#0d6efd",
+		values: validatorCounts,
+		format: func(value float64) string {
+			return utils.FormatFloat(value, 0)
+		},
+	}, &chartSeries{
+		name:      "Total effective balance",
+		color:     "#fd7e14",
+		values:    effectiveBalances,
+		rightAxis: true,
+		format: func(value float64) string {
+			return fmt.Sprintf("%v %v", utils.FormatFloat(value, 0), utils.GetDenomination().ConsensusSymbol)
+		},
+	})
+
+	return pageData, 10 * time.Minute
+}
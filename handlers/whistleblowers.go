@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// Whistleblowers will return the whistleblower leaderboard page using a go template
+func Whistleblowers(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"whistleblowers/whistleblowers.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/whistleblowers", "Whistleblowers", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+	statsRange := urlArgs.Get("range")
+	if _, isValid := chartRanges[statsRange]; !isValid {
+		statsRange = "all"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getWhistleblowersPageData(pageIdx, pageSize, statsRange)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "whistleblowers.go", "Whistleblowers", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getWhistleblowersPageData(pageIdx uint64, pageSize uint64, statsRange string) (*models.WhistleblowersPageData, error) {
+	pageData := &models.WhistleblowersPageData{}
+	pageCacheKey := fmt.Sprintf("whistleblowers:%v:%v:%v", pageIdx, pageSize, statsRange)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildWhistleblowersPageData(pageIdx, pageSize, statsRange)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.WhistleblowersPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildWhistleblowersPageData(pageIdx uint64, pageSize uint64, statsRange string) *models.WhistleblowersPageData {
+	logrus.Debugf("whistleblowers page called: %v:%v [%v]", pageIdx, pageSize, statsRange)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.WhistleblowersPageData{
+		Range: statsRange,
+	}
+
+	pageSize = services.LimitPageSize(pageSize)
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	minSlot := uint64(0)
+	if statsRange != "all" {
+		firstEpoch, _ := getChartRangeEpochs(statsRange)
+		minSlot = uint64(chainState.EpochToSlot(phase0.Epoch(firstEpoch)))
+	}
+
+	dbStats, totalRows, err := db.GetWhistleblowerStats(minSlot, (pageIdx-1)*pageSize, uint32(pageSize))
+	if err != nil {
+		return pageData
+	}
+
+	for idx, dbEntry := range dbStats {
+		pageData.Whistleblowers = append(pageData.Whistleblowers, &models.WhistleblowersPageDataEntry{
+			Rank:                  (pageIdx-1)*pageSize + uint64(idx) + 1,
+			Index:                 dbEntry.SlasherIndex,
+			Name:                  services.GlobalBeaconService.GetValidatorName(dbEntry.SlasherIndex),
+			SlashingCount:         dbEntry.SlashingCount,
+			ProposerSlashingCount: dbEntry.ProposerSlashingCount,
+			AttesterSlashingCount: dbEntry.AttesterSlashingCount,
+			TotalReward:           dbEntry.TotalReward,
+			LastSlot:              dbEntry.LastSlot,
+			LastTime:              chainState.SlotToTime(phase0.Slot(dbEntry.LastSlot)),
+		})
+	}
+	pageData.WhistleblowerCount = uint64(len(pageData.Whistleblowers))
+	pageData.TotalWhistleblowers = totalRows
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/validators/whistleblowers?range=%v&c=%v", statsRange, pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/validators/whistleblowers?range=%v&c=%v&p=%v", statsRange, pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/validators/whistleblowers?range=%v&c=%v&p=%v", statsRange, pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/validators/whistleblowers?range=%v&c=%v&p=%v", statsRange, pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// max number of buckets shown in the chart when grouping by epoch, larger ranges are aggregated
+const withdrawalThroughputMaxPoints = 500
+
+// WithdrawalThroughput will return the withdrawal throughput chart page using a go template
+func WithdrawalThroughput(w http.ResponseWriter, r *http.Request) {
+	var pageTemplateFiles = append(layoutTemplateFiles,
+		"withdrawal_throughput/withdrawal_throughput.html",
+		"_svg/linechart.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(pageTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/withdrawal_throughput", "Withdrawal Throughput", pageTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	chartRange := urlArgs.Get("range")
+	if _, isValid := chartRanges[chartRange]; !isValid {
+		chartRange = "30d"
+	}
+	chartGroup := urlArgs.Get("group")
+	if chartGroup != "day" {
+		chartGroup = "epoch"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getWithdrawalThroughputPageData(chartRange, chartGroup)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "withdrawal_throughput.go", "WithdrawalThroughput", "", executeLayoutTemplate(w, r, pageTemplate, data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getWithdrawalThroughputPageData(chartRange string, chartGroup string) (*models.WithdrawalThroughputPageData, error) {
+	pageData := &models.WithdrawalThroughputPageData{}
+	pageCacheKey := fmt.Sprintf("withdrawal_throughput:%v:%v", chartRange, chartGroup)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildWithdrawalThroughputPageData(chartRange, chartGroup)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.WithdrawalThroughputPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildWithdrawalThroughputPageData(chartRange string, chartGroup string) (*models.WithdrawalThroughputPageData, time.Duration) {
+	logrus.Debugf("withdrawal throughput page called: %v, %v", chartRange, chartGroup)
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	pageData := &models.WithdrawalThroughputPageData{
+		Range: chartRange,
+		Group: chartGroup,
+	}
+	pageData.FirstEpoch, pageData.LastEpoch = getChartRangeEpochs(chartRange)
+
+	if chartGroup == "day" {
+		epochDuration := specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+		pageData.BucketSize = uint64(24 * time.Hour / epochDuration)
+		if pageData.BucketSize == 0 {
+			pageData.BucketSize = 1
+		}
+	} else {
+		pageData.BucketSize = (pageData.LastEpoch-pageData.FirstEpoch)/withdrawalThroughputMaxPoints + 1
+	}
+
+	// current withdrawal sweep position
+	sweepIndex, sweepEpoch, hasSweep := services.GlobalBeaconService.GetBeaconIndexer().GetWithdrawalSweepPosition(nil)
+	if hasSweep {
+		pageData.ShowSweep = true
+		pageData.SweepValidatorIndex = uint64(sweepIndex)
+		pageData.SweepValidatorName = services.GlobalBeaconService.GetValidatorName(uint64(sweepIndex))
+		pageData.SweepEpoch = uint64(sweepEpoch)
+		pageData.ValidatorCount = uint64(len(services.GlobalBeaconService.GetBeaconIndexer().GetValidatorSet(nil)))
+		if pageData.ValidatorCount > 0 {
+			pageData.SweepProgress = float64(pageData.SweepValidatorIndex) * 100 / float64(pageData.ValidatorCount)
+		}
+	}
+
+	dbStats := db.GetEpochWithdrawalStats(pageData.FirstEpoch, pageData.LastEpoch, pageData.BucketSize)
+	pageData.Buckets = make([]*models.WithdrawalThroughputPageDataBucket, 0, len(dbStats))
+
+	bucketEpochs := make([]uint64, 0, len(dbStats))
+	partialAmounts := make([]float64, 0, len(dbStats))
+	fullAmounts := make([]float64, 0, len(dbStats))
+	withdrawCounts := make([]float64, 0, len(dbStats))
+	for _, dbBucket := range dbStats {
+		bucket := &models.WithdrawalThroughputPageDataBucket{
+			FirstEpoch:     dbBucket.FirstEpoch,
+			LastEpoch:      dbBucket.LastEpoch,
+			Time:           chainState.EpochToTime(phase0.Epoch(dbBucket.FirstEpoch)),
+			WithdrawCount:  dbBucket.WithdrawCount,
+			WithdrawAmount: dbBucket.WithdrawAmount,
+			FullCount:      dbBucket.FullWithdrawCount,
+			FullAmount:     dbBucket.FullWithdrawAmount,
+		}
+		if bucket.WithdrawCount > bucket.FullCount {
+			bucket.PartialCount = bucket.WithdrawCount - bucket.FullCount
+		}
+		if bucket.WithdrawAmount > bucket.FullAmount {
+			bucket.PartialAmount = bucket.WithdrawAmount - bucket.FullAmount
+		}
+		pageData.Buckets = append(pageData.Buckets, bucket)
+
+		pageData.TotalCount += bucket.WithdrawCount
+		pageData.TotalAmount += bucket.WithdrawAmount
+		pageData.FullCount += bucket.FullCount
+		pageData.FullAmount += bucket.FullAmount
+		pageData.PartialCount += bucket.PartialCount
+		pageData.PartialAmount += bucket.PartialAmount
+
+		bucketEpochs = append(bucketEpochs, bucket.FirstEpoch)
+		partialAmounts = append(partialAmounts, utils.ConsensusUnitsFromGwei(bucket.PartialAmount))
+		fullAmounts = append(fullAmounts, utils.ConsensusUnitsFromGwei(bucket.FullAmount))
+		withdrawCounts = append(withdrawCounts, float64(bucket.WithdrawCount))
+	}
+	pageData.BucketCount = uint64(len(pageData.Buckets))
+
+	formatEthValue := func(value float64) string {
+		return fmt.Sprintf("%v %v", utils.FormatFloat(value, 2), utils.GetDenomination().ConsensusSymbol)
+	}
+	pageData.Chart = buildLineChart(bucketEpochs, func(epoch uint64) string {
+		if chartGroup == "day" {
+			return chainState.EpochToTime(phase0.Epoch(epoch)).UTC().Format("2006-01-02")
+		}
+		return fmt.Sprintf("Epoch %v", utils.FormatFloat(float64(epoch), 0))
+	}, &chartSeries{
+		name:   "Partial withdrawals",
+		color:  "#198754",
+		values: partialAmounts,
+		format: formatEthValue,
+	}, &chartSeries{
+		name:   "Full withdrawals",
+		color:  "#dc3545",
+		values: fullAmounts,
+		format: formatEthValue,
+	}, &chartSeries{
+		name:      "Withdrawal count",
+		color:     "#0d6efd",
+		values:    withdrawCounts,
+		rightAxis: true,
+		format: func(value float64) string {
+			return utils.FormatFloat(value, 0)
+		},
+	})
+
+	return pageData, 1 * time.Minute
+}
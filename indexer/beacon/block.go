@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand/v2"
+	"sort"
 	"sync"
 	"time"
 
@@ -34,7 +35,7 @@ type Block struct {
 	isInUnfinalizedDb bool // block is in unfinalized table (unfinalized_blocks)
 	processingStatus  dbtypes.UnfinalizedBlockStatus
 	seenMutex         sync.RWMutex
-	seenMap           map[uint16]*Client
+	seenMap           map[uint16]*BlockSeenBy
 	processedActivity uint8
 }
 
@@ -47,13 +48,19 @@ type BlockBodyIndex struct {
 	ExecutionNumber    uint64
 }
 
+// BlockSeenBy holds the attribution of a block to a client that served or announced it.
+type BlockSeenBy struct {
+	Client *Client
+	Time   time.Time // time the client first served or announced the block
+}
+
 // newBlock creates a new Block instance.
 func newBlock(dynSsz *dynssz.DynSsz, root phase0.Root, slot phase0.Slot) *Block {
 	return &Block{
 		Root:       root,
 		Slot:       slot,
 		dynSsz:     dynSsz,
-		seenMap:    make(map[uint16]*Client),
+		seenMap:    make(map[uint16]*BlockSeenBy),
 		headerChan: make(chan bool),
 		blockChan:  make(chan bool),
 	}
@@ -66,8 +73,8 @@ func (block *Block) GetSeenBy() []*Client {
 
 	clients := []*Client{}
 
-	for _, client := range block.seenMap {
-		clients = append(clients, client)
+	for _, seenBy := range block.seenMap {
+		clients = append(clients, seenBy.Client)
 	}
 
 	rand.Shuffle(len(clients), func(i, j int) {
@@ -77,11 +84,34 @@ func (block *Block) GetSeenBy() []*Client {
 	return clients
 }
 
+// GetSeenByAttributions returns the clients that have seen this block, ordered by the time they first served or announced it.
+func (block *Block) GetSeenByAttributions() []*BlockSeenBy {
+	block.seenMutex.RLock()
+	defer block.seenMutex.RUnlock()
+
+	attributions := make([]*BlockSeenBy, 0, len(block.seenMap))
+	for _, seenBy := range block.seenMap {
+		attributions = append(attributions, seenBy)
+	}
+
+	sort.Slice(attributions, func(i, j int) bool {
+		return attributions[i].Time.Before(attributions[j].Time)
+	})
+
+	return attributions
+}
+
 // SetSeenBy sets the client that has seen this block.
 func (block *Block) SetSeenBy(client *Client) {
 	block.seenMutex.Lock()
 	defer block.seenMutex.Unlock()
-	block.seenMap[client.index] = client
+
+	if block.seenMap[client.index] == nil {
+		block.seenMap[client.index] = &BlockSeenBy{
+			Client: client,
+			Time:   time.Now(),
+		}
+	}
 }
 
 // GetHeader returns the signed beacon block header of this block.
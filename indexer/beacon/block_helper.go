@@ -3,6 +3,8 @@ package beacon
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
@@ -286,6 +288,42 @@ func getStateDepositIndex(state *spec.VersionedBeaconState) uint64 {
 	return 0
 }
 
+// getStateFinalizedEpoch returns the epoch of the finalized checkpoint from a versioned beacon state.
+func getStateFinalizedEpoch(state *spec.VersionedBeaconState) phase0.Epoch {
+	var checkpoint *phase0.Checkpoint
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		checkpoint = state.Phase0.FinalizedCheckpoint
+	case spec.DataVersionAltair:
+		checkpoint = state.Altair.FinalizedCheckpoint
+	case spec.DataVersionBellatrix:
+		checkpoint = state.Bellatrix.FinalizedCheckpoint
+	case spec.DataVersionCapella:
+		checkpoint = state.Capella.FinalizedCheckpoint
+	case spec.DataVersionDeneb:
+		checkpoint = state.Deneb.FinalizedCheckpoint
+	case spec.DataVersionElectra:
+		checkpoint = state.Electra.FinalizedCheckpoint
+	}
+	if checkpoint == nil {
+		return 0
+	}
+	return checkpoint.Epoch
+}
+
+// getStateNextWithdrawalValidatorIndex returns the next validator index of the withdrawal sweep from a versioned beacon state.
+func getStateNextWithdrawalValidatorIndex(state *spec.VersionedBeaconState) (phase0.ValidatorIndex, bool) {
+	switch state.Version {
+	case spec.DataVersionCapella:
+		return state.Capella.NextWithdrawalValidatorIndex, true
+	case spec.DataVersionDeneb:
+		return state.Deneb.NextWithdrawalValidatorIndex, true
+	case spec.DataVersionElectra:
+		return state.Electra.NextWithdrawalValidatorIndex, true
+	}
+	return 0, false
+}
+
 // getStateCurrentSyncCommittee returns the current sync committee from a versioned beacon state.
 func getStateCurrentSyncCommittee(v *spec.VersionedBeaconState) ([]phase0.BLSPubKey, error) {
 	switch v.Version {
@@ -325,3 +363,165 @@ func getStateCurrentSyncCommittee(v *spec.VersionedBeaconState) ([]phase0.BLSPub
 		return nil, errors.New("unknown version")
 	}
 }
+
+// BlockSizes holds the ssz encoded size of a block and the size of its major components in bytes.
+type BlockSizes struct {
+	Total           uint64 // full signed beacon block
+	Attestations    uint64 // attestations list
+	Payload         uint64 // execution payload
+	BlobCommitments uint64 // blob kzg commitments list (blob references)
+}
+
+// getBlockSszSizes calculates the ssz encoded sizes of a versioned signed beacon block and its components.
+func getBlockSszSizes(dynSsz *dynssz.DynSsz, block *spec.VersionedSignedBeaconBlock) (*BlockSizes, error) {
+	var signedBlock, attestations, payload any
+	var blobCommitments int
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		if block.Phase0 == nil || block.Phase0.Message == nil || block.Phase0.Message.Body == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		signedBlock = block.Phase0
+		attestations = block.Phase0.Message.Body.Attestations
+	case spec.DataVersionAltair:
+		if block.Altair == nil || block.Altair.Message == nil || block.Altair.Message.Body == nil {
+			return nil, errors.New("no altair block")
+		}
+		signedBlock = block.Altair
+		attestations = block.Altair.Message.Body.Attestations
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		signedBlock = block.Bellatrix
+		attestations = block.Bellatrix.Message.Body.Attestations
+		payload = block.Bellatrix.Message.Body.ExecutionPayload
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil {
+			return nil, errors.New("no capella block")
+		}
+		signedBlock = block.Capella
+		attestations = block.Capella.Message.Body.Attestations
+		payload = block.Capella.Message.Body.ExecutionPayload
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil {
+			return nil, errors.New("no deneb block")
+		}
+		signedBlock = block.Deneb
+		attestations = block.Deneb.Message.Body.Attestations
+		payload = block.Deneb.Message.Body.ExecutionPayload
+		blobCommitments = len(block.Deneb.Message.Body.BlobKZGCommitments)
+	case spec.DataVersionElectra:
+		if block.Electra == nil || block.Electra.Message == nil || block.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		signedBlock = block.Electra
+		attestations = block.Electra.Message.Body.Attestations
+		payload = block.Electra.Message.Body.ExecutionPayload
+		blobCommitments = len(block.Electra.Message.Body.BlobKZGCommitments)
+	default:
+		return nil, errors.New("unknown version")
+	}
+
+	sizes := &BlockSizes{
+		BlobCommitments: uint64(blobCommitments * len(deneb.KZGCommitment{})),
+	}
+
+	size, err := dynSsz.SizeSSZ(signedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed calculating block size: %v", err)
+	}
+	sizes.Total = uint64(size)
+
+	size, err = dynSsz.SizeSSZ(attestations)
+	if err != nil {
+		return nil, fmt.Errorf("failed calculating attestations size: %v", err)
+	}
+	sizes.Attestations = uint64(size)
+
+	if payload != nil {
+		size, err = dynSsz.SizeSSZ(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed calculating payload size: %v", err)
+		}
+		sizes.Payload = uint64(size)
+	}
+
+	return sizes, nil
+}
+
+// BlockFees holds the execution fee figures of a block that can be derived from the execution payload.
+type BlockFees struct {
+	GasUsed    uint64
+	BaseFee    uint64 // base fee per gas in wei
+	BurnedFees uint64 // burned base fees (gas used * base fee) in gwei
+}
+
+// getBlockExecutionFees returns the gas used, base fee and burned fees of a versioned signed beacon block.
+func getBlockExecutionFees(v *spec.VersionedSignedBeaconBlock) (*BlockFees, error) {
+	var gasUsed uint64
+	baseFee := new(big.Int)
+
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		executionPayload := v.Bellatrix.Message.Body.ExecutionPayload
+		gasUsed = executionPayload.GasUsed
+		var baseFeeBEBytes [32]byte
+		for i := 0; i < 32; i++ {
+			baseFeeBEBytes[i] = executionPayload.BaseFeePerGas[32-1-i]
+		}
+		baseFee.SetBytes(baseFeeBEBytes[:])
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		executionPayload := v.Capella.Message.Body.ExecutionPayload
+		gasUsed = executionPayload.GasUsed
+		var baseFeeBEBytes [32]byte
+		for i := 0; i < 32; i++ {
+			baseFeeBEBytes[i] = executionPayload.BaseFeePerGas[32-1-i]
+		}
+		baseFee.SetBytes(baseFeeBEBytes[:])
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		executionPayload := v.Deneb.Message.Body.ExecutionPayload
+		gasUsed = executionPayload.GasUsed
+		baseFee = executionPayload.BaseFeePerGas.ToBig()
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		executionPayload := v.Electra.Message.Body.ExecutionPayload
+		gasUsed = executionPayload.GasUsed
+		baseFee = executionPayload.BaseFeePerGas.ToBig()
+	default:
+		return nil, errors.New("no execution payload")
+	}
+
+	burnedFees := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed))
+	burnedFees.Div(burnedFees, big.NewInt(1000000000))
+
+	// clamp absurd values (e.g. on spam-test devnets) to the range of the signed bigint db columns
+	if !baseFee.IsInt64() {
+		baseFee = big.NewInt(math.MaxInt64)
+	}
+	if !burnedFees.IsInt64() {
+		burnedFees.SetInt64(math.MaxInt64)
+	}
+
+	return &BlockFees{
+		GasUsed:    gasUsed,
+		BaseFee:    baseFee.Uint64(),
+		BurnedFees: burnedFees.Uint64(),
+	}, nil
+}
@@ -2,7 +2,9 @@ package beacon
 
 import (
 	"bytes"
+	"encoding/hex"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -162,6 +164,22 @@ func (cache *blockCache) getBlockByStateRoot(stateRoot phase0.Root) *Block {
 	return nil
 }
 
+// getBlocksByRootPrefix returns the blocks with a block root starting with the given hex prefix.
+func (cache *blockCache) getBlocksByRootPrefix(hexPrefix string) []*Block {
+	cache.cacheMutex.RLock()
+	defer cache.cacheMutex.RUnlock()
+
+	hexPrefix = strings.ToLower(hexPrefix)
+	resBlocks := []*Block{}
+	for root, block := range cache.rootMap {
+		if strings.HasPrefix(hex.EncodeToString(root[:]), hexPrefix) {
+			resBlocks = append(resBlocks, block)
+		}
+	}
+
+	return resBlocks
+}
+
 func (cache *blockCache) getBlocksByExecutionBlockHash(blockHash phase0.Hash32) []*Block {
 	cache.cacheMutex.RLock()
 	defer cache.cacheMutex.RUnlock()
@@ -10,6 +10,9 @@ import (
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/utils"
 )
 
 const FarFutureEpoch = phase0.Epoch(math.MaxUint64)
@@ -19,6 +22,7 @@ type ChainHead struct {
 	HeadBlock             *Block      // The head block of the chain.
 	AggregatedHeadVotes   phase0.Gwei // The aggregated votes of the last 2 epochs for the head block.
 	PerEpochVotingPercent []float64   // The voting percentage in the last epochs (ascendeing order).
+	ClientCount           int         // The number of connected clients following this head.
 }
 
 // GetCanonicalHead returns the canonical head block of the chain.
@@ -69,6 +73,12 @@ func (indexer *Indexer) IsCanonicalBlockByHead(block *Block, headBlock *Block) b
 	return indexer.blockCache.isCanonicalBlock(block.Root, headBlock.Root)
 }
 
+// SubscribeCanonicalHead subscribes to canonical head changes.
+// The subscription receives the new head block whenever the canonical chain is updated.
+func (indexer *Indexer) SubscribeCanonicalHead(capacity int) *consensus.Subscription[*Block] {
+	return indexer.canonicalHeadDispatcher.Subscribe(capacity, false)
+}
+
 // computeCanonicalChain computes the canonical chain and updates the indexer's state.
 func (indexer *Indexer) computeCanonicalChain() bool {
 	indexer.canonicalHeadMutex.Lock()
@@ -96,6 +106,10 @@ func (indexer *Indexer) computeCanonicalChain() bool {
 	t1 := time.Now()
 
 	defer func() {
+		if headBlock != nil && headBlock != indexer.canonicalHead {
+			indexer.canonicalHeadDispatcher.Fire(headBlock)
+		}
+
 		indexer.canonicalHead = headBlock
 		indexer.cachedChainHeads = chainHeads
 		indexer.canonicalComputation = latestBlockRoot
@@ -103,16 +117,15 @@ func (indexer *Indexer) computeCanonicalChain() bool {
 		if headBlock == nil {
 			indexer.logger.Warnf("canonical head computation failed. forks: %v, latest block: %v, time: %v ms", len(chainHeads), latestBlockRoot.String(), time.Since(t1).Milliseconds())
 		} else {
-			indexer.logger.Infof("canonical head computation complete. forks: %v, head: %v (%v), time: %v ms", len(chainHeads), headBlock.Slot, headBlock.Root.String(), time.Since(t1).Milliseconds())
+			indexer.logger.Infof("canonical head computation complete. forks: %v, strategy: %v, head: %v (%v), time: %v ms", len(chainHeads), indexer.headSelection, headBlock.Slot, headBlock.Root.String(), time.Since(t1).Milliseconds())
 		}
 	}()
 
 	headForks := indexer.forkCache.getForkHeads()
 
-	// compare forks, select the one with the most votes
+	// aggregate the votes of all forks, the head is selected by the configured head selection strategy
 	headForkVotes := map[ForkKey]phase0.Gwei{}
 	chainHeads = make([]*ChainHead, 0, len(headForks))
-	var bestForkVotes phase0.Gwei = 0
 
 	for _, fork := range headForks {
 		if fork.Block == nil {
@@ -142,13 +155,11 @@ func (indexer *Indexer) computeCanonicalChain() bool {
 				fork.Block.Root.String(),
 			)
 		}
+	}
 
-		if forkVotes > bestForkVotes || headBlock == nil {
-			bestForkVotes = forkVotes
-			headBlock = fork.Block
-		} else if forkVotes == bestForkVotes && headBlock.Slot < fork.Block.Slot {
-			headBlock = fork.Block
-		}
+	if len(chainHeads) > 0 {
+		indexer.countChainHeadClients(chainHeads)
+		headBlock = indexer.selectCanonicalHead(chainHeads)
 	}
 
 	if headBlock == nil {
@@ -178,6 +189,7 @@ func (indexer *Indexer) computeCanonicalChain() bool {
 				AggregatedHeadVotes:   forkVotes,
 				PerEpochVotingPercent: epochParticipation,
 			}}
+			indexer.countChainHeadClients(chainHeads)
 		}
 	}
 
@@ -205,6 +217,33 @@ func (indexer *Indexer) computeCanonicalChain() bool {
 	return true
 }
 
+// resetCanonicalComputation forces a recomputation of the canonical chain on the next access.
+func (indexer *Indexer) resetCanonicalComputation() {
+	indexer.canonicalHeadMutex.Lock()
+	defer indexer.canonicalHeadMutex.Unlock()
+
+	indexer.canonicalComputation = phase0.Root{}
+}
+
+// reconcileEpochStatsValues loads the epoch stats values from the db in background and recomputes the canonical chain afterwards.
+func (indexer *Indexer) reconcileEpochStatsValues(epochStats *EpochStats) {
+	if !epochStats.reconciling.CompareAndSwap(false, true) {
+		return
+	}
+
+	go func() {
+		defer utils.HandleSubroutinePanic("reconcileEpochStatsValues", nil)
+		defer epochStats.reconciling.Store(false)
+
+		t1 := time.Now()
+		if values := epochStats.GetOrLoadValues(indexer, false, true); values != nil && epochStats.values != nil {
+			// values might have been evicted right away if the memory limit is exceeded, skip recomputation in that case to avoid reload loops
+			indexer.logger.Debugf("loaded epoch %v stats for canonical head reconciliation (%v ms)", epochStats.epoch, time.Since(t1).Milliseconds())
+			indexer.resetCanonicalComputation()
+		}
+	}()
+}
+
 // aggregateForkVotes aggregates the votes for a given fork.
 func (indexer *Indexer) aggregateForkVotes(forkId ForkKey, epochLimit uint64) (totalVotes phase0.Gwei, epochPercent []float64) {
 	chainState := indexer.consensusPool.GetChainState()
@@ -297,6 +336,13 @@ func (indexer *Indexer) aggregateForkVotes(forkId ForkKey, epochLimit uint64) (t
 			continue
 		}
 
+		if epochStats.values == nil && epochStats.isInDb {
+			// do not block the head computation on loading the epoch stats from the db (slow for huge validator sets)
+			// aggregate vote counts for now and recompute the canonical chain once the values are loaded
+			indexer.reconcileEpochStatsValues(epochStats)
+			epochStats = nil
+		}
+
 		epochVotes := indexer.aggregateEpochVotes(epoch, chainState, epochVotingBlocks, epochStats)
 		if epochVotes.AmountIsCount {
 			totalVotes += (epochVotes.CurrentEpoch.TargetVoteAmount + epochVotes.NextEpoch.TargetVoteAmount) * 32 * EtherGweiFactor
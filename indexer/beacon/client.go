@@ -13,7 +13,6 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/clients/consensus"
 	"github.com/ethpandaops/dora/db"
-	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 )
@@ -29,9 +28,11 @@ type Client struct {
 	priority       int
 	archive        bool
 	skipValidators bool
+	historyOnly    bool
 
 	blockSubscription *consensus.Subscription[*v1.BlockEvent]
 	headSubscription  *consensus.Subscription[*v1.HeadEvent]
+	restartChan       chan bool
 
 	headRoot phase0.Root
 }
@@ -48,6 +49,8 @@ func newClient(index uint16, client *consensus.Client, priority int, archive boo
 		priority:       priority,
 		archive:        archive,
 		skipValidators: skipValidators,
+
+		restartChan: make(chan bool, 1),
 	}
 }
 
@@ -83,6 +86,15 @@ func (c *Client) startIndexing() {
 	go c.startClientLoop()
 }
 
+// restartIndexing signals the client event processing subroutine to restart (reload head & backfill parent blocks).
+// used by the watchdog to recover from a stalled indexer.
+func (c *Client) restartIndexing() {
+	select {
+	case c.restartChan <- true:
+	default:
+	}
+}
+
 // startClientLoop starts the client event processing subroutine.
 func (c *Client) startClientLoop() {
 	defer func() {
@@ -167,6 +179,9 @@ func (c *Client) runClientLoop() error {
 		select {
 		case <-c.client.GetContext().Done():
 			return nil
+		case <-c.restartChan:
+			c.logger.Infof("restarting client indexing loop")
+			return nil
 		case blockEvent := <-c.blockSubscription.Channel():
 			err := c.processBlockEvent(blockEvent)
 			if err != nil {
@@ -230,6 +245,9 @@ func (c *Client) processHeadEvent(headEvent *v1.HeadEvent) error {
 		} else if err := c.processReorg(oldBlock, block); err != nil {
 			c.logger.Errorf("failed processing reorg: %v", err)
 		}
+
+		// the client switched to another fork, the canonical head might change with the client majority
+		c.indexer.resetCanonicalComputation()
 	}
 
 	chainState := c.client.GetPool().GetChainState()
@@ -391,35 +409,29 @@ func (c *Client) processBlock(slot phase0.Slot, root phase0.Root, header *phase0
 		return
 	}
 
+	block.SetSeenBy(c)
+
 	if slot >= finalizedSlot && isNew {
 		c.indexer.blockCache.addBlockToParentMap(block)
 		c.indexer.blockCache.addBlockToExecBlockMap(block)
 		t1 := time.Now()
 
-		// fork detection
-		err2 := c.indexer.forkCache.processBlock(block)
-		if err2 != nil {
-			c.logger.Warnf("failed processing new fork: %v", err2)
-		}
-
-		// insert into unfinalized blocks
-		var dbBlock *dbtypes.UnfinalizedBlock
-		dbBlock, err = block.buildUnfinalizedBlock(c.indexer.blockCompression)
-		if err != nil {
-			return
-		}
+		// fork detection & insert into unfinalized blocks
+		// both are written in a single transaction, so the fork ids of unfinalized blocks always match the persisted forks
+		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			if err := c.indexer.forkCache.processBlock(block, tx); err != nil {
+				return fmt.Errorf("failed processing new fork: %v", err)
+			}
 
-		processingTimes[1] = time.Since(t1)
-		t1 = time.Now()
+			processingTimes[1] = time.Since(t1)
+			t1 = time.Now()
 
-		// write to db
-		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
-			err := db.InsertUnfinalizedBlock(dbBlock, tx)
+			dbBlock, err := block.buildUnfinalizedBlock(c.indexer.blockCompression)
 			if err != nil {
 				return err
 			}
 
-			return nil
+			return db.InsertUnfinalizedBlock(dbBlock, tx)
 		})
 		if err != nil {
 			return
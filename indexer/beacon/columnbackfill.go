@@ -0,0 +1,320 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+// columnBackfillBatchSize is the number of slots rows processed per backfill batch.
+const columnBackfillBatchSize = 100
+
+var errNoBackfillClients = errors.New("no clients available")
+
+// columnBackfillTask describes a derived slots column (or set of columns) that needs to be populated for historic rows.
+type columnBackfillTask struct {
+	// unique task name, used as key for the persisted progress
+	name string
+	// sql condition matching slots rows that still need the backfill
+	condition string
+	// whether the task needs the block body to compute the column values
+	needsBody bool
+	// optional lowest slot that might need the backfill
+	minSlot func(indexer *Indexer) phase0.Slot
+	// returns the column values to update (nil to skip the block)
+	apply func(indexer *Indexer, slot *dbtypes.Slot, body *spec.VersionedSignedBeaconBlock) (map[string]any, error)
+}
+
+// columnBackfillTasks is the list of all column backfill tasks, processed in order.
+// new derived columns can be backfilled for historic blocks by adding a task here.
+var columnBackfillTasks = []*columnBackfillTask{
+	{
+		name:      "graffiti_text",
+		condition: "graffiti_text = ''",
+		apply: func(indexer *Indexer, slot *dbtypes.Slot, body *spec.VersionedSignedBeaconBlock) (map[string]any, error) {
+			graffitiText := utils.GraffitiToString(slot.Graffiti)
+			if graffitiText == "" {
+				return nil, nil
+			}
+			return map[string]any{
+				"graffiti_text": graffitiText,
+			}, nil
+		},
+	},
+	{
+		name:      "block_sizes",
+		condition: "block_size = 0",
+		needsBody: true,
+		apply: func(indexer *Indexer, slot *dbtypes.Slot, body *spec.VersionedSignedBeaconBlock) (map[string]any, error) {
+			blockSizes, err := getBlockSszSizes(indexer.dynSsz, body)
+			if err != nil {
+				return nil, fmt.Errorf("failed calculating block sizes: %v", err)
+			}
+			return map[string]any{
+				"block_size":        blockSizes.Total,
+				"attestations_size": blockSizes.Attestations,
+				"payload_size":      blockSizes.Payload,
+				"blob_refs_size":    blockSizes.BlobCommitments,
+			}, nil
+		},
+	},
+	{
+		name:      "tx_type_counts",
+		condition: "eth_transaction_count > 0 AND eth_tx_legacy_count + eth_tx_access_list_count + eth_tx_dynamic_fee_count + eth_tx_blob_count + eth_tx_setcode_count = 0",
+		needsBody: true,
+		apply: func(indexer *Indexer, slot *dbtypes.Slot, body *spec.VersionedSignedBeaconBlock) (map[string]any, error) {
+			transactions, err := body.ExecutionTransactions()
+			if err != nil {
+				return nil, nil
+			}
+
+			txTypeCounts := utils.CountTransactionTypes(transactions)
+			return map[string]any{
+				"eth_tx_legacy_count":      txTypeCounts.Legacy,
+				"eth_tx_access_list_count": txTypeCounts.AccessList,
+				"eth_tx_dynamic_fee_count": txTypeCounts.DynamicFee,
+				"eth_tx_blob_count":        txTypeCounts.Blob,
+				"eth_tx_setcode_count":     txTypeCounts.SetCode,
+			}, nil
+		},
+	},
+	{
+		// the blob commitments size is recorded with the block sizes, so the blob count can be derived without loading the block
+		name:      "blob_count",
+		condition: "blob_count = 0 AND blob_refs_size > 0",
+		apply: func(indexer *Indexer, slot *dbtypes.Slot, body *spec.VersionedSignedBeaconBlock) (map[string]any, error) {
+			return map[string]any{
+				"blob_count": slot.BlobRefsSize / uint64(len(deneb.KZGCommitment{})),
+			}, nil
+		},
+	},
+	{
+		name:      "block_fees",
+		condition: "eth_block_number IS NOT NULL AND eth_base_fee = 0",
+		needsBody: true,
+		apply: func(indexer *Indexer, slot *dbtypes.Slot, body *spec.VersionedSignedBeaconBlock) (map[string]any, error) {
+			blockFees, err := getBlockExecutionFees(body)
+			if err != nil {
+				return nil, nil
+			}
+			return map[string]any{
+				"eth_gas_used":    blockFees.GasUsed,
+				"eth_base_fee":    blockFees.BaseFee,
+				"eth_burned_fees": blockFees.BurnedFees,
+			}, nil
+		},
+	},
+	{
+		name:      "sync_participation",
+		condition: "sync_participation = 0",
+		needsBody: true,
+		minSlot: func(indexer *Indexer) phase0.Slot {
+			chainState := indexer.consensusPool.GetChainState()
+			altairForkEpoch := chainState.GetSpecs().AltairForkEpoch
+			if altairForkEpoch == nil {
+				return math.MaxInt64
+			}
+			return chainState.EpochToSlot(phase0.Epoch(*altairForkEpoch))
+		},
+		apply: func(indexer *Indexer, slot *dbtypes.Slot, body *spec.VersionedSignedBeaconBlock) (map[string]any, error) {
+			syncAggregate, _ := body.SyncAggregate()
+			if syncAggregate == nil {
+				return nil, nil
+			}
+
+			// epoch assignments are not available for historic blocks, so use the full committee size like the synchronizer
+			assignedCount := len(syncAggregate.SyncCommitteeBits) * 8
+			votedCount := 0
+			for i := 0; i < assignedCount; i++ {
+				if utils.BitAtVector(syncAggregate.SyncCommitteeBits, i) {
+					votedCount++
+				}
+			}
+			if votedCount == 0 {
+				return nil, nil
+			}
+
+			return map[string]any{
+				"sync_participation": float32(votedCount) / float32(assignedCount),
+			}, nil
+		},
+	},
+}
+
+// columnBackfiller populates newly introduced derived columns for historic blocks in the background.
+// block bodies are reloaded from the orphaned blocks table or from the attached clients with a rate limit.
+type columnBackfiller struct {
+	indexer      *Indexer
+	logger       logrus.FieldLogger
+	requestDelay time.Duration
+}
+
+func (indexer *Indexer) startColumnBackfill() {
+	if utils.Config.Indexer.DisableColumnBackfill {
+		return
+	}
+
+	backfillRate := utils.Config.Indexer.ColumnBackfillRate
+	if backfillRate == 0 {
+		backfillRate = 5
+	}
+
+	backfiller := &columnBackfiller{
+		indexer:      indexer,
+		logger:       indexer.logger.WithField("service", "column-backfill"),
+		requestDelay: time.Second / time.Duration(backfillRate),
+	}
+
+	go backfiller.runBackfill()
+}
+
+func (backfiller *columnBackfiller) runBackfill() {
+	defer utils.HandleSubroutinePanic("runColumnBackfill", nil)
+
+	for _, task := range columnBackfillTasks {
+		stateKey := fmt.Sprintf("indexer.columnbackfill.%v", task.name)
+		backfillState := &dbtypes.IndexerColumnBackfillState{}
+		if _, err := db.GetExplorerState(stateKey, backfillState); err != nil {
+			backfillState.Slot = math.MaxInt64
+		}
+		if backfillState.Complete {
+			continue
+		}
+
+		backfiller.runBackfillTask(task, stateKey, backfillState)
+	}
+}
+
+func (backfiller *columnBackfiller) runBackfillTask(task *columnBackfillTask, stateKey string, backfillState *dbtypes.IndexerColumnBackfillState) {
+	minSlot := phase0.Slot(0)
+	if task.minSlot != nil {
+		minSlot = task.minSlot(backfiller.indexer)
+	}
+
+	tasklogger := backfiller.logger.WithField("task", task.name)
+	tasklogger.Infof("column backfill started. head slot: %v", backfillState.Slot)
+	updatedCount := 0
+
+	for {
+		slots := db.GetSlotsForColumnBackfill(task.condition, uint64(minSlot), backfillState.Slot, columnBackfillBatchSize)
+		if slots == nil {
+			// db error, retry later
+			time.Sleep(time.Minute)
+			continue
+		}
+
+		nextSlot := uint64(minSlot)
+		if len(slots) == columnBackfillBatchSize {
+			// batch might end within a slot (canonical & orphaned blocks), so leave the lowest slot for the next batch
+			nextSlot = slots[len(slots)-1].Slot + 1
+		}
+
+		updates := map[*dbtypes.Slot]map[string]any{}
+		clientsAvailable := true
+		for _, slot := range slots {
+			if slot.Slot < nextSlot {
+				break
+			}
+
+			var body *spec.VersionedSignedBeaconBlock
+			if task.needsBody {
+				var err error
+				body, err = backfiller.loadBlockBody(slot)
+				if errors.Is(err, errNoBackfillClients) {
+					clientsAvailable = false
+					break
+				}
+				if err != nil {
+					tasklogger.Warnf("failed loading block body for slot %v (0x%x): %v", slot.Slot, slot.Root, err)
+					continue
+				}
+			}
+
+			columns, err := task.apply(backfiller.indexer, slot, body)
+			if err != nil {
+				tasklogger.Warnf("failed computing columns for slot %v (0x%x): %v", slot.Slot, slot.Root, err)
+				continue
+			}
+			if columns != nil {
+				updates[slot] = columns
+			}
+		}
+
+		if !clientsAvailable {
+			tasklogger.Warnf("no clients available for column backfill")
+
+			// wait for 10 seconds before retrying
+			time.Sleep(10 * time.Second)
+			continue
+		}
+
+		backfillState.Slot = nextSlot
+		backfillState.Complete = len(slots) < columnBackfillBatchSize
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			for slot, columns := range updates {
+				if err := db.UpdateSlotColumns(slot.Root, columns, tx); err != nil {
+					return fmt.Errorf("error updating slot %v: %v", slot.Slot, err)
+				}
+			}
+
+			return db.SetExplorerState(stateKey, backfillState, tx)
+		})
+		if err != nil {
+			tasklogger.Errorf("failed persisting column backfill batch: %v", err)
+			return
+		}
+
+		updatedCount += len(updates)
+		if backfillState.Complete {
+			tasklogger.Infof("column backfill complete. updated %v blocks", updatedCount)
+			return
+		}
+
+		tasklogger.Debugf("column backfill progress: slot %v, updated %v blocks", backfillState.Slot, updatedCount)
+	}
+}
+
+// loadBlockBody loads the block body for a historic block.
+// orphaned blocks are read from the db, canonical blocks are requested from a ready client (rate limited).
+func (backfiller *columnBackfiller) loadBlockBody(slot *dbtypes.Slot) (*spec.VersionedSignedBeaconBlock, error) {
+	if slot.Status == dbtypes.Orphaned {
+		orphanedBlock := db.GetOrphanedBlock(slot.Root)
+		if orphanedBlock == nil {
+			return nil, fmt.Errorf("orphaned block not found")
+		}
+		return unmarshalVersionedSignedBeaconBlockSSZ(backfiller.indexer.dynSsz, orphanedBlock.BlockVer, orphanedBlock.BlockSSZ)
+	}
+
+	chainState := backfiller.indexer.consensusPool.GetChainState()
+	clients := backfiller.indexer.synchronizer.getSyncClients(chainState.EpochOfSlot(phase0.Slot(slot.Slot)))
+	if len(clients) == 0 {
+		return nil, errNoBackfillClients
+	}
+
+	defer time.Sleep(backfiller.requestDelay)
+
+	var err error
+	for retry := 0; retry < 3 && retry < len(clients); retry++ {
+		var body *spec.VersionedSignedBeaconBlock
+		body, err = LoadBeaconBlock(context.Background(), clients[retry], phase0.Root(slot.Root))
+		if err == nil && body == nil {
+			err = fmt.Errorf("block not found on %v", clients[retry].client.GetName())
+		}
+		if err == nil {
+			return body, nil
+		}
+	}
+
+	return nil, err
+}
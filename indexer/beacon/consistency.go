@@ -0,0 +1,119 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// consistencyCheckLimit is the max number of inconsistent slots repaired per consistency check run.
+	consistencyCheckLimit = 1000
+
+	// consistencyRepairAttempts is the number of attempts to repair an inconsistent epoch before giving up until the next restart.
+	consistencyRepairAttempts = 5
+)
+
+// runConsistencyCheck verifies the invariants of the persisted finalized chain:
+//   - each finalized slot has at most one canonical block
+//
+// epochs violating an invariant (e.g. after a crash during head processing) are re-synchronized in place by a repair mode synchronizer,
+// which re-persists the canonical blocks and demotes all other blocks of the epoch to orphaned.
+// the synchronizer progress itself is not changed, so only the affected epochs are written again.
+func (indexer *Indexer) runConsistencyCheck() {
+	defer utils.HandleSubroutinePanic("runConsistencyCheck", nil)
+
+	if utils.Config.Indexer.DisableConsistencyCheck {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	finalizedSlot := chainState.EpochToSlot(indexer.lastFinalizedEpoch)
+
+	duplicateSlots, err := db.GetDuplicateCanonicalSlots(uint64(finalizedSlot), consistencyCheckLimit)
+	if err != nil {
+		indexer.logger.Errorf("consistency check failed: %v", err)
+		return
+	}
+
+	if len(duplicateSlots) == 0 {
+		indexer.logger.Infof("consistency check passed (finalized slots < %v)", finalizedSlot)
+		return
+	}
+
+	repairEpochs := []phase0.Epoch{}
+	repairEpochMap := map[phase0.Epoch]bool{}
+	for _, slot := range duplicateSlots {
+		epoch := chainState.EpochOfSlot(phase0.Slot(slot))
+		if !repairEpochMap[epoch] {
+			repairEpochMap[epoch] = true
+			repairEpochs = append(repairEpochs, epoch)
+		}
+	}
+
+	indexer.logger.Warnf("consistency check found %v slots with multiple canonical blocks in %v epochs (first: slot %v), scheduling repair", len(duplicateSlots), len(repairEpochs), duplicateSlots[0])
+
+	if indexer.disableSync {
+		indexer.logger.Warnf("synchronizer is disabled, cannot repair inconsistent epochs")
+		return
+	}
+
+	indexer.repairInconsistentEpochs(repairEpochs)
+}
+
+// repairInconsistentEpochs re-synchronizes the given epochs in repair mode.
+// epochs that cannot be loaded from any client are retried once per epoch, up to `consistencyRepairAttempts` times.
+func (indexer *Indexer) repairInconsistentEpochs(epochs []phase0.Epoch) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repairSync := newSynchronizer(indexer, indexer.logger.WithField("service", "consistency-repair"))
+	repairSync.repairMode = true
+	repairSync.syncCtx = ctx
+
+	chainState := indexer.consensusPool.GetChainState()
+	for attempt := 1; len(epochs) > 0; attempt++ {
+		if attempt > 1 {
+			specs := chainState.GetSpecs()
+			time.Sleep(time.Duration(specs.SlotsPerEpoch) * specs.SecondsPerSlot)
+		}
+
+		repairSync.blockCache = newSyncBlockCache()
+		repairSync.lastEpochState = nil
+		repairSync.lastEpochBlocks = nil
+
+		failedEpochs := []phase0.Epoch{}
+		for _, epoch := range epochs {
+			if !indexer.repairInconsistentEpoch(repairSync, epoch, attempt == consistencyRepairAttempts) {
+				failedEpochs = append(failedEpochs, epoch)
+			}
+		}
+
+		epochs = failedEpochs
+		if len(epochs) > 0 && attempt >= consistencyRepairAttempts {
+			indexer.logger.Errorf("consistency repair failed for %v epochs (first: epoch %v)", len(epochs), epochs[0])
+			return
+		}
+	}
+}
+
+// repairInconsistentEpoch re-synchronizes a single epoch with the preferred sync clients and returns true if the epoch has been persisted.
+func (indexer *Indexer) repairInconsistentEpoch(repairSync *synchronizer, epoch phase0.Epoch, lastAttempt bool) bool {
+	syncClients := repairSync.getSyncClients(epoch)
+	for i, client := range syncClients {
+		lastTry := lastAttempt && i == len(syncClients)-1
+		done, err := repairSync.syncEpoch(epoch, client, lastTry)
+		if done && err == nil {
+			indexer.logger.Infof("consistency repair: re-synchronized epoch %v from %v", epoch, client.client.GetName())
+			return true
+		}
+
+		indexer.logger.Warnf("consistency repair: failed re-synchronizing epoch %v from %v: %v", epoch, client.client.GetName(), err)
+	}
+
+	return false
+}
@@ -24,6 +24,8 @@ type CacheDebugStats struct {
 		StatsFull      uint64
 		StatsPrecalc   uint64
 		StatsPruned    uint64
+		StatsMemory    uint64
+		StatsEvictions uint64
 		StateLoaded    uint64
 		VotesCacheLen  uint64
 		VotesCacheHit  uint64
@@ -122,6 +124,9 @@ func (indexer *Indexer) getEpochCacheDebugStats(cacheStats *CacheDebugStats) {
 		}
 	}
 
+	cacheStats.EpochCache.StatsMemory = indexer.epochCache.statsMemory
+	cacheStats.EpochCache.StatsEvictions = indexer.epochCache.statsEvictions
+
 	for _, state := range indexer.epochCache.stateMap {
 		if state.loadingStatus == 2 {
 			cacheStats.EpochCache.StateLoaded++
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/clients/consensus"
@@ -218,6 +219,36 @@ func GetProposerIndex(spec *consensus.ChainSpec, state *BeaconState, slot phase0
 	}
 }
 
+// GetProposerDuties computes the proposers for all slots of the given epoch and resolves them to validator indices.
+// proposers that can't be computed are set to math.MaxInt64, the returned error describes the first failed slot.
+func GetProposerDuties(spec *consensus.ChainSpec, state *BeaconState, epoch phase0.Epoch, activeIndices []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+	proposerDuties := make([]phase0.ValidatorIndex, spec.SlotsPerEpoch)
+	firstSlot := phase0.Slot(uint64(epoch) * spec.SlotsPerEpoch)
+
+	var firstErr error
+	errCount := 0
+	for i := range proposerDuties {
+		slot := firstSlot + phase0.Slot(i)
+		proposer, err := GetProposerIndex(spec, state, slot)
+		if err != nil {
+			proposerDuties[i] = math.MaxInt64
+			if firstErr == nil {
+				firstErr = fmt.Errorf("slot %v: %w", slot, err)
+			}
+			errCount++
+			continue
+		}
+
+		proposerDuties[i] = activeIndices[proposer]
+	}
+
+	if errCount > 0 {
+		return proposerDuties, fmt.Errorf("failed computing %v proposers: %w", errCount, firstErr)
+	}
+
+	return proposerDuties, nil
+}
+
 func GetAttesterDuties(spec *consensus.ChainSpec, state *BeaconState, epoch phase0.Epoch) ([][][]ActiveIndiceIndex, error) {
 	seed := GetSeed(spec, state, epoch, spec.DomainBeaconAttester)
 
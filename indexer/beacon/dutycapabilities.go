@@ -0,0 +1,113 @@
+package beacon
+
+import (
+	"errors"
+	"math"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/indexer/beacon/duties"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DutyType is a bit set of duty types computed from the dependent state of an epoch.
+type DutyType uint8
+
+const (
+	DutyTypeProposer DutyType = 1 << iota
+	DutyTypeAttester
+	DutyTypeSyncCommittee
+)
+
+// Has returns true if all duty types of the given set are included.
+func (d DutyType) Has(duties DutyType) bool {
+	return d&duties == duties
+}
+
+// dutyForkCapabilities describes the changes of the computable duty types introduced by a fork.
+type dutyForkCapabilities struct {
+	fork        string
+	forkEpoch   func(specs *consensus.ChainSpec) *uint64
+	applicable  DutyType // duty types introduced with the fork
+	unsupported DutyType // duty types that can no longer be computed from the dependent state since the fork
+}
+
+// dutyCapabilityMatrix lists the duty capabilities per fork in activation order.
+// experimental forks that change the duty selection (like whisk with secret proposers) mark the affected duty types
+// as unsupported, so they are skipped instead of being computed wrongly. the affected epochs are backfilled once
+// the matrix supports the duty types (see epochrepair.go).
+var dutyCapabilityMatrix = []*dutyForkCapabilities{
+	{
+		fork:       "phase0",
+		forkEpoch:  func(specs *consensus.ChainSpec) *uint64 { return new(uint64) },
+		applicable: DutyTypeProposer | DutyTypeAttester,
+	},
+	{
+		fork:       "altair",
+		forkEpoch:  func(specs *consensus.ChainSpec) *uint64 { return specs.AltairForkEpoch },
+		applicable: DutyTypeSyncCommittee,
+	},
+	{
+		// proposers are selected via shuffled secret trackers and can't be derived from the randao mix
+		fork:        "whisk",
+		forkEpoch:   getWhiskForkEpoch,
+		unsupported: DutyTypeProposer,
+	},
+}
+
+func getWhiskForkEpoch(specs *consensus.ChainSpec) *uint64 {
+	if specs.WhiskForkEpoch != nil {
+		return specs.WhiskForkEpoch
+	}
+	return utils.Config.Chain.WhiskForkEpoch
+}
+
+// getDutyCapabilities returns the duty types applicable at the given epoch and the subset that can be computed from the dependent state.
+func getDutyCapabilities(specs *consensus.ChainSpec, epoch phase0.Epoch) (applicable DutyType, supported DutyType) {
+	unsupported := DutyType(0)
+	for _, capabilities := range dutyCapabilityMatrix {
+		forkEpoch := capabilities.forkEpoch(specs)
+		if forkEpoch == nil || uint64(epoch) < *forkEpoch {
+			continue
+		}
+
+		applicable |= capabilities.applicable
+		unsupported |= capabilities.unsupported
+	}
+
+	return applicable, applicable &^ unsupported
+}
+
+// GetDutyCapabilities returns the duty types that can be computed for the given epoch.
+func (indexer *Indexer) GetDutyCapabilities(epoch phase0.Epoch) DutyType {
+	specs := indexer.consensusPool.GetChainState().GetSpecs()
+	if specs == nil {
+		return 0
+	}
+
+	_, supported := getDutyCapabilities(specs, epoch)
+	return supported
+}
+
+// getUnknownProposerDuties returns the proposer duties for an epoch with unsupported proposer duties (all proposers unknown).
+func getUnknownProposerDuties(specs *consensus.ChainSpec) []phase0.ValidatorIndex {
+	proposerDuties := make([]phase0.ValidatorIndex, specs.SlotsPerEpoch)
+	for i := range proposerDuties {
+		proposerDuties[i] = math.MaxInt64
+	}
+	return proposerDuties
+}
+
+// errProposerDutiesUnsupported is returned by getProposerDuties for epochs with unsupported proposer duties.
+var errProposerDutiesUnsupported = errors.New("proposer duties not supported for epoch")
+
+// getProposerDuties computes the proposer duties for an epoch, if supported by the duty capabilities of the fork.
+// the returned duties always cover the full epoch, proposers that could not be computed are unknown (math.MaxInt64).
+func getProposerDuties(specs *consensus.ChainSpec, epoch phase0.Epoch, beaconState *duties.BeaconState, activeIndices []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+	if _, supported := getDutyCapabilities(specs, epoch); !supported.Has(DutyTypeProposer) {
+		return getUnknownProposerDuties(specs), errProposerDutiesUnsupported
+	}
+
+	return duties.GetProposerDuties(specs, beaconState, epoch, activeIndices)
+}
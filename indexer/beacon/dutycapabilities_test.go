@@ -0,0 +1,92 @@
+package beacon
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/indexer/beacon/duties"
+)
+
+func newTestProposerState(activeCount int) (*duties.BeaconState, []phase0.ValidatorIndex) {
+	randaoMix := phase0.Hash32{0x42}
+	activeIndices := make([]phase0.ValidatorIndex, activeCount)
+	for i := range activeIndices {
+		activeIndices[i] = phase0.ValidatorIndex(1000 + i)
+	}
+
+	return &duties.BeaconState{
+		RandaoMix: &randaoMix,
+		GetActiveCount: func() uint64 {
+			return uint64(len(activeIndices))
+		},
+		GetEffectiveBalance: func(index duties.ActiveIndiceIndex) phase0.Gwei {
+			return 32 * EtherGweiFactor
+		},
+	}, activeIndices
+}
+
+func TestGetProposerDuties(t *testing.T) {
+	whiskForkEpoch := uint64(100)
+	specs := &consensus.ChainSpec{
+		SlotsPerEpoch:        8,
+		ShuffleRoundCount:    10,
+		MaxEffectiveBalance:  32 * EtherGweiFactor,
+		DomainBeaconProposer: phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+		WhiskForkEpoch:       &whiskForkEpoch,
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		beaconState, activeIndices := newTestProposerState(64)
+		proposerDuties, err := getProposerDuties(specs, 10, beaconState, activeIndices)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(proposerDuties) != 8 {
+			t.Fatalf("expected 8 proposers, got %v", len(proposerDuties))
+		}
+
+		// proposers must match the per slot computation
+		for i, proposer := range proposerDuties {
+			proposerIdx, err := duties.GetProposerIndex(specs, beaconState, phase0.Slot(80+i))
+			if err != nil {
+				t.Fatalf("unexpected error for slot %v: %v", 80+i, err)
+			}
+			if proposer != activeIndices[proposerIdx] {
+				t.Errorf("proposer mismatch for slot %v: %v != %v", 80+i, proposer, activeIndices[proposerIdx])
+			}
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		beaconState, activeIndices := newTestProposerState(64)
+		proposerDuties, err := getProposerDuties(specs, phase0.Epoch(whiskForkEpoch), beaconState, activeIndices)
+		if !errors.Is(err, errProposerDutiesUnsupported) {
+			t.Fatalf("expected unsupported error, got %v", err)
+		}
+		if len(proposerDuties) != 8 {
+			t.Fatalf("expected 8 proposers, got %v", len(proposerDuties))
+		}
+		for i, proposer := range proposerDuties {
+			if proposer != math.MaxInt64 {
+				t.Errorf("expected unknown proposer for slot index %v, got %v", i, proposer)
+			}
+		}
+	})
+
+	t.Run("no active validators", func(t *testing.T) {
+		beaconState, _ := newTestProposerState(0)
+		proposerDuties, err := getProposerDuties(specs, 10, beaconState, nil)
+		if err == nil || errors.Is(err, errProposerDutiesUnsupported) {
+			t.Fatalf("expected computation error, got %v", err)
+		}
+		for i, proposer := range proposerDuties {
+			if proposer != math.MaxInt64 {
+				t.Errorf("expected unknown proposer for slot index %v, got %v", i, proposer)
+			}
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package beacon
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// dutyPrewarmRecheckSlots is the number of slots at the start of an epoch in which the prewarmed dependent root is rechecked for reorgs.
+const dutyPrewarmRecheckSlots = 4
+
+var (
+	dutyPrewarmCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_indexer_duty_prewarm_total",
+		Help: "Number of epoch duties prewarmed before the epoch boundary",
+	})
+	dutyPrewarmInvalidationsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_indexer_duty_prewarm_invalidations_total",
+		Help: "Number of prewarmed epoch duties invalidated by a reorg of the dependent root",
+	})
+)
+
+// dutyPrewarmer tracks the dependent roots of the prewarmed epoch duties.
+type dutyPrewarmer struct {
+	indexer     *Indexer
+	prewarmed   map[phase0.Epoch]phase0.Root
+	prewarmedBy map[phase0.Epoch]*EpochStats
+}
+
+// runDutyPrewarmLoop prewarms the proposer & attester duties of the next epoch as soon as the dependent root is known,
+// so epoch boundary page loads and head processing do not need to wait for the dependent state.
+// the prewarm runs 2/3 into the last slot of each epoch (after the attestation deadline) and is rechecked in the first
+// slots of the new epoch, so late blocks or reorgs of the dependent root replace the prewarmed duties.
+func (indexer *Indexer) runDutyPrewarmLoop() {
+	defer utils.HandleSubroutinePanic("runDutyPrewarmLoop", indexer.runDutyPrewarmLoop)
+
+	if utils.Config.Indexer.DisableDutyPrewarm {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	prewarmer := &dutyPrewarmer{
+		indexer:     indexer,
+		prewarmed:   map[phase0.Epoch]phase0.Root{},
+		prewarmedBy: map[phase0.Epoch]*EpochStats{},
+	}
+
+	for {
+		specs := chainState.GetSpecs()
+		slotOffset := specs.SecondsPerSlot * 2 / 3
+
+		// wait for the next prewarm point of the slot clock
+		currentSlot := chainState.CurrentSlot()
+		checkTime := chainState.SlotToTime(currentSlot).Add(slotOffset)
+		if time.Now().After(checkTime) {
+			currentSlot++
+			checkTime = chainState.SlotToTime(currentSlot).Add(slotOffset)
+		}
+		time.Sleep(time.Until(checkTime))
+
+		epoch := chainState.EpochOfSlot(currentSlot)
+		slotIndex := chainState.SlotToSlotIndex(currentSlot)
+		switch {
+		case uint64(slotIndex) == specs.SlotsPerEpoch-1:
+			prewarmer.prewarmEpoch(epoch + 1)
+		case uint64(slotIndex) < dutyPrewarmRecheckSlots:
+			prewarmer.prewarmEpoch(epoch)
+		}
+
+		for prewarmedEpoch := range prewarmer.prewarmed {
+			if prewarmedEpoch < epoch {
+				delete(prewarmer.prewarmed, prewarmedEpoch)
+				delete(prewarmer.prewarmedBy, prewarmedEpoch)
+			}
+		}
+	}
+}
+
+// prewarmEpoch ensures the epoch stats for the given epoch at the dependent root of the canonical head are created & loading.
+// epoch stats prewarmed for a dependent root that is no longer canonical are removed, unless a client references them.
+func (prewarmer *dutyPrewarmer) prewarmEpoch(epoch phase0.Epoch) {
+	indexer := prewarmer.indexer
+	if epoch == 0 || indexer.survivalMode.Load() {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	dependentBlock := indexer.GetCanonicalHead(nil)
+	for dependentBlock != nil && chainState.EpochOfSlot(dependentBlock.Slot) >= epoch {
+		parentRoot := dependentBlock.GetParentRoot()
+		if parentRoot == nil {
+			dependentBlock = nil
+			break
+		}
+		dependentBlock = indexer.blockCache.getBlockByRoot(*parentRoot)
+	}
+	if dependentBlock == nil {
+		indexer.logger.Debugf("duty prewarm for epoch %v skipped: dependent block not found", epoch)
+		return
+	}
+
+	prewarmedRoot, isPrewarmed := prewarmer.prewarmed[epoch]
+	if isPrewarmed && prewarmedRoot == dependentBlock.Root {
+		return
+	}
+
+	if isPrewarmed {
+		dutyPrewarmInvalidationsCounter.Inc()
+		indexer.logger.Infof("dependent root of epoch %v changed (%v -> %v), replacing prewarmed duties", epoch, prewarmedRoot.String(), dependentBlock.Root.String())
+
+		if staleStats := prewarmer.prewarmedBy[epoch]; staleStats != nil && len(staleStats.getRequestedBy()) == 0 {
+			indexer.epochCache.removeEpochStats(staleStats)
+		}
+	}
+
+	epochStats := indexer.epochCache.createOrGetEpochStats(epoch, dependentBlock.Root, true)
+	prewarmer.prewarmed[epoch] = dependentBlock.Root
+	prewarmer.prewarmedBy[epoch] = epochStats
+	if epochStats.ready {
+		return
+	}
+
+	if epochStats.dependentState != nil {
+		epochStats.dependentState.highPriority = true
+	}
+
+	// provide precomputed duties from the parent epoch stats until the dependent state is loaded
+	parentDependentBlock := dependentBlock
+	if chainState.EpochOfSlot(dependentBlock.Slot) == epoch-1 {
+		parentDependentBlock = indexer.blockCache.getDependentBlock(chainState, dependentBlock, nil)
+	}
+	if parentDependentBlock != nil {
+		if parentStats := indexer.epochCache.getEpochStats(epoch-1, parentDependentBlock.Root); parentStats != nil && parentStats.ready {
+			if err := epochStats.precomputeFromParentState(indexer, parentStats); err != nil {
+				indexer.logger.Debugf("duty prewarm for epoch %v: failed precomputing duties: %v", epoch, err)
+			}
+		}
+	}
+
+	dutyPrewarmCounter.Inc()
+	indexer.logger.Infof("prewarmed duties for epoch %v (dep: %v)", epoch, dependentBlock.Root.String())
+}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/ethpandaops/dora/clients/consensus"
 )
@@ -44,8 +46,23 @@ type epochCache struct {
 	votesCache     *lru.Cache[epochVotesKey, *EpochVotes] // cache for epoch vote aggregations
 	votesCacheHit  uint64
 	votesCacheMiss uint64
+
+	memoryMutex    sync.Mutex // mutex to prevent concurrent memory limit checks
+	statsMemory    uint64     // estimated memory size of all cached epoch stats values (updated on memory limit checks)
+	statsEvictions uint64     // number of epoch stats evicted due to the memory limit
 }
 
+var (
+	epochStatsMemoryGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_indexer_epoch_stats_memory_bytes",
+		Help: "Estimated memory size of all cached epoch stats values",
+	})
+	epochStatsEvictionsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_indexer_epoch_stats_evictions_total",
+		Help: "Number of cached epoch stats evicted to the database due to the memory limit",
+	})
+)
+
 // newEpochCache creates & returns a new instance of epochCache.
 // initializes the cache & starts the beacon state loader subroutine.
 func newEpochCache(indexer *Indexer) *epochCache {
@@ -132,7 +149,7 @@ func (cache *epochCache) getPendingEpochStats() []*EpochStats {
 
 	pendingStats := make([]*EpochStats, 0)
 	for _, stats := range cache.statsMap {
-		if stats.dependentState != nil && stats.dependentState.loadingStatus == 0 {
+		if stats.dependentState != nil && stats.dependentState.loadingStatus == 0 && !stats.partialDuties {
 			pendingStats = append(pendingStats, stats)
 		}
 	}
@@ -229,6 +246,75 @@ func (cache *epochCache) removeUnreferencedEpochStates() uint64 {
 	return removed
 }
 
+// enforceMemoryLimit checks the estimated memory size of all cached epoch stats values against the configured limit.
+// full values of the least recently used epochs are evicted (reduced to pruned values) until the cache fits the limit again.
+// only values that are persisted in the unfinalized duties table are evicted, so they can be reloaded from db on demand.
+func (cache *epochCache) enforceMemoryLimit() {
+	cache.memoryMutex.Lock()
+	defer cache.memoryMutex.Unlock()
+
+	type evictionCandidate struct {
+		stats *EpochStats
+		size  uint64
+	}
+
+	// keep the most recent epochs in memory, they are needed for precomputations & proposer previews
+	currentEpoch := cache.indexer.consensusPool.GetChainState().CurrentEpoch()
+	totalSize := uint64(0)
+	candidates := []*evictionCandidate{}
+
+	cache.cacheMutex.RLock()
+	for _, stats := range cache.statsMap {
+		totalSize += stats.prunedValues.getMemorySize()
+
+		values := stats.values
+		if values == nil {
+			continue
+		}
+
+		size := values.getMemorySize()
+		totalSize += size
+		if stats.ready && stats.isInDb && stats.epoch+2 <= currentEpoch {
+			candidates = append(candidates, &evictionCandidate{
+				stats: stats,
+				size:  size,
+			})
+		}
+	}
+	cache.cacheMutex.RUnlock()
+
+	memoryLimit := cache.indexer.epochStatsMemoryLimit
+	if memoryLimit > 0 && totalSize > memoryLimit {
+		sort.Slice(candidates, func(a, b int) bool {
+			return candidates[a].stats.lastAccess.Load() < candidates[b].stats.lastAccess.Load()
+		})
+
+		evicted := 0
+		for _, candidate := range candidates {
+			if totalSize <= memoryLimit {
+				break
+			}
+
+			candidate.stats.pruneValues()
+			totalSize -= candidate.size
+			totalSize += candidate.stats.prunedValues.getMemorySize()
+			evicted++
+		}
+
+		if evicted > 0 {
+			cache.statsEvictions += uint64(evicted)
+			epochStatsEvictionsCounter.Add(float64(evicted))
+			cache.indexer.logger.Infof("evicted %v epoch stats from cache (memory limit: %v MB, cached: %v MB)", evicted, memoryLimit/1024/1024, totalSize/1024/1024)
+		}
+		if totalSize > memoryLimit {
+			cache.indexer.logger.Warnf("epoch stats cache exceeds memory limit (limit: %v MB, cached: %v MB), no more epochs to evict", memoryLimit/1024/1024, totalSize/1024/1024)
+		}
+	}
+
+	cache.statsMemory = totalSize
+	epochStatsMemoryGauge.Set(float64(totalSize))
+}
+
 // getOrUpdateSyncCommittee replaces the supplied sync committee with an older sync committee from cache if all properties match.
 // heavily reduces memory consumption as sync committee objects are not duplicated for each sync committee request.
 func (cache *epochCache) getOrUpdateSyncCommittee(syncCommittee []phase0.ValidatorIndex) []phase0.ValidatorIndex {
@@ -435,6 +521,14 @@ func (cache *epochCache) loadEpochStats(epochStats *EpochStats) bool {
 	if epochStats.dependentState.loadingStatus != 2 {
 		// epoch state could not be loaded
 		epochStats.dependentState.retryCount++
+
+		if epochStats.dependentState.retryCount >= beaconStateRetryCount && epochStats.dependentState.loadingStatus == 0 {
+			// the state seems to be unavailable, fall back to the beacon committees endpoint
+			if err := epochStats.loadFromCommittees(cache.indexer, client); err != nil {
+				client.logger.Warnf("failed loading partial epoch %v stats (dep: %v): %v", epochStats.epoch, epochStats.dependentRoot.String(), err)
+			}
+		}
+
 		return false
 	}
 
@@ -0,0 +1,158 @@
+package beacon
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/indexer/beacon/duties"
+)
+
+// loadFromCommittees loads partial epoch stats from the beacon committees endpoint of the client.
+// this is the fallback for clients that refuse to serve the dependent state (e.g. pruned states or restricted api
+// configurations). the committees cover all active validators, so the active indices are derived from the committee
+// members and the attester duties are complete. proposers are taken from the known blocks of the epoch, the sync
+// committee, randao mixes & balances remain unknown (effective balances are used as balance approximation).
+// the stats are marked as partial, so they're not persisted as unfinalized duties and the missing duty types are
+// backfilled by the epoch repair routine after finalization.
+func (es *EpochStats) loadFromCommittees(indexer *Indexer, client *Client) error {
+	stateRoot, err := es.getDependentStateRoot(indexer, client)
+	if err != nil {
+		return fmt.Errorf("failed loading dependent block header: %v", err)
+	}
+
+	committees, err := LoadBeaconCommittees(client.getContext(), client, stateRoot, es.epoch)
+	if err != nil {
+		return fmt.Errorf("failed loading beacon committees: %v", err)
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	firstSlot := chainState.EpochToSlot(es.epoch)
+
+	// every active validator is assigned to exactly one committee per epoch
+	activeIndices := []phase0.ValidatorIndex{}
+	for _, committee := range committees {
+		if committee.Slot < firstSlot || uint64(committee.Slot-firstSlot) >= specs.SlotsPerEpoch {
+			return fmt.Errorf("committee slot %v not in epoch %v", committee.Slot, es.epoch)
+		}
+		activeIndices = append(activeIndices, committee.Validators...)
+	}
+	if len(activeIndices) == 0 {
+		return fmt.Errorf("no beacon committees for epoch %v", es.epoch)
+	}
+
+	sort.Slice(activeIndices, func(a, b int) bool {
+		return activeIndices[a] < activeIndices[b]
+	})
+
+	activeIndiceMap := make(map[phase0.ValidatorIndex]duties.ActiveIndiceIndex, len(activeIndices))
+	for i, validatorIndex := range activeIndices {
+		activeIndiceMap[validatorIndex] = duties.ActiveIndiceIndex(i)
+	}
+
+	attesterDuties := make([][][]duties.ActiveIndiceIndex, specs.SlotsPerEpoch)
+	for _, committee := range committees {
+		slotIndex := uint64(committee.Slot - firstSlot)
+		for uint64(len(attesterDuties[slotIndex])) <= uint64(committee.Index) {
+			attesterDuties[slotIndex] = append(attesterDuties[slotIndex], []duties.ActiveIndiceIndex{})
+		}
+
+		members := make([]duties.ActiveIndiceIndex, len(committee.Validators))
+		for i, validatorIndex := range committee.Validators {
+			members[i] = activeIndiceMap[validatorIndex]
+		}
+		attesterDuties[slotIndex][committee.Index] = members
+	}
+
+	values := &EpochStatsValues{
+		ActiveIndices:     activeIndices,
+		EffectiveBalances: make([]uint16, len(activeIndices)),
+		ProposerDuties:    es.getProposersFromBlocks(indexer),
+		AttesterDuties:    attesterDuties,
+		ActiveValidators:  uint64(len(activeIndices)),
+	}
+
+	validatorSet := indexer.validatorCache.getValidatorSetForRoot(es.dependentRoot)
+	for i, validatorIndex := range activeIndices {
+		if int(validatorIndex) >= len(validatorSet) {
+			continue
+		}
+
+		effectiveBalance := validatorSet[validatorIndex].EffectiveBalance
+		values.EffectiveBalances[i] = uint16(effectiveBalance / EtherGweiFactor)
+		values.EffectiveBalance += effectiveBalance
+	}
+	values.TotalBalance = values.EffectiveBalance
+	values.ActiveBalance = values.EffectiveBalance
+
+	es.values = values
+	es.precalcValues = nil
+	es.partialDuties = true
+	es.computedDuties = DutyTypeAttester
+	es.lastAccess.Store(time.Now().UnixNano())
+
+	client.logger.Infof("loaded partial epoch %v stats from beacon committees (root: %v, validators: %v)", es.epoch, es.dependentRoot.String(), values.ActiveValidators)
+
+	es.setStatsReady()
+	indexer.resetCanonicalComputation()
+
+	return nil
+}
+
+// getDependentStateRoot returns the state root of the dependent block.
+func (es *EpochStats) getDependentStateRoot(indexer *Indexer, client *Client) (phase0.Root, error) {
+	if es.dependentState != nil && es.dependentState.stateRoot != (phase0.Root{}) {
+		return es.dependentState.stateRoot, nil
+	}
+
+	if block := indexer.blockCache.getBlockByRoot(es.dependentRoot); block != nil {
+		if header := block.GetHeader(); header != nil {
+			return header.Message.StateRoot, nil
+		}
+	}
+
+	header, err := LoadBeaconHeader(client.getContext(), client, es.dependentRoot)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return header.Message.StateRoot, nil
+}
+
+// getProposersFromBlocks returns the proposers of the blocks in the epoch that descend from the dependent block.
+// proposers of missed or not yet proposed slots are unknown (math.MaxInt64).
+func (es *EpochStats) getProposersFromBlocks(indexer *Indexer) []phase0.ValidatorIndex {
+	chainState := indexer.consensusPool.GetChainState()
+	firstSlot := chainState.EpochToSlot(es.epoch)
+	proposerDuties := getUnknownProposerDuties(chainState.GetSpecs())
+
+	for i := range proposerDuties {
+		for _, block := range indexer.blockCache.getBlocksBySlot(firstSlot + phase0.Slot(i)) {
+			header := block.GetHeader()
+			if header == nil || !indexer.blockCache.isCanonicalBlock(es.dependentRoot, block.Root) {
+				continue
+			}
+
+			proposerDuties[i] = header.Message.ProposerIndex
+			break
+		}
+	}
+
+	// finalized blocks are not kept in the block cache
+	if es.epoch < indexer.lastFinalizedEpoch {
+		lastSlot := firstSlot + phase0.Slot(len(proposerDuties)) - 1
+		for _, dbSlot := range db.GetSlotsRange(uint64(lastSlot), uint64(firstSlot), false, false) {
+			slotIndex := dbSlot.Slot - uint64(firstSlot)
+			if proposerDuties[slotIndex] == math.MaxInt64 {
+				proposerDuties[slotIndex] = phase0.ValidatorIndex(dbSlot.Proposer)
+			}
+		}
+	}
+
+	return proposerDuties
+}
@@ -0,0 +1,130 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// epochRepairInterval is the number of epochs between two epoch repair runs.
+	epochRepairInterval = 8
+
+	// epochRepairBatchSize is the max number of epochs repaired per run.
+	epochRepairBatchSize = 10
+
+	// epochRepairRetryDelay is the delay before an epoch that could not be repaired is tried again.
+	epochRepairRetryDelay = 1 * time.Hour
+)
+
+// runEpochRepairLoop periodically re-derives the stats of epochs that have been persisted without validator stats
+// (e.g. synchronized while no archive node was available) or with partial duties from an archive state.
+func (indexer *Indexer) runEpochRepairLoop() {
+	defer utils.HandleSubroutinePanic("runEpochRepairLoop", indexer.runEpochRepairLoop)
+
+	if utils.Config.Indexer.DisableEpochRepair || indexer.disableSync {
+		return
+	}
+
+	repairSync := newSynchronizer(indexer, indexer.logger.WithField("service", "epoch-repair"))
+	repairSync.repairMode = true
+	retryAfter := map[phase0.Epoch]time.Time{}
+
+	chainState := indexer.consensusPool.GetChainState()
+	for {
+		specs := chainState.GetSpecs()
+		if specs == nil {
+			time.Sleep(10 * time.Second)
+			continue
+		}
+
+		time.Sleep(time.Duration(epochRepairInterval*specs.SlotsPerEpoch) * specs.SecondsPerSlot)
+
+		if err := indexer.repairEpochStats(repairSync, retryAfter); err != nil {
+			indexer.logger.Warnf("epoch stats repair failed: %v", err)
+		}
+	}
+}
+
+// repairEpochStats re-synchronizes up to `epochRepairBatchSize` epochs without validator stats or with backfillable partial duties from an archive node.
+// only epochs the synchronizer has already passed are repaired, the synchronizer progress itself is not changed.
+func (indexer *Indexer) repairEpochStats(repairSync *synchronizer, retryAfter map[phase0.Epoch]time.Time) error {
+	indexer.synchronizer.stateMutex.Lock()
+	syncedEpoch := indexer.synchronizer.currentEpoch
+	indexer.synchronizer.stateMutex.Unlock()
+
+	epochs, err := db.GetEpochsWithoutValidatorStats(uint64(syncedEpoch), 100)
+	if err != nil {
+		return err
+	}
+
+	// backfill epochs persisted with partial duties, if the missing duty types are supported by now
+	if specs := indexer.consensusPool.GetChainState().GetSpecs(); specs != nil {
+		knownEpochs := make(map[uint64]bool, len(epochs))
+		for _, epoch := range epochs {
+			knownEpochs[epoch] = true
+		}
+		for _, epoch := range indexer.dbWriter.partialDuties.getBackfillEpochs(specs, syncedEpoch, 100) {
+			if !knownEpochs[epoch] {
+				epochs = append(epochs, epoch)
+			}
+		}
+		sort.Slice(epochs, func(a, b int) bool {
+			return epochs[a] < epochs[b]
+		})
+	}
+
+	if len(epochs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repairSync.syncCtx = ctx
+	repairSync.blockCache = newSyncBlockCache()
+	repairSync.lastEpochState = nil
+	repairSync.lastEpochBlocks = nil
+
+	now := time.Now()
+	attempts := 0
+	for _, dbEpoch := range epochs {
+		epoch := phase0.Epoch(dbEpoch)
+		if retryTime, found := retryAfter[epoch]; found && now.Before(retryTime) {
+			continue
+		}
+		if attempts >= epochRepairBatchSize {
+			break
+		}
+
+		var archiveClient *Client
+		for _, client := range repairSync.getSyncClients(epoch) {
+			if client.archive {
+				archiveClient = client
+				break
+			}
+		}
+		if archiveClient == nil {
+			indexer.logger.Debugf("no archive client available for epoch stats repair of epoch %v", epoch)
+			return nil
+		}
+
+		attempts++
+		done, err := repairSync.syncEpoch(epoch, archiveClient, false)
+		if !done || err != nil {
+			retryAfter[epoch] = now.Add(epochRepairRetryDelay)
+			indexer.logger.Warnf("epoch stats repair: failed re-deriving stats of epoch %v from %v: %v", epoch, archiveClient.client.GetName(), err)
+			continue
+		}
+
+		delete(retryAfter, epoch)
+		indexer.logger.Infof("epoch stats repair: re-derived stats of epoch %v from %v", epoch, archiveClient.client.GetName())
+	}
+
+	return nil
+}
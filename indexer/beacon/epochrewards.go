@@ -0,0 +1,261 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// epochRewardsBatchSize is the number of epochs processed per reward summary batch.
+	epochRewardsBatchSize = 100
+
+	// protocol constants used for the reward estimation (altair incentive weights)
+	epochRewardsBaseRewardFactor   = 64
+	epochRewardsTimelySourceWeight = 14
+	epochRewardsTimelyTargetWeight = 26
+	epochRewardsTimelyHeadWeight   = 14
+	epochRewardsSyncRewardWeight   = 2
+	epochRewardsProposerWeight     = 8
+	epochRewardsWeightDenominator  = 64
+)
+
+// runEpochRewardsLoop periodically aggregates the rewards of finalized epochs into epoch level reward summaries.
+func (indexer *Indexer) runEpochRewardsLoop() {
+	defer utils.HandleSubroutinePanic("runEpochRewardsLoop", indexer.runEpochRewardsLoop)
+
+	if utils.Config.Indexer.DisableEpochRewards {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	for {
+		specs := chainState.GetSpecs()
+		if specs == nil {
+			time.Sleep(10 * time.Second)
+			continue
+		}
+
+		processed, err := indexer.processEpochRewards()
+		if err != nil {
+			indexer.logger.Warnf("epoch rewards aggregation failed: %v", err)
+		}
+
+		if processed < epochRewardsBatchSize {
+			time.Sleep(time.Duration(specs.SlotsPerEpoch) * specs.SecondsPerSlot)
+		} else {
+			time.Sleep(1 * time.Second)
+		}
+	}
+}
+
+// processEpochRewards builds the reward summaries for a batch of finalized epochs without summary (newest first).
+// recent epochs are aggregated from the rewards apis, older epochs or epochs the apis fail for are estimated from the epoch votes.
+func (indexer *Indexer) processEpochRewards() (int, error) {
+	chainState := indexer.consensusPool.GetChainState()
+
+	// attestation rewards of an epoch are applied with the epoch transition of the next epoch
+	if indexer.lastFinalizedEpoch < 2 {
+		return 0, nil
+	}
+	lastEpoch := indexer.lastFinalizedEpoch - 2
+
+	apiEpochs := utils.Config.Indexer.EpochRewardsApiEpochs
+	if apiEpochs == 0 {
+		apiEpochs = 256
+	}
+	minApiEpoch := phase0.Epoch(0)
+	if uint64(lastEpoch) > apiEpochs {
+		minApiEpoch = lastEpoch - phase0.Epoch(apiEpochs)
+	}
+
+	dbEpochs, err := db.GetEpochsWithoutRewards(0, uint64(lastEpoch), epochRewardsBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed loading epochs without reward summary: %v", err)
+	}
+	if len(dbEpochs) == 0 {
+		return 0, nil
+	}
+
+	var client *Client
+	useApi := true
+
+	for _, dbEpoch := range dbEpochs {
+		epoch := phase0.Epoch(dbEpoch.Epoch)
+
+		var rewards *dbtypes.EpochRewards
+		if useApi && epoch >= minApiEpoch {
+			if client == nil {
+				client = indexer.GetReadyClient(true)
+			}
+			if client != nil {
+				rewards, err = indexer.loadEpochRewardsFromApi(client, dbEpoch)
+				if err != nil {
+					indexer.logger.Debugf("failed loading rewards for epoch %v from client %v, falling back to estimation: %v", epoch, client.client.GetName(), err)
+					rewards = nil
+
+					// do not hammer clients that lack the rewards apis
+					useApi = false
+				}
+			}
+		}
+
+		if rewards == nil {
+			rewards = indexer.estimateEpochRewards(dbEpoch, chainState)
+		}
+
+		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			return db.InsertEpochRewards(rewards, tx)
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed persisting reward summary for epoch %v: %v", epoch, err)
+		}
+	}
+
+	indexer.logger.Debugf("aggregated reward summaries for %v epochs (%v - %v)", len(dbEpochs), dbEpochs[len(dbEpochs)-1].Epoch, dbEpochs[0].Epoch)
+
+	return len(dbEpochs), nil
+}
+
+// loadEpochRewardsFromApi sums the attestation rewards of all validators and the proposer & sync committee rewards
+// of all canonical blocks in the epoch from the beacon rewards apis.
+func (indexer *Indexer) loadEpochRewardsFromApi(client *Client, dbEpoch *dbtypes.Epoch) (*dbtypes.EpochRewards, error) {
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	epoch := phase0.Epoch(dbEpoch.Epoch)
+
+	ctx, cancel := context.WithTimeout(client.getContext(), 5*time.Minute)
+	defer cancel()
+
+	rewards := &dbtypes.EpochRewards{
+		Epoch:          dbEpoch.Epoch,
+		ValidatorCount: dbEpoch.ValidatorCount,
+	}
+
+	attestationRewards, err := client.client.GetRPCClient().GetAttestationRewards(ctx, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading attestation rewards: %v", err)
+	}
+
+	for _, validatorRewards := range attestationRewards.TotalRewards {
+		rewards.AttestationRewards += uint64(validatorRewards.Head)
+		addSignedEpochReward(validatorRewards.Target, &rewards.AttestationRewards, &rewards.AttestationPenalties)
+		addSignedEpochReward(validatorRewards.Source, &rewards.AttestationRewards, &rewards.AttestationPenalties)
+		if validatorRewards.InclusionDelay != nil {
+			rewards.AttestationRewards += uint64(*validatorRewards.InclusionDelay)
+		}
+		rewards.AttestationPenalties += uint64(validatorRewards.Inactivity)
+	}
+
+	firstSlot := chainState.EpochToSlot(epoch)
+	lastSlot := firstSlot + phase0.Slot(specs.SlotsPerEpoch) - 1
+	withSyncRewards := specs.IsAltairActive(epoch)
+
+	for _, assignedSlot := range db.GetSlotsRange(uint64(lastSlot), uint64(firstSlot), false, false) {
+		if assignedSlot.Block == nil || assignedSlot.Block.Status != dbtypes.Canonical {
+			continue
+		}
+
+		blockRoot := phase0.Root(assignedSlot.Block.Root)
+
+		blockRewards, err := client.client.GetRPCClient().GetBlockRewards(ctx, blockRoot)
+		if err != nil {
+			return nil, fmt.Errorf("failed loading block rewards for slot %v: %v", assignedSlot.Slot, err)
+		}
+		rewards.ProposerRewards += uint64(blockRewards.Total)
+
+		if !withSyncRewards {
+			continue
+		}
+
+		syncRewards, err := client.client.GetRPCClient().GetSyncCommitteeRewards(ctx, blockRoot)
+		if err != nil {
+			return nil, fmt.Errorf("failed loading sync committee rewards for slot %v: %v", assignedSlot.Slot, err)
+		}
+		for _, syncReward := range syncRewards {
+			addSignedEpochReward(syncReward.Reward, &rewards.SyncRewards, &rewards.SyncPenalties)
+		}
+	}
+
+	rewards.TotalIssuance = getEpochRewardsIssuance(rewards)
+
+	return rewards, nil
+}
+
+// estimateEpochRewards estimates the rewards of an epoch with the altair reward formulas from the aggregated epoch votes.
+// the estimation ignores inactivity leaks, slashings and per validator effective balance rounding.
+func (indexer *Indexer) estimateEpochRewards(dbEpoch *dbtypes.Epoch, chainState *consensus.ChainState) *dbtypes.EpochRewards {
+	specs := chainState.GetSpecs()
+
+	rewards := &dbtypes.EpochRewards{
+		Epoch:          dbEpoch.Epoch,
+		ValidatorCount: dbEpoch.ValidatorCount,
+		Estimated:      true,
+	}
+
+	if dbEpoch.Eligible == 0 || specs.EffectiveBalanceIncrement == 0 {
+		return rewards
+	}
+
+	eligible := float64(dbEpoch.Eligible)
+	participation := func(voted uint64) float64 {
+		return math.Min(float64(voted)/eligible, 1)
+	}
+
+	// total base rewards of all active validators: active increments * base reward per increment
+	baseRewardPerIncrement := math.Floor(float64(specs.EffectiveBalanceIncrement) * epochRewardsBaseRewardFactor / math.Floor(math.Sqrt(eligible)))
+	totalBaseRewards := math.Floor(eligible/float64(specs.EffectiveBalanceIncrement)) * baseRewardPerIncrement
+
+	sourceRate := participation(dbEpoch.VotedTotal)
+	targetRate := participation(dbEpoch.VotedTarget)
+	headRate := participation(dbEpoch.VotedHead)
+
+	attestationRewards := totalBaseRewards * (epochRewardsTimelySourceWeight*sourceRate + epochRewardsTimelyTargetWeight*targetRate + epochRewardsTimelyHeadWeight*headRate) / epochRewardsWeightDenominator
+	attestationPenalties := totalBaseRewards * (epochRewardsTimelySourceWeight*(1-sourceRate) + epochRewardsTimelyTargetWeight*(1-targetRate)) / epochRewardsWeightDenominator
+
+	syncRewards := float64(0)
+	syncPenalties := float64(0)
+	if specs.IsAltairActive(phase0.Epoch(dbEpoch.Epoch)) && specs.SlotsPerEpoch > 0 {
+		// sync committee rewards are only paid for proposed blocks
+		blockRate := math.Min(float64(dbEpoch.BlockCount)/float64(specs.SlotsPerEpoch), 1)
+		syncParticipation := math.Min(float64(dbEpoch.SyncParticipation), 1)
+		maxSyncRewards := totalBaseRewards * epochRewardsSyncRewardWeight / epochRewardsWeightDenominator * blockRate
+		syncRewards = maxSyncRewards * syncParticipation
+		syncPenalties = maxSyncRewards * (1 - syncParticipation)
+	}
+
+	// proposers receive a share of the participant rewards for every included attestation & sync aggregate
+	proposerRewards := (attestationRewards + syncRewards) * epochRewardsProposerWeight / (epochRewardsWeightDenominator - epochRewardsProposerWeight)
+
+	rewards.AttestationRewards = uint64(attestationRewards)
+	rewards.AttestationPenalties = uint64(attestationPenalties)
+	rewards.ProposerRewards = uint64(proposerRewards)
+	rewards.SyncRewards = uint64(syncRewards)
+	rewards.SyncPenalties = uint64(syncPenalties)
+	rewards.TotalIssuance = getEpochRewardsIssuance(rewards)
+
+	return rewards
+}
+
+// addSignedEpochReward adds a signed reward value to the rewards or penalties sum.
+func addSignedEpochReward(value int64, rewards *uint64, penalties *uint64) {
+	if value >= 0 {
+		*rewards += uint64(value)
+	} else {
+		*penalties += uint64(-value)
+	}
+}
+
+// getEpochRewardsIssuance returns the net issuance (rewards minus penalties) of an epoch reward summary.
+func getEpochRewardsIssuance(rewards *dbtypes.EpochRewards) int64 {
+	return int64(rewards.AttestationRewards) - int64(rewards.AttestationPenalties) + int64(rewards.ProposerRewards) + int64(rewards.SyncRewards) - int64(rewards.SyncPenalties)
+}
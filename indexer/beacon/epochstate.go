@@ -26,6 +26,11 @@ type epochState struct {
 	randaoMixes       []phase0.Root
 	depositIndex      uint64
 	syncCommittee     []phase0.ValidatorIndex
+
+	nextWithdrawalValidatorIndex phase0.ValidatorIndex
+	hasWithdrawalSweep           bool
+
+	finalizedEpoch phase0.Epoch
 }
 
 // newEpochState creates a new epochState instance with the root of the state to be loaded.
@@ -173,6 +178,8 @@ func (s *epochState) processState(state *spec.VersionedBeaconState, cache *epoch
 
 	s.randaoMixes = randaoMixes
 	s.depositIndex = getStateDepositIndex(state)
+	s.nextWithdrawalValidatorIndex, s.hasWithdrawalSweep = getStateNextWithdrawalValidatorIndex(state)
+	s.finalizedEpoch = getStateFinalizedEpoch(state)
 
 	if state.Version >= spec.DataVersionAltair {
 		currentSyncCommittee, err := getStateCurrentSyncCommittee(state)
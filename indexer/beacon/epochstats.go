@@ -3,11 +3,13 @@ package beacon
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unsafe"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/clients/consensus"
@@ -33,6 +35,8 @@ type EpochStats struct {
 	processingMutex sync.Mutex
 	processing      bool
 	isInDb          bool
+	lastAccess      atomic.Int64 // unix nano timestamp of the last access to the full values (for lru eviction)
+	reconciling     atomic.Bool  // values are being loaded from the db for the canonical head reconciliation
 
 	precalcBaseRoot phase0.Root
 	precalcValues   *EpochStatsValues
@@ -40,6 +44,16 @@ type EpochStats struct {
 	prunedValues    *EpochStatsValues
 
 	prunedEpochAggregations []*dbtypes.UnfinalizedEpoch
+
+	// finality & penalty tracking based on the dependent state (not persisted with the packed values)
+	hasFinalityStats    bool
+	finalizedEpoch      phase0.Epoch
+	penalizedValidators uint64
+	penaltyAmount       phase0.Gwei
+	parentState         *epochState // optional parent epoch state provided by the synchronizer
+	parentBlocks        []*Block    // optional blocks between the parent & dependent state provided by the synchronizer
+	computedDuties      DutyType    // duty types computed from the dependent state (0 if not processed from the state)
+	partialDuties       bool        // values loaded from the beacon committees endpoint, as the dependent state was unavailable
 }
 
 // EpochStatsValues holds the values for the epoch-specific information.
@@ -95,6 +109,12 @@ func (es *EpochStats) GetDependentRoot() phase0.Root {
 	return es.dependentRoot
 }
 
+// IsPartial returns true if the values have been loaded from the beacon committees endpoint instead of the dependent state.
+// partial values have complete attester duties, but proposers are only known for existing blocks and the sync committee is missing.
+func (es *EpochStats) IsPartial() bool {
+	return es.partialDuties
+}
+
 // addRequestedBy adds a client to the list of clients that have requested this EpochStats.
 func (es *EpochStats) addRequestedBy(client *Client) bool {
 	es.requestedMutex.Lock()
@@ -247,18 +267,7 @@ func (es *EpochStats) parsePackedSSZ(dynSsz *dynssz.DynSsz, chainState *consensu
 		}
 
 		// compute proposers
-		proposerDuties := []phase0.ValidatorIndex{}
-		for slot := chainState.EpochToSlot(es.epoch); slot < chainState.EpochToSlot(es.epoch+1); slot++ {
-			proposer, err := duties.GetProposerIndex(chainState.GetSpecs(), beaconState, slot)
-			proposerIndex := phase0.ValidatorIndex(math.MaxInt64)
-			if err == nil {
-				proposerIndex = values.ActiveIndices[proposer]
-			}
-
-			proposerDuties = append(proposerDuties, proposerIndex)
-		}
-
-		values.ProposerDuties = proposerDuties
+		values.ProposerDuties, _ = getProposerDuties(chainState.GetSpecs(), es.epoch, beaconState, values.ActiveIndices)
 		if beaconState.RandaoMix != nil {
 			values.RandaoMix = *beaconState.RandaoMix
 		}
@@ -360,6 +369,16 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 	}
 
 	values.ActiveValidators = uint64(len(values.ActiveIndices))
+
+	// track finality & the balance drain of penalized validators since the parent epoch state
+	es.hasFinalityStats = true
+	es.finalizedEpoch = es.dependentState.finalizedEpoch
+	if parentState, parentBlocks := es.getParentEpochState(indexer); parentState != nil {
+		es.penalizedValidators, es.penaltyAmount = getEpochBalanceDrain(parentState.validatorBalances, es.dependentState.validatorBalances, parentBlocks)
+	}
+	es.parentState = nil
+	es.parentBlocks = nil
+
 	beaconState := &duties.BeaconState{
 		GetRandaoMixes: func() []phase0.Root {
 			return es.dependentState.randaoMixes
@@ -374,33 +393,43 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 
 	indexer.logger.Debugf("processing epoch %v stats (root: %v / state: %v), validators: %v/%v", es.epoch, es.dependentRoot.String(), es.dependentState.stateRoot.String(), values.ActiveValidators, len(validatorSet))
 
-	// compute proposers
-	proposerDuties := []phase0.ValidatorIndex{}
-	for slot := chainState.EpochToSlot(es.epoch); slot < chainState.EpochToSlot(es.epoch+1); slot++ {
-		proposer, err := duties.GetProposerIndex(chainState.GetSpecs(), beaconState, slot)
-		proposerIndex := phase0.ValidatorIndex(math.MaxInt64)
-		if err != nil {
-			indexer.logger.Warnf("failed computing proposer for slot %v: %v", slot, err)
-			proposerIndex = math.MaxInt64
-		} else {
-			proposerIndex = values.ActiveIndices[proposer]
-		}
+	_, supportedDuties := getDutyCapabilities(chainState.GetSpecs(), es.epoch)
+	computedDuties := DutyType(0)
 
-		proposerDuties = append(proposerDuties, proposerIndex)
+	// compute proposers
+	proposerDuties, proposerErr := getProposerDuties(chainState.GetSpecs(), es.epoch, beaconState, values.ActiveIndices)
+	values.ProposerDuties = proposerDuties
+	if proposerErr == nil {
+		computedDuties |= DutyTypeProposer
+	} else if !errors.Is(proposerErr, errProposerDutiesUnsupported) {
+		indexer.logger.Warnf("failed computing proposers for epoch %v: %v", es.epoch, proposerErr)
 	}
 
-	values.ProposerDuties = proposerDuties
+	// resolve the randao mixes from the state, even if the proposers have not been computed
+	if beaconState.RandaoMix == nil {
+		duties.GetSeed(chainState.GetSpecs(), beaconState, es.epoch, chainState.GetSpecs().DomainBeaconAttester)
+	}
 	if beaconState.RandaoMix != nil {
 		values.RandaoMix = *beaconState.RandaoMix
 		values.NextRandaoMix = *beaconState.NextRandaoMix
 	}
 
 	// compute committees
-	attesterDuties, err := duties.GetAttesterDuties(chainState.GetSpecs(), beaconState, es.epoch)
-	if err != nil {
-		indexer.logger.Warnf("failed computing attester duties for epoch %v: %v", es.epoch, err)
+	if supportedDuties.Has(DutyTypeAttester) {
+		attesterDuties, err := duties.GetAttesterDuties(chainState.GetSpecs(), beaconState, es.epoch)
+		if err != nil {
+			indexer.logger.Warnf("failed computing attester duties for epoch %v: %v", es.epoch, err)
+		} else {
+			computedDuties |= DutyTypeAttester
+		}
+		values.AttesterDuties = attesterDuties
+	}
+
+	if len(values.SyncCommitteeDuties) > 0 {
+		computedDuties |= DutyTypeSyncCommittee
 	}
-	values.AttesterDuties = attesterDuties
+	es.computedDuties = computedDuties
+	es.partialDuties = false
 
 	es.values = values
 	es.precalcValues = nil
@@ -412,7 +441,18 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 		DutiesSSZ:     packedSsz,
 	}
 
-	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+	var validatorSnapshot *dbtypes.ValidatorSnapshot
+	if shouldStoreValidatorSnapshot(es.epoch) {
+		validatorSnapshot = buildValidatorSnapshot(es.epoch, es.dependentRoot, validatorSet, es.dependentState.validatorBalances)
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		if validatorSnapshot != nil {
+			if err := db.InsertValidatorSnapshot(validatorSnapshot, tx); err != nil {
+				return err
+			}
+		}
+
 		return db.InsertUnfinalizedDuty(dbDuty, tx)
 	})
 	if err != nil {
@@ -420,6 +460,7 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 	}
 
 	es.isInDb = true
+	es.lastAccess.Store(time.Now().UnixNano())
 
 	indexer.logger.Infof(
 		"processed epoch %v stats (root: %v / state: %v, validators: %v/%v, %v ms), %v bytes",
@@ -433,6 +474,96 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 	)
 
 	es.setStatsReady()
+
+	// the canonical head may have been computed with vote counts only, recompute it with the new values
+	indexer.resetCanonicalComputation()
+
+	indexer.epochCache.enforceMemoryLimit()
+}
+
+// getParentEpochState returns the loaded dependent state of the parent epoch and the blocks between both dependent states.
+// uses the parent state provided by the synchronizer or looks up the parent epoch stats in the cache.
+func (es *EpochStats) getParentEpochState(indexer *Indexer) (*epochState, []*Block) {
+	if es.parentState != nil {
+		if es.parentState.loadingStatus != 2 {
+			return nil, nil
+		}
+		return es.parentState, es.parentBlocks
+	}
+
+	if es.epoch == 0 {
+		return nil, nil
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	dependentBlock := indexer.blockCache.getBlockByRoot(es.dependentRoot)
+	if dependentBlock == nil {
+		return nil, nil
+	}
+
+	var parentDependentBlock *Block
+	if chainState.EpochOfSlot(dependentBlock.Slot) == es.epoch-1 {
+		parentDependentBlock = indexer.blockCache.getDependentBlock(chainState, dependentBlock, nil)
+	} else {
+		parentDependentBlock = dependentBlock
+	}
+	if parentDependentBlock == nil {
+		return nil, nil
+	}
+
+	parentStats := indexer.epochCache.getEpochStats(es.epoch-1, parentDependentBlock.Root)
+	if parentStats == nil || parentStats.dependentState == nil || parentStats.dependentState.loadingStatus != 2 {
+		return nil, nil
+	}
+
+	blocks := []*Block{}
+	for block := dependentBlock; block != nil && block.Slot > parentDependentBlock.Slot; {
+		blocks = append(blocks, block)
+
+		parentRoot := block.GetParentRoot()
+		if parentRoot == nil {
+			break
+		}
+		block = indexer.blockCache.getBlockByRoot(*parentRoot)
+	}
+
+	return parentStats.dependentState, blocks
+}
+
+// getEpochBalanceDrain compares the validator balances of two consecutive epoch states and returns the number of validators
+// with a decreased balance and the sum of all decreases.
+// balance moves are not penalties and get excluded: validators withdrawn in the given blocks and drops of a full ETH or more (consolidations).
+func getEpochBalanceDrain(parentBalances []phase0.Gwei, balances []phase0.Gwei, blocks []*Block) (uint64, phase0.Gwei) {
+	withdrawnValidators := map[phase0.ValidatorIndex]bool{}
+	for _, block := range blocks {
+		blockBody := block.GetBlock()
+		if blockBody == nil {
+			continue
+		}
+
+		withdrawals, _ := blockBody.Withdrawals()
+		for _, withdrawal := range withdrawals {
+			withdrawnValidators[withdrawal.ValidatorIndex] = true
+		}
+	}
+
+	penalizedCount := uint64(0)
+	penaltyAmount := phase0.Gwei(0)
+	for index, parentBalance := range parentBalances {
+		if index >= len(balances) || balances[index] >= parentBalance {
+			continue
+		}
+
+		drain := parentBalance - balances[index]
+		if drain >= EtherGweiFactor || withdrawnValidators[phase0.ValidatorIndex(index)] {
+			continue
+		}
+
+		penalizedCount++
+		penaltyAmount += drain
+	}
+
+	return penalizedCount, penaltyAmount
 }
 
 // precomputeFromParentState precomputes the EpochStats values based on the parent state.
@@ -457,6 +588,10 @@ func (es *EpochStats) precomputeFromParentState(indexer *Indexer, parentState *E
 			}
 		}
 
+		if parentState.partialDuties {
+			return fmt.Errorf("parent stats values are partial")
+		}
+
 		parentStatsValues := parentState.GetValues(false)
 		if parentStatsValues == nil {
 			return fmt.Errorf("parent stats values not available")
@@ -486,18 +621,7 @@ func (es *EpochStats) precomputeFromParentState(indexer *Indexer, parentState *E
 		chainState := indexer.consensusPool.GetChainState()
 
 		// compute proposers
-		proposerDuties := []phase0.ValidatorIndex{}
-		for slot := chainState.EpochToSlot(es.epoch); slot < chainState.EpochToSlot(es.epoch+1); slot++ {
-			proposer, err := duties.GetProposerIndex(chainState.GetSpecs(), beaconState, slot)
-			proposerIndex := phase0.ValidatorIndex(math.MaxInt64)
-			if err == nil {
-				proposerIndex = values.ActiveIndices[proposer]
-			}
-
-			proposerDuties = append(proposerDuties, proposerIndex)
-		}
-
-		values.ProposerDuties = proposerDuties
+		values.ProposerDuties, _ = getProposerDuties(chainState.GetSpecs(), es.epoch, beaconState, values.ActiveIndices)
 
 		// compute committees
 		attesterDuties, _ := duties.GetAttesterDuties(chainState.GetSpecs(), beaconState, es.epoch)
@@ -549,8 +673,9 @@ func (es *EpochStats) GetValues(withPrecalc bool) *EpochStatsValues {
 		return nil
 	}
 
-	if es.values != nil {
-		return es.values
+	if values := es.values; values != nil {
+		es.lastAccess.Store(time.Now().UnixNano())
+		return values
 	}
 
 	if es.prunedValues != nil {
@@ -570,8 +695,9 @@ func (es *EpochStats) GetOrLoadValues(indexer *Indexer, withPrecalc bool, keepIn
 		return nil
 	}
 
-	if es.values != nil {
-		return es.values
+	if values := es.values; values != nil {
+		es.lastAccess.Store(time.Now().UnixNano())
+		return values
 	}
 
 	if es.isInDb {
@@ -579,6 +705,8 @@ func (es *EpochStats) GetOrLoadValues(indexer *Indexer, withPrecalc bool, keepIn
 		if values != nil {
 			if keepInCache {
 				es.values = values
+				es.lastAccess.Store(time.Now().UnixNano())
+				indexer.epochCache.enforceMemoryLimit()
 			}
 			return values
 		}
@@ -591,6 +719,27 @@ func (es *EpochStats) GetOrLoadValues(indexer *Indexer, withPrecalc bool, keepIn
 	return nil
 }
 
+// getMemorySize returns the estimated memory size of the EpochStats values in bytes.
+func (v *EpochStatsValues) getMemorySize() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	size := uint64(unsafe.Sizeof(*v))
+	size += uint64(len(v.ActiveIndices)) * uint64(unsafe.Sizeof(phase0.ValidatorIndex(0)))
+	size += uint64(len(v.EffectiveBalances)) * 2
+	size += uint64(len(v.ProposerDuties)) * uint64(unsafe.Sizeof(phase0.ValidatorIndex(0)))
+	size += uint64(len(v.SyncCommitteeDuties)) * uint64(unsafe.Sizeof(phase0.ValidatorIndex(0)))
+	for _, slotDuties := range v.AttesterDuties {
+		size += uint64(len(slotDuties)) * uint64(unsafe.Sizeof(slotDuties))
+		for _, committee := range slotDuties {
+			size += uint64(len(committee)) * uint64(unsafe.Sizeof(duties.ActiveIndiceIndex(0)))
+		}
+	}
+
+	return size
+}
+
 // GetEffectiveBalance returns the effective balance for the given active validator indice.
 func (v *EpochStatsValues) GetEffectiveBalance(index duties.ActiveIndiceIndex) phase0.Gwei {
 	if v == nil {
@@ -601,7 +750,8 @@ func (v *EpochStatsValues) GetEffectiveBalance(index duties.ActiveIndiceIndex) p
 }
 
 // GetDbEpoch returns the database Epoch representaion for the EpochStats.
-func (es *EpochStats) GetDbEpoch(indexer *Indexer, headBlock *Block) *dbtypes.Epoch {
+// withVotes controls whether votes are aggregated when no pruned aggregation is available.
+func (es *EpochStats) GetDbEpoch(indexer *Indexer, headBlock *Block, withVotes bool) *dbtypes.Epoch {
 	chainState := indexer.consensusPool.GetChainState()
 	if headBlock == nil {
 		headBlock = indexer.GetCanonicalHead(nil)
@@ -659,7 +809,10 @@ func (es *EpochStats) GetDbEpoch(indexer *Indexer, headBlock *Block) *dbtypes.Ep
 	})
 
 	// compute epoch votes
-	epochVotes := es.GetEpochVotes(indexer, headBlock)
+	var epochVotes *EpochVotes
+	if withVotes {
+		epochVotes = es.GetEpochVotes(indexer, headBlock)
+	}
 
 	return indexer.dbWriter.buildDbEpoch(es.epoch, epochBlocks, es, epochVotes, nil)
 }
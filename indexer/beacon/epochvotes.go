@@ -3,11 +3,13 @@ package beacon
 import (
 	"bytes"
 	"encoding/binary"
+	"sort"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
 	"github.com/prysmaticlabs/go-bitfield"
 )
 
@@ -49,6 +51,23 @@ type EpochVotes struct {
 	HeadVotePercent   float64
 	TotalVotePercent  float64
 	AmountIsCount     bool
+
+	// BlockAttestations holds the vote deduplication stats for the attestations of this epoch by block root & attestation index
+	BlockAttestations map[phase0.Root]map[int]*EpochVotesAttestation
+
+	// InclusionDistances holds the number of votes by the distance between the attested slot and the first block including the vote.
+	// index 0 holds the votes included with distance 1, the last bucket holds all votes with a distance of InclusionDistanceBuckets or more.
+	InclusionDistances   [InclusionDistanceBuckets]uint64
+	InclusionDistanceSum uint64
+}
+
+// InclusionDistanceBuckets is the number of inclusion distance buckets tracked per epoch (1, 2, 3 & 4+ slots).
+const InclusionDistanceBuckets = 4
+
+// EpochVotesAttestation holds the vote deduplication stats of an attestation.
+type EpochVotesAttestation struct {
+	IncludedBits uint64 // number of set aggregation bits
+	NewVotes     uint64 // number of votes not included in any earlier block of the chain
 }
 
 // aggregateEpochVotes aggregates the votes for an epoch based on the provided chain state, blocks, and epoch stats.
@@ -100,7 +119,8 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 	specs := chainState.GetSpecs()
 
 	votes := &EpochVotes{
-		AmountIsCount: epochStatsValues == nil,
+		AmountIsCount:     epochStatsValues == nil,
+		BlockAttestations: map[phase0.Root]map[int]*EpochVotesAttestation{},
 	}
 
 	var activityBitlist bitfield.Bitlist
@@ -134,6 +154,9 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 			block.processedActivity |= processedFlag
 		}
 
+		blockAttestations := map[int]*EpochVotesAttestation{}
+		votes.BlockAttestations[block.Root] = blockAttestations
+
 		for attIdx, attVersioned := range attestations {
 			attData, err := attVersioned.Data()
 			if err != nil {
@@ -150,11 +173,25 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 				continue
 			}
 
+			parentRoot := block.GetParentRoot()
+			isTargetCorrect := bytes.Equal(attData.Target.Root[:], targetRoot[:])
+			isHeadCorrect := parentRoot != nil && bytes.Equal(attData.BeaconBlockRoot[:], parentRoot[:])
+
+			voteFlags := uint8(0)
+			if isTargetCorrect {
+				voteFlags |= ValidatorVoteTargetCorrect
+			}
+			if isHeadCorrect {
+				voteFlags |= ValidatorVoteHeadCorrect
+			}
+
 			voteAmount := phase0.Gwei(0)
+			newVotes := uint64(0)
 			slotIndex := chainState.SlotToSlotIndex(attData.Slot)
 			updateActivity := func(validatorIndex phase0.ValidatorIndex) {
+				newVotes++
 				if processActivity {
-					indexer.validatorCache.updateValidatorActivity(validatorIndex, epoch, attData.Slot, block)
+					indexer.validatorCache.updateValidatorActivity(validatorIndex, epoch, attData.Slot, block, voteFlags)
 				}
 			}
 
@@ -196,7 +233,18 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 				}
 			}
 
-			if bytes.Equal(attData.Target.Root[:], targetRoot[:]) {
+			if epochStatsValues == nil {
+				newVotes = uint64(voteAmount)
+			}
+			if newVotes > 0 && slot > attData.Slot {
+				votes.addInclusionDistance(uint64(slot-attData.Slot), newVotes)
+			}
+			blockAttestations[attIdx] = &EpochVotesAttestation{
+				IncludedBits: attAggregationBits.Count(),
+				NewVotes:     newVotes,
+			}
+
+			if isTargetCorrect {
 				if isNextEpoch {
 					votes.NextEpoch.TargetVoteAmount += voteAmount
 				} else {
@@ -205,9 +253,8 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 			} /*else {
 				indexer.logger.Infof("vote target missmatch %v != 0x%x", attData.Target.Root, targetRoot)
 			}*/
-			parentRoot := block.GetParentRoot()
 
-			if parentRoot != nil && bytes.Equal(attData.BeaconBlockRoot[:], parentRoot[:]) {
+			if isHeadCorrect {
 				if isNextEpoch {
 					votes.NextEpoch.HeadVoteAmount += voteAmount
 				} else {
@@ -236,6 +283,17 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 	return votes
 }
 
+// addInclusionDistance adds the given number of votes to the inclusion distance bucket of the distance.
+func (votes *EpochVotes) addInclusionDistance(distance uint64, voteCount uint64) {
+	bucket := distance - 1
+	if bucket >= InclusionDistanceBuckets {
+		bucket = InclusionDistanceBuckets - 1
+	}
+
+	votes.InclusionDistances[bucket] += voteCount
+	votes.InclusionDistanceSum += distance * voteCount
+}
+
 // aggregateVotes aggregates the votes for a specific slot and committee based on the provided epoch statistics, aggregation bits, and offset.
 func (votes *EpochVotes) aggregateVotes(epochStatsValues *EpochStatsValues, slotIndex phase0.Slot, committee uint64, aggregationBits bitfield.Bitfield, aggregationBitsOffset uint64, activityBitlist *bitfield.Bitlist, updateActivity func(validatorIndex phase0.ValidatorIndex)) (phase0.Gwei, uint64) {
 	voteAmount := phase0.Gwei(0)
@@ -260,6 +318,78 @@ func (votes *EpochVotes) aggregateVotes(epochStatsValues *EpochStatsValues, slot
 	return voteAmount, uint64(len(voteDuties))
 }
 
+// GetBlockAttestationVotes returns the vote deduplication stats for the attestations included in the block by attestation index.
+// The stats are aggregated over the chain of the block, so they're only available for blocks with all parents of the
+// previous epoch in cache.
+func (indexer *Indexer) GetBlockAttestationVotes(block *Block) map[int]*EpochVotesAttestation {
+	chainState := indexer.consensusPool.GetChainState()
+	blockEpoch := chainState.EpochOfSlot(block.Slot)
+	minEpoch := blockEpoch
+	if minEpoch > 0 {
+		minEpoch--
+	}
+
+	// collect all blocks of the previous & current epoch in chain up to the block
+	chainBlocks := []*Block{}
+	currentBlock := block
+	for {
+		if chainState.EpochOfSlot(currentBlock.Slot) < minEpoch {
+			break
+		}
+
+		chainBlocks = append(chainBlocks, currentBlock)
+
+		parentRoot := currentBlock.GetParentRoot()
+		if parentRoot == nil {
+			return nil
+		}
+
+		currentBlock = indexer.blockCache.getBlockByRoot(*parentRoot)
+		if currentBlock == nil {
+			// parent might already be finalized & pruned from cache, the chain is complete if it's before the previous epoch
+			blockHead := db.GetBlockHeadByRoot(parentRoot[:])
+			if blockHead == nil || chainState.EpochOfSlot(phase0.Slot(blockHead.Slot)) >= minEpoch {
+				// incomplete chain, deduplication stats would be wrong
+				return nil
+			}
+			break
+		}
+	}
+
+	// sort blocks ascending
+	sort.Slice(chainBlocks, func(i, j int) bool {
+		return chainBlocks[i].Slot < chainBlocks[j].Slot
+	})
+
+	attestationVotes := map[int]*EpochVotesAttestation{}
+	for epoch := minEpoch; epoch <= blockEpoch; epoch++ {
+		votingBlocks := []*Block{}
+		for _, chainBlock := range chainBlocks {
+			chainBlockEpoch := chainState.EpochOfSlot(chainBlock.Slot)
+			if chainBlockEpoch == epoch || chainBlockEpoch == epoch+1 {
+				votingBlocks = append(votingBlocks, chainBlock)
+			}
+		}
+
+		if len(votingBlocks) == 0 {
+			continue
+		}
+
+		dependentRoot := votingBlocks[0].GetParentRoot()
+		if dependentRoot == nil {
+			continue
+		}
+
+		epochStats := indexer.epochCache.getEpochStats(epoch, *dependentRoot)
+		epochVotes := indexer.aggregateEpochVotes(epoch, chainState, votingBlocks, epochStats)
+		for attIdx, attVotes := range epochVotes.BlockAttestations[block.Root] {
+			attestationVotes[attIdx] = attVotes
+		}
+	}
+
+	return attestationVotes
+}
+
 type voteDeduplicationKey [14]byte // slotIndex (8) + committee (2) + index (4) = 14 bytes
 
 func getVoteDeduplicationKey(slotIndex phase0.Slot, committee uint16, index uint32) voteDeduplicationKey {
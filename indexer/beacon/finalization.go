@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sort"
@@ -9,8 +10,10 @@ import (
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/dora/clients/consensus"
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -298,6 +301,12 @@ func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.R
 	t1dur := time.Since(t1) - t1loading
 	t1 = time.Now()
 
+	// diff validator set against the last finalized validator set
+	var validatorEvents []*dbtypes.ValidatorEvent
+	if len(canonicalBlocks) > 0 {
+		validatorEvents = indexer.validatorCache.getFinalizedValidatorEvents(epoch, canonicalBlocks[len(canonicalBlocks)-1].Root)
+	}
+
 	// persist to db
 	deleteBeforeSlot := chainState.EpochToSlot(epoch + 1)
 	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
@@ -334,10 +343,27 @@ func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.R
 			return fmt.Errorf("error persisting sync committee assignments to db: %v", err)
 		}
 
+		// persist validator status transitions
+		if err := indexer.dbWriter.persistValidatorEvents(tx, validatorEvents); err != nil {
+			return fmt.Errorf("error persisting validator events to db: %v", err)
+		}
+
 		if err := db.UpdateMevBlockByEpoch(uint64(epoch), specs.SlotsPerEpoch, canonicalBlockHashes, tx); err != nil {
 			return fmt.Errorf("error while updating mev block proposal state: %v", err)
 		}
 
+		// delete validator snapshots of orphaned forks and snapshots out of the retention range
+		if shouldStoreValidatorSnapshot(epoch) && !bytes.Equal(dependentRoot[:], consensus.NullRoot[:]) {
+			if err := db.DeleteOrphanedValidatorSnapshots(uint64(epoch), dependentRoot[:], tx); err != nil {
+				return fmt.Errorf("failed deleting orphaned validator snapshots for epoch %v: %v", epoch, err)
+			}
+		}
+		if retention := phase0.Epoch(utils.Config.Indexer.ValidatorSnapshotRetention); retention > 0 && epoch > retention {
+			if err := db.DeleteValidatorSnapshotsBefore(uint64(epoch-retention), tx); err != nil {
+				return fmt.Errorf("failed deleting validator snapshots < epoch %v: %v", epoch-retention, err)
+			}
+		}
+
 		// delete unfinalized duties before epoch
 		if err := db.DeleteUnfinalizedDutiesBefore(uint64(epoch+1), tx); err != nil {
 			return fmt.Errorf("failed deleting unfinalized duties <= epoch %v: %v", epoch, err)
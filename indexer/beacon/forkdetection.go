@@ -22,9 +22,9 @@ type updateForkInfo struct {
 }
 
 // processBlock processes a block and detects new forks if any.
-// It persists the new forks to the database, sets the forkId of the supplied block
+// It persists the new forks to the database (within the supplied transaction), sets the forkId of the supplied block
 // and updates the forkId of all blocks affected by newly detected forks.
-func (cache *forkCache) processBlock(block *Block) error {
+func (cache *forkCache) processBlock(block *Block, tx *sqlx.Tx) error {
 	cache.forkProcessLock.Lock()
 	defer cache.forkProcessLock.Unlock()
 
@@ -227,76 +227,69 @@ func (cache *forkCache) processBlock(block *Block) error {
 
 	// persist new forks and updated blocks to the database
 	if len(newForks) > 0 || len(updatedBlocks) > 0 {
-		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
-			// helper function to update unfinalized block fork ids in batches
-			updateUnfinalizedBlockForkIds := func(updateRoots [][]byte, forkId ForkKey) error {
-				batchSize := 1000
-				numBatches := (len(updateRoots) + batchSize - 1) / batchSize
-
-				for i := 0; i < numBatches; i++ {
-					start := i * batchSize
-					end := (i + 1) * batchSize
-					if end > len(updateRoots) {
-						end = len(updateRoots)
-					}
-
-					batchRoots := updateRoots[start:end]
-
-					err := db.UpdateUnfinalizedBlockForkId(batchRoots, uint64(forkId), tx)
-					if err != nil {
-						return err
-					}
+		// helper function to update unfinalized block fork ids in batches
+		updateUnfinalizedBlockForkIds := func(updateRoots [][]byte, forkId ForkKey) error {
+			batchSize := 1000
+			numBatches := (len(updateRoots) + batchSize - 1) / batchSize
+
+			for i := 0; i < numBatches; i++ {
+				start := i * batchSize
+				end := (i + 1) * batchSize
+				if end > len(updateRoots) {
+					end = len(updateRoots)
 				}
 
-				return nil
-			}
+				batchRoots := updateRoots[start:end]
 
-			// add new forks
-			for _, newFork := range newForks {
-				err := db.InsertFork(newFork.fork.toDbFork(), tx)
+				err := db.UpdateUnfinalizedBlockForkId(batchRoots, uint64(forkId), tx)
 				if err != nil {
 					return err
 				}
+			}
 
-				if len(newFork.updateRoots) > 0 {
-					err := updateUnfinalizedBlockForkIds(newFork.updateRoots, newFork.fork.forkId)
-					if err != nil {
-						return err
-					}
-				}
+			return nil
+		}
+
+		// add new forks
+		for _, newFork := range newForks {
+			err := db.InsertFork(newFork.fork.toDbFork(), tx)
+			if err != nil {
+				return err
 			}
 
-			// update blocks building on top of current block
-			if len(updatedBlocks) > 0 {
-				err := updateUnfinalizedBlockForkIds(updatedBlocks, currentForkId)
+			if len(newFork.updateRoots) > 0 {
+				err := updateUnfinalizedBlockForkIds(newFork.updateRoots, newFork.fork.forkId)
 				if err != nil {
 					return err
 				}
+			}
+		}
 
-				cache.indexer.logger.Infof("updated %v blocks to fork %v", len(updatedBlocks), currentForkId)
+		// update blocks building on top of current block
+		if len(updatedBlocks) > 0 {
+			err := updateUnfinalizedBlockForkIds(updatedBlocks, currentForkId)
+			if err != nil {
+				return err
 			}
 
-			// update parents of forks building on top of current blocks chain segment
-			if len(updateForks) > 0 {
-				for _, updatedFork := range updateForks {
-					err := db.UpdateForkParent(updatedFork.baseRoot, uint64(updatedFork.parent), tx)
-					if err != nil {
-						return err
-					}
-				}
+			cache.indexer.logger.Infof("updated %v blocks to fork %v", len(updatedBlocks), currentForkId)
+		}
 
-				cache.indexer.logger.Infof("updated %v fork parents", len(updateForks))
+		// update parents of forks building on top of current blocks chain segment
+		if len(updateForks) > 0 {
+			for _, updatedFork := range updateForks {
+				err := db.UpdateForkParent(updatedFork.baseRoot, uint64(updatedFork.parent), tx)
+				if err != nil {
+					return err
+				}
 			}
 
-			err := cache.updateForkState(tx)
-			if err != nil {
-				return fmt.Errorf("error while updating fork state: %v", err)
-			}
+			cache.indexer.logger.Infof("updated %v fork parents", len(updateForks))
+		}
 
-			return nil
-		})
+		err := cache.updateForkState(tx)
 		if err != nil {
-			return err
+			return fmt.Errorf("error while updating fork state: %v", err)
 		}
 	}
 
@@ -0,0 +1,139 @@
+package beacon
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+)
+
+// HeadSelectionStrategy defines how the canonical head is selected from the known chain heads.
+type HeadSelectionStrategy string
+
+const (
+	// HeadSelectionMajority selects the head most connected clients agree on, ties are broken by the aggregated votes.
+	HeadSelectionMajority HeadSelectionStrategy = "majority"
+	// HeadSelectionHighest selects the head with the highest slot.
+	HeadSelectionHighest HeadSelectionStrategy = "highest"
+	// HeadSelectionFcu selects the head with the most aggregated head votes (local fork choice), ties are broken by the slot.
+	HeadSelectionFcu HeadSelectionStrategy = "fcu"
+)
+
+// parseHeadSelectionStrategy parses the configured head selection strategy, defaults to majority.
+func parseHeadSelectionStrategy(strategy string) (HeadSelectionStrategy, bool) {
+	switch HeadSelectionStrategy(strings.ToLower(strategy)) {
+	case "", HeadSelectionMajority:
+		return HeadSelectionMajority, true
+	case HeadSelectionHighest:
+		return HeadSelectionHighest, true
+	case HeadSelectionFcu:
+		return HeadSelectionFcu, true
+	default:
+		return HeadSelectionMajority, false
+	}
+}
+
+// GetHeadSelectionStrategy returns the strategy used to select the canonical head.
+func (indexer *Indexer) GetHeadSelectionStrategy() HeadSelectionStrategy {
+	return indexer.headSelection
+}
+
+// countChainHeadClients attributes each online client to the chain head it follows (the closest head containing the client head)
+// and sets the client counts of the chain heads.
+func (indexer *Indexer) countChainHeadClients(chainHeads []*ChainHead) {
+	for _, client := range indexer.clients {
+		clientStatus := client.client.GetStatus()
+		if clientStatus != consensus.ClientStatusOnline && clientStatus != consensus.ClientStatusOptimistic {
+			continue
+		}
+
+		_, clientHeadRoot := client.client.GetLastHead()
+
+		var matchingHead *ChainHead
+		var matchingDistance uint64
+		for _, chainHead := range chainHeads {
+			if bytes.Equal(chainHead.HeadBlock.Root[:], clientHeadRoot[:]) {
+				matchingHead = chainHead
+				break
+			}
+
+			isInChain, distance := indexer.blockCache.getCanonicalDistance(clientHeadRoot, chainHead.HeadBlock.Root, 0)
+			if isInChain && (matchingHead == nil || distance < matchingDistance) {
+				matchingHead = chainHead
+				matchingDistance = distance
+			}
+		}
+
+		if matchingHead != nil {
+			matchingHead.ClientCount++
+		}
+	}
+}
+
+// selectCanonicalHead selects the canonical head from the chain heads with the configured strategy.
+// heads descending from the finalized checkpoint are preferred, other heads are only considered if no head descends from it.
+func (indexer *Indexer) selectCanonicalHead(chainHeads []*ChainHead) *Block {
+	candidates := chainHeads
+
+	_, finalizedRoot := indexer.consensusPool.GetChainState().GetFinalizedCheckpoint()
+	if finalizedBlock := indexer.blockCache.getBlockByRoot(finalizedRoot); finalizedBlock != nil {
+		finalizedCandidates := make([]*ChainHead, 0, len(chainHeads))
+		for _, chainHead := range chainHeads {
+			if indexer.IsCanonicalBlockByHead(finalizedBlock, chainHead.HeadBlock) {
+				finalizedCandidates = append(finalizedCandidates, chainHead)
+			}
+		}
+		if len(finalizedCandidates) > 0 {
+			candidates = finalizedCandidates
+		}
+	}
+
+	var bestHead *ChainHead
+	for _, chainHead := range candidates {
+		if bestHead == nil || indexer.compareChainHeads(chainHead, bestHead) > 0 {
+			bestHead = chainHead
+		}
+	}
+
+	if bestHead == nil {
+		return nil
+	}
+	return bestHead.HeadBlock
+}
+
+// compareChainHeads compares two chain heads with the configured strategy (> 0 if headA is preferred).
+func (indexer *Indexer) compareChainHeads(headA, headB *ChainHead) int {
+	compareVotes := func() int {
+		if headA.AggregatedHeadVotes != headB.AggregatedHeadVotes {
+			if headA.AggregatedHeadVotes > headB.AggregatedHeadVotes {
+				return 1
+			}
+			return -1
+		}
+		return 0
+	}
+	compareSlot := func() int {
+		return int(headA.HeadBlock.Slot) - int(headB.HeadBlock.Slot)
+	}
+
+	switch indexer.headSelection {
+	case HeadSelectionHighest:
+		if res := compareSlot(); res != 0 {
+			return res
+		}
+		return compareVotes()
+	case HeadSelectionFcu:
+		if res := compareVotes(); res != 0 {
+			return res
+		}
+		return compareSlot()
+	default:
+		if headA.ClientCount != headB.ClientCount {
+			return headA.ClientCount - headB.ClientCount
+		}
+		if res := compareVotes(); res != 0 {
+			return res
+		}
+		return compareSlot()
+	}
+}
@@ -0,0 +1,173 @@
+package beacon
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+
+	"github.com/ethpandaops/dora/db"
+)
+
+// HeadVoteStatus is the head vote classification of a validators attestation duty.
+type HeadVoteStatus uint8
+
+const (
+	// HeadVoteMissing indicates that no attestation of the validator has been included in the canonical chain.
+	HeadVoteMissing HeadVoteStatus = iota
+	// HeadVoteWrong indicates that the included attestation voted for a head that did not end up canonical at the attested slot.
+	HeadVoteWrong
+	// HeadVoteCorrect indicates that the included attestation voted for the canonical head of the attested slot.
+	HeadVoteCorrect
+)
+
+// EpochHeadVotes holds the head vote classification of all validators with attester duties in an epoch.
+type EpochHeadVotes struct {
+	Epoch phase0.Epoch
+	Votes map[phase0.ValidatorIndex]HeadVoteStatus
+}
+
+// GetEpochHeadVotes classifies the head votes of all validators with attester duties in the given epoch against the canonical chain.
+// a head vote is correct if it matches the latest canonical block at the attested slot. the canonical blocks of the epoch and the
+// following epoch (inclusion window) need to be in cache, nil is returned if they have already been pruned or the duties are unknown.
+func (indexer *Indexer) GetEpochHeadVotes(epoch phase0.Epoch) *EpochHeadVotes {
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	if specs == nil {
+		return nil
+	}
+
+	epochStats := indexer.GetEpochStats(epoch, nil)
+	if epochStats == nil {
+		return nil
+	}
+	epochStatsValues := epochStats.GetOrLoadValues(indexer, true, false)
+	if epochStatsValues == nil || epochStatsValues.AttesterDuties == nil {
+		return nil
+	}
+
+	// collect the canonical blocks of the epoch & inclusion window (ascending)
+	firstSlot := chainState.EpochToSlot(epoch)
+	lastSlot := chainState.EpochToSlot(epoch+2) - 1
+	canonicalBlocks := []*Block{}
+	var preEpochRoot *phase0.Root
+
+	block := indexer.GetCanonicalHead(nil)
+	for block != nil {
+		if block.Slot < firstSlot {
+			preEpochRoot = &block.Root
+			break
+		}
+		if block.Slot <= lastSlot {
+			canonicalBlocks = append([]*Block{block}, canonicalBlocks...)
+		}
+
+		parentRoot := block.GetParentRoot()
+		if parentRoot == nil {
+			return nil
+		}
+		block = indexer.blockCache.getBlockByRoot(*parentRoot)
+		if block == nil {
+			// the last block before the epoch might already be finalized & pruned, its root is all we need
+			blockHead := db.GetBlockHeadByRoot(parentRoot[:])
+			if blockHead == nil || phase0.Slot(blockHead.Slot) >= firstSlot {
+				// incomplete chain
+				return nil
+			}
+			preEpochRoot = parentRoot
+		}
+	}
+	if preEpochRoot == nil || len(canonicalBlocks) == 0 {
+		return nil
+	}
+
+	// canonical head root at the given slot (latest canonical block with slot <= the attested slot)
+	getCanonicalRoot := func(slot phase0.Slot) phase0.Root {
+		headRoot := *preEpochRoot
+		for _, canonicalBlock := range canonicalBlocks {
+			if canonicalBlock.Slot > slot {
+				break
+			}
+			headRoot = canonicalBlock.Root
+		}
+		return headRoot
+	}
+
+	headVotes := &EpochHeadVotes{
+		Epoch: epoch,
+		Votes: make(map[phase0.ValidatorIndex]HeadVoteStatus, epochStatsValues.ActiveValidators),
+	}
+	for _, slotDuties := range epochStatsValues.AttesterDuties {
+		for _, committeeDuties := range slotDuties {
+			for _, validatorIndice := range committeeDuties {
+				headVotes.Votes[epochStatsValues.ActiveIndices[validatorIndice]] = HeadVoteMissing
+			}
+		}
+	}
+
+	classifyVotes := func(slotIndex phase0.Slot, committee uint64, aggregationBits bitfield.Bitfield, aggregationBitsOffset uint64, status HeadVoteStatus) uint64 {
+		if int(slotIndex) >= len(epochStatsValues.AttesterDuties) || committee >= uint64(len(epochStatsValues.AttesterDuties[slotIndex])) {
+			return 0
+		}
+
+		committeeDuties := epochStatsValues.AttesterDuties[slotIndex][committee]
+		for bitIdx, validatorIndice := range committeeDuties {
+			if !aggregationBits.BitAt(uint64(bitIdx) + aggregationBitsOffset) {
+				continue
+			}
+
+			validatorIndex := epochStatsValues.ActiveIndices[validatorIndice]
+			if headVotes.Votes[validatorIndex] < status {
+				headVotes.Votes[validatorIndex] = status
+			}
+		}
+		return uint64(len(committeeDuties))
+	}
+
+	for _, canonicalBlock := range canonicalBlocks {
+		blockBody := canonicalBlock.GetBlock()
+		if blockBody == nil {
+			return nil
+		}
+		attestations, err := blockBody.Attestations()
+		if err != nil {
+			continue
+		}
+
+		for _, attVersioned := range attestations {
+			attData, err := attVersioned.Data()
+			if err != nil || chainState.EpochOfSlot(attData.Slot) != epoch {
+				continue
+			}
+			aggregationBits, err := attVersioned.AggregationBits()
+			if err != nil {
+				continue
+			}
+
+			status := HeadVoteWrong
+			if attData.BeaconBlockRoot == getCanonicalRoot(attData.Slot) {
+				status = HeadVoteCorrect
+			}
+			slotIndex := chainState.SlotToSlotIndex(attData.Slot)
+
+			if attVersioned.Version >= spec.DataVersionElectra {
+				// EIP-7549: aggregation bits of all committees in committee bits order
+				committeeBits, err := attVersioned.CommitteeBits()
+				if err != nil {
+					continue
+				}
+
+				aggregationBitsOffset := uint64(0)
+				for _, committee := range committeeBits.BitIndices() {
+					if uint64(committee) >= specs.MaxCommitteesPerSlot {
+						continue
+					}
+					aggregationBitsOffset += classifyVotes(slotIndex, uint64(committee), aggregationBits, aggregationBitsOffset, status)
+				}
+			} else {
+				classifyVotes(slotIndex, uint64(attData.Index), aggregationBits, 0, status)
+			}
+		}
+	}
+
+	return headVotes
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -29,6 +30,7 @@ type Indexer struct {
 	consensusPool *consensus.Pool
 	dynSsz        *dynssz.DynSsz
 	synchronizer  *synchronizer
+	writeQueue    *writeQueue
 
 	// configuration
 	disableSync           bool
@@ -36,15 +38,20 @@ type Indexer struct {
 	inMemoryEpochs        uint16
 	activityHistoryLength uint16
 	maxParallelStateCalls uint16
+	survivalModeEpochs    uint16
+	epochStatsMemoryLimit uint64
+	headSelection         HeadSelectionStrategy
 
 	// caches
-	blockCache     *blockCache
-	epochCache     *epochCache
-	forkCache      *forkCache
-	validatorCache *validatorCache
+	blockCache       *blockCache
+	epochCache       *epochCache
+	forkCache        *forkCache
+	validatorCache   *validatorCache
+	proposerPreviews *proposerPreviewCache
 
 	// indexer state
 	clients               []*Client
+	historyClients        []*Client
 	dbWriter              *dbWriter
 	running               bool
 	backfillCompleteMutex sync.Mutex
@@ -52,6 +59,7 @@ type Indexer struct {
 	backfillComplete      bool
 	backfillCompleteChan  chan bool
 	lastFinalizedEpoch    phase0.Epoch
+	survivalMode          atomic.Bool // read by the frontend handlers & the duty prewarmer
 	lastPrunedEpoch       phase0.Epoch
 	lastPruneRunEpoch     phase0.Epoch
 	lastPrecalcRunEpoch   phase0.Epoch
@@ -59,10 +67,13 @@ type Indexer struct {
 	wallclockSubscription *consensus.Subscription[*ethwallclock.Slot]
 
 	// canonical head state
-	canonicalHeadMutex   sync.Mutex
-	canonicalHead        *Block
-	canonicalComputation phase0.Root
-	cachedChainHeads     []*ChainHead
+	canonicalHeadMutex      sync.Mutex
+	canonicalHead           *Block
+	canonicalComputation    phase0.Root
+	cachedChainHeads        []*ChainHead
+	canonicalHeadDispatcher consensus.Dispatcher[*Block]
+
+	orphanResolutionDispatcher consensus.Dispatcher[*OrphanResolution]
 }
 
 // NewIndexer creates a new instance of the Indexer.
@@ -80,10 +91,21 @@ func NewIndexer(logger logrus.FieldLogger, consensusPool *consensus.Pool) *Index
 	if maxParallelStateCalls < 2 {
 		maxParallelStateCalls = 2
 	}
+	survivalModeEpochs := utils.Config.Indexer.SurvivalModeEpochs
+	if survivalModeEpochs == 0 {
+		survivalModeEpochs = 64
+	}
+	if survivalModeEpochs <= inMemoryEpochs {
+		survivalModeEpochs = inMemoryEpochs + 1
+	}
 	blockCompression := true
 	if utils.Config.KillSwitch.DisableBlockCompression {
 		blockCompression = false
 	}
+	headSelection, validHeadSelection := parseHeadSelectionStrategy(utils.Config.Indexer.HeadSelection)
+	if !validHeadSelection {
+		logger.Warnf("invalid head selection strategy '%v', using '%v'", utils.Config.Indexer.HeadSelection, headSelection)
+	}
 
 	// Create the indexer instance.
 	indexer := &Indexer{
@@ -95,6 +117,9 @@ func NewIndexer(logger logrus.FieldLogger, consensusPool *consensus.Pool) *Index
 		inMemoryEpochs:        inMemoryEpochs,
 		activityHistoryLength: activityHistoryLength,
 		maxParallelStateCalls: maxParallelStateCalls,
+		survivalModeEpochs:    survivalModeEpochs,
+		epochStatsMemoryLimit: uint64(utils.Config.Indexer.EpochStatsMemoryLimit) * 1024 * 1024,
+		headSelection:         headSelection,
 
 		clients:              make([]*Client, 0),
 		backfillCompleteChan: make(chan bool),
@@ -105,6 +130,9 @@ func NewIndexer(logger logrus.FieldLogger, consensusPool *consensus.Pool) *Index
 	indexer.forkCache = newForkCache(indexer)
 	indexer.validatorCache = newValidatorCache(indexer)
 	indexer.dbWriter = newDbWriter(indexer)
+	indexer.proposerPreviews = &proposerPreviewCache{
+		previews: map[phase0.Epoch]*ProposerPreview{},
+	}
 
 	return indexer
 }
@@ -113,6 +141,37 @@ func (indexer *Indexer) GetActivityHistoryLength() uint16 {
 	return indexer.activityHistoryLength
 }
 
+// IsSurvivalMode returns true if the indexer runs in non-finality survival mode.
+// In survival mode only the minimum number of epochs is kept in memory to keep the explorer responsive during long non-finality.
+func (indexer *Indexer) IsSurvivalMode() bool {
+	return indexer.survivalMode.Load()
+}
+
+// getInMemoryEpochs returns the number of unfinalized epochs to keep in memory.
+func (indexer *Indexer) getInMemoryEpochs() uint16 {
+	if indexer.survivalMode.Load() {
+		return 2
+	}
+
+	return indexer.inMemoryEpochs
+}
+
+// updateSurvivalMode enters or leaves the non-finality survival mode based on the distance to the last finalized epoch.
+func (indexer *Indexer) updateSurvivalMode(currentEpoch phase0.Epoch) bool {
+	survivalMode := currentEpoch > indexer.lastFinalizedEpoch+phase0.Epoch(indexer.survivalModeEpochs)
+	if !indexer.survivalMode.CompareAndSwap(!survivalMode, survivalMode) {
+		return false
+	}
+
+	if survivalMode {
+		indexer.logger.Warnf("no finality for %v epochs, entering non-finality survival mode (in-memory epochs: %v)", currentEpoch-indexer.lastFinalizedEpoch, indexer.getInMemoryEpochs())
+	} else {
+		indexer.logger.Infof("finality recovered, leaving non-finality survival mode (in-memory epochs: %v)", indexer.getInMemoryEpochs())
+	}
+
+	return true
+}
+
 func (indexer *Indexer) getMinInMemoryEpoch() phase0.Epoch {
 	minInMemoryEpoch := phase0.Epoch(0)
 	if indexer.lastFinalizedEpoch > 0 {
@@ -128,8 +187,8 @@ func (indexer *Indexer) getMinInMemoryEpoch() phase0.Epoch {
 func (indexer *Indexer) getAbsoluteMinInMemoryEpoch() phase0.Epoch {
 	minInMemoryEpoch := phase0.Epoch(0)
 	currentEpoch := indexer.consensusPool.GetChainState().CurrentEpoch()
-	if currentEpoch > phase0.Epoch(indexer.inMemoryEpochs) {
-		minInMemoryEpoch = currentEpoch - phase0.Epoch(indexer.inMemoryEpochs)
+	if inMemoryEpochs := phase0.Epoch(indexer.getInMemoryEpochs()); currentEpoch > inMemoryEpochs {
+		minInMemoryEpoch = currentEpoch - inMemoryEpochs
 	} else {
 		minInMemoryEpoch = 0
 	}
@@ -188,6 +247,17 @@ func (indexer *Indexer) AddClient(index uint16, client *consensus.Client, priori
 	return indexerClient
 }
 
+// AddHistoryClient adds a history only client, which is used to synchronize historic epochs but not for live indexing.
+// history clients are treated as archive clients by the synchronizer.
+func (indexer *Indexer) AddHistoryClient(index uint16, client *consensus.Client, priority int) *Client {
+	logger := indexer.logger.WithField("client", client.GetName())
+	indexerClient := newClient(index, client, priority, true, false, indexer, logger)
+	indexerClient.historyOnly = true
+	indexer.historyClients = append(indexer.historyClients, indexerClient)
+
+	return indexerClient
+}
+
 // StartIndexer starts the indexing process.
 func (indexer *Indexer) StartIndexer() {
 	if indexer.running {
@@ -389,8 +459,32 @@ func (indexer *Indexer) StartIndexer() {
 
 		go indexer.runIndexerLoop()
 
+		// restore & replay queued epochs from previous database outages
+		indexer.writeQueue = newWriteQueue(indexer)
+
 		// start synchronizer
 		indexer.startSynchronizer(indexer.lastFinalizedEpoch)
+
+		// start column backfill for historic blocks
+		indexer.startColumnBackfill()
+
+		// verify the persisted finalized chain & schedule resyncs for inconsistent epochs
+		go indexer.runConsistencyCheck()
+
+		// periodically re-verify recently orphaned blocks against the canonical chain
+		go indexer.runOrphanRecheckLoop()
+
+		// re-derive the stats of epochs persisted without validator stats from archive states
+		go indexer.runEpochRepairLoop()
+
+		// aggregate epoch reward summaries for the issuance charts
+		go indexer.runEpochRewardsLoop()
+
+		// watch the indexer head & restart the client indexing loops on stalls
+		go indexer.runWatchdogLoop()
+
+		// prewarm the next epoch duties at the epoch boundary
+		go indexer.runDutyPrewarmLoop()
 	}()
 }
 
@@ -446,8 +540,14 @@ func (indexer *Indexer) runIndexerLoop() {
 				indexer.lastPrecalcRunEpoch = epoch + 1
 			}
 
+			// prefetch next epoch proposer preview (no-op if the preview is up to date)
+			indexer.GetProposerPreview(epoch + 1)
+
+			// switch to survival mode on long non-finality, prune immediately to reduce memory usage
+			forcePruning := indexer.updateSurvivalMode(epoch) && indexer.survivalMode.Load()
+
 			// prune cache if last pruning epoch is outdated and we are at least 50% into the current
-			if epoch > indexer.lastPruneRunEpoch && slotProgress >= 50 {
+			if forcePruning || (epoch > indexer.lastPruneRunEpoch && slotProgress >= 50) {
 				err := indexer.runCachePruning()
 				if err != nil {
 					indexer.logger.WithError(err).Errorf("failed pruning cache")
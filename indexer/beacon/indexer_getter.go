@@ -7,9 +7,11 @@ import (
 	"slices"
 	"sort"
 
+	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/clients/consensus"
 	"github.com/ethpandaops/dora/db"
+	dynssz "github.com/pk910/dynamic-ssz"
 )
 
 // GetAllClients returns a slice of all clients in the indexer.
@@ -136,6 +138,11 @@ func (indexer *Indexer) GetBlockByRoot(blockRoot phase0.Root) *Block {
 	return indexer.blockCache.getBlockByRoot(blockRoot)
 }
 
+// GetBlocksByRootPrefix returns a slice of cached blocks with a block root starting with the given hex prefix.
+func (indexer *Indexer) GetBlocksByRootPrefix(hexPrefix string) []*Block {
+	return indexer.blockCache.getBlocksByRootPrefix(hexPrefix)
+}
+
 // GetBlocksBySlot returns a slice of blocks with the given slot.
 func (indexer *Indexer) GetBlocksBySlot(slot phase0.Slot) []*Block {
 	return indexer.blockCache.getBlocksBySlot(slot)
@@ -195,6 +202,12 @@ func (indexer *Indexer) GetOrphanedBlockByRoot(blockRoot phase0.Root) (*Block, e
 	return block, nil
 }
 
+// GetEpochStatsValues returns the values of the given epoch stats, loading them from the database if necessary.
+// values loaded from the database are not kept in the cache, precalculated values are returned if the final values are not available yet.
+func (indexer *Indexer) GetEpochStatsValues(epochStats *EpochStats) *EpochStatsValues {
+	return epochStats.GetOrLoadValues(indexer, true, false)
+}
+
 // GetEpochStats returns the epoch stats for the given epoch and optional fork ID override.
 func (indexer *Indexer) GetEpochStats(epoch phase0.Epoch, overrideForkId *ForkKey) *EpochStats {
 	epochStats := indexer.epochCache.getEpochStatsByEpoch(epoch)
@@ -280,6 +293,11 @@ func (indexer *Indexer) GetValidatorIndexByPubkey(pubkey phase0.BLSPubKey) (phas
 	return indexer.validatorCache.getValidatorIndexByPubkey(pubkey)
 }
 
+// GetValidatorIndicesByPubkeyPrefix returns up to limit validator indices with a pubkey starting with the given hex prefix.
+func (indexer *Indexer) GetValidatorIndicesByPubkeyPrefix(hexPrefix string, limit int) []phase0.ValidatorIndex {
+	return indexer.validatorCache.getValidatorIndicesByPubkeyPrefix(hexPrefix, limit)
+}
+
 // GetValidatorByIndex returns the validator by index for a given forkId.
 func (indexer *Indexer) GetValidatorByIndex(index phase0.ValidatorIndex, overrideForkId *ForkKey) *phase0.Validator {
 	return indexer.validatorCache.getValidatorByIndex(index, overrideForkId)
@@ -290,3 +308,60 @@ func (indexer *Indexer) GetValidatorActivity(validatorIndex phase0.ValidatorInde
 	activity := indexer.validatorCache.getValidatorActivity(validatorIndex)
 	return activity, indexer.validatorCache.oldestActivityEpoch
 }
+
+// GetWithdrawalSweepPosition returns the next validator index of the withdrawal sweep from the most recent loaded
+// epoch state of the canonical chain and the epoch of that state. The bool is false if no capella+ state is loaded.
+func (indexer *Indexer) GetWithdrawalSweepPosition(overrideForkId *ForkKey) (phase0.ValidatorIndex, phase0.Epoch, bool) {
+	chainState := indexer.consensusPool.GetChainState()
+	canonicalHead := indexer.GetCanonicalHead(overrideForkId)
+	if canonicalHead == nil {
+		return 0, 0, false
+	}
+
+	headEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
+	for {
+		epoch := chainState.EpochOfSlot(canonicalHead.Slot)
+		if headEpoch-epoch > 2 {
+			return 0, 0, false
+		}
+
+		dependentBlock := indexer.blockCache.getDependentBlock(chainState, canonicalHead, nil)
+		if dependentBlock == nil {
+			return 0, 0, false
+		}
+		canonicalHead = dependentBlock
+
+		stats := indexer.epochCache.getEpochStats(epoch, dependentBlock.Root)
+		if stats == nil || stats.dependentState == nil || stats.dependentState.loadingStatus != 2 {
+			continue // try previous epoch state
+		}
+
+		if !stats.dependentState.hasWithdrawalSweep {
+			return 0, 0, false
+		}
+
+		return stats.dependentState.nextWithdrawalValidatorIndex, epoch, true
+	}
+}
+
+// GetBlockSszSizes returns the ssz encoded size of a block and its major components.
+func (indexer *Indexer) GetBlockSszSizes(block *spec.VersionedSignedBeaconBlock) (*BlockSizes, error) {
+	return BlockSszSizes(indexer.dynSsz, block)
+}
+
+// GetBlockJson returns the beacon api json representation of a block.
+func (indexer *Indexer) GetBlockJson(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	return BlockJson(block)
+}
+
+// BlockSszSizes returns the ssz encoded size of a block and its major components with the given ssz encoder.
+// the block encoding helpers do not depend on the indexer state, so they can be shared with other data backends.
+func BlockSszSizes(dynSsz *dynssz.DynSsz, block *spec.VersionedSignedBeaconBlock) (*BlockSizes, error) {
+	return getBlockSszSizes(dynSsz, block)
+}
+
+// BlockJson returns the beacon api json representation of a block.
+func BlockJson(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	_, jsonRes, err := marshalVersionedSignedBeaconBlockJson(block)
+	return jsonRes, err
+}
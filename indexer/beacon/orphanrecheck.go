@@ -0,0 +1,144 @@
+package beacon
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// OrphanResolution is a block that has been flagged as orphaned in the db, but turned out to be canonical on a later re-check.
+type OrphanResolution struct {
+	Slot      phase0.Slot
+	Root      phase0.Root
+	Proposer  phase0.ValidatorIndex
+	Finalized bool
+}
+
+// SubscribeOrphanResolution subscribes to orphan resolutions of the orphan re-check routine.
+func (indexer *Indexer) SubscribeOrphanResolution(capacity int) *consensus.Subscription[*OrphanResolution] {
+	return indexer.orphanResolutionDispatcher.Subscribe(capacity, false)
+}
+
+// runOrphanRecheckLoop periodically re-verifies recently orphaned blocks against the current canonical chain.
+func (indexer *Indexer) runOrphanRecheckLoop() {
+	defer utils.HandleSubroutinePanic("runOrphanRecheckLoop", indexer.runOrphanRecheckLoop)
+
+	if utils.Config.Indexer.DisableOrphanRecheck {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	for {
+		specs := chainState.GetSpecs()
+		if specs == nil {
+			time.Sleep(10 * time.Second)
+			continue
+		}
+
+		time.Sleep(time.Duration(specs.SlotsPerEpoch) * specs.SecondsPerSlot)
+
+		if err := indexer.recheckOrphanedBlocks(); err != nil {
+			indexer.logger.Warnf("orphan re-check failed: %v", err)
+		}
+	}
+}
+
+// recheckOrphanedBlocks loads the orphaned blocks of the last `orphanRecheckEpochs` epochs from the db and flips them to canonical
+// if they are part of the current canonical chain (e.g. restored by a deeper reorg).
+// blocks in the unfinalized range are checked against the block cache, finalized blocks against a ready node.
+func (indexer *Indexer) recheckOrphanedBlocks() error {
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+
+	recheckEpochs := utils.Config.Indexer.OrphanRecheckEpochs
+	if recheckEpochs == 0 {
+		recheckEpochs = 8
+	}
+
+	currentSlot := chainState.CurrentSlot()
+	firstSlot := phase0.Slot(0)
+	if recheckRange := phase0.Slot(recheckEpochs * specs.SlotsPerEpoch); currentSlot > recheckRange {
+		firstSlot = currentSlot - recheckRange
+	}
+
+	orphanedSlots, err := db.GetOrphanedSlotsInRange(uint64(firstSlot), uint64(currentSlot))
+	if err != nil {
+		return err
+	}
+	if len(orphanedSlots) == 0 {
+		return nil
+	}
+
+	finalizedSlot := chainState.EpochToSlot(indexer.lastFinalizedEpoch)
+	var client *Client
+
+	resolutions := []*OrphanResolution{}
+	for _, orphanedSlot := range orphanedSlots {
+		slot := phase0.Slot(orphanedSlot.Slot)
+		root := phase0.Root(orphanedSlot.Root)
+
+		if slot >= finalizedSlot {
+			block := indexer.blockCache.getBlockByRoot(root)
+			if block == nil || !indexer.IsCanonicalBlock(block, nil) {
+				continue
+			}
+		} else {
+			if client == nil {
+				client = indexer.GetReadyClient(true)
+				if client == nil {
+					indexer.logger.Debugf("no ready client for orphan re-check of finalized blocks")
+					continue
+				}
+			}
+
+			_, canonicalRoot, orphaned, err := LoadBeaconHeaderBySlot(client.getContext(), client, slot)
+			if err != nil {
+				indexer.logger.Debugf("failed loading canonical block header for slot %v: %v", slot, err)
+				continue
+			}
+			if orphaned || canonicalRoot != root {
+				continue
+			}
+		}
+
+		resolutions = append(resolutions, &OrphanResolution{
+			Slot:      slot,
+			Root:      root,
+			Proposer:  phase0.ValidatorIndex(orphanedSlot.Proposer),
+			Finalized: slot < finalizedSlot,
+		})
+	}
+
+	if len(resolutions) == 0 {
+		return nil
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		for _, resolution := range resolutions {
+			// keep the single canonical block per slot invariant
+			if err := db.UpdateOrphanedSlotsInRange(uint64(resolution.Slot), uint64(resolution.Slot), [][]byte{resolution.Root[:]}, tx); err != nil {
+				return err
+			}
+			if err := db.UpdateSlotStatus(resolution.Root[:], dbtypes.Canonical, tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, resolution := range resolutions {
+		indexer.logger.Infof("orphan re-check: block %v (%v) is canonical, restored block status", resolution.Slot, resolution.Root.String())
+		indexer.orphanResolutionDispatcher.Fire(resolution)
+	}
+
+	return nil
+}
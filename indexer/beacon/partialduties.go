@@ -0,0 +1,100 @@
+package beacon
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// partialDutiesTracker keeps track of the epochs that have been persisted with partial duties, either because the duty
+// types are not supported for the fork (see dutycapabilities.go), because they could not be computed from the state or
+// because the epoch stats have been loaded from the beacon committees endpoint (see epochcommittees.go).
+// the tracked epochs are backfilled by the epoch repair routine once the missing duty types are supported.
+type partialDutiesTracker struct {
+	mutex  sync.Mutex
+	loaded bool
+	epochs map[uint64]uint8
+}
+
+// updateEpoch tracks the missing duty types of the persisted epoch, or removes the epoch if all applicable duties have been computed.
+// epoch stats that have not been processed from the dependent state or the beacon committees (restored from db) leave the tracking untouched.
+func (tracker *partialDutiesTracker) updateEpoch(tx *sqlx.Tx, specs *consensus.ChainSpec, epoch phase0.Epoch, epochStats *EpochStats) error {
+	if epochStats == nil || (!epochStats.hasFinalityStats && !epochStats.partialDuties) {
+		return nil
+	}
+
+	applicable, _ := getDutyCapabilities(specs, epoch)
+	missing := applicable &^ epochStats.computedDuties
+
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	tracker.load()
+
+	if missing == 0 {
+		if _, found := tracker.epochs[uint64(epoch)]; !found {
+			return nil
+		}
+		delete(tracker.epochs, uint64(epoch))
+	} else {
+		if tracker.epochs[uint64(epoch)] == uint8(missing) {
+			return nil
+		}
+		tracker.epochs[uint64(epoch)] = uint8(missing)
+	}
+
+	return db.SetExplorerState("indexer.partialduties", &dbtypes.IndexerPartialDutiesState{
+		Epochs: tracker.epochs,
+	}, tx)
+}
+
+// getBackfillEpochs returns up to `limit` tracked epochs before `beforeEpoch` with missing duty types that are supported by now.
+func (tracker *partialDutiesTracker) getBackfillEpochs(specs *consensus.ChainSpec, beforeEpoch phase0.Epoch, limit int) []uint64 {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	tracker.load()
+
+	epochs := []uint64{}
+	for epoch, missing := range tracker.epochs {
+		if epoch >= uint64(beforeEpoch) {
+			continue
+		}
+
+		_, supported := getDutyCapabilities(specs, phase0.Epoch(epoch))
+		if supported&DutyType(missing) == 0 {
+			continue
+		}
+
+		epochs = append(epochs, epoch)
+	}
+
+	sort.Slice(epochs, func(a, b int) bool {
+		return epochs[a] < epochs[b]
+	})
+	if len(epochs) > limit {
+		epochs = epochs[:limit]
+	}
+
+	return epochs
+}
+
+func (tracker *partialDutiesTracker) load() {
+	if tracker.loaded {
+		return
+	}
+
+	partialDutiesState := &dbtypes.IndexerPartialDutiesState{}
+	db.GetExplorerState("indexer.partialduties", partialDutiesState)
+	tracker.epochs = partialDutiesState.Epochs
+	if tracker.epochs == nil {
+		tracker.epochs = map[uint64]uint8{}
+	}
+	tracker.loaded = true
+}
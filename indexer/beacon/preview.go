@@ -0,0 +1,86 @@
+package beacon
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/indexer/beacon/duties"
+)
+
+// ProposerPreview holds the predicted proposer duties for an upcoming epoch.
+// The prediction is based on the parent epoch stats and may change until the epoch boundary,
+// as effective balance updates, activations and exits at the boundary affect the proposer selection.
+type ProposerPreview struct {
+	Epoch          phase0.Epoch
+	BaseRoot       phase0.Root // dependent root of the parent epoch stats the preview is based on
+	RandaoMix      phase0.Hash32
+	ProposerDuties []phase0.ValidatorIndex
+}
+
+// proposerPreviewCache holds the latest proposer previews by epoch.
+type proposerPreviewCache struct {
+	mutex    sync.Mutex
+	previews map[phase0.Epoch]*ProposerPreview
+}
+
+// GetProposerPreview returns the predicted proposer duties for the given epoch.
+// Previews are only available for the epoch following the latest epoch with loaded epoch stats and for forks with computable proposers.
+// The preview is cached by the dependent root of the parent epoch stats, so it's recomputed after reorgs.
+func (indexer *Indexer) GetProposerPreview(epoch phase0.Epoch) *ProposerPreview {
+	if epoch == 0 {
+		return nil
+	}
+
+	parentStats := indexer.GetEpochStats(epoch-1, nil)
+	if parentStats == nil || !parentStats.ready {
+		return nil
+	}
+
+	indexer.proposerPreviews.mutex.Lock()
+	defer indexer.proposerPreviews.mutex.Unlock()
+
+	if preview := indexer.proposerPreviews.previews[epoch]; preview != nil && preview.BaseRoot == parentStats.dependentRoot {
+		return preview
+	}
+
+	parentValues := parentStats.GetValues(false)
+	if parentValues == nil || len(parentValues.ActiveIndices) == 0 {
+		return nil
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	randaoMix := parentValues.NextRandaoMix
+	beaconState := &duties.BeaconState{
+		RandaoMix: &randaoMix,
+		GetActiveCount: func() uint64 {
+			return uint64(len(parentValues.ActiveIndices))
+		},
+		GetEffectiveBalance: func(index duties.ActiveIndiceIndex) phase0.Gwei {
+			return phase0.Gwei(parentValues.EffectiveBalances[index]) * EtherGweiFactor
+		},
+	}
+
+	proposerDuties, err := getProposerDuties(chainState.GetSpecs(), epoch, beaconState, parentValues.ActiveIndices)
+	if errors.Is(err, errProposerDutiesUnsupported) {
+		return nil
+	}
+
+	preview := &ProposerPreview{
+		Epoch:          epoch,
+		BaseRoot:       parentStats.dependentRoot,
+		RandaoMix:      randaoMix,
+		ProposerDuties: proposerDuties,
+	}
+
+	// drop outdated previews
+	for previewEpoch := range indexer.proposerPreviews.previews {
+		if previewEpoch < epoch {
+			delete(indexer.proposerPreviews.previews, previewEpoch)
+		}
+	}
+	indexer.proposerPreviews.previews[epoch] = preview
+
+	return preview
+}
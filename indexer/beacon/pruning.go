@@ -19,8 +19,8 @@ func (indexer *Indexer) runCachePruning() error {
 	chainState := indexer.consensusPool.GetChainState()
 
 	pruneToEpoch := chainState.CurrentEpoch()
-	if pruneToEpoch >= phase0.Epoch(indexer.inMemoryEpochs) {
-		pruneToEpoch -= phase0.Epoch(indexer.inMemoryEpochs)
+	if inMemoryEpochs := phase0.Epoch(indexer.getInMemoryEpochs()); pruneToEpoch >= inMemoryEpochs {
+		pruneToEpoch -= inMemoryEpochs
 	} else {
 		pruneToEpoch = 0
 	}
@@ -190,52 +190,55 @@ func (indexer *Indexer) processEpochPruning(pruneEpoch phase0.Epoch) (uint64, ui
 	t1 = time.Now()
 
 	// persist data in db
-	db.RunDBTransaction(func(tx *sqlx.Tx) error {
+	// all pruned blocks, epoch aggregations & the block status flips are written in a single transaction.
+	// any failure rolls back the whole epoch, so the blocks stay in cache and the epoch is pruned again in the next run.
+	prunedEpochAggregations := make([]*dbtypes.UnfinalizedEpoch, len(epochData))
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
 		persistedBlocks := map[phase0.Root]bool{}
 
-		for _, epochData := range epochData {
+		for idx, epochData := range epochData {
+			var persistErr error
 			dbEpoch := indexer.dbWriter.buildDbEpoch(pruneEpoch, epochData.chain, epochData.epochStats, epochData.epochVotes, func(block *Block, depositIndex *uint64) {
-				if persistedBlocks[block.Root] {
+				if persistedBlocks[block.Root] || persistErr != nil {
 					return
 				}
 
 				// persist pruned block data as orphaned here, the canonical blocks will be updated by the finalization or synchronization process later
 				_, err := indexer.dbWriter.persistBlockData(tx, block, epochData.epochStats, depositIndex, true, nil)
 				if err != nil {
-					indexer.logger.Errorf("error persisting pruned slot %v: %v", block.Root.String(), err)
+					persistErr = fmt.Errorf("error persisting pruned slot %v: %v", block.Root.String(), err)
+					return
 				}
+
+				persistedBlocks[block.Root] = true
 			})
+			if persistErr != nil {
+				return persistErr
+			}
 
 			mapped := smapping.MapTags(dbEpoch, "db")
 
 			dbUnfinalizedEpoch := dbtypes.UnfinalizedEpoch{}
 			err := smapping.FillStructByTags(&dbUnfinalizedEpoch, mapped, "db")
 			if err != nil {
-				indexer.logger.Errorf("mapper failed copying epoch to unfinalized epoch: %v", err)
-				continue
+				return fmt.Errorf("mapper failed copying epoch to unfinalized epoch: %v", err)
 			}
 
 			dbUnfinalizedEpoch.DependentRoot = epochData.dependentRoot[:]
 			dbUnfinalizedEpoch.EpochHeadRoot = epochData.chainHead.Root[:]
 			dbUnfinalizedEpoch.EpochHeadForkId = uint64(epochData.chainHead.forkId)
 
-			if epochData.epochStats != nil {
-				if epochData.epochStats.prunedEpochAggregations == nil {
-					epochData.epochStats.prunedEpochAggregations = []*dbtypes.UnfinalizedEpoch{}
-				}
-
-				epochData.epochStats.prunedEpochAggregations = append(epochData.epochStats.prunedEpochAggregations, &dbUnfinalizedEpoch)
-			}
-
 			err = db.InsertUnfinalizedEpoch(&dbUnfinalizedEpoch, tx)
 			if err != nil {
-				indexer.logger.Errorf("error persisting unfinalized epoch %v: %v", dbUnfinalizedEpoch.Epoch, err)
+				return fmt.Errorf("error persisting unfinalized epoch %v: %v", dbUnfinalizedEpoch.Epoch, err)
 			}
+
+			prunedEpochAggregations[idx] = &dbUnfinalizedEpoch
 		}
 
 		err := db.UpdateUnfinalizedBlockStatus(pruningBlockRoots, dbtypes.UnfinalizedBlockStatusPruned, tx)
 		if err != nil {
-			indexer.logger.Errorf("error updating block status to pruned: %v", err)
+			return fmt.Errorf("error updating block status to pruned: %v", err)
 		}
 
 		err = indexer.updatePruningState(tx, pruneEpoch+1)
@@ -245,6 +248,15 @@ func (indexer *Indexer) processEpochPruning(pruneEpoch phase0.Epoch) (uint64, ui
 
 		return nil
 	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed persisting pruned epoch %v: %v", pruneEpoch, err)
+	}
+
+	for idx, epochData := range epochData {
+		if epochData.epochStats != nil {
+			epochData.epochStats.prunedEpochAggregations = append(epochData.epochStats.prunedEpochAggregations, prunedEpochAggregations[idx])
+		}
+	}
 
 	indexer.lastPrunedEpoch = pruneEpoch + 1
 	t2dur := time.Since(t1)
@@ -344,19 +356,19 @@ func (indexer *Indexer) processCachePruning(prunedEpochStats, prunedEpochStates
 			for _, pruneBlock := range pruningData {
 				_, err := indexer.dbWriter.persistBlockData(tx, pruneBlock.block, pruneBlock.epochStats, nil, true, nil)
 				if err != nil {
-					indexer.logger.Errorf("error persisting old pruned slot %v: %v", pruneBlock.block.Root.String(), err)
+					return fmt.Errorf("error persisting old pruned slot %v: %v", pruneBlock.block.Root.String(), err)
 				}
 			}
 
 			err := db.UpdateUnfinalizedBlockStatus(pruningBlockRoots, dbtypes.UnfinalizedBlockStatusPruned, tx)
 			if err != nil {
-				indexer.logger.Errorf("error updating block status to pruned: %v", err)
+				return fmt.Errorf("error updating block status to pruned: %v", err)
 			}
 
 			return nil
 		})
 		if err != nil {
-			indexer.logger.Errorf("error persisting old pruned blocks: %v", err)
+			return fmt.Errorf("error persisting old pruned blocks: %v", err)
 		}
 
 		// sleep 500 ms to give running UI threads time to fetch data from cache
@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+var (
+	// ErrReindexBlockNotFound is returned if the block to re-index is not stored in the database.
+	ErrReindexBlockNotFound = errors.New("block not found")
+
+	// ErrReindexNotFinalized is returned if the block to re-index is not finalized yet.
+	ErrReindexNotFinalized = errors.New("block is not finalized yet")
+
+	// ErrReindexNoClient is returned if no (or not the requested) client is available to refetch the epoch of the block.
+	ErrReindexNoClient = errors.New("no client available")
+)
+
+// only one block is re-indexed at a time
+var reindexMutex sync.Mutex
+
+// BlockReindexResult is the result of a block re-index.
+type BlockReindexResult struct {
+	Slot   phase0.Slot
+	Epoch  phase0.Epoch
+	Client string
+	Status dbtypes.SlotStatus // status of the block after the re-index (canonical if the block was refetched with the same root)
+}
+
+// ReindexBlock invalidates a stored block of a finalized epoch and re-synchronizes the epoch from a healthy client.
+// the objects derived from the stored block body are dropped and the epoch (blocks, missed slots, epoch aggregations,
+// sync assignments & mev block states) is persisted again from the refetched data. if the client serves a block with
+// the same root, it replaces the stored block, otherwise the stored block is kept as orphaned block.
+// if clientName is set, only the client with that name is used, otherwise all clients are tried in sync order.
+func (indexer *Indexer) ReindexBlock(ctx context.Context, root phase0.Root, clientName string) (*BlockReindexResult, error) {
+	dbSlot := db.GetSlotByRoot(root[:])
+	if dbSlot == nil {
+		return nil, ErrReindexBlockNotFound
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	epoch := chainState.EpochOfSlot(phase0.Slot(dbSlot.Slot))
+	if epoch >= indexer.lastFinalizedEpoch {
+		return nil, ErrReindexNotFinalized
+	}
+
+	reindexMutex.Lock()
+	defer reindexMutex.Unlock()
+
+	reindexSync := newSynchronizer(indexer, indexer.logger.WithField("service", "block-reindex"))
+	reindexSync.repairMode = true
+	reindexSync.syncCtx = ctx
+	reindexSync.blockCache = newSyncBlockCache()
+	reindexSync.invalidateRoots = [][]byte{root[:]}
+
+	syncClients := []*Client{}
+	for _, client := range reindexSync.getSyncClients(epoch) {
+		if clientName == "" || client.client.GetName() == clientName {
+			syncClients = append(syncClients, client)
+		}
+	}
+	if len(syncClients) == 0 {
+		return nil, ErrReindexNoClient
+	}
+
+	var lastErr error
+	for _, client := range syncClients {
+		done, err := reindexSync.syncEpoch(epoch, client, false)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !done || err != nil {
+			if err == nil {
+				err = fmt.Errorf("epoch data incomplete")
+			}
+			indexer.logger.Warnf("block re-index: failed re-synchronizing epoch %v from %v: %v", epoch, client.client.GetName(), err)
+			lastErr = err
+			continue
+		}
+
+		result := &BlockReindexResult{
+			Slot:   phase0.Slot(dbSlot.Slot),
+			Epoch:  epoch,
+			Client: client.client.GetName(),
+			Status: dbtypes.Missing,
+		}
+		if reindexedSlot := db.GetSlotByRoot(root[:]); reindexedSlot != nil {
+			result.Status = reindexedSlot.Status
+		}
+
+		indexer.logger.Infof("block re-index: re-indexed block 0x%x (slot %v) from %v, status: %v", root[:], dbSlot.Slot, result.Client, result.Status)
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("failed re-synchronizing epoch %v: %v", epoch, lastErr)
+}
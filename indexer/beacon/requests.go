@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -18,6 +19,9 @@ const beaconBodyRequestTimeout time.Duration = 30 * time.Second
 // BeaconStateRequestTimeout is the timeout duration for beacon state requests.
 const beaconStateRequestTimeout time.Duration = 600 * time.Second
 
+// BeaconCommitteesRequestTimeout is the timeout duration for beacon committee requests.
+const beaconCommitteesRequestTimeout time.Duration = 60 * time.Second
+
 const beaconStateRetryCount = 10
 
 // LoadBeaconHeader loads the block header from the client.
@@ -75,3 +79,16 @@ func LoadBeaconState(ctx context.Context, client *Client, root phase0.Root) (*sp
 
 	return resState, nil
 }
+
+// LoadBeaconCommittees loads the attester committees of the given epoch from the state with the given root.
+func LoadBeaconCommittees(ctx context.Context, client *Client, stateRoot phase0.Root, epoch phase0.Epoch) ([]*v1.BeaconCommittee, error) {
+	ctx, cancel := context.WithTimeout(ctx, beaconCommitteesRequestTimeout)
+	defer cancel()
+
+	committees, err := client.client.GetRPCClient().GetBeaconCommittees(ctx, fmt.Sprintf("0x%x", stateRoot[:]), &epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return committees, nil
+}
@@ -1,9 +1,11 @@
 package beacon
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/rand/v2"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -30,8 +32,19 @@ type synchronizer struct {
 	running      bool
 	currentEpoch phase0.Epoch
 
-	cachedSlot   phase0.Slot
-	cachedBlocks map[phase0.Slot]*Block
+	blockCache *syncBlockCache
+	pacer      *syncPacer
+
+	// dependent state & canonical blocks of the last synchronized epoch for the balance drain tracking
+	lastEpoch       phase0.Epoch
+	lastEpochState  *epochState
+	lastEpochBlocks []*Block
+
+	// repair mode re-synchronizes already persisted epochs without touching the synchronizer progress (see epochrepair.go)
+	repairMode bool
+
+	// stored blocks that are invalidated before the epoch is persisted again (see reindex.go)
+	invalidateRoots [][]byte
 }
 
 func (indexer *Indexer) startSynchronizer(startEpoch phase0.Epoch) {
@@ -47,6 +60,7 @@ func newSynchronizer(indexer *Indexer, logger logrus.FieldLogger) *synchronizer
 	sync := &synchronizer{
 		indexer: indexer,
 		logger:  logger,
+		pacer:   newSyncPacer(),
 	}
 
 	// restore sync state
@@ -124,11 +138,16 @@ func (sync *synchronizer) runSync() {
 		sync.syncCtxCancel()
 	}()
 
-	sync.cachedBlocks = make(map[phase0.Slot]*Block)
-	sync.cachedSlot = 0
+	sync.blockCache = newSyncBlockCache()
+	sync.lastEpochState = nil
+	sync.lastEpochBlocks = nil
 	isComplete := false
 	retryCount := 0
 
+	// epochs loaded ahead by the sync workers, keyed by epoch
+	syncWorkers := utils.Config.Indexer.SyncWorkers
+	prefetches := map[phase0.Epoch]*syncEpochPrefetch{}
+
 	sync.logger.Infof("synchronization started. head epoch: %v", sync.currentEpoch)
 
 	for {
@@ -168,7 +187,26 @@ func (sync *synchronizer) runSync() {
 			synclogger.Infof("synchronizing epoch %v", syncEpoch)
 		}
 
-		done, err := sync.syncEpoch(syncEpoch, syncClient, lastRetry)
+		sync.pacer.startEpoch()
+		var done bool
+		var err error
+		if prefetch := prefetches[syncEpoch]; prefetch != nil {
+			// epoch has been loaded ahead by a sync worker, process it in order
+			delete(prefetches, syncEpoch)
+			sync.startPrefetches(prefetches, syncEpoch, syncClients[0])
+
+			epochData, perr := prefetch.await(sync.syncCtx)
+			if perr != nil {
+				err = perr
+			} else if epochData != nil {
+				done, err = sync.processEpochData(epochData, lastRetry)
+			}
+		} else {
+			if syncWorkers > 1 && retryCount == 0 {
+				sync.startPrefetches(prefetches, syncEpoch, syncClients[0])
+			}
+			done, err = sync.syncEpoch(syncEpoch, syncClient, lastRetry)
+		}
 		if done || lastRetry {
 			if err != nil {
 				sync.logger.Errorf("synchronization of epoch %v failed: %v - skipping epoch", syncEpoch, err)
@@ -182,6 +220,15 @@ func (sync *synchronizer) runSync() {
 				isComplete = true
 				break
 			}
+
+			// pace the synchronization depending on the node latency & distance to the head
+			if cooldown := sync.pacer.getCooldown(uint64(sync.indexer.lastFinalizedEpoch - syncEpoch)); cooldown > 0 {
+				synclogger.Debugf("synchronization cooldown: %v", cooldown)
+				select {
+				case <-sync.syncCtx.Done():
+				case <-time.After(cooldown):
+				}
+			}
 		} else if err != nil {
 			synclogger.Warnf("synchronization of epoch %v failed: %v - Retrying in 10 sec...", syncEpoch, err)
 			retryCount++
@@ -207,8 +254,11 @@ func (sync *synchronizer) runSync() {
 	sync.running = false
 }
 
+// getSyncClients returns the clients that can be used to synchronize the given epoch, ordered by preference:
+// live archive clients first, followed by the history only clients and the (possibly pruned) live clients.
 func (sync *synchronizer) getSyncClients(epoch phase0.Epoch) []*Client {
 	archiveClients := make([]*Client, 0)
+	historyClients := make([]*Client, 0)
 	normalClients := make([]*Client, 0)
 
 	for _, client := range sync.indexer.clients {
@@ -232,6 +282,19 @@ func (sync *synchronizer) getSyncClients(epoch phase0.Epoch) []*Client {
 		}
 	}
 
+	for _, client := range sync.indexer.historyClients {
+		if client.client.GetStatus() != consensus.ClientStatusOnline {
+			continue
+		}
+
+		finalizedEpoch, _, _, _ := client.client.GetFinalityCheckpoint()
+		if finalizedEpoch < epoch {
+			continue
+		}
+
+		historyClients = append(historyClients, client)
+	}
+
 	sort.Slice(archiveClients, func(i, j int) bool {
 		if archiveClients[i].priority == archiveClients[j].priority {
 			return rand.UintN(1) == 0
@@ -246,14 +309,21 @@ func (sync *synchronizer) getSyncClients(epoch phase0.Epoch) []*Client {
 		return normalClients[i].priority > normalClients[j].priority
 	})
 
-	return append(archiveClients, normalClients...)
+	sort.Slice(historyClients, func(i, j int) bool {
+		return historyClients[i].priority > historyClients[j].priority
+	})
+
+	syncClients := append(archiveClients, historyClients...)
+	return append(syncClients, normalClients...)
 }
 
 func (sync *synchronizer) loadBlockHeader(client *Client, slot phase0.Slot) (*phase0.SignedBeaconBlockHeader, phase0.Root, error) {
 	ctx, cancel := context.WithTimeout(sync.syncCtx, beaconHeaderRequestTimeout)
 	defer cancel()
 
+	start := time.Now()
 	header, root, orphaned, err := LoadBeaconHeaderBySlot(ctx, client, slot)
+	sync.pacer.trackRequest(time.Since(start))
 	if orphaned {
 		return nil, root, nil
 	}
@@ -264,37 +334,132 @@ func (sync *synchronizer) loadBlockHeader(client *Client, slot phase0.Slot) (*ph
 func (sync *synchronizer) loadBlockBody(client *Client, root phase0.Root) (*spec.VersionedSignedBeaconBlock, error) {
 	ctx, cancel := context.WithTimeout(sync.syncCtx, beaconHeaderRequestTimeout)
 	defer cancel()
-	return LoadBeaconBlock(ctx, client, root)
+
+	start := time.Now()
+	block, err := LoadBeaconBlock(ctx, client, root)
+	sync.pacer.trackRequest(time.Since(start))
+
+	return block, err
+}
+
+// syncBlockCache keeps the blocks loaded for an epoch (including the next epoch for vote aggregation), so retries do not need to reload them.
+type syncBlockCache struct {
+	slot   phase0.Slot
+	blocks map[phase0.Slot]*Block
+}
+
+func newSyncBlockCache() *syncBlockCache {
+	return &syncBlockCache{
+		blocks: make(map[phase0.Slot]*Block),
+	}
+}
+
+// clear removes the blocks loaded for the epoch from the cache.
+func (cache *syncBlockCache) clear(epoch phase0.Epoch, chainState *consensus.ChainState) {
+	lastSlot := chainState.EpochStartSlot(epoch+2) - 1
+	for slot := chainState.EpochStartSlot(epoch); slot <= lastSlot; slot++ {
+		delete(cache.blocks, slot)
+	}
+}
+
+// syncEpochData holds the canonical blocks & dependent state loaded for an epoch, before the epoch gets processed & persisted.
+type syncEpochData struct {
+	epoch           phase0.Epoch
+	synchronized    bool
+	canonicalBlocks []*Block
+	nextEpochBlocks []*Block
+	dependentRoot   phase0.Root
+	epochState      *epochState
+	validatorSet    []*phase0.Validator
+}
+
+// syncEpochPrefetch is an epoch loaded ahead of the synchronization head by a sync worker.
+type syncEpochPrefetch struct {
+	done chan struct{}
+	data *syncEpochData
+	err  error
+}
+
+// startPrefetches loads the epochs following the current synchronization epoch in background, so up to `syncWorkers` epochs are fetched concurrently.
+// the prefetched epochs are processed & persisted in order by the synchronization loop.
+func (sync *synchronizer) startPrefetches(prefetches map[phase0.Epoch]*syncEpochPrefetch, syncEpoch phase0.Epoch, client *Client) {
+	workers := utils.Config.Indexer.SyncWorkers
+	for epoch := syncEpoch + 1; epoch < syncEpoch+phase0.Epoch(workers) && epoch < sync.indexer.lastFinalizedEpoch; epoch++ {
+		if prefetches[epoch] != nil {
+			continue
+		}
+
+		prefetch := &syncEpochPrefetch{
+			done: make(chan struct{}),
+		}
+		prefetches[epoch] = prefetch
+
+		go func(epoch phase0.Epoch) {
+			defer utils.HandleSubroutinePanic("runSyncPrefetch", nil)
+			defer close(prefetch.done)
+
+			sync.logger.Debugf("prefetching epoch %v from %v", epoch, client.client.GetName())
+			prefetch.data, prefetch.err = sync.loadEpochData(epoch, client, newSyncBlockCache(), false)
+		}(epoch)
+	}
+}
+
+// await waits for the prefetched epoch to be loaded.
+func (prefetch *syncEpochPrefetch) await(ctx context.Context) (*syncEpochData, error) {
+	select {
+	case <-prefetch.done:
+		return prefetch.data, prefetch.err
+	case <-ctx.Done():
+		return nil, nil
+	}
 }
 
 func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, lastTry bool) (bool, error) {
-	if !utils.Config.Indexer.ResyncForceUpdate && db.IsEpochSynchronized(uint64(syncEpoch)) {
-		return true, nil
+	epochData, err := sync.loadEpochData(syncEpoch, client, sync.blockCache, lastTry)
+	if err != nil || epochData == nil {
+		return false, err
+	}
+
+	done, err := sync.processEpochData(epochData, lastTry)
+	if done {
+		sync.blockCache.clear(epochData.epoch, sync.indexer.consensusPool.GetChainState())
+	}
+
+	return done, err
+}
+
+// loadEpochData loads the canonical blocks of the epoch (and the next epoch for vote aggregation) and the dependent state from the client.
+// it returns nil without error if the synchronization got cancelled.
+func (sync *synchronizer) loadEpochData(syncEpoch phase0.Epoch, client *Client, blockCache *syncBlockCache, lastTry bool) (*syncEpochData, error) {
+	epochData := &syncEpochData{
+		epoch: syncEpoch,
+	}
+
+	if !sync.repairMode && !utils.Config.Indexer.ResyncForceUpdate && db.IsEpochSynchronized(uint64(syncEpoch)) {
+		epochData.synchronized = true
+		return epochData, nil
 	}
 
 	chainState := sync.indexer.consensusPool.GetChainState()
-	specs := chainState.GetSpecs()
 
 	// load headers & blocks from this & next epoch
 	firstSlot := chainState.EpochStartSlot(syncEpoch)
 	lastSlot := chainState.EpochStartSlot(syncEpoch+2) - 1
 	canonicalBlocks := []*Block{}
-	canonicalBlockRoots := [][]byte{}
-	canonicalBlockHashes := [][]byte{}
 	nextEpochCanonicalBlocks := []*Block{}
 
 	var firstBlock *Block
 	for slot := firstSlot; slot <= lastSlot; slot++ {
-		if sync.cachedSlot < slot || sync.cachedBlocks[slot] == nil {
+		if blockCache.slot < slot || blockCache.blocks[slot] == nil {
 			blockHeader, blockRoot, err := sync.loadBlockHeader(client, slot)
 			if err != nil {
-				return false, fmt.Errorf("error fetching slot %v header: %v", slot, err)
+				return nil, fmt.Errorf("error fetching slot %v header: %v", slot, err)
 			}
 			if blockHeader == nil {
 				continue
 			}
 			if sync.syncCtx.Err() != nil {
-				return false, nil
+				return nil, nil
 			}
 
 			block := newBlock(sync.indexer.dynSsz, blockRoot, slot)
@@ -303,76 +468,100 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 			if slot > 0 {
 				blockBody, err := sync.loadBlockBody(client, phase0.Root(blockRoot))
 				if err != nil {
-					return false, fmt.Errorf("error fetching slot %v block: %v", slot, err)
+					return nil, fmt.Errorf("error fetching slot %v block: %v", slot, err)
 				}
 				if blockBody == nil {
-					return false, fmt.Errorf("error fetching slot %v block: not found", slot)
+					return nil, fmt.Errorf("error fetching slot %v block: not found", slot)
 				}
 
 				block.SetBlock(blockBody)
 			}
 
-			sync.cachedBlocks[slot] = block
+			blockCache.blocks[slot] = block
 		}
 
-		if firstBlock == nil && sync.cachedBlocks[slot] != nil {
-			firstBlock = sync.cachedBlocks[slot]
+		if firstBlock == nil && blockCache.blocks[slot] != nil {
+			firstBlock = blockCache.blocks[slot]
 		}
 
 		if chainState.EpochOfSlot(slot) == syncEpoch {
-			canonicalBlocks = append(canonicalBlocks, sync.cachedBlocks[slot])
-			canonicalBlockRoots = append(canonicalBlockRoots, sync.cachedBlocks[slot].Root[:])
-			if blockIndex := sync.cachedBlocks[slot].GetBlockIndex(); blockIndex != nil {
-				canonicalBlockHashes = append(canonicalBlockHashes, blockIndex.ExecutionHash[:])
-			}
+			canonicalBlocks = append(canonicalBlocks, blockCache.blocks[slot])
 		} else {
-			nextEpochCanonicalBlocks = append(nextEpochCanonicalBlocks, sync.cachedBlocks[slot])
+			nextEpochCanonicalBlocks = append(nextEpochCanonicalBlocks, blockCache.blocks[slot])
 		}
 	}
-	sync.cachedSlot = lastSlot
+	blockCache.slot = lastSlot
 
 	if sync.syncCtx.Err() != nil {
-		return false, nil
+		return nil, nil
 	}
 
+	epochData.canonicalBlocks = canonicalBlocks
+	epochData.nextEpochBlocks = nextEpochCanonicalBlocks
+
 	// load epoch state
-	var dependentRoot phase0.Root
 	if firstBlock != nil {
 		if firstBlock.Slot == 0 { // epoch 0 dependent root is the genesis block
-			dependentRoot = firstBlock.Root
+			epochData.dependentRoot = firstBlock.Root
 		} else {
-			dependentRoot = firstBlock.header.Message.ParentRoot
+			epochData.dependentRoot = firstBlock.header.Message.ParentRoot
 		}
 	} else {
 		// get from db
 		depRoot := db.GetHighestRootBeforeSlot(uint64(firstSlot), false)
-		dependentRoot = phase0.Root(depRoot)
+		epochData.dependentRoot = phase0.Root(depRoot)
 	}
 
-	epochState := newEpochState(dependentRoot)
+	epochState := newEpochState(epochData.dependentRoot)
 	state, err := epochState.loadState(sync.syncCtx, client, nil)
 	if (err != nil || epochState.loadingStatus != 2) && !lastTry {
-		return false, fmt.Errorf("error fetching epoch %v state: %v", syncEpoch, err)
+		return nil, fmt.Errorf("error fetching epoch %v state: %v", syncEpoch, err)
 	}
 
-	var epochStats *EpochStats
-	var epochStatsValues *EpochStatsValues
-	if epochState != nil && epochState.loadingStatus == 2 {
-		epochStats = newEpochStats(syncEpoch, dependentRoot)
-		epochStats.dependentState = epochState
+	if epochState.loadingStatus == 2 {
+		epochData.epochState = epochState
 
-		var validatorSet []*phase0.Validator
 		if state == nil {
 			sync.logger.Warnf("state for epoch %v not found", syncEpoch)
 		} else {
-			validatorSet, err = state.Validators()
+			epochData.validatorSet, err = state.Validators()
 			if err != nil {
-				sync.logger.Warnf("error getting validator set from state %v: %v", epochStats.dependentRoot.String(), err)
+				sync.logger.Warnf("error getting validator set from state %v: %v", epochData.dependentRoot.String(), err)
 			}
 		}
+	}
+
+	return epochData, nil
+}
 
-		epochStats.processState(sync.indexer, validatorSet)
+// processEpochData computes the epoch aggregations & votes for the loaded epoch and persists it.
+// epochs need to be processed in order, as the balance drain tracking relies on the state of the previous epoch.
+func (sync *synchronizer) processEpochData(epochData *syncEpochData, lastTry bool) (bool, error) {
+	if epochData.synchronized {
+		return true, nil
+	}
+
+	syncEpoch := epochData.epoch
+	canonicalBlocks := epochData.canonicalBlocks
+	chainState := sync.indexer.consensusPool.GetChainState()
+
+	var epochStats *EpochStats
+	var epochStatsValues *EpochStatsValues
+	if epochData.epochState != nil {
+		epochStats = newEpochStats(syncEpoch, epochData.dependentRoot)
+		epochStats.dependentState = epochData.epochState
+
+		if sync.lastEpochState != nil && sync.lastEpoch+1 == syncEpoch {
+			epochStats.parentState = sync.lastEpochState
+			epochStats.parentBlocks = sync.lastEpochBlocks
+		}
+
+		epochStats.processState(sync.indexer, epochData.validatorSet)
 		epochStatsValues = epochStats.GetValues(false)
+
+		sync.lastEpoch = syncEpoch
+		sync.lastEpochState = epochData.epochState
+		sync.lastEpochBlocks = canonicalBlocks
 	}
 
 	if sync.syncCtx.Err() != nil {
@@ -382,9 +571,9 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 	// process epoch vote aggregations
 	var epochVotes *EpochVotes
 	if epochStatsValues != nil {
-		votingBlocks := make([]*Block, len(canonicalBlocks)+len(nextEpochCanonicalBlocks))
+		votingBlocks := make([]*Block, len(canonicalBlocks)+len(epochData.nextEpochBlocks))
 		copy(votingBlocks, canonicalBlocks)
-		copy(votingBlocks[len(canonicalBlocks):], nextEpochCanonicalBlocks)
+		copy(votingBlocks[len(canonicalBlocks):], epochData.nextEpochBlocks)
 		epochVotes = sync.indexer.aggregateEpochVotes(syncEpoch, chainState, votingBlocks, epochStats)
 		if epochVotes == nil && !lastTry {
 			return false, fmt.Errorf("failed computing votes for epoch %v", syncEpoch)
@@ -392,8 +581,54 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 	}
 
 	// save blocks
-	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
-		err = sync.indexer.dbWriter.persistEpochData(tx, syncEpoch, canonicalBlocks, epochStats, epochVotes)
+	if sync.repairMode {
+		if err := sync.persistEpoch(syncEpoch, canonicalBlocks, epochStats, epochVotes); err != nil {
+			return false, err
+		}
+	} else if !sync.indexer.writeQueue.isEmpty() {
+		// older epochs are still waiting in the write queue, queue this epoch too to keep the persistence order
+		if err := sync.indexer.writeQueue.enqueueEpoch(syncEpoch, canonicalBlocks, epochStats, epochVotes); err != nil {
+			return false, fmt.Errorf("error queuing epoch %v for persistence: %v", syncEpoch, err)
+		}
+	} else if err := sync.persistEpoch(syncEpoch, canonicalBlocks, epochStats, epochVotes); err != nil {
+		if sync.indexer.writeQueue == nil || db.PingWriterDb() == nil {
+			return false, err
+		}
+
+		// database is unavailable, buffer the epoch in the write queue and continue with the next epoch
+		if qerr := sync.indexer.writeQueue.enqueueEpoch(syncEpoch, canonicalBlocks, epochStats, epochVotes); qerr != nil {
+			return false, fmt.Errorf("%v (write queue: %v)", err, qerr)
+		}
+		sync.logger.Warnf("database unavailable, queued epoch %v for persistence", syncEpoch)
+	}
+
+	return true, nil
+}
+
+// persistEpoch writes the synchronized epoch with its canonical blocks, stats & votes to the database.
+func (sync *synchronizer) persistEpoch(syncEpoch phase0.Epoch, canonicalBlocks []*Block, epochStats *EpochStats, epochVotes *EpochVotes) error {
+	specs := sync.indexer.consensusPool.GetChainState().GetSpecs()
+
+	canonicalBlockRoots := make([][]byte, 0, len(canonicalBlocks))
+	canonicalBlockHashes := make([][]byte, 0, len(canonicalBlocks))
+	for _, block := range canonicalBlocks {
+		canonicalBlockRoots = append(canonicalBlockRoots, block.Root[:])
+		if blockIndex := block.GetBlockIndex(); blockIndex != nil {
+			canonicalBlockHashes = append(canonicalBlockHashes, blockIndex.ExecutionHash[:])
+		}
+	}
+
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		for _, root := range sync.invalidateRoots {
+			replaced := slices.ContainsFunc(canonicalBlockRoots, func(canonicalRoot []byte) bool {
+				return bytes.Equal(canonicalRoot, root)
+			})
+			if err := db.InvalidateSlot(root, replaced, tx); err != nil {
+				return fmt.Errorf("error invalidating block 0x%x: %v", root, err)
+			}
+		}
+
+		err := sync.indexer.dbWriter.persistEpochData(tx, syncEpoch, canonicalBlocks, epochStats, epochVotes)
 		if err != nil {
 			return fmt.Errorf("error persisting epoch data to db: %v", err)
 		}
@@ -422,25 +657,15 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 			}
 		}
 
-		err = db.SetExplorerState("indexer.syncstate", &dbtypes.IndexerSyncState{
-			Epoch: uint64(syncEpoch),
-		}, tx)
-		if err != nil {
-			return fmt.Errorf("error while updating sync state: %v", err)
+		if !sync.repairMode {
+			err = db.SetExplorerState("indexer.syncstate", &dbtypes.IndexerSyncState{
+				Epoch: uint64(syncEpoch),
+			}, tx)
+			if err != nil {
+				return fmt.Errorf("error while updating sync state: %v", err)
+			}
 		}
 
 		return nil
 	})
-	if err != nil {
-		return false, err
-	}
-
-	// cleanup cache (remove blocks from this epoch)
-	for slot := firstSlot; slot <= lastSlot; slot++ {
-		if sync.cachedBlocks[slot] != nil {
-			delete(sync.cachedBlocks, slot)
-		}
-	}
-
-	return true, nil
 }
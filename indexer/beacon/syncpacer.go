@@ -0,0 +1,150 @@
+package beacon
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// head distance (in epochs) below which the synchronizer paces gently (cooldown ~ time spent on the epoch)
+	syncPaceNearEpochs = 4
+	// head distance (in epochs) from which the synchronizer runs without head distance based cooldown
+	syncPaceFarEpochs = 256
+	// smoothing factors for the recent & baseline request latency
+	syncPaceLatencyAlpha  = 0.3
+	syncPaceBaselineAlpha = 0.02
+	// max slowdown factor (recent / baseline latency) taken into account
+	syncPaceMaxSlowdown = 5
+	// default upper bound of the cooldown
+	syncPaceDefaultMaxCooldown = 30 * time.Second
+)
+
+var (
+	syncCooldownGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_indexer_sync_cooldown_seconds",
+		Help: "Cooldown applied by the synchronizer after the last synchronized epoch",
+	})
+	syncRequestLatencyGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_indexer_sync_request_latency_seconds",
+		Help: "Smoothed latency of the block requests made by the synchronizer",
+	})
+)
+
+// syncPacer computes the cooldown between synchronized epochs from the node response latency & the distance to the head.
+// it syncs aggressively when far behind and gently near the head, and backs off when the node gets slower than usual.
+type syncPacer struct {
+	mutex           sync.Mutex
+	minCooldown     time.Duration
+	maxCooldown     time.Duration
+	fixedCooldown   time.Duration
+	adaptive        bool
+	requestCount    uint64
+	requestTime     time.Duration
+	epochStart      time.Time
+	recentLatency   float64
+	baselineLatency float64
+}
+
+func newSyncPacer() *syncPacer {
+	config := &utils.Config.Indexer
+	pacer := &syncPacer{
+		minCooldown:   config.SyncCooldownMin,
+		maxCooldown:   config.SyncCooldownMax,
+		fixedCooldown: time.Duration(config.SyncEpochCooldown) * time.Second,
+		adaptive:      !config.DisableAdaptiveSyncCooldown,
+	}
+
+	// the legacy fixed cooldown serves as upper bound if no explicit bound is configured
+	if pacer.maxCooldown == 0 {
+		pacer.maxCooldown = pacer.fixedCooldown
+	}
+	if pacer.maxCooldown == 0 {
+		pacer.maxCooldown = syncPaceDefaultMaxCooldown
+	}
+	if pacer.minCooldown > pacer.maxCooldown {
+		pacer.minCooldown = pacer.maxCooldown
+	}
+
+	return pacer
+}
+
+// startEpoch resets the request tracking for the next epoch.
+func (pacer *syncPacer) startEpoch() {
+	pacer.mutex.Lock()
+	defer pacer.mutex.Unlock()
+
+	pacer.requestCount = 0
+	pacer.requestTime = 0
+	pacer.epochStart = time.Now()
+}
+
+// trackRequest records the duration of a block request made for the current epoch.
+func (pacer *syncPacer) trackRequest(duration time.Duration) {
+	pacer.mutex.Lock()
+	defer pacer.mutex.Unlock()
+
+	pacer.requestCount++
+	pacer.requestTime += duration
+}
+
+// getCooldown returns the cooldown to apply after the current epoch for the given distance to the finalized head.
+// epochs that required no requests (already synchronized) are not paced.
+func (pacer *syncPacer) getCooldown(headDistance uint64) time.Duration {
+	pacer.mutex.Lock()
+	defer pacer.mutex.Unlock()
+
+	if pacer.requestCount == 0 {
+		return 0
+	}
+
+	if !pacer.adaptive {
+		syncCooldownGauge.Set(pacer.fixedCooldown.Seconds())
+		return pacer.fixedCooldown
+	}
+
+	latency := pacer.requestTime.Seconds() / float64(pacer.requestCount)
+	if pacer.recentLatency == 0 {
+		pacer.recentLatency = latency
+		pacer.baselineLatency = latency
+	} else {
+		pacer.recentLatency += (latency - pacer.recentLatency) * syncPaceLatencyAlpha
+		pacer.baselineLatency += (latency - pacer.baselineLatency) * syncPaceBaselineAlpha
+	}
+	syncRequestLatencyGauge.Set(pacer.recentLatency)
+
+	// 1 near the head, 0 far behind
+	nearFactor := 0.0
+	switch {
+	case headDistance <= syncPaceNearEpochs:
+		nearFactor = 1
+	case headDistance < syncPaceFarEpochs:
+		nearFactor = float64(syncPaceFarEpochs-headDistance) / float64(syncPaceFarEpochs-syncPaceNearEpochs)
+	}
+
+	// >0 when the node responds slower than its baseline
+	slowdown := 0.0
+	if pacer.baselineLatency > 0 {
+		slowdown = pacer.recentLatency/pacer.baselineLatency - 1
+	}
+	if slowdown < 0 {
+		slowdown = 0
+	} else if slowdown > syncPaceMaxSlowdown {
+		slowdown = syncPaceMaxSlowdown
+	}
+
+	epochTime := time.Since(pacer.epochStart)
+	cooldown := time.Duration(float64(epochTime) * (nearFactor + slowdown))
+	if cooldown < pacer.minCooldown {
+		cooldown = pacer.minCooldown
+	} else if cooldown > pacer.maxCooldown {
+		cooldown = pacer.maxCooldown
+	}
+
+	syncCooldownGauge.Set(cooldown.Seconds())
+	return cooldown
+}
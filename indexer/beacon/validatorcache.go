@@ -1,13 +1,17 @@
 package beacon
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"math"
 	"reflect"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/dora/dbtypes"
 )
 
 // validatorCache is the cache for the validator set and validator activity.
@@ -19,9 +23,18 @@ type validatorCache struct {
 	validatorActivityMap map[phase0.ValidatorIndex][]ValidatorActivity
 	activityMutex        sync.RWMutex // mutex to protect recentActivity for concurrent access
 	lastFinalized        phase0.Epoch // last finalized epoch
+	hasFinalizedSet      bool         // finalized validator set has been initialized by a previous finalization
 	oldestActivityEpoch  phase0.Epoch // oldest epoch in activity cache
 	pubkeyMap            map[phase0.BLSPubKey]phase0.ValidatorIndex
 	pubkeyMutex          sync.RWMutex // mutex to protect pubkeyMap for concurrent access
+	pubkeyPrefixIndex    []pubkeyPrefixEntry
+	pubkeyPrefixDirty    bool // pubkeyPrefixIndex needs to be rebuilt on next access
+}
+
+// pubkeyPrefixEntry is an entry of the pubkey prefix index, sorted by the first 8 bytes of the pubkey.
+type pubkeyPrefixEntry struct {
+	prefix [8]byte
+	index  phase0.ValidatorIndex
 }
 
 // validatorDiffKey is the primary key for validatorDiff entries in cache.
@@ -46,23 +59,31 @@ type validatorEntry struct {
 }
 
 // ValidatorActivity represents a validator's activity in an epoch.
-// entry size: 18 bytes (10 bytes data + 8 bytes pointer)
+// entry size: 19 bytes (11 bytes data + 8 bytes pointer)
 // max. entries per validator: 3-8 (inMemoryEpochs)
 // total memory consumption:
 //   - 10k active validators:
-//     min: 10000 * 18 * 3 = 540kB = 0.54MB
-//     max: 10000 * 18 * 8 = 1440kB = 1.44MB
+//     min: 10000 * 19 * 3 = 570kB = 0.57MB
+//     max: 10000 * 19 * 8 = 1520kB = 1.52MB
 //   - 100k active validators:
-//     min: 100000 * 18 * 3 = 5400kB = 5.4MB
-//     max: 100000 * 18 * 8 = 14400kB = 14.4MB
+//     min: 100000 * 19 * 3 = 5700kB = 5.7MB
+//     max: 100000 * 19 * 8 = 15200kB = 15.2MB
 //   - 1M active validators:
-//     min: 1000000 * 18 * 3 = 54000kB = 54MB
-//     max: 1000000 * 18 * 8 = 144000kB = 144MB
+//     min: 1000000 * 19 * 3 = 57000kB = 57MB
+//     max: 1000000 * 19 * 8 = 152000kB = 152MB
 type ValidatorActivity struct {
 	VoteBlock *Block // the block where the vote was included
 	VoteDelay uint16 // the inclusion delay of the vote in slots
+	VoteFlags uint8  // correctness of the vote (ValidatorVoteTargetCorrect, ValidatorVoteHeadCorrect)
 }
 
+const (
+	// ValidatorVoteTargetCorrect is set if the vote attested the canonical epoch boundary block.
+	ValidatorVoteTargetCorrect uint8 = 1 << iota
+	// ValidatorVoteHeadCorrect is set if the vote attested the parent of the including block as head.
+	ValidatorVoteHeadCorrect
+)
+
 // validatorDiff represents an updated validator entry in the validator set cache.
 type validatorDiff struct {
 	epoch         phase0.Epoch
@@ -97,8 +118,8 @@ func (cache *validatorCache) updateValidatorSet(epoch phase0.Epoch, dependentRoo
 	currentEpoch := cache.indexer.consensusPool.GetChainState().CurrentEpoch()
 	finalizedEpoch, _ := cache.indexer.consensusPool.GetChainState().GetFinalizedCheckpoint()
 	cutOffEpoch := phase0.Epoch(0)
-	if currentEpoch > phase0.Epoch(cache.indexer.inMemoryEpochs) {
-		cutOffEpoch = currentEpoch - phase0.Epoch(cache.indexer.inMemoryEpochs)
+	if inMemoryEpochs := phase0.Epoch(cache.indexer.getInMemoryEpochs()); currentEpoch > inMemoryEpochs {
+		cutOffEpoch = currentEpoch - inMemoryEpochs
 	}
 	if cutOffEpoch > finalizedEpoch {
 		cutOffEpoch = finalizedEpoch
@@ -130,6 +151,7 @@ func (cache *validatorCache) updateValidatorSet(epoch phase0.Epoch, dependentRoo
 			cache.valsetCache = append(cache.valsetCache, cachedValidator)
 			cache.pubkeyMutex.Lock()
 			cache.pubkeyMap[validators[i].PublicKey] = phase0.ValidatorIndex(i)
+			cache.pubkeyPrefixDirty = true
 			cache.pubkeyMutex.Unlock()
 		} else {
 			parentValidator = cachedValidator.finalValidator
@@ -193,7 +215,7 @@ func (cache *validatorCache) updateValidatorSet(epoch phase0.Epoch, dependentRoo
 }
 
 // updateValidatorActivity updates the validator activity cache.
-func (cache *validatorCache) updateValidatorActivity(validatorIndex phase0.ValidatorIndex, epoch phase0.Epoch, dutySlot phase0.Slot, voteBlock *Block) {
+func (cache *validatorCache) updateValidatorActivity(validatorIndex phase0.ValidatorIndex, epoch phase0.Epoch, dutySlot phase0.Slot, voteBlock *Block, voteFlags uint8) {
 	chainState := cache.indexer.consensusPool.GetChainState()
 	currentEpoch := chainState.CurrentEpoch()
 	cutOffEpoch := phase0.Epoch(0)
@@ -253,6 +275,7 @@ func (cache *validatorCache) updateValidatorActivity(validatorIndex phase0.Valid
 		recentActivity[replaceIndex] = ValidatorActivity{
 			VoteBlock: voteBlock,
 			VoteDelay: uint16(voteBlock.Slot - dutySlot),
+			VoteFlags: voteFlags,
 		}
 
 		if cutOffLength > 0 {
@@ -262,6 +285,7 @@ func (cache *validatorCache) updateValidatorActivity(validatorIndex phase0.Valid
 		recentActivity = append(recentActivity, ValidatorActivity{
 			VoteBlock: voteBlock,
 			VoteDelay: uint16(voteBlock.Slot - dutySlot),
+			VoteFlags: voteFlags,
 		})
 	}
 
@@ -274,6 +298,7 @@ func (cache *validatorCache) setFinalizedEpoch(epoch phase0.Epoch, nextEpochDepe
 	defer cache.cacheMutex.Unlock()
 
 	cache.lastFinalized = epoch
+	cache.hasFinalizedSet = true
 
 	for _, cachedValidator := range cache.valsetCache {
 		for diffKey, diff := range cachedValidator.validatorDiffs {
@@ -288,6 +313,75 @@ func (cache *validatorCache) setFinalizedEpoch(epoch phase0.Epoch, nextEpochDepe
 	}
 }
 
+// getFinalizedValidatorEvents diffs the validator set of the epoch to be finalized against the last finalized validator set
+// and returns the validator status transitions (activated, exited, slashed, withdrawable) found between both snapshots.
+// only validators with a diff for the given dependent root are checked, so unchanged validators do not add any overhead.
+func (cache *validatorCache) getFinalizedValidatorEvents(epoch phase0.Epoch, nextEpochDependentRoot phase0.Root) []*dbtypes.ValidatorEvent {
+	cache.cacheMutex.RLock()
+	defer cache.cacheMutex.RUnlock()
+
+	if !cache.hasFinalizedSet {
+		// no previous snapshot to diff against (first finalization after startup)
+		return nil
+	}
+
+	validatorEvents := []*dbtypes.ValidatorEvent{}
+	for _, cachedValidator := range cache.valsetCache {
+		for _, diff := range cachedValidator.validatorDiffs {
+			if diff.dependentRoot != nextEpochDependentRoot {
+				continue
+			}
+
+			validatorEvents = appendValidatorEvents(validatorEvents, cachedValidator.index, epoch, cachedValidator.finalValidator, diff.validator)
+		}
+	}
+
+	return validatorEvents
+}
+
+// appendValidatorEvents appends the status transitions between the old and new validator entry to the events list.
+// a nil oldValidator is treated as a new validator without any status transitions yet.
+func appendValidatorEvents(events []*dbtypes.ValidatorEvent, index phase0.ValidatorIndex, epoch phase0.Epoch, oldValidator *phase0.Validator, newValidator *phase0.Validator) []*dbtypes.ValidatorEvent {
+	if newValidator == nil {
+		return events
+	}
+
+	oldActivationEpoch := FarFutureEpoch
+	oldExitEpoch := FarFutureEpoch
+	oldWithdrawableEpoch := FarFutureEpoch
+	oldSlashed := false
+	if oldValidator != nil {
+		oldActivationEpoch = oldValidator.ActivationEpoch
+		oldExitEpoch = oldValidator.ExitEpoch
+		oldWithdrawableEpoch = oldValidator.WithdrawableEpoch
+		oldSlashed = oldValidator.Slashed
+	}
+
+	addEvent := func(eventType dbtypes.ValidatorEventType, eventEpoch phase0.Epoch) {
+		events = append(events, &dbtypes.ValidatorEvent{
+			ValidatorIndex: uint64(index),
+			EventType:      eventType,
+			Epoch:          uint64(eventEpoch),
+		})
+	}
+
+	if newValidator.ActivationEpoch != FarFutureEpoch && newValidator.ActivationEpoch != oldActivationEpoch {
+		addEvent(dbtypes.ValidatorEventActivated, newValidator.ActivationEpoch)
+	}
+	if newValidator.Slashed && !oldSlashed {
+		addEvent(dbtypes.ValidatorEventSlashed, epoch)
+	}
+	if newValidator.ExitEpoch != FarFutureEpoch && newValidator.ExitEpoch != oldExitEpoch {
+		addEvent(dbtypes.ValidatorEventExited, newValidator.ExitEpoch)
+	}
+	if newValidator.WithdrawableEpoch != FarFutureEpoch && newValidator.WithdrawableEpoch != oldWithdrawableEpoch {
+		// slashings extend the withdrawable epoch, the event gets updated in that case
+		addEvent(dbtypes.ValidatorEventWithdrawable, newValidator.WithdrawableEpoch)
+	}
+
+	return events
+}
+
 // getValidatorSet returns the validator set for a given forkId.
 func (cache *validatorCache) getValidatorSet(overrideForkId *ForkKey) []*phase0.Validator {
 	canonicalHead := cache.indexer.GetCanonicalHead(overrideForkId)
@@ -405,6 +499,68 @@ func (cache *validatorCache) getValidatorIndexByPubkey(pubkey phase0.BLSPubKey)
 	return index, found
 }
 
+// getValidatorIndicesByPubkeyPrefix returns up to limit validator indices with a pubkey starting with the given hex prefix.
+// the prefix index is rebuilt lazily after new validators have been added to the cache.
+func (cache *validatorCache) getValidatorIndicesByPubkeyPrefix(hexPrefix string, limit int) []phase0.ValidatorIndex {
+	hexPrefix = strings.ToLower(hexPrefix)
+	minHex := hexPrefix
+	if len(minHex)%2 == 1 {
+		minHex += "0"
+	}
+	minPrefix, err := hex.DecodeString(minHex)
+	if err != nil {
+		return nil
+	}
+
+	cache.pubkeyMutex.Lock()
+	if cache.pubkeyPrefixDirty || cache.pubkeyPrefixIndex == nil {
+		prefixIndex := make([]pubkeyPrefixEntry, 0, len(cache.pubkeyMap))
+		for pubkey, index := range cache.pubkeyMap {
+			entry := pubkeyPrefixEntry{index: index}
+			copy(entry.prefix[:], pubkey[:8])
+			prefixIndex = append(prefixIndex, entry)
+		}
+		sort.Slice(prefixIndex, func(a, b int) bool {
+			return bytes.Compare(prefixIndex[a].prefix[:], prefixIndex[b].prefix[:]) < 0
+		})
+		cache.pubkeyPrefixIndex = prefixIndex
+		cache.pubkeyPrefixDirty = false
+	}
+	prefixIndex := cache.pubkeyPrefixIndex
+	cache.pubkeyMutex.Unlock()
+
+	if len(minPrefix) > 8 {
+		minPrefix = minPrefix[:8]
+	}
+	indexPrefixHex := hexPrefix
+	if len(indexPrefixHex) > 16 {
+		indexPrefixHex = indexPrefixHex[:16]
+	}
+
+	indices := []phase0.ValidatorIndex{}
+	firstIdx := sort.Search(len(prefixIndex), func(i int) bool {
+		return bytes.Compare(prefixIndex[i].prefix[:len(minPrefix)], minPrefix) >= 0
+	})
+	for i := firstIdx; i < len(prefixIndex) && len(indices) < limit; i++ {
+		entry := prefixIndex[i]
+		if !strings.HasPrefix(hex.EncodeToString(entry.prefix[:]), indexPrefixHex) {
+			break
+		}
+
+		if len(hexPrefix) > 16 {
+			// the index only covers the first 8 bytes, check the full pubkey for longer prefixes
+			validator := cache.getValidatorByIndex(entry.index, nil)
+			if validator == nil || !strings.HasPrefix(hex.EncodeToString(validator.PublicKey[:]), hexPrefix) {
+				continue
+			}
+		}
+
+		indices = append(indices, entry.index)
+	}
+
+	return indices
+}
+
 // getValidatorActivity returns the validator activity for a given validator index.
 func (cache *validatorCache) getValidatorActivity(validatorIndex phase0.ValidatorIndex) []ValidatorActivity {
 	cache.activityMutex.RLock()
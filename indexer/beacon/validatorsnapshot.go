@@ -0,0 +1,149 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// validatorSnapshotEntrySize is the serialized size of a validator snapshot entry (6 uint64 fields, slashed flag, withdrawal credentials).
+const validatorSnapshotEntrySize = 6*8 + 1 + 32
+
+// ValidatorSnapshot holds the state of all validators at the start of an epoch.
+type ValidatorSnapshot struct {
+	Epoch         phase0.Epoch
+	DependentRoot phase0.Root
+	Validators    []ValidatorSnapshotEntry
+}
+
+// ValidatorSnapshotEntry holds the balances, status epochs and credentials of a validator in a snapshot.
+type ValidatorSnapshotEntry struct {
+	Balance                    phase0.Gwei
+	EffectiveBalance           phase0.Gwei
+	ActivationEligibilityEpoch phase0.Epoch
+	ActivationEpoch            phase0.Epoch
+	ExitEpoch                  phase0.Epoch
+	WithdrawableEpoch          phase0.Epoch
+	Slashed                    bool
+	WithdrawalCredentials      [32]byte
+}
+
+// GetStatus returns the status of the validator at the given epoch.
+func (entry *ValidatorSnapshotEntry) GetStatus(epoch phase0.Epoch) v1.ValidatorState {
+	validator := &phase0.Validator{
+		EffectiveBalance:           entry.EffectiveBalance,
+		Slashed:                    entry.Slashed,
+		ActivationEligibilityEpoch: entry.ActivationEligibilityEpoch,
+		ActivationEpoch:            entry.ActivationEpoch,
+		ExitEpoch:                  entry.ExitEpoch,
+		WithdrawableEpoch:          entry.WithdrawableEpoch,
+	}
+	return v1.ValidatorToState(validator, &entry.Balance, epoch, FarFutureEpoch)
+}
+
+// shouldStoreValidatorSnapshot returns true if a validator snapshot should be stored for the given epoch.
+func shouldStoreValidatorSnapshot(epoch phase0.Epoch) bool {
+	interval := utils.Config.Indexer.ValidatorSnapshotInterval
+	return interval > 0 && uint64(epoch)%interval == 0
+}
+
+// buildValidatorSnapshot serializes the validator set and balances of an epoch state into a compressed snapshot.
+func buildValidatorSnapshot(epoch phase0.Epoch, dependentRoot phase0.Root, validatorSet []*phase0.Validator, balances []phase0.Gwei) *dbtypes.ValidatorSnapshot {
+	snapshotData := make([]byte, len(validatorSet)*validatorSnapshotEntrySize)
+	for index, validator := range validatorSet {
+		entryData := snapshotData[index*validatorSnapshotEntrySize : (index+1)*validatorSnapshotEntrySize]
+
+		var balance phase0.Gwei
+		if index < len(balances) {
+			balance = balances[index]
+		}
+
+		binary.LittleEndian.PutUint64(entryData[0:8], uint64(balance))
+		binary.LittleEndian.PutUint64(entryData[8:16], uint64(validator.EffectiveBalance))
+		binary.LittleEndian.PutUint64(entryData[16:24], uint64(validator.ActivationEligibilityEpoch))
+		binary.LittleEndian.PutUint64(entryData[24:32], uint64(validator.ActivationEpoch))
+		binary.LittleEndian.PutUint64(entryData[32:40], uint64(validator.ExitEpoch))
+		binary.LittleEndian.PutUint64(entryData[40:48], uint64(validator.WithdrawableEpoch))
+		if validator.Slashed {
+			entryData[48] = 1
+		}
+		copy(entryData[49:81], validator.WithdrawalCredentials)
+	}
+
+	return &dbtypes.ValidatorSnapshot{
+		Epoch:          uint64(epoch),
+		DependentRoot:  dependentRoot[:],
+		ValidatorCount: uint64(len(validatorSet)),
+		Snapshot:       compressBytes(snapshotData),
+	}
+}
+
+// parseValidatorSnapshot restores a validator snapshot from its database representation.
+func parseValidatorSnapshot(dbSnapshot *dbtypes.ValidatorSnapshot) (*ValidatorSnapshot, error) {
+	snapshotData, err := decompressBytes(dbSnapshot.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed decompressing validator snapshot: %v", err)
+	}
+
+	if uint64(len(snapshotData)) != dbSnapshot.ValidatorCount*validatorSnapshotEntrySize {
+		return nil, fmt.Errorf("invalid validator snapshot size (%v bytes for %v validators)", len(snapshotData), dbSnapshot.ValidatorCount)
+	}
+
+	snapshot := &ValidatorSnapshot{
+		Epoch:         phase0.Epoch(dbSnapshot.Epoch),
+		DependentRoot: phase0.Root(dbSnapshot.DependentRoot),
+		Validators:    make([]ValidatorSnapshotEntry, dbSnapshot.ValidatorCount),
+	}
+
+	for index := range snapshot.Validators {
+		entryData := snapshotData[index*validatorSnapshotEntrySize : (index+1)*validatorSnapshotEntrySize]
+		entry := &snapshot.Validators[index]
+
+		entry.Balance = phase0.Gwei(binary.LittleEndian.Uint64(entryData[0:8]))
+		entry.EffectiveBalance = phase0.Gwei(binary.LittleEndian.Uint64(entryData[8:16]))
+		entry.ActivationEligibilityEpoch = phase0.Epoch(binary.LittleEndian.Uint64(entryData[16:24]))
+		entry.ActivationEpoch = phase0.Epoch(binary.LittleEndian.Uint64(entryData[24:32]))
+		entry.ExitEpoch = phase0.Epoch(binary.LittleEndian.Uint64(entryData[32:40]))
+		entry.WithdrawableEpoch = phase0.Epoch(binary.LittleEndian.Uint64(entryData[40:48]))
+		entry.Slashed = entryData[48] == 1
+		copy(entry.WithdrawalCredentials[:], entryData[49:81])
+	}
+
+	return snapshot, nil
+}
+
+// GetValidatorSnapshot returns the latest stored validator snapshot at or before the given epoch.
+// for unfinalized epochs with snapshots of multiple forks, the snapshot of the canonical chain is preferred.
+// returns nil if no snapshot is available.
+func (indexer *Indexer) GetValidatorSnapshot(epoch phase0.Epoch) (*ValidatorSnapshot, error) {
+	dbSnapshots, err := db.GetValidatorSnapshotsBefore(uint64(epoch))
+	if err != nil {
+		return nil, fmt.Errorf("failed loading validator snapshots: %v", err)
+	}
+
+	if len(dbSnapshots) == 0 {
+		return nil, nil
+	}
+
+	dbSnapshot := dbSnapshots[0]
+	if len(dbSnapshots) > 1 {
+		if epochStats := indexer.GetEpochStats(phase0.Epoch(dbSnapshot.Epoch), nil); epochStats != nil {
+			dependentRoot := epochStats.GetDependentRoot()
+			for _, snapshot := range dbSnapshots {
+				if bytes.Equal(snapshot.DependentRoot, dependentRoot[:]) {
+					dbSnapshot = snapshot
+					break
+				}
+			}
+		}
+	}
+
+	return parseValidatorSnapshot(dbSnapshot)
+}
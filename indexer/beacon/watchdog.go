@@ -0,0 +1,247 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// watchdogMinHeadLag is the min number of slots the indexer head needs to be behind the client heads to be considered stalled.
+const watchdogMinHeadLag = 2
+
+var (
+	watchdogStalledGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_indexer_watchdog_stalled",
+		Help: "Whether the indexer head is currently considered stalled by the watchdog (1 = stalled)",
+	})
+	watchdogHeadLagGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_indexer_watchdog_head_lag_slots",
+		Help: "Number of slots the indexer head is behind the highest head reported by the consensus clients",
+	})
+	watchdogStallsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_indexer_watchdog_stalls_total",
+		Help: "Number of indexer stalls detected by the watchdog",
+	})
+	watchdogRestartsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_indexer_watchdog_restarts_total",
+		Help: "Number of client indexing loop restarts triggered by the watchdog",
+	})
+)
+
+// WatchdogEvent is the payload of the watchdog webhook.
+type WatchdogEvent struct {
+	Event           string                `json:"event"`
+	Time            time.Time             `json:"time"`
+	IndexerHeadSlot uint64                `json:"indexer_head_slot"`
+	IndexerHeadRoot string                `json:"indexer_head_root"`
+	ClientHeadSlot  uint64                `json:"client_head_slot"`
+	StalledSince    time.Time             `json:"stalled_since"`
+	Restarts        uint64                `json:"restarts"`
+	Clients         []*WatchdogClientInfo `json:"clients"`
+}
+
+// WatchdogClientInfo holds the diagnostics of a single client for the watchdog logs & webhook.
+type WatchdogClientInfo struct {
+	Name          string    `json:"name"`
+	Status        string    `json:"status"`
+	HeadSlot      uint64    `json:"head_slot"`
+	HeadRoot      string    `json:"head_root"`
+	LastEventTime time.Time `json:"last_event_time"`
+	Indexing      bool      `json:"indexing"`
+}
+
+// watchdog tracks the progress of the indexer head between the watchdog checks.
+type watchdog struct {
+	indexer       *Indexer
+	lastHeadSlot  phase0.Slot
+	lastProgress  time.Time
+	stalled       bool
+	stalledSince  time.Time
+	lastRestart   time.Time
+	restartsCount uint64
+}
+
+// runWatchdogLoop periodically checks whether the indexer head is advancing while the clients report newer heads.
+// stalls are logged with diagnostics, reported via metrics & the configured webhook and recovered by restarting the client indexing loops.
+func (indexer *Indexer) runWatchdogLoop() {
+	defer utils.HandleSubroutinePanic("runWatchdogLoop", indexer.runWatchdogLoop)
+
+	if utils.Config.Indexer.DisableWatchdog {
+		return
+	}
+
+	stallTimeout := utils.Config.Indexer.WatchdogStallTimeout
+	if stallTimeout == 0 {
+		stallTimeout = 5 * time.Minute
+	}
+
+	wd := &watchdog{
+		indexer:      indexer,
+		lastProgress: time.Now(),
+	}
+
+	for {
+		time.Sleep(30 * time.Second)
+		wd.check(stallTimeout)
+	}
+}
+
+// check runs a single watchdog check.
+func (wd *watchdog) check(stallTimeout time.Duration) {
+	indexer := wd.indexer
+	headSlot := phase0.Slot(0)
+	headRoot := phase0.Root{}
+	if headBlock := indexer.GetCanonicalHead(nil); headBlock != nil {
+		headSlot = headBlock.Slot
+		headRoot = headBlock.Root
+	}
+
+	clientHeadSlot := phase0.Slot(0)
+	for _, client := range indexer.clients {
+		status := client.client.GetStatus()
+		if status != consensus.ClientStatusOnline && status != consensus.ClientStatusOptimistic {
+			continue
+		}
+
+		if clientSlot, _ := client.client.GetLastHead(); clientSlot > clientHeadSlot {
+			clientHeadSlot = clientSlot
+		}
+	}
+
+	headLag := uint64(0)
+	if clientHeadSlot > headSlot {
+		headLag = uint64(clientHeadSlot - headSlot)
+	}
+	watchdogHeadLagGauge.Set(float64(headLag))
+
+	if headSlot > wd.lastHeadSlot || headLag < watchdogMinHeadLag {
+		if headSlot > wd.lastHeadSlot {
+			wd.lastHeadSlot = headSlot
+		}
+		wd.lastProgress = time.Now()
+
+		if wd.stalled {
+			wd.stalled = false
+			watchdogStalledGauge.Set(0)
+			indexer.logger.Infof("watchdog: indexer recovered after %v (head: %v [0x%x])", time.Since(wd.stalledSince).Round(time.Second), headSlot, headRoot[:])
+			wd.sendWebhook(wd.buildEvent("indexer_recovered", headSlot, headRoot, clientHeadSlot))
+		}
+		return
+	}
+
+	if time.Since(wd.lastProgress) < stallTimeout {
+		return
+	}
+
+	event := wd.buildEvent("indexer_stalled", headSlot, headRoot, clientHeadSlot)
+	if !wd.stalled {
+		wd.stalled = true
+		wd.stalledSince = wd.lastProgress
+		event.StalledSince = wd.stalledSince
+		watchdogStalledGauge.Set(1)
+		watchdogStallsCounter.Inc()
+
+		indexer.logger.Warnf("watchdog: indexer head stalled at slot %v [0x%x] for %v while clients report slot %v", headSlot, headRoot[:], time.Since(wd.lastProgress).Round(time.Second), clientHeadSlot)
+		wd.logDiagnostics(event)
+		wd.sendWebhook(event)
+	}
+
+	// restart the client indexing loops, at most once per stall timeout
+	if time.Since(wd.lastRestart) < stallTimeout {
+		return
+	}
+
+	wd.lastRestart = time.Now()
+	wd.restartsCount++
+	watchdogRestartsCounter.Inc()
+
+	indexer.logger.Warnf("watchdog: restarting client indexing loops (restart %v)", wd.restartsCount)
+	for _, client := range indexer.clients {
+		client.restartIndexing()
+	}
+}
+
+// buildEvent builds the watchdog event with the current client diagnostics.
+func (wd *watchdog) buildEvent(eventType string, headSlot phase0.Slot, headRoot phase0.Root, clientHeadSlot phase0.Slot) *WatchdogEvent {
+	event := &WatchdogEvent{
+		Event:           eventType,
+		Time:            time.Now(),
+		IndexerHeadSlot: uint64(headSlot),
+		IndexerHeadRoot: fmt.Sprintf("0x%x", headRoot[:]),
+		ClientHeadSlot:  uint64(clientHeadSlot),
+		StalledSince:    wd.stalledSince,
+		Restarts:        wd.restartsCount,
+		Clients:         make([]*WatchdogClientInfo, 0, len(wd.indexer.clients)),
+	}
+
+	for _, client := range wd.indexer.clients {
+		clientSlot, clientRoot := client.client.GetLastHead()
+		event.Clients = append(event.Clients, &WatchdogClientInfo{
+			Name:          client.client.GetName(),
+			Status:        client.client.GetStatus().String(),
+			HeadSlot:      uint64(clientSlot),
+			HeadRoot:      fmt.Sprintf("0x%x", clientRoot[:]),
+			LastEventTime: client.client.GetLastEventTime(),
+			Indexing:      client.indexing,
+		})
+	}
+
+	return event
+}
+
+// logDiagnostics logs the state of the indexer & all clients on a detected stall.
+func (wd *watchdog) logDiagnostics(event *WatchdogEvent) {
+	indexer := wd.indexer
+	latestBlockSlot := phase0.Slot(0)
+	if latestBlock := indexer.blockCache.latestBlock; latestBlock != nil {
+		latestBlockSlot = latestBlock.Slot
+	}
+	syncRunning, syncEpoch := indexer.GetSynchronizerState()
+
+	indexer.logger.Warnf("watchdog diagnostics: latest cached block: %v, finalized epoch: %v, pruned epoch: %v, backfilling clients: %v, survival mode: %v, synchronizer: %v (epoch %v)",
+		latestBlockSlot, indexer.lastFinalizedEpoch, indexer.lastPrunedEpoch, indexer.backfillingCount, indexer.survivalMode.Load(), syncRunning, syncEpoch)
+
+	for _, client := range event.Clients {
+		lastEvent := "never"
+		if !client.LastEventTime.IsZero() {
+			lastEvent = fmt.Sprintf("%v ago", time.Since(client.LastEventTime).Round(time.Second))
+		}
+		indexer.logger.Warnf("watchdog diagnostics: client %v: status %v, head %v [%v], last event %v, indexing: %v",
+			client.Name, client.Status, client.HeadSlot, client.HeadRoot, lastEvent, client.Indexing)
+	}
+}
+
+// sendWebhook posts the watchdog event to the configured webhook url (if any).
+func (wd *watchdog) sendWebhook(event *WatchdogEvent) {
+	webhookUrl := utils.Config.Indexer.WatchdogWebhookUrl
+	if webhookUrl == "" {
+		return
+	}
+
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		wd.indexer.logger.Warnf("watchdog: failed encoding webhook event: %v", err)
+		return
+	}
+
+	client := &http.Client{Timeout: time.Second * 10}
+	resp, err := client.Post(webhookUrl, "application/json", bytes.NewReader(eventJson))
+	if err != nil {
+		wd.indexer.logger.Warnf("watchdog: failed sending webhook: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		wd.indexer.logger.Warnf("watchdog: webhook returned status %v", resp.StatusCode)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethpandaops/dora/clients/consensus"
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
@@ -15,7 +16,8 @@ import (
 )
 
 type dbWriter struct {
-	indexer *Indexer
+	indexer       *Indexer
+	partialDuties partialDutiesTracker
 }
 
 func newDbWriter(indexer *Indexer) *dbWriter {
@@ -126,9 +128,84 @@ func (dbw *dbWriter) persistBlockChildObjects(tx *sqlx.Tx, block *Block, deposit
 		return err
 	}
 
+	// insert client attributions
+	err = dbw.persistBlockAttributions(tx, block)
+	if err != nil {
+		return err
+	}
+
+	// insert execution transaction hashes
+	err = dbw.persistBlockTxHashes(tx, block)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dbw *dbWriter) persistBlockAttributions(tx *sqlx.Tx, block *Block) error {
+	dbAttributions := dbw.buildDbBlockAttributions(block)
+	if len(dbAttributions) > 0 {
+		err := db.InsertBlockAttributions(dbAttributions, tx)
+		if err != nil {
+			return fmt.Errorf("error inserting block attributions: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (dbw *dbWriter) persistBlockTxHashes(tx *sqlx.Tx, block *Block) error {
+	dbTxHashes := dbw.buildDbTxHashes(block)
+	if len(dbTxHashes) > 0 {
+		err := db.InsertTxHashes(dbTxHashes, tx)
+		if err != nil {
+			return fmt.Errorf("error inserting tx hashes: %v", err)
+		}
+	}
 	return nil
 }
 
+// buildDbTxHashes hashes the raw execution transactions of the block, the tx hash is the keccak256 hash
+// of the (typed) transaction envelope as included in the execution payload.
+func (dbw *dbWriter) buildDbTxHashes(block *Block) []*dbtypes.TxHash {
+	blockBody := block.GetBlock()
+	if blockBody == nil {
+		return nil
+	}
+
+	transactions, err := blockBody.ExecutionTransactions()
+	if err != nil {
+		return nil
+	}
+
+	dbTxHashes := make([]*dbtypes.TxHash, len(transactions))
+	for idx, transaction := range transactions {
+		txHash := crypto.Keccak256(transaction)
+		dbTxHashes[idx] = &dbtypes.TxHash{
+			TxHash:    txHash,
+			BlockRoot: block.Root[:],
+			Slot:      uint64(block.Slot),
+			TxIndex:   uint64(idx),
+		}
+	}
+	return dbTxHashes
+}
+
+func (dbw *dbWriter) buildDbBlockAttributions(block *Block) []*dbtypes.BlockAttribution {
+	seenBy := block.GetSeenByAttributions()
+	dbAttributions := make([]*dbtypes.BlockAttribution, len(seenBy))
+	for idx, attribution := range seenBy {
+		dbAttributions[idx] = &dbtypes.BlockAttribution{
+			Root:       block.Root[:],
+			ClientName: attribution.Client.client.GetName(),
+			SeenTime:   uint64(attribution.Time.UnixMilli()),
+		}
+	}
+
+	return dbAttributions
+}
+
 func (dbw *dbWriter) persistEpochData(tx *sqlx.Tx, epoch phase0.Epoch, blocks []*Block, epochStats *EpochStats, epochVotes *EpochVotes) error {
 	if tx == nil {
 		return db.RunDBTransaction(func(tx *sqlx.Tx) error {
@@ -137,15 +214,34 @@ func (dbw *dbWriter) persistEpochData(tx *sqlx.Tx, epoch phase0.Epoch, blocks []
 	}
 	canonicalForkId := ForkKey(0)
 
+	var persistErr error
 	dbEpoch := dbw.buildDbEpoch(epoch, blocks, epochStats, epochVotes, func(block *Block, depositIndex *uint64) {
+		if persistErr != nil {
+			return
+		}
+
 		_, err := dbw.persistBlockData(tx, block, epochStats, depositIndex, false, &canonicalForkId)
 		if err != nil {
-			dbw.indexer.logger.Errorf("error persisting slot: %v", err)
+			persistErr = fmt.Errorf("error persisting slot %v (%v): %v", block.Slot, block.Root.String(), err)
 		}
 	})
+	if persistErr != nil {
+		return persistErr
+	}
+
+	// demote all other canonical blocks in this epoch (e.g. left over from an interrupted reorg), so each slot has at most one canonical block
+	chainState := dbw.indexer.consensusPool.GetChainState()
+	canonicalRoots := make([][]byte, len(blocks))
+	for i, block := range blocks {
+		canonicalRoots[i] = block.Root[:]
+	}
+	err := db.UpdateOrphanedSlotsInRange(uint64(chainState.EpochToSlot(epoch)), uint64(chainState.EpochToSlot(epoch+1)-1), canonicalRoots, tx)
+	if err != nil {
+		return fmt.Errorf("error while updating orphaned slots: %w", err)
+	}
 
 	// insert missing slots
-	err := dbw.persistMissedSlots(tx, epoch, blocks, epochStats)
+	err = dbw.persistMissedSlots(tx, epoch, blocks, epochStats)
 	if err != nil {
 		return err
 	}
@@ -156,6 +252,12 @@ func (dbw *dbWriter) persistEpochData(tx *sqlx.Tx, epoch phase0.Epoch, blocks []
 		return fmt.Errorf("error while saving epoch to db: %w", err)
 	}
 
+	// track epochs with partial duties for the backfill
+	err = dbw.partialDuties.updateEpoch(tx, chainState.GetSpecs(), epoch, epochStats)
+	if err != nil {
+		return fmt.Errorf("error while updating partial duties state: %w", err)
+	}
+
 	return nil
 }
 
@@ -163,7 +265,7 @@ func (dbw *dbWriter) persistSyncAssignments(tx *sqlx.Tx, epoch phase0.Epoch, epo
 	chainState := dbw.indexer.consensusPool.GetChainState()
 	specs := chainState.GetSpecs()
 
-	if specs.AltairForkEpoch == nil || epoch < phase0.Epoch(*specs.AltairForkEpoch) {
+	if !specs.IsAltairActive(epoch) {
 		// no sync committees before altair
 		return nil
 	}
@@ -194,6 +296,22 @@ func (dbw *dbWriter) persistSyncAssignments(tx *sqlx.Tx, epoch phase0.Epoch, epo
 	return db.InsertSyncAssignments(syncAssignments, tx)
 }
 
+func (dbw *dbWriter) persistValidatorEvents(tx *sqlx.Tx, validatorEvents []*dbtypes.ValidatorEvent) error {
+	batchSize := 1000
+	for start := 0; start < len(validatorEvents); start += batchSize {
+		end := start + batchSize
+		if end > len(validatorEvents) {
+			end = len(validatorEvents)
+		}
+
+		if err := db.InsertValidatorEvents(validatorEvents[start:end], tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (dbw *dbWriter) buildDbBlock(block *Block, epochStats *EpochStats, overrideForkId *ForkKey) *dbtypes.Slot {
 	if block.Slot == 0 {
 		// genesis block
@@ -231,6 +349,10 @@ func (dbw *dbWriter) buildDbBlock(block *Block, epochStats *EpochStats, override
 		epochStatsValues = epochStats.GetValues(true)
 	}
 
+	chainState := dbw.indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	blockEpoch := chainState.EpochOfSlot(block.Slot)
+
 	graffiti, _ := blockBody.Graffiti()
 	attestations, _ := blockBody.Attestations()
 	deposits, _ := blockBody.Deposits()
@@ -244,6 +366,7 @@ func (dbw *dbWriter) buildDbBlock(block *Block, epochStats *EpochStats, override
 	executionExtraData, _ := getBlockExecutionExtraData(blockBody)
 	executionTransactions, _ := blockBody.ExecutionTransactions()
 	executionWithdrawals, _ := blockBody.Withdrawals()
+	blobKzgCommitments, _ := blockBody.BlobKZGCommitments()
 
 	var depositRequests []*electra.DepositRequest
 
@@ -268,15 +391,25 @@ func (dbw *dbWriter) buildDbBlock(block *Block, epochStats *EpochStats, override
 		AttesterSlashingCount: uint64(len(attesterSlashings)),
 		ProposerSlashingCount: uint64(len(proposerSlashings)),
 		BLSChangeCount:        uint64(len(blsToExecChanges)),
+		BlobCount:             uint64(len(blobKzgCommitments)),
 	}
 
 	if overrideForkId != nil {
 		dbBlock.ForkId = uint64(*overrideForkId)
 	}
 
-	if syncAggregate != nil {
+	if blockSizes, err := getBlockSszSizes(dbw.indexer.dynSsz, blockBody); err == nil {
+		dbBlock.BlockSize = blockSizes.Total
+		dbBlock.AttestationsSize = blockSizes.Attestations
+		dbBlock.PayloadSize = blockSizes.Payload
+		dbBlock.BlobRefsSize = blockSizes.BlobCommitments
+	} else {
+		dbw.indexer.logger.Warnf("error while building db blocks: failed calculating block sizes for slot %v: %v", block.Slot, err)
+	}
+
+	if syncAggregate != nil && specs.IsAltairActive(blockEpoch) {
 		var assignedCount int
-		if epochStatsValues != nil {
+		if epochStatsValues != nil && len(epochStatsValues.SyncCommitteeDuties) > 0 {
 			assignedCount = len(epochStatsValues.SyncCommitteeDuties)
 		} else {
 			// this is not accurate, but best we can get without epoch assignments
@@ -292,8 +425,24 @@ func (dbw *dbWriter) buildDbBlock(block *Block, epochStats *EpochStats, override
 		dbBlock.SyncParticipation = float32(votedCount) / float32(assignedCount)
 	}
 
-	if executionBlockNumber > 0 {
+	if executionBlockNumber > 0 && specs.IsBellatrixActive(blockEpoch) {
 		dbBlock.EthTransactionCount = uint64(len(executionTransactions))
+		txTypeCounts := utils.CountTransactionTypes(executionTransactions)
+		dbBlock.EthTxLegacyCount = txTypeCounts.Legacy
+		dbBlock.EthTxAccessListCount = txTypeCounts.AccessList
+		dbBlock.EthTxDynamicFeeCount = txTypeCounts.DynamicFee
+		dbBlock.EthTxBlobCount = txTypeCounts.Blob
+		dbBlock.EthTxSetCodeCount = txTypeCounts.SetCode
+		if blockFees, err := getBlockExecutionFees(blockBody); err == nil {
+			dbBlock.EthGasUsed = blockFees.GasUsed
+			dbBlock.EthBaseFee = blockFees.BaseFee
+			dbBlock.EthBurnedFees = blockFees.BurnedFees
+		}
+		if len(executionTransactions) == 0 {
+			// no transactions, no priority fees
+			noPriorityFees := uint64(0)
+			dbBlock.EthPriorityFees = &noPriorityFees
+		}
 		dbBlock.EthBlockNumber = &executionBlockNumber
 		dbBlock.EthBlockHash = executionBlockHash[:]
 		dbBlock.EthBlockExtra = executionExtraData
@@ -329,6 +478,11 @@ func (dbw *dbWriter) buildDbEpoch(epoch phase0.Epoch, blocks []*Block, epochStat
 		dbEpoch.VotedTarget = uint64(epochVotes.CurrentEpoch.TargetVoteAmount + epochVotes.NextEpoch.TargetVoteAmount)
 		dbEpoch.VotedHead = uint64(epochVotes.CurrentEpoch.HeadVoteAmount + epochVotes.NextEpoch.HeadVoteAmount)
 		dbEpoch.VotedTotal = uint64(epochVotes.CurrentEpoch.TotalVoteAmount + epochVotes.NextEpoch.TotalVoteAmount)
+		dbEpoch.InclusionDist1 = epochVotes.InclusionDistances[0]
+		dbEpoch.InclusionDist2 = epochVotes.InclusionDistances[1]
+		dbEpoch.InclusionDist3 = epochVotes.InclusionDistances[2]
+		dbEpoch.InclusionDistLate = epochVotes.InclusionDistances[3]
+		dbEpoch.InclusionDistSum = epochVotes.InclusionDistanceSum
 	}
 	if epochStatsValues != nil {
 		dbEpoch.ValidatorCount = epochStatsValues.ActiveValidators
@@ -337,6 +491,14 @@ func (dbw *dbWriter) buildDbEpoch(epoch phase0.Epoch, blocks []*Block, epochStat
 		depositIndexField := epochStatsValues.FirstDepositIndex
 		depositIndex = &depositIndexField
 	}
+	if epochStats != nil && epochStats.hasFinalityStats {
+		// finality delay as used by the inactivity leak condition (previous epoch - finalized epoch)
+		if epoch > epochStats.finalizedEpoch+1 {
+			dbEpoch.FinalityDelay = uint64(epoch - epochStats.finalizedEpoch - 1)
+		}
+		dbEpoch.PenalizedCount = epochStats.penalizedValidators
+		dbEpoch.PenaltyAmount = uint64(epochStats.penaltyAmount)
+	}
 
 	// aggregate blocks
 	blockIdx := 0
@@ -391,7 +553,7 @@ func (dbw *dbWriter) buildDbEpoch(epoch phase0.Epoch, blocks []*Block, epochStat
 			dbEpoch.ProposerSlashingCount += uint64(len(proposerSlashings))
 			dbEpoch.BLSChangeCount += uint64(len(blsToExecChanges))
 
-			if syncAggregate != nil && epochStatsValues != nil {
+			if syncAggregate != nil && epochStatsValues != nil && chainState.GetSpecs().IsAltairActive(epoch) {
 				votedCount := 0
 				assignedCount := len(epochStatsValues.SyncCommitteeDuties)
 				for i := 0; i < assignedCount; i++ {
@@ -407,6 +569,13 @@ func (dbw *dbWriter) buildDbEpoch(epoch phase0.Epoch, blocks []*Block, epochStat
 			dbEpoch.WithdrawCount += uint64(len(executionWithdrawals))
 			for _, withdrawal := range executionWithdrawals {
 				dbEpoch.WithdrawAmount += uint64(withdrawal.Amount)
+
+				// withdrawals of withdrawable (exited) validators are full withdrawals, all others are partial withdrawals
+				validator := dbw.indexer.validatorCache.getValidatorByIndexAndRoot(withdrawal.ValidatorIndex, block.Root)
+				if validator != nil && validator.WithdrawableEpoch <= epoch {
+					dbEpoch.FullWithdrawCount++
+					dbEpoch.FullWithdrawAmount += uint64(withdrawal.Amount)
+				}
 			}
 		}
 	}
@@ -608,19 +777,31 @@ func (dbw *dbWriter) buildDbSlashings(block *Block, orphaned bool, overrideForkI
 		return nil
 	}
 
+	// the block proposer is the whistleblower and receives the full whistleblower reward (slash_validator)
+	chainState := dbw.indexer.consensusPool.GetChainState()
+	rewardQuotient := phase0.Gwei(chainState.GetSpecs().GetWhistleblowerRewardQuotient(chainState.EpochOfSlot(block.Slot)))
+	getWhistleblowerReward := func(validatorIndex phase0.ValidatorIndex) uint64 {
+		validator := dbw.indexer.validatorCache.getValidatorByIndexAndRoot(validatorIndex, block.Root)
+		if validator == nil {
+			return 0
+		}
+		return uint64(validator.EffectiveBalance / rewardQuotient)
+	}
+
 	dbSlashings := []*dbtypes.Slashing{}
 	slashingIndex := 0
 
 	for _, proposerSlashing := range proposerSlashings {
 		dbSlashing := &dbtypes.Slashing{
-			SlotNumber:     uint64(block.Slot),
-			SlotIndex:      uint64(slashingIndex),
-			SlotRoot:       block.Root[:],
-			Orphaned:       orphaned,
-			ForkId:         uint64(block.forkId),
-			ValidatorIndex: uint64(proposerSlashing.SignedHeader1.Message.ProposerIndex),
-			SlasherIndex:   uint64(proposerIndex),
-			Reason:         dbtypes.ProposerSlashing,
+			SlotNumber:          uint64(block.Slot),
+			SlotIndex:           uint64(slashingIndex),
+			SlotRoot:            block.Root[:],
+			Orphaned:            orphaned,
+			ForkId:              uint64(block.forkId),
+			ValidatorIndex:      uint64(proposerSlashing.SignedHeader1.Message.ProposerIndex),
+			SlasherIndex:        uint64(proposerIndex),
+			Reason:              dbtypes.ProposerSlashing,
+			WhistleblowerReward: getWhistleblowerReward(proposerSlashing.SignedHeader1.Message.ProposerIndex),
 		}
 		if overrideForkId != nil {
 			dbSlashing.ForkId = uint64(*overrideForkId)
@@ -648,13 +829,18 @@ func (dbw *dbWriter) buildDbSlashings(block *Block, orphaned bool, overrideForkI
 			valIdx := j.(uint64)
 
 			dbSlashing := &dbtypes.Slashing{
-				SlotNumber:     uint64(block.Slot),
-				SlotIndex:      uint64(slashingIndex),
-				SlotRoot:       block.Root[:],
-				Orphaned:       orphaned,
-				ValidatorIndex: uint64(valIdx),
-				SlasherIndex:   uint64(proposerIndex),
-				Reason:         dbtypes.AttesterSlashing,
+				SlotNumber:          uint64(block.Slot),
+				SlotIndex:           uint64(slashingIndex),
+				SlotRoot:            block.Root[:],
+				Orphaned:            orphaned,
+				ForkId:              uint64(block.forkId),
+				ValidatorIndex:      uint64(valIdx),
+				SlasherIndex:        uint64(proposerIndex),
+				Reason:              dbtypes.AttesterSlashing,
+				WhistleblowerReward: getWhistleblowerReward(phase0.ValidatorIndex(valIdx)),
+			}
+			if overrideForkId != nil {
+				dbSlashing.ForkId = uint64(*overrideForkId)
 			}
 			dbSlashings = append(dbSlashings, dbSlashing)
 		}
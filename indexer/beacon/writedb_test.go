@@ -0,0 +1,234 @@
+package beacon
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	dynssz "github.com/pk910/dynamic-ssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+)
+
+const testSyncCommitteeSize = 512
+
+// newTestDbWriter returns a db writer for an indexer with a restored chain state for the given altair fork epoch.
+func newTestDbWriter(t *testing.T, altairForkEpoch uint64) *dbWriter {
+	t.Helper()
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	pool := consensus.NewPool(context.Background(), logger)
+	pool.GetChainState().RestoreCachedState(&consensus.ChainSpec{
+		SlotsPerEpoch:     32,
+		SecondsPerSlot:    12 * time.Second,
+		SyncCommitteeSize: testSyncCommitteeSize,
+		AltairForkEpoch:   &altairForkEpoch,
+	}, &v1.Genesis{
+		GenesisTime: time.Unix(1606824023, 0),
+	}, nil)
+
+	indexer := &Indexer{
+		logger:        logger,
+		consensusPool: pool,
+		dynSsz:        dynssz.NewDynSsz(nil),
+	}
+
+	return newDbWriter(indexer)
+}
+
+// newTestBlock returns a block with a phase0 or altair body (altair bodies carry a sync aggregate with the given number of participants).
+func newTestBlock(dbw *dbWriter, slot phase0.Slot, version spec.DataVersion, syncParticipants int) *Block {
+	root := phase0.Root{byte(slot), byte(slot >> 8), 0x01}
+	block := newBlock(dbw.indexer.dynSsz, root, slot)
+	block.SetHeader(&phase0.SignedBeaconBlockHeader{
+		Message: &phase0.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: phase0.ValidatorIndex(slot),
+		},
+	})
+
+	attestations := []*phase0.Attestation{{
+		AggregationBits: bitfield.NewBitlist(8),
+		Data: &phase0.AttestationData{
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}}
+
+	switch version {
+	case spec.DataVersionPhase0:
+		block.SetBlock(&spec.VersionedSignedBeaconBlock{
+			Version: version,
+			Phase0: &phase0.SignedBeaconBlock{
+				Message: &phase0.BeaconBlock{
+					Slot: slot,
+					Body: &phase0.BeaconBlockBody{
+						ETH1Data:     &phase0.ETH1Data{},
+						Attestations: attestations,
+					},
+				},
+			},
+		})
+	case spec.DataVersionAltair:
+		syncBits := bitfield.NewBitvector512()
+		for i := 0; i < syncParticipants; i++ {
+			syncBits.SetBitAt(uint64(i), true)
+		}
+
+		block.SetBlock(&spec.VersionedSignedBeaconBlock{
+			Version: version,
+			Altair: &altair.SignedBeaconBlock{
+				Message: &altair.BeaconBlock{
+					Slot: slot,
+					Body: &altair.BeaconBlockBody{
+						ETH1Data:     &phase0.ETH1Data{},
+						Attestations: attestations,
+						SyncAggregate: &altair.SyncAggregate{
+							SyncCommitteeBits: syncBits,
+						},
+					},
+				},
+			},
+		})
+	default:
+		panic("unsupported test block version")
+	}
+
+	return block
+}
+
+func newTestEpochStats(epoch phase0.Epoch, withSyncDuties bool) *EpochStats {
+	epochStats := newEpochStats(epoch, phase0.Root{})
+	epochStats.values = &EpochStatsValues{
+		ActiveValidators: 1000,
+		ActiveBalance:    32000 * EtherGweiFactor,
+		EffectiveBalance: 32000 * EtherGweiFactor,
+	}
+	if withSyncDuties {
+		epochStats.values.SyncCommitteeDuties = make([]phase0.ValidatorIndex, testSyncCommitteeSize)
+	}
+
+	return epochStats
+}
+
+func TestBuildDbEpoch(t *testing.T) {
+	tests := []struct {
+		name              string
+		altairForkEpoch   uint64
+		version           spec.DataVersion
+		withSyncDuties    bool
+		syncParticipants  int
+		blockCount        int
+		syncParticipation float32
+	}{
+		{"phase0, altair far future", math.MaxUint64, spec.DataVersionPhase0, false, 0, 32, 0},
+		{"altair blocks, altair far future", math.MaxUint64, spec.DataVersionAltair, true, 512, 16, 0},
+		{"altair, no epoch stats duties", 0, spec.DataVersionAltair, false, 512, 16, 0},
+		{"altair, full participation", 0, spec.DataVersionAltair, true, 512, 16, 1},
+		{"altair, half participation", 0, spec.DataVersionAltair, true, 256, 32, 0.5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dbw := newTestDbWriter(t, test.altairForkEpoch)
+			epoch := phase0.Epoch(10)
+			firstSlot := phase0.Slot(epoch) * 32
+
+			blocks := make([]*Block, 0, test.blockCount)
+			for i := 0; i < test.blockCount; i++ {
+				// leave gaps between blocks, so missed slots are skipped by the aggregation
+				slot := firstSlot + phase0.Slot(i*32/test.blockCount)
+				blocks = append(blocks, newTestBlock(dbw, slot, test.version, test.syncParticipants))
+			}
+
+			aggregated := 0
+			dbEpoch := dbw.buildDbEpoch(epoch, blocks, newTestEpochStats(epoch, test.withSyncDuties), nil, func(block *Block, depositIndex *uint64) {
+				aggregated++
+			})
+
+			if dbEpoch.Epoch != uint64(epoch) {
+				t.Errorf("expected epoch %v, got %v", epoch, dbEpoch.Epoch)
+			}
+			if dbEpoch.BlockCount != uint16(test.blockCount) || aggregated != test.blockCount {
+				t.Errorf("expected %v blocks, got %v (callbacks: %v)", test.blockCount, dbEpoch.BlockCount, aggregated)
+			}
+			if dbEpoch.AttestationCount != uint64(test.blockCount) {
+				t.Errorf("expected %v attestations, got %v", test.blockCount, dbEpoch.AttestationCount)
+			}
+			if dbEpoch.ValidatorCount != 1000 {
+				t.Errorf("expected 1000 validators, got %v", dbEpoch.ValidatorCount)
+			}
+			if dbEpoch.SyncParticipation != test.syncParticipation {
+				t.Errorf("expected sync participation %v, got %v", test.syncParticipation, dbEpoch.SyncParticipation)
+			}
+		})
+	}
+}
+
+func TestBuildDbEpochWithoutStats(t *testing.T) {
+	dbw := newTestDbWriter(t, 0)
+	epoch := phase0.Epoch(3)
+	blocks := []*Block{
+		newTestBlock(dbw, phase0.Slot(epoch)*32, spec.DataVersionAltair, 512),
+	}
+
+	dbEpoch := dbw.buildDbEpoch(epoch, blocks, nil, nil, nil)
+	if dbEpoch.BlockCount != 1 {
+		t.Errorf("expected 1 block, got %v", dbEpoch.BlockCount)
+	}
+	if dbEpoch.ValidatorCount != 0 || dbEpoch.SyncParticipation != 0 {
+		t.Errorf("expected no validator stats without epoch stats, got %v validators, %v sync participation", dbEpoch.ValidatorCount, dbEpoch.SyncParticipation)
+	}
+}
+
+func TestBuildDbBlockSyncAggregate(t *testing.T) {
+	tests := []struct {
+		name              string
+		altairForkEpoch   uint64
+		version           spec.DataVersion
+		withSyncDuties    bool
+		syncParticipants  int
+		syncParticipation float32
+	}{
+		{"phase0, altair far future", math.MaxUint64, spec.DataVersionPhase0, false, 0, 0},
+		{"altair block, altair far future", math.MaxUint64, spec.DataVersionAltair, true, 512, 0},
+		{"altair, full participation", 0, spec.DataVersionAltair, true, 512, 1},
+		{"altair, quarter participation", 0, spec.DataVersionAltair, true, 128, 0.25},
+		{"altair, no epoch stats duties", 0, spec.DataVersionAltair, false, 384, 0.75},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dbw := newTestDbWriter(t, test.altairForkEpoch)
+			epoch := phase0.Epoch(10)
+			slot := phase0.Slot(epoch)*32 + 5
+			block := newTestBlock(dbw, slot, test.version, test.syncParticipants)
+
+			dbBlock := dbw.buildDbBlock(block, newTestEpochStats(epoch, test.withSyncDuties), nil)
+			if dbBlock == nil {
+				t.Fatalf("expected db block, got nil")
+			}
+			if dbBlock.Slot != uint64(slot) || dbBlock.Proposer != uint64(slot) {
+				t.Errorf("unexpected slot/proposer: %v/%v", dbBlock.Slot, dbBlock.Proposer)
+			}
+			if dbBlock.AttestationCount != 1 {
+				t.Errorf("expected 1 attestation, got %v", dbBlock.AttestationCount)
+			}
+			if dbBlock.SyncParticipation != test.syncParticipation {
+				t.Errorf("expected sync participation %v, got %v", test.syncParticipation, dbBlock.SyncParticipation)
+			}
+			if dbBlock.EthBlockNumber != nil {
+				t.Errorf("expected no execution payload before bellatrix")
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+// writeQueueMaxReplayBackoff is the max delay between replay attempts of a queued epoch that failed to persist.
+const writeQueueMaxReplayBackoff = 10 * time.Minute
+
+var (
+	writeQueueEpochsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_indexer_write_queue_epochs",
+		Help: "Number of synchronized epochs waiting in the write queue",
+	})
+	writeQueueReplayFailuresGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_indexer_write_queue_replay_failures",
+		Help: "Number of consecutive failed replays of the oldest queued epoch (> 0 = replay is stuck)",
+	})
+	writeQueueReplayFailuresCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_indexer_write_queue_replay_failures_total",
+		Help: "Number of failed replays of queued epochs with a reachable database",
+	})
+)
+
+// writeQueue buffers synchronized epochs in a bounded on-disk queue while the database is unavailable.
+// queued epochs are replayed in order as soon as the database is reachable again.
+type writeQueue struct {
+	indexer *Indexer
+	logger  logrus.FieldLogger
+	dir     string
+	maxSize uint64
+	mutex   sync.Mutex
+	entries []*writeQueueFile
+	size    uint64
+}
+
+// writeQueueFile references a queued epoch on disk.
+type writeQueueFile struct {
+	path  string
+	epoch phase0.Epoch
+	size  uint64
+}
+
+// writeQueueEntry holds all data needed to persist a synchronized epoch (gob encoded on disk).
+type writeQueueEntry struct {
+	Epoch         phase0.Epoch
+	DependentRoot phase0.Root
+	StatsSSZ      []byte
+	Votes         *EpochVotes
+	Blocks        []*writeQueueBlock
+}
+
+// writeQueueBlock holds the serialized header & body of a queued canonical block.
+type writeQueueBlock struct {
+	Root      phase0.Root
+	Slot      phase0.Slot
+	HeaderSSZ []byte
+	BlockVer  uint64
+	BlockSSZ  []byte
+}
+
+// newWriteQueue creates the write queue and restores queued epochs from disk.
+// returns nil if the write queue is disabled.
+func newWriteQueue(indexer *Indexer) *writeQueue {
+	queueDir := utils.Config.Indexer.WriteQueueDir
+	if queueDir == "" {
+		return nil
+	}
+
+	maxSize := uint64(utils.Config.Indexer.WriteQueueMaxSize)
+	if maxSize == 0 {
+		maxSize = 256
+	}
+
+	queue := &writeQueue{
+		indexer: indexer,
+		logger:  indexer.logger.WithField("service", "write-queue"),
+		dir:     queueDir,
+		maxSize: maxSize * 1024 * 1024,
+	}
+
+	if err := os.MkdirAll(queueDir, 0755); err != nil {
+		queue.logger.Errorf("failed creating write queue directory, write queue disabled: %v", err)
+		return nil
+	}
+
+	if err := queue.loadEntries(); err != nil {
+		queue.logger.Errorf("failed loading write queue entries: %v", err)
+	}
+
+	if len(queue.entries) > 0 {
+		queue.logger.Infof("restored %v queued epochs (%v bytes) from write queue", len(queue.entries), queue.size)
+	}
+	writeQueueEpochsGauge.Set(float64(len(queue.entries)))
+
+	go queue.runReplayLoop()
+
+	return queue
+}
+
+// loadEntries restores the list of queued epochs from the queue directory.
+func (queue *writeQueue) loadEntries() error {
+	files, err := os.ReadDir(queue.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		filePath := filepath.Join(queue.dir, file.Name())
+		if strings.HasSuffix(file.Name(), ".tmp") {
+			// incomplete write
+			os.Remove(filePath)
+			continue
+		}
+
+		var epoch uint64
+		if _, err := fmt.Sscanf(file.Name(), "epoch-%020d.wq", &epoch); err != nil {
+			continue
+		}
+
+		fileInfo, err := file.Info()
+		if err != nil {
+			return err
+		}
+
+		queue.entries = append(queue.entries, &writeQueueFile{
+			path:  filePath,
+			epoch: phase0.Epoch(epoch),
+			size:  uint64(fileInfo.Size()),
+		})
+		queue.size += uint64(fileInfo.Size())
+	}
+
+	sort.Slice(queue.entries, func(i, j int) bool {
+		return queue.entries[i].epoch < queue.entries[j].epoch
+	})
+
+	return nil
+}
+
+// isEmpty returns true if there are no queued epochs (or the write queue is disabled).
+func (queue *writeQueue) isEmpty() bool {
+	if queue == nil {
+		return true
+	}
+
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return len(queue.entries) == 0
+}
+
+// enqueueEpoch serializes the synchronized epoch and appends it to the queue.
+func (queue *writeQueue) enqueueEpoch(epoch phase0.Epoch, canonicalBlocks []*Block, epochStats *EpochStats, epochVotes *EpochVotes) error {
+	entry := &writeQueueEntry{
+		Epoch:  epoch,
+		Votes:  epochVotes,
+		Blocks: make([]*writeQueueBlock, 0, len(canonicalBlocks)),
+	}
+
+	if epochStats != nil {
+		entry.DependentRoot = epochStats.dependentRoot
+		if epochStats.values != nil {
+			statsSSZ, err := epochStats.buildPackedSSZ(queue.indexer.dynSsz)
+			if err != nil {
+				return fmt.Errorf("failed serializing epoch stats: %v", err)
+			}
+			entry.StatsSSZ = statsSSZ
+		}
+	}
+
+	for _, block := range canonicalBlocks {
+		queueBlock := &writeQueueBlock{
+			Root: block.Root,
+			Slot: block.Slot,
+		}
+
+		headerSSZ, err := block.GetHeader().MarshalSSZ()
+		if err != nil {
+			return fmt.Errorf("failed serializing block header %v: %v", block.Slot, err)
+		}
+		queueBlock.HeaderSSZ = headerSSZ
+
+		if blockBody := block.GetBlock(); blockBody != nil {
+			queueBlock.BlockVer, queueBlock.BlockSSZ, err = marshalVersionedSignedBeaconBlockSSZ(queue.indexer.dynSsz, blockBody, queue.indexer.blockCompression)
+			if err != nil {
+				return fmt.Errorf("failed serializing block %v: %v", block.Slot, err)
+			}
+		}
+
+		entry.Blocks = append(entry.Blocks, queueBlock)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(entry); err != nil {
+		return fmt.Errorf("failed encoding queue entry: %v", err)
+	}
+
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	entrySize := uint64(buf.Len())
+	if queue.size+entrySize > queue.maxSize {
+		return fmt.Errorf("write queue full (%v bytes queued)", queue.size)
+	}
+
+	filePath := filepath.Join(queue.dir, fmt.Sprintf("epoch-%020d.wq", epoch))
+	if err := os.WriteFile(filePath+".tmp", buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed writing queue file: %v", err)
+	}
+	if err := os.Rename(filePath+".tmp", filePath); err != nil {
+		return fmt.Errorf("failed writing queue file: %v", err)
+	}
+
+	queue.size += entrySize
+	for _, entry := range queue.entries {
+		if entry.epoch == epoch {
+			// epoch has been synchronized again, the queue file has been replaced
+			queue.size -= entry.size
+			entry.size = entrySize
+			return nil
+		}
+	}
+
+	queue.entries = append(queue.entries, &writeQueueFile{
+		path:  filePath,
+		epoch: epoch,
+		size:  entrySize,
+	})
+	writeQueueEpochsGauge.Set(float64(len(queue.entries)))
+
+	return nil
+}
+
+// runReplayLoop replays queued epochs as soon as the database is reachable.
+// queued epochs are never dropped: a failing epoch is retried with increasing backoff and blocks the following epochs,
+// so the persisted chain has no gaps. the synchronizer keeps queueing new epochs until the queue is full and stalls then.
+func (queue *writeQueue) runReplayLoop() {
+	defer utils.HandleSubroutinePanic("writeQueue.runReplayLoop", queue.runReplayLoop)
+
+	failures := 0
+	for {
+		entry := queue.getFirstEntry()
+		if entry == nil {
+			time.Sleep(10 * time.Second)
+			continue
+		}
+
+		if err := db.PingWriterDb(); err != nil {
+			queue.logger.Debugf("database still unavailable, %v epochs queued: %v", queue.getEntryCount(), err)
+			time.Sleep(10 * time.Second)
+			continue
+		}
+
+		if err := queue.replayEntry(entry); err != nil {
+			failures++
+			writeQueueReplayFailuresGauge.Set(float64(failures))
+			writeQueueReplayFailuresCounter.Inc()
+
+			backoff := queue.getReplayBackoff(failures)
+			queue.logger.Errorf("failed replaying queued epoch %v (attempt %v, retrying in %v, %v epochs queued): %v", entry.epoch, failures, backoff, queue.getEntryCount(), err)
+			time.Sleep(backoff)
+			continue
+		}
+
+		queue.logger.Infof("replayed queued epoch %v", entry.epoch)
+		failures = 0
+		writeQueueReplayFailuresGauge.Set(0)
+		queue.removeFirstEntry()
+	}
+}
+
+// getReplayBackoff returns the delay before the next replay attempt (10s, doubled per failure, capped at writeQueueMaxReplayBackoff).
+func (queue *writeQueue) getReplayBackoff(failures int) time.Duration {
+	backoff := 10 * time.Second
+	for i := 1; i < failures && backoff < writeQueueMaxReplayBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > writeQueueMaxReplayBackoff {
+		backoff = writeQueueMaxReplayBackoff
+	}
+
+	return backoff
+}
+
+func (queue *writeQueue) getFirstEntry() *writeQueueFile {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if len(queue.entries) == 0 {
+		return nil
+	}
+
+	return queue.entries[0]
+}
+
+func (queue *writeQueue) getEntryCount() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return len(queue.entries)
+}
+
+func (queue *writeQueue) removeFirstEntry() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	entry := queue.entries[0]
+	queue.entries = queue.entries[1:]
+	queue.size -= entry.size
+	writeQueueEpochsGauge.Set(float64(len(queue.entries)))
+
+	if err := os.Remove(entry.path); err != nil {
+		queue.logger.Warnf("failed removing queue file %v: %v", entry.path, err)
+	}
+}
+
+// replayEntry restores the queued epoch from disk and persists it to the database.
+func (queue *writeQueue) replayEntry(file *writeQueueFile) error {
+	fileData, err := os.ReadFile(file.path)
+	if err != nil {
+		return fmt.Errorf("failed reading queue file: %v", err)
+	}
+
+	entry := &writeQueueEntry{}
+	if err := gob.NewDecoder(bytes.NewReader(fileData)).Decode(entry); err != nil {
+		return fmt.Errorf("failed decoding queue entry: %v", err)
+	}
+
+	chainState := queue.indexer.consensusPool.GetChainState()
+	dynSsz := queue.indexer.dynSsz
+
+	canonicalBlocks := make([]*Block, 0, len(entry.Blocks))
+	for _, queueBlock := range entry.Blocks {
+		block := newBlock(dynSsz, queueBlock.Root, queueBlock.Slot)
+
+		header := &phase0.SignedBeaconBlockHeader{}
+		if err := header.UnmarshalSSZ(queueBlock.HeaderSSZ); err != nil {
+			return fmt.Errorf("failed unmarshal block header %v: %v", queueBlock.Slot, err)
+		}
+		block.SetHeader(header)
+
+		if len(queueBlock.BlockSSZ) > 0 {
+			blockBody, err := unmarshalVersionedSignedBeaconBlockSSZ(dynSsz, queueBlock.BlockVer, queueBlock.BlockSSZ)
+			if err != nil {
+				return fmt.Errorf("failed unmarshal block %v: %v", queueBlock.Slot, err)
+			}
+			block.SetBlock(blockBody)
+		}
+
+		canonicalBlocks = append(canonicalBlocks, block)
+	}
+
+	var epochStats *EpochStats
+	if len(entry.StatsSSZ) > 0 {
+		epochStats = newEpochStats(entry.Epoch, entry.DependentRoot)
+		err := epochStats.restoreFromDb(&dbtypes.UnfinalizedDuty{
+			Epoch:         uint64(entry.Epoch),
+			DependentRoot: entry.DependentRoot[:],
+			DutiesSSZ:     entry.StatsSSZ,
+		}, dynSsz, chainState, true)
+		if err != nil {
+			return fmt.Errorf("failed restoring epoch stats: %v", err)
+		}
+	}
+
+	return queue.indexer.synchronizer.persistEpoch(entry.Epoch, canonicalBlocks, epochStats, entry.Votes)
+}
@@ -0,0 +1,192 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// priorityFeeBatchSize is the number of blocks loaded from the db per batch.
+const priorityFeeBatchSize = 100
+
+// priorityFeeMaxAttempts is the number of failed attempts after which a block is considered unavailable on the execution clients.
+const priorityFeeMaxAttempts = 3
+
+// PriorityFeeIndexer loads the priority fees of finalized canonical blocks from the block receipts of the execution clients.
+// blocks are processed newest first, so recent blocks are available quickly while historic blocks are filled up with a rate limit.
+type PriorityFeeIndexer struct {
+	indexerCtx   *IndexerCtx
+	logger       logrus.FieldLogger
+	requestDelay time.Duration
+	minSlot      uint64
+	failures     map[common.Hash]int
+}
+
+// NewPriorityFeeIndexer creates a new priority fee indexer
+func NewPriorityFeeIndexer(indexer *IndexerCtx) *PriorityFeeIndexer {
+	if utils.Config.ExecutionApi.DisablePriorityFees {
+		return nil
+	}
+
+	requestRate := utils.Config.ExecutionApi.PriorityFeeRate
+	if requestRate == 0 {
+		requestRate = 5
+	}
+
+	pi := &PriorityFeeIndexer{
+		indexerCtx:   indexer,
+		logger:       indexer.logger.WithField("indexer", "priorityfees"),
+		requestDelay: time.Second / time.Duration(requestRate),
+		failures:     map[common.Hash]int{},
+	}
+
+	go pi.runPriorityFeeIndexerLoop()
+
+	return pi
+}
+
+// runPriorityFeeIndexerLoop is the main loop for the priority fee indexer
+func (pi *PriorityFeeIndexer) runPriorityFeeIndexerLoop() {
+	defer utils.HandleSubroutinePanic("PriorityFeeIndexer.runPriorityFeeIndexerLoop", pi.runPriorityFeeIndexerLoop)
+
+	for {
+		processed, err := pi.processNextBatch()
+		if err != nil {
+			pi.logger.Warnf("priority fee indexer error: %v", err)
+		}
+		if !processed {
+			time.Sleep(30 * time.Second)
+		}
+	}
+}
+
+// processNextBatch loads the priority fees for the next batch of blocks, returns false if there was nothing to process.
+func (pi *PriorityFeeIndexer) processNextBatch() (bool, error) {
+	slots := db.GetSlotsWithoutPriorityFees(pi.minSlot, math.MaxInt64, priorityFeeBatchSize)
+	if len(slots) == 0 {
+		return false, nil
+	}
+
+	clients := pi.getClients()
+	if len(clients) == 0 {
+		return false, fmt.Errorf("no ready execution clients")
+	}
+
+	for _, slot := range slots {
+		blockHash := common.BytesToHash(slot.EthBlockHash)
+		priorityFees, err := pi.loadPriorityFees(clients, slot)
+		if err != nil {
+			pi.failures[blockHash]++
+			if pi.failures[blockHash] < priorityFeeMaxAttempts {
+				return false, fmt.Errorf("failed loading priority fees for slot %v: %v", slot.Slot, err)
+			}
+
+			// history is most likely pruned on all clients, skip all older blocks
+			delete(pi.failures, blockHash)
+			pi.minSlot = slot.Slot + 1
+			pi.logger.Warnf("block receipts for slot %v not available (%v), skipping priority fees for older blocks", slot.Slot, err)
+			return true, nil
+		}
+		delete(pi.failures, blockHash)
+
+		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			return db.UpdateSlotPriorityFees(slot.Root, priorityFees, tx)
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed persisting priority fees for slot %v: %v", slot.Slot, err)
+		}
+
+		time.Sleep(pi.requestDelay)
+	}
+
+	return true, nil
+}
+
+// getClients returns the ready execution clients sorted by priority (archive clients first)
+func (pi *PriorityFeeIndexer) getClients() []*execution.Client {
+	clients := pi.indexerCtx.executionPool.GetReadyEndpoints(execution.AnyClient)
+	sort.Slice(clients, func(i, j int) bool {
+		return pi.indexerCtx.sortClients(clients[i], clients[j], true)
+	})
+	return clients
+}
+
+// loadPriorityFees calculates the priority fees (in gwei) paid to the fee recipient of a block from its receipts.
+func (pi *PriorityFeeIndexer) loadPriorityFees(clients []*execution.Client, slot *dbtypes.Slot) (uint64, error) {
+	if slot.EthTransactionCount == 0 {
+		return 0, nil
+	}
+
+	blockHash := common.BytesToHash(slot.EthBlockHash)
+	var lastErr error
+	for _, client := range clients {
+		priorityFees, err := pi.loadPriorityFeesFromClient(client, blockHash, slot)
+		if err == nil {
+			return priorityFees, nil
+		}
+
+		pi.logger.Debugf("failed loading receipts for block %v from %v: %v", blockHash.String(), client.GetName(), err)
+		lastErr = err
+	}
+
+	return 0, lastErr
+}
+
+func (pi *PriorityFeeIndexer) loadPriorityFeesFromClient(client *execution.Client, blockHash common.Hash, slot *dbtypes.Slot) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	baseFee := new(big.Int).SetUint64(slot.EthBaseFee)
+	if slot.EthBaseFee == 0 || slot.EthBaseFee == math.MaxInt64 {
+		// base fee not backfilled yet or clamped to the db column range, load it from the block header
+		header, err := client.GetRPCClient().GetHeaderByHash(ctx, blockHash)
+		if err != nil {
+			return 0, fmt.Errorf("failed loading header: %v", err)
+		}
+		if header.BaseFee != nil {
+			baseFee = header.BaseFee
+		}
+	}
+
+	receipts, err := client.GetRPCClient().GetBlockReceipts(ctx, blockHash)
+	if err != nil {
+		return 0, err
+	}
+	if uint64(len(receipts)) != slot.EthTransactionCount {
+		return 0, fmt.Errorf("unexpected receipt count: %v, expected %v", len(receipts), slot.EthTransactionCount)
+	}
+
+	priorityFees := new(big.Int)
+	for _, receipt := range receipts {
+		if receipt.EffectiveGasPrice == nil {
+			continue
+		}
+
+		tip := new(big.Int).Sub(receipt.EffectiveGasPrice, baseFee)
+		if tip.Sign() <= 0 {
+			continue
+		}
+
+		priorityFees.Add(priorityFees, tip.Mul(tip, new(big.Int).SetUint64(receipt.GasUsed)))
+	}
+
+	priorityFees.Div(priorityFees, big.NewInt(1000000000))
+	if !priorityFees.IsInt64() {
+		// clamp to the range of the signed bigint db column
+		priorityFees.SetInt64(math.MaxInt64)
+	}
+
+	return priorityFees.Uint64(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	"net/http"
 	"net/url"
@@ -308,6 +309,10 @@ func (mev *MevIndexer) loadMevBlocksFromRelay(relay *types.MevRelayConfig) error
 			}
 			blockValueBytes := blockValue.Bytes()
 			blockValueGwei := big.NewInt(0).Div(blockValue, utils.GWEI)
+			if !blockValueGwei.IsInt64() {
+				// clamp absurd bids (e.g. on spam-test devnets) to the bigint column range, the exact value is kept in block_value
+				blockValueGwei.SetInt64(math.MaxInt64)
+			}
 
 			validatorPubkey := phase0.BLSPubKey(common.FromHex(blockData.ProposerPubkey))
 			validatorIndex, found := mev.beaconIndexer.GetValidatorIndexByPubkey(validatorPubkey)
@@ -0,0 +1,122 @@
+// Package plugins provides compile-time extension points for custom explorer builds.
+//
+// Plugins register themselves via Register from an init function of their package and are
+// enabled by importing that package into the explorer binary (see cmd/dora-explorer/plugins.go).
+// A plugin implements the Plugin interface and any number of the optional hook interfaces below.
+package plugins
+
+import (
+	"fmt"
+	"html/template"
+	"sort"
+	"sync"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+// Plugin is the base interface all plugins need to implement.
+type Plugin interface {
+	// Name returns the unique name of the plugin.
+	Name() string
+}
+
+// RouteProvider is implemented by plugins that serve additional pages or api endpoints.
+// Routes are registered after the core routes, so core routes always take precedence.
+type RouteProvider interface {
+	RegisterRoutes(router *mux.Router, apiRouter *mux.Router)
+}
+
+// TemplateFuncProvider is implemented by plugins that provide additional template functions.
+// Functions that collide with core template functions are ignored.
+type TemplateFuncProvider interface {
+	TemplateFuncs() template.FuncMap
+}
+
+// IndexerContext gives indexer hooks access to the running explorer services.
+type IndexerContext struct {
+	Logger        logrus.FieldLogger
+	ConsensusPool *consensus.Pool
+	BeaconIndexer *beacon.Indexer
+}
+
+// IndexerHook is implemented by plugins that need to run alongside the indexer (e.g. custom data sources).
+// StartIndexerHook is called once after the beacon indexer has been started.
+type IndexerHook interface {
+	StartIndexerHook(ctx *IndexerContext) error
+}
+
+// CanonicalHeadHook is implemented by plugins that want to be notified about canonical head changes.
+type CanonicalHeadHook interface {
+	OnCanonicalHead(headBlock *beacon.Block)
+}
+
+// FinalityHook is implemented by plugins that want to be notified about finality checkpoint updates.
+type FinalityHook interface {
+	OnFinalityUpdate(finality *v1.Finality)
+}
+
+var (
+	pluginsMutex sync.RWMutex
+	plugins      = map[string]Plugin{}
+)
+
+// Register registers a plugin. It is meant to be called from the init function of the plugin package
+// and panics if a plugin with the same name has already been registered.
+func Register(plugin Plugin) {
+	pluginsMutex.Lock()
+	defer pluginsMutex.Unlock()
+
+	name := plugin.Name()
+	if _, exists := plugins[name]; exists {
+		panic(fmt.Sprintf("plugin %v registered twice", name))
+	}
+
+	plugins[name] = plugin
+}
+
+// GetPlugins returns all registered plugins sorted by name.
+func GetPlugins() []Plugin {
+	pluginsMutex.RLock()
+	defer pluginsMutex.RUnlock()
+
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]Plugin, 0, len(names))
+	for _, name := range names {
+		result = append(result, plugins[name])
+	}
+
+	return result
+}
+
+// RegisterRoutes registers the routes of all plugins implementing RouteProvider.
+func RegisterRoutes(router *mux.Router, apiRouter *mux.Router) {
+	for _, plugin := range GetPlugins() {
+		if routeProvider, ok := plugin.(RouteProvider); ok {
+			routeProvider.RegisterRoutes(router, apiRouter)
+		}
+	}
+}
+
+// GetTemplateFuncs returns the template functions of all plugins implementing TemplateFuncProvider.
+func GetTemplateFuncs() template.FuncMap {
+	funcs := template.FuncMap{}
+	for _, plugin := range GetPlugins() {
+		if funcProvider, ok := plugin.(TemplateFuncProvider); ok {
+			for name, fn := range funcProvider.TemplateFuncs() {
+				funcs[name] = fn
+			}
+		}
+	}
+
+	return funcs
+}
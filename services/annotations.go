@@ -0,0 +1,179 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+const (
+	maxAnnotationLabelLength       = 100
+	maxAnnotationDescriptionLength = 2000
+)
+
+// Annotations keeps the operator provided incident annotations of slot ranges in memory.
+// annotations are rarely changed and few in number, so the full list is cached and reloaded on changes.
+type Annotations struct {
+	mutex       sync.RWMutex
+	loaded      bool
+	annotations []*dbtypes.Annotation
+}
+
+func newAnnotations() *Annotations {
+	return &Annotations{}
+}
+
+func (a *Annotations) getAll() ([]*dbtypes.Annotation, error) {
+	a.mutex.RLock()
+	if a.loaded {
+		defer a.mutex.RUnlock()
+		return a.annotations, nil
+	}
+	a.mutex.RUnlock()
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !a.loaded {
+		annotations, err := db.GetAnnotations()
+		if err != nil {
+			return nil, err
+		}
+		a.annotations = annotations
+		a.loaded = true
+	}
+
+	return a.annotations, nil
+}
+
+func (a *Annotations) reset() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.loaded = false
+	a.annotations = nil
+}
+
+// GetAnnotationsCacheKey returns a short fingerprint of the current annotation set for use in page cache keys.
+// annotations cannot be edited and ids are never reused, so the highest id and the count identify the set.
+func (bs *ChainService) GetAnnotationsCacheKey() string {
+	annotations, err := bs.annotations.getAll()
+	if err != nil {
+		return "0-0"
+	}
+
+	maxId := uint64(0)
+	for _, annotation := range annotations {
+		if annotation.Id > maxId {
+			maxId = annotation.Id
+		}
+	}
+	return fmt.Sprintf("%v-%v", maxId, len(annotations))
+}
+
+// GetAnnotations returns all annotations that overlap with the given slot range (inclusive), ordered by start slot.
+func (bs *ChainService) GetAnnotations(firstSlot uint64, lastSlot uint64) []*dbtypes.Annotation {
+	annotations, err := bs.annotations.getAll()
+	if err != nil {
+		bs.logger.Errorf("error loading annotations: %v", err)
+		return []*dbtypes.Annotation{}
+	}
+
+	result := []*dbtypes.Annotation{}
+	for _, annotation := range annotations {
+		if annotation.StartSlot <= lastSlot && annotation.EndSlot >= firstSlot {
+			result = append(result, annotation)
+		}
+	}
+	return result
+}
+
+// GetAnnotationsBySlot returns the overlapping annotations of the given slot range (inclusive) indexed by slot.
+// slots without annotations are not included in the map.
+func (bs *ChainService) GetAnnotationsBySlot(firstSlot uint64, lastSlot uint64) map[uint64][]*dbtypes.Annotation {
+	result := map[uint64][]*dbtypes.Annotation{}
+	for _, annotation := range bs.GetAnnotations(firstSlot, lastSlot) {
+		startSlot := annotation.StartSlot
+		if startSlot < firstSlot {
+			startSlot = firstSlot
+		}
+		endSlot := annotation.EndSlot
+		if endSlot > lastSlot {
+			endSlot = lastSlot
+		}
+
+		for slot := startSlot; slot <= endSlot; slot++ {
+			result[slot] = append(result[slot], annotation)
+		}
+	}
+	return result
+}
+
+// ValidateAnnotation checks the user provided fields of an annotation.
+func ValidateAnnotation(startSlot uint64, endSlot uint64, label string, description string) error {
+	if endSlot < startSlot {
+		return fmt.Errorf("end slot must not be before start slot")
+	}
+	if label == "" {
+		return fmt.Errorf("label must not be empty")
+	}
+	if len(label) > maxAnnotationLabelLength {
+		return fmt.Errorf("label must not be longer than %v characters", maxAnnotationLabelLength)
+	}
+	if len(description) > maxAnnotationDescriptionLength {
+		return fmt.Errorf("description must not be longer than %v characters", maxAnnotationDescriptionLength)
+	}
+	return nil
+}
+
+// CreateAnnotation validates and stores a new annotation for the given slot range (inclusive).
+func (bs *ChainService) CreateAnnotation(startSlot uint64, endSlot uint64, label string, description string) (*dbtypes.Annotation, error) {
+	if err := ValidateAnnotation(startSlot, endSlot, label, description); err != nil {
+		return nil, err
+	}
+
+	annotation := &dbtypes.Annotation{
+		StartSlot:   startSlot,
+		EndSlot:     endSlot,
+		Label:       label,
+		Description: description,
+		CreatedAt:   uint64(time.Now().Unix()),
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertAnnotation(annotation, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bs.annotations.reset()
+	bs.logger.Infof("created annotation %v for slots %v-%v: %v", annotation.Id, startSlot, endSlot, label)
+
+	return annotation, nil
+}
+
+// DeleteAnnotation removes an annotation, returns false if the annotation does not exist.
+func (bs *ChainService) DeleteAnnotation(id uint64) (bool, error) {
+	deleted := false
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		var err error
+		deleted, err = db.DeleteAnnotation(id, tx)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if deleted {
+		bs.annotations.reset()
+		bs.logger.Infof("deleted annotation %v", id)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+// BeaconIndexer is the view of the beacon indexer used by the frontend & api handlers.
+// it is implemented by the beacon.Indexer and by alternative data backends (e.g. the fixture based fakebeacon indexer).
+type BeaconIndexer interface {
+	// indexer state
+	GetBlockCacheState() (finalizedEpoch phase0.Epoch, prunedEpoch phase0.Epoch)
+	GetActivityHistoryLength() uint16
+	IsSurvivalMode() bool
+	GetCacheDebugStats() *beacon.CacheDebugStats
+
+	// cached blocks
+	GetCanonicalHead(overrideForkId *beacon.ForkKey) *beacon.Block
+	GetBlockByRoot(blockRoot phase0.Root) *beacon.Block
+	GetBlockByStateRoot(stateRoot phase0.Root) *beacon.Block
+	GetBlocksByRootPrefix(hexPrefix string) []*beacon.Block
+	GetBlocksBySlot(slot phase0.Slot) []*beacon.Block
+	GetBlocksByExecutionBlockHash(blockHash phase0.Hash32) []*beacon.Block
+	GetBlocksByExecutionBlockNumber(blockNumber uint64) []*beacon.Block
+	GetBlockAttestationVotes(block *beacon.Block) map[int]*beacon.EpochVotesAttestation
+	IsCanonicalBlock(block *beacon.Block, overrideForkId *beacon.ForkKey) bool
+	IsCanonicalBlockByHead(block *beacon.Block, headBlock *beacon.Block) bool
+
+	// block encoding
+	GetBlockJson(block *spec.VersionedSignedBeaconBlock) ([]byte, error)
+	GetBlockSszSizes(block *spec.VersionedSignedBeaconBlock) (*beacon.BlockSizes, error)
+
+	// epochs & validators
+	GetEpochStats(epoch phase0.Epoch, overrideForkId *beacon.ForkKey) *beacon.EpochStats
+	GetEpochStatsValues(epochStats *beacon.EpochStats) *beacon.EpochStatsValues
+	GetEpochHeadVotes(epoch phase0.Epoch) *beacon.EpochHeadVotes
+	GetProposerPreview(epoch phase0.Epoch) *beacon.ProposerPreview
+	GetValidatorSet(overrideForkId *beacon.ForkKey) []*phase0.Validator
+	GetValidatorSnapshot(epoch phase0.Epoch) (*beacon.ValidatorSnapshot, error)
+	GetValidatorActivity(validatorIndex phase0.ValidatorIndex) ([]beacon.ValidatorActivity, phase0.Epoch)
+	GetValidatorIndicesByPubkeyPrefix(hexPrefix string, limit int) []phase0.ValidatorIndex
+	GetWithdrawalSweepPosition(overrideForkId *beacon.ForkKey) (phase0.ValidatorIndex, phase0.Epoch, bool)
+}
+
+var _ BeaconIndexer = (*beacon.Indexer)(nil)
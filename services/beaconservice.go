@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+// BeaconService is the data backend the frontend handlers & api are served from.
+// it is implemented by the ChainService (client pools, indexers & db), alternative implementations
+// (e.g. the fixture based fake in services/fakebeacon) can be set as GlobalBeaconService.
+type BeaconService interface {
+	StartService() error
+
+	// chain & client state
+	GetChainState() *consensus.ChainState
+	GetGenesis() (*v1.Genesis, error)
+	GetFinalizedEpoch() (phase0.Epoch, phase0.Root)
+	GetBeaconIndexer() BeaconIndexer
+	GetEventHub() *EventHub
+	GetDutyVerifier() *DutyVerifier
+	GetConsensusClients() []*consensus.Client
+	GetExecutionClients() []*execution.Client
+	GetConsensusClientForks() []*ConsensusClientFork
+	GetChainHeads() []*ChainHeadInfo
+	IsLiveDataAvailable() bool
+	IsEpochInVoteWindow(epoch phase0.Epoch) bool
+
+	// blocks & epochs
+	GetSlotDetailsByBlockroot(ctx context.Context, blockroot phase0.Root) (*CombinedBlockResponse, error)
+	GetSlotDetailsBySlot(ctx context.Context, slot phase0.Slot) (*CombinedBlockResponse, error)
+	GetSlotBlockCandidates(slot phase0.Slot) []*SlotBlockCandidate
+	GetBlockBlob(ctx context.Context, blockroot phase0.Root, commitment deneb.KZGCommitment) (*deneb.BlobSidecar, error)
+	GetDbBlockByRoot(blockRoot phase0.Root) *dbtypes.Slot
+	GetDbBlocksForSlots(firstSlot uint64, slotLimit uint32, withMissing bool, withOrphaned bool) []*dbtypes.Slot
+	GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx uint64, pageSize uint32, withScheduledCount uint64) []*dbtypes.AssignedSlot
+	GetDbBlocksByParentRoot(parentRoot phase0.Root) []*dbtypes.Slot
+	CheckBlockOrphanedStatus(blockRoot phase0.Root) dbtypes.SlotStatus
+	ReindexBlock(ctx context.Context, blockRoot phase0.Root, clientName string) (*beacon.BlockReindexResult, error)
+	GetRecentGraffitis(limit uint32) []*dbtypes.SlotGraffiti
+	GetDbEpochs(firstEpoch uint64, limit uint32) []*dbtypes.Epoch
+	GetEpochVotes(epoch phase0.Epoch) *beacon.EpochVotes
+	GetSlotRangeStats(firstSlot uint64, lastSlot uint64) (*dbtypes.SlotRangeStats, error)
+	GetEpochRangeStats(firstEpoch uint64, lastEpoch uint64) (*EpochRangeStats, []*dbtypes.Epoch)
+	GetBlobInclusionStats(firstSlot phase0.Slot, lastSlot phase0.Slot, groupByEntity bool) []*BlobInclusionStats
+
+	// validators
+	GetCachedValidatorSet(withBalance bool) []*v1.Validator
+	GetValidatorSetSnapshot() *ValidatorSetSnapshot
+	GetValidatorByIndex(index phase0.ValidatorIndex, withBalance bool) *v1.Validator
+	GetValidatorIndexByPubkey(pubkey phase0.BLSPubKey) (phase0.ValidatorIndex, bool)
+	GetValidatorLiveness(validatorIndex phase0.ValidatorIndex, lookbackEpochs uint64) (votedEpochs uint64)
+	GetValidatorVotingActivity(validatorIndex phase0.ValidatorIndex) ([]beacon.ValidatorActivity, phase0.Epoch)
+	GetValidatorDepositSummary(pubkey []byte) *ValidatorDepositSummary
+	GetLastValidatorProposals(indices []phase0.ValidatorIndex) map[phase0.ValidatorIndex]phase0.Slot
+	EstimateValidatorExit(validatorIndex phase0.ValidatorIndex) (*ValidatorExitEstimation, error)
+	EstimateSlashingPenalty(validatorIndex phase0.ValidatorIndex, slashedEpoch *phase0.Epoch) (*SlashingPenaltyEstimation, error)
+
+	// validator names
+	GetValidatorName(index uint64) string
+	GetValidatorNamesCount() uint64
+	GetValidatorNameRules() []*ValidatorNameRule
+	SetValidatorNameOverride(key string, name string) (string, error)
+	DeleteValidatorNameOverride(key string) (bool, error)
+
+	// validator operations
+	GetIncludedDepositsByFilter(filter *dbtypes.DepositFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Deposit, uint64)
+	GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.VoluntaryExit, uint64)
+	GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Slashing, uint64)
+	GetWithdrawalRequestsByFilter(filter *CombinedWithdrawalRequestFilter, pageOffset uint64, pageSize uint32) ([]*CombinedWithdrawalRequest, uint64, uint64)
+	GetConsolidationRequestsByFilter(filter *CombinedConsolidationRequestFilter, pageOffset uint64, pageSize uint32) ([]*CombinedConsolidationRequest, uint64, uint64)
+	GetValidatorEventsByFilter(filter *dbtypes.ValidatorEventFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.ValidatorEvent, uint64)
+
+	// annotations & test runs
+	GetAnnotations(firstSlot uint64, lastSlot uint64) []*dbtypes.Annotation
+	GetAnnotationsBySlot(firstSlot uint64, lastSlot uint64) map[uint64][]*dbtypes.Annotation
+	GetAnnotationsCacheKey() string
+	CreateAnnotation(startSlot uint64, endSlot uint64, label string, description string) (*dbtypes.Annotation, error)
+	DeleteAnnotation(id uint64) (bool, error)
+	GetTestRun(id uint64) (*dbtypes.TestRun, error)
+	GetTestRuns(firstSlot uint64, lastSlot uint64) []*dbtypes.TestRun
+	GetTestRunsCacheKey() string
+	RegisterTestRun(name string, source string, url string, status string, startSlot uint64, endSlot *uint64) (*dbtypes.TestRun, error)
+	UpdateTestRun(id uint64, status string, endSlot *uint64) (*dbtypes.TestRun, error)
+}
+
+var _ BeaconService = (*ChainService)(nil)
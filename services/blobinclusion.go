@@ -0,0 +1,113 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// minimum number of proposed blocks for a group to be flagged as excluding blobs
+const blobInclusionMinExcludingBlocks = 3
+
+// BlobInclusionStats holds the blob inclusion aggregates of the blocks proposed by a validator or entity.
+type BlobInclusionStats struct {
+	Group      string // validator name (entity grouping) or validator index (validator grouping)
+	Validators uint64 // number of validators that proposed blocks in the range
+	Blocks     uint64
+	BlobBlocks uint64 // blocks with at least one blob
+	Blobs      uint64
+	MaxBlobs   uint64
+}
+
+// EmptyBlockShare returns the share of blocks without blobs in percent.
+func (stats *BlobInclusionStats) EmptyBlockShare() float64 {
+	if stats.Blocks == 0 {
+		return 0
+	}
+	return float64(stats.Blocks-stats.BlobBlocks) * 100 / float64(stats.Blocks)
+}
+
+// BlobsPerBlock returns the average number of blobs per block.
+func (stats *BlobInclusionStats) BlobsPerBlock() float64 {
+	if stats.Blocks == 0 {
+		return 0
+	}
+	return float64(stats.Blobs) / float64(stats.Blocks)
+}
+
+// IsExcludingBlobs returns true if the group proposed several blocks, but none of them contained blobs.
+func (stats *BlobInclusionStats) IsExcludingBlobs() bool {
+	return stats.Blocks >= blobInclusionMinExcludingBlocks && stats.BlobBlocks == 0
+}
+
+// GetBlobInclusionStats returns the blob inclusion stats of the canonical, finalized blocks in the slot range per validator
+// or per entity (validator name, unnamed validators are grouped individually), groups without blobs first.
+// blocks before the deneb fork are excluded.
+func (bs *ChainService) GetBlobInclusionStats(firstSlot phase0.Slot, lastSlot phase0.Slot, groupByEntity bool) []*BlobInclusionStats {
+	chainState := bs.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	if specs == nil || specs.DenebForkEpoch == nil {
+		return []*BlobInclusionStats{}
+	}
+
+	denebSlot := chainState.EpochToSlot(phase0.Epoch(*specs.DenebForkEpoch))
+	if firstSlot < denebSlot {
+		firstSlot = denebSlot
+	}
+	if lastSlot < firstSlot {
+		return []*BlobInclusionStats{}
+	}
+
+	return AggregateBlobInclusionStats(db.GetProposerBlobStats(uint64(firstSlot), uint64(lastSlot)), bs.GetValidatorName, groupByEntity)
+}
+
+// AggregateBlobInclusionStats groups the per proposer blob stats by validator or entity (validator name,
+// unnamed validators are grouped individually) and sorts the groups without blobs first.
+func AggregateBlobInclusionStats(proposerStatsList []*dbtypes.ProposerBlobStats, getValidatorName func(index uint64) string, groupByEntity bool) []*BlobInclusionStats {
+	groupMap := map[string]*BlobInclusionStats{}
+	groups := []*BlobInclusionStats{}
+	for _, proposerStats := range proposerStatsList {
+		groupName := fmt.Sprintf("%v", proposerStats.Proposer)
+		if groupByEntity {
+			if validatorName := getValidatorName(proposerStats.Proposer); validatorName != "" {
+				groupName = validatorName
+			}
+		}
+		groupKey := strings.ToLower(groupName)
+
+		group := groupMap[groupKey]
+		if group == nil {
+			group = &BlobInclusionStats{
+				Group: groupName,
+			}
+			groupMap[groupKey] = group
+			groups = append(groups, group)
+		}
+
+		group.Validators++
+		group.Blocks += proposerStats.BlockCount
+		group.BlobBlocks += proposerStats.BlobBlockCount
+		group.Blobs += proposerStats.BlobCount
+		if proposerStats.MaxBlobCount > group.MaxBlobs {
+			group.MaxBlobs = proposerStats.MaxBlobCount
+		}
+	}
+
+	sort.Slice(groups, func(a, b int) bool {
+		shareA, shareB := groups[a].EmptyBlockShare(), groups[b].EmptyBlockShare()
+		if shareA != shareB {
+			return shareA > shareB
+		}
+		if groups[a].Blocks != groups[b].Blocks {
+			return groups[a].Blocks > groups[b].Blocks
+		}
+		return groups[a].Group < groups[b].Group
+	})
+
+	return groups
+}
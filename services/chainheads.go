@@ -0,0 +1,68 @@
+package services
+
+import (
+	"bytes"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+// ChainHeadInfo represents one of the currently known competing chain heads.
+type ChainHeadInfo struct {
+	Slot      phase0.Slot
+	Root      phase0.Root
+	ForkId    beacon.ForkKey
+	Canonical bool
+
+	AggregatedVotes       phase0.Gwei // aggregated head votes of the last epochs (0 if unknown)
+	PerEpochVotingPercent []float64   // voting percentages of the last epochs (ascending order)
+
+	Clients []*beacon.Client // clients following this head
+}
+
+// GetChainHeads returns all currently known chain heads sorted by their voting weight.
+// The first head is the canonical head. Each client is attributed to the head it follows.
+func (bs *ChainService) GetChainHeads() []*ChainHeadInfo {
+	canonicalHead := bs.beaconIndexer.GetCanonicalHead(nil)
+	chainHeads := bs.beaconIndexer.GetChainHeads()
+
+	heads := make([]*ChainHeadInfo, 0, len(chainHeads))
+	for _, chainHead := range chainHeads {
+		headBlock := chainHead.HeadBlock
+		heads = append(heads, &ChainHeadInfo{
+			Slot:                  headBlock.Slot,
+			Root:                  headBlock.Root,
+			ForkId:                headBlock.GetForkId(),
+			Canonical:             canonicalHead != nil && bytes.Equal(headBlock.Root[:], canonicalHead.Root[:]),
+			AggregatedVotes:       chainHead.AggregatedHeadVotes,
+			PerEpochVotingPercent: chainHead.PerEpochVotingPercent,
+			Clients:               []*beacon.Client{},
+		})
+	}
+
+	for _, client := range bs.beaconIndexer.GetAllClients() {
+		_, clientHeadRoot := client.GetClient().GetLastHead()
+
+		var matchingHead *ChainHeadInfo
+		var matchingDistance uint64
+		for _, head := range heads {
+			if bytes.Equal(head.Root[:], clientHeadRoot[:]) {
+				matchingHead = head
+				break
+			}
+
+			isInChain, distance := bs.beaconIndexer.GetBlockDistance(clientHeadRoot, head.Root)
+			if isInChain && (matchingHead == nil || distance < matchingDistance) {
+				matchingHead = head
+				matchingDistance = distance
+			}
+		}
+
+		if matchingHead != nil {
+			matchingHead.Clients = append(matchingHead.Clients, client)
+		}
+	}
+
+	return heads
+}
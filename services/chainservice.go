@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"sync"
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -24,6 +25,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// default clock drift to the consensus clients that is reported as skew of the explorer host clock
+const defaultClockDriftThreshold = 2 * time.Second
+
 type ChainService struct {
 	logger               logrus.FieldLogger
 	consensusPool        *consensus.Pool
@@ -33,11 +37,21 @@ type ChainService struct {
 	depositIndexer       *execindexer.DepositIndexer
 	consolidationIndexer *execindexer.ConsolidationIndexer
 	withdrawalIndexer    *execindexer.WithdrawalIndexer
+	priorityFeeIndexer   *execindexer.PriorityFeeIndexer
 	mevRelayIndexer      *mevrelay.MevIndexer
+	eventHub             *EventHub
+	dutyVerifier         *DutyVerifier
+	annotations          *Annotations
+	testRuns             *TestRuns
+	exitEstimator        *ExitEstimator
+	slashingEstimator    *SlashingEstimator
 	started              bool
+
+	validatorSnapshotMutex sync.Mutex
+	validatorSnapshot      *ValidatorSetSnapshot
 }
 
-var GlobalBeaconService *ChainService
+var GlobalBeaconService BeaconService
 
 // InitChainService is used to initialize the global beaconchain service
 func InitChainService(ctx context.Context, logger logrus.FieldLogger) {
@@ -48,19 +62,68 @@ func InitChainService(ctx context.Context, logger logrus.FieldLogger) {
 	// initialize client pools & indexers
 	consensusPool := consensus.NewPool(ctx, logger.WithField("service", "cl-pool"))
 	executionPool := execution.NewPool(ctx, logger.WithField("service", "el-pool"))
+	consensusPool.SetForkEpochOverrides(getForkEpochOverrides())
+	consensusPool.SetTimeSource(getTimeSource(logger))
 	beaconIndexer := beacon.NewIndexer(logger.WithField("service", "cl-indexer"), consensusPool)
 	chainState := consensusPool.GetChainState()
 	validatorNames := NewValidatorNames(beaconIndexer, chainState)
 	mevRelayIndexer := mevrelay.NewMevIndexer(logger.WithField("service", "mev-relay"), beaconIndexer, chainState)
 
+	eventHub := newEventHub(logger.WithField("service", "event-hub"), beaconIndexer, consensusPool)
+	dutyVerifier := newDutyVerifier(logger.WithField("service", "duty-verifier"), beaconIndexer, chainState)
+
 	GlobalBeaconService = &ChainService{
-		logger:          logger,
-		consensusPool:   consensusPool,
-		executionPool:   executionPool,
-		beaconIndexer:   beaconIndexer,
-		validatorNames:  validatorNames,
-		mevRelayIndexer: mevRelayIndexer,
+		logger:            logger,
+		consensusPool:     consensusPool,
+		executionPool:     executionPool,
+		beaconIndexer:     beaconIndexer,
+		validatorNames:    validatorNames,
+		mevRelayIndexer:   mevRelayIndexer,
+		eventHub:          eventHub,
+		dutyVerifier:      dutyVerifier,
+		annotations:       newAnnotations(),
+		testRuns:          newTestRuns(),
+		exitEstimator:     newExitEstimator(),
+		slashingEstimator: newSlashingEstimator(),
+	}
+}
+
+// getForkEpochOverrides returns the configured fork epoch overrides by fork name.
+func getForkEpochOverrides() map[string]uint64 {
+	overrides := map[string]uint64{}
+	forkEpochs := &utils.Config.Chain.ForkEpochs
+	for fork, epoch := range map[string]*uint64{
+		"altair":    forkEpochs.Altair,
+		"bellatrix": forkEpochs.Bellatrix,
+		"capella":   forkEpochs.Capella,
+		"deneb":     forkEpochs.Deneb,
+		"electra":   forkEpochs.Electra,
+		"eip7594":   forkEpochs.Eip7594,
+	} {
+		if epoch != nil {
+			overrides[fork] = *epoch
+		}
 	}
+	return overrides
+}
+
+// getTimeSource returns the configured time source of the slot clock and the clock drift threshold.
+func getTimeSource(logger logrus.FieldLogger) (bool, time.Duration) {
+	useNodeClock := false
+	switch utils.Config.Chain.TimeSource {
+	case "", "local":
+	case "nodes":
+		useNodeClock = true
+	default:
+		logger.Warnf("unknown chain time source '%v', using local time", utils.Config.Chain.TimeSource)
+	}
+
+	driftThreshold := utils.Config.Chain.ClockDriftThreshold
+	if driftThreshold <= 0 {
+		driftThreshold = defaultClockDriftThreshold
+	}
+
+	return useNodeClock, driftThreshold
 }
 
 // StartService is used to start the beaconchain service
@@ -70,6 +133,13 @@ func (cs *ChainService) StartService() error {
 	}
 	cs.started = true
 
+	// restore chain state of the last run from db, so the explorer can start serving data without waiting for a ready client.
+	// the clients are reconciled with the restored state in background as soon as they are ready.
+	if cs.restoreChainStateCache() {
+		cs.logger.Infof("restored chain state from db, starting without waiting for consensus clients")
+	}
+	go cs.runChainStateCacheUpdater()
+
 	executionIndexerCtx := execindexer.NewIndexerCtx(cs.logger.WithField("service", "el-indexer"), cs.executionPool, cs.consensusPool, cs.beaconIndexer)
 
 	// add consensus clients
@@ -104,6 +174,36 @@ func (cs *ChainService) StartService() error {
 		return fmt.Errorf("no beacon clients configured")
 	}
 
+	// add history only consensus clients (used by the synchronizer for historic epochs)
+	liveClientCount := len(utils.Config.BeaconApi.Endpoints)
+	for index, endpoint := range utils.Config.BeaconApi.HistoryEndpoints {
+		endpointConfig := &consensus.ClientConfig{
+			URL:         endpoint.Url,
+			Name:        endpoint.Name,
+			Headers:     endpoint.Headers,
+			DisableSSZ:  utils.Config.KillSwitch.DisableSSZRequests,
+			HistoryOnly: true,
+		}
+
+		if endpoint.Ssh != nil {
+			endpointConfig.SshConfig = &sshtunnel.SshConfig{
+				Host:     endpoint.Ssh.Host,
+				Port:     endpoint.Ssh.Port,
+				User:     endpoint.Ssh.User,
+				Password: endpoint.Ssh.Password,
+				Keyfile:  endpoint.Ssh.Keyfile,
+			}
+		}
+
+		client, err := cs.consensusPool.AddEndpoint(endpointConfig)
+		if err != nil {
+			cs.logger.Errorf("could not add history beacon client '%v' to pool: %v", endpoint.Name, err)
+			continue
+		}
+
+		cs.beaconIndexer.AddHistoryClient(uint16(liveClientCount+index), client, endpoint.Priority)
+	}
+
 	// add execution clients
 	for _, endpoint := range utils.Config.ExecutionApi.Endpoints {
 		endpointConfig := &execution.ClientConfig{
@@ -170,6 +270,19 @@ func (cs *ChainService) StartService() error {
 		"genesis_fork": fmt.Sprintf("%x", genesis.GenesisForkVersion),
 	}).Infof("beacon client pool ready")
 
+	for _, override := range chainState.GetForkEpochOverrides() {
+		if override.SpecEpoch == nil || *override.SpecEpoch != override.Epoch {
+			specEpoch := "unset"
+			if override.SpecEpoch != nil {
+				specEpoch = fmt.Sprintf("%v", *override.SpecEpoch)
+			}
+			cs.logger.Warnf("%v fork epoch overridden by config: %v (node spec: %v)", override.Fork, override.Epoch, specEpoch)
+		}
+	}
+
+	utils.ApplyChainDenomination(specs.ConfigName)
+	utils.InitLinkBuilder(specs.ConfigName)
+
 	// start validator names updater
 	validatorNamesLoading := cs.validatorNames.LoadValidatorNames()
 	<-validatorNamesLoading
@@ -182,21 +295,43 @@ func (cs *ChainService) StartService() error {
 	// start chain indexer
 	cs.beaconIndexer.StartIndexer()
 
+	// start event hub
+	cs.eventHub.start(cs.consensusPool)
+
+	// start proposer duty verification
+	cs.dutyVerifier.start(cs.consensusPool)
+
 	// add execution indexers
 	cs.depositIndexer = execindexer.NewDepositIndexer(executionIndexerCtx)
 	cs.consolidationIndexer = execindexer.NewConsolidationIndexer(executionIndexerCtx)
 	cs.withdrawalIndexer = execindexer.NewWithdrawalIndexer(executionIndexerCtx)
+	cs.priorityFeeIndexer = execindexer.NewPriorityFeeIndexer(executionIndexerCtx)
 
 	// start MEV relay indexer
 	cs.mevRelayIndexer.StartUpdater()
 
+	// start plugin indexer hooks
+	cs.startPluginHooks()
+
 	return nil
 }
 
-func (bs *ChainService) GetBeaconIndexer() *beacon.Indexer {
+func (bs *ChainService) GetBeaconIndexer() BeaconIndexer {
+	if bs.beaconIndexer == nil {
+		// avoid returning a non-nil interface holding a nil indexer
+		return nil
+	}
 	return bs.beaconIndexer
 }
 
+func (bs *ChainService) GetEventHub() *EventHub {
+	return bs.eventHub
+}
+
+func (bs *ChainService) GetDutyVerifier() *DutyVerifier {
+	return bs.dutyVerifier
+}
+
 func (bs *ChainService) GetConsolidationIndexer() *execindexer.ConsolidationIndexer {
 	return bs.consolidationIndexer
 }
@@ -264,6 +399,18 @@ func (bs *ChainService) GetValidatorNamesCount() uint64 {
 	return bs.validatorNames.GetValidatorNamesCount()
 }
 
+func (bs *ChainService) GetValidatorNameRules() []*ValidatorNameRule {
+	return bs.validatorNames.GetNameRules()
+}
+
+func (bs *ChainService) SetValidatorNameOverride(key string, name string) (string, error) {
+	return bs.validatorNames.SetNameOverride(key, name)
+}
+
+func (bs *ChainService) DeleteValidatorNameOverride(key string) (bool, error) {
+	return bs.validatorNames.DeleteNameOverride(key)
+}
+
 func (bs *ChainService) GetCachedValidatorSet(withBalance bool) []*v1.Validator {
 	currentEpoch := bs.consensusPool.GetChainState().CurrentEpoch()
 	return bs.beaconIndexer.GetEpochValidatorSet(currentEpoch, nil, withBalance)
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/spec"
@@ -14,6 +15,7 @@ import (
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
 	"github.com/sirupsen/logrus"
 )
 
@@ -36,7 +38,7 @@ func (bs *ChainService) GetBlockBlob(ctx context.Context, blockroot phase0.Root,
 	}
 
 	if client == nil {
-		return nil, fmt.Errorf("no clients available")
+		return nil, ErrLiveDataUnavailable
 	}
 
 	blobs, err := client.GetClient().GetRPCClient().GetBlobSidecarsByBlockroot(ctx, blockroot[:])
@@ -87,7 +89,7 @@ func (bs *ChainService) GetSlotDetailsByBlockroot(ctx context.Context, blockroot
 			clients = bs.beaconIndexer.GetReadyClients(true)
 		}
 		if len(clients) == 0 {
-			return nil, fmt.Errorf("no clients available")
+			return nil, ErrLiveDataUnavailable
 		}
 
 		headRetry := 0
@@ -171,7 +173,7 @@ func (bs *ChainService) GetSlotDetailsBySlot(ctx context.Context, slot phase0.Sl
 
 		clients := bs.beaconIndexer.GetReadyClients(true)
 		if len(clients) == 0 {
-			return nil, fmt.Errorf("no clients available")
+			return nil, ErrLiveDataUnavailable
 		}
 
 		headRetry := 0
@@ -221,6 +223,46 @@ func (bs *ChainService) GetSlotDetailsBySlot(ctx context.Context, slot phase0.Sl
 	return result, nil
 }
 
+// SlotBlockCandidate represents a known block for a slot.
+type SlotBlockCandidate struct {
+	Root     phase0.Root
+	Proposer uint64
+	Status   dbtypes.SlotStatus
+}
+
+// GetSlotBlockCandidates returns all known blocks for the given slot (canonical & orphaned, e.g. from equivocations or forks).
+// The canonical block (if any) is returned first.
+func (bs *ChainService) GetSlotBlockCandidates(slot phase0.Slot) []*SlotBlockCandidate {
+	candidates := []*SlotBlockCandidate{}
+	for _, dbBlock := range bs.GetDbBlocksForSlots(uint64(slot), 1, false, true) {
+		if dbBlock.Slot != uint64(slot) || dbBlock.Status == dbtypes.Missing {
+			continue
+		}
+
+		candidates = append(candidates, &SlotBlockCandidate{
+			Root:     phase0.Root(dbBlock.Root),
+			Proposer: dbBlock.Proposer,
+			Status:   dbBlock.Status,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Status == dbtypes.Canonical && candidates[j].Status != dbtypes.Canonical
+	})
+
+	return candidates
+}
+
+// GetDbBlockByRoot returns the database representation of the block with the given root.
+// It checks the block cache first and falls back to the database for finalized or pruned blocks.
+func (bs *ChainService) GetDbBlockByRoot(blockRoot phase0.Root) *dbtypes.Slot {
+	if block := bs.beaconIndexer.GetBlockByRoot(blockRoot); block != nil {
+		return block.GetDbBlock(bs.beaconIndexer, bs.beaconIndexer.IsCanonicalBlock(block, nil))
+	}
+
+	return db.GetSlotByRoot(blockRoot[:])
+}
+
 // GetBlobSidecarsByBlockRoot retrieves the blob sidecars for a given block root.
 // It first tries to find a client that has the block root in its cache, and if not found,
 // it falls back to a random ready client. It then retrieves the blob sidecars for the block root
@@ -228,6 +270,9 @@ func (bs *ChainService) GetSlotDetailsBySlot(ctx context.Context, slot phase0.Sl
 func (bs *ChainService) GetBlobSidecarsByBlockRoot(ctx context.Context, blockroot []byte) ([]*deneb.BlobSidecar, error) {
 	client := bs.beaconIndexer.GetReadyClientByBlockRoot(phase0.Root(blockroot), true)
 	if client == nil {
+		if !bs.IsLiveDataAvailable() {
+			return nil, ErrLiveDataUnavailable
+		}
 		return nil, fmt.Errorf("no clients available")
 	}
 
@@ -487,6 +532,11 @@ type cachedDbBlock struct {
 // The withScheduledCount parameter specifies the number of scheduled slots to include.
 // The returned slice contains the retrieved blocks.
 func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx uint64, pageSize uint32, withScheduledCount uint64) []*dbtypes.AssignedSlot {
+	pageSize, allowed := limitListQuery(pageIdx*uint64(pageSize), pageSize)
+	if !allowed {
+		return []*dbtypes.AssignedSlot{}
+	}
+
 	cachedMatches := make([]cachedDbBlock, 0)
 
 	chainState := bs.consensusPool.GetChainState()
@@ -594,6 +644,14 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 				}
 			}
 
+			// filter by block stats (sync participation, attestation count)
+			if filter.HasBlockStatsFilter() {
+				dbBlock := block.GetDbBlock(bs.beaconIndexer, isCanonical)
+				if dbBlock == nil || !filter.MatchBlockStats(dbBlock) {
+					continue
+				}
+			}
+
 			cachedMatches = append(cachedMatches, cachedDbBlock{
 				slot:     uint64(block.Slot),
 				proposer: uint64(blockHeader.Message.ProposerIndex),
@@ -603,7 +661,7 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 		}
 
 		// reconstruct missing blocks from epoch duties
-		if filter.WithMissing != 0 && filter.Graffiti == "" && filter.ExtraData == "" && filter.WithOrphaned != 2 {
+		if filter.WithMissing != 0 && filter.Graffiti == "" && filter.ExtraData == "" && filter.WithOrphaned != 2 && !filter.HasBlockStatsFilter() {
 			hasCanonicalProposer := false
 			canonicalProposer := getCanonicalProposer(slot)
 
@@ -754,6 +812,50 @@ func (bs *ChainService) GetDbBlocksByParentRoot(parentRoot phase0.Root) []*dbtyp
 	return resBlocks
 }
 
+// GetRecentGraffitis returns the non-empty graffitis of the latest canonical blocks, newest first.
+// unfinalized blocks are taken from the block cache by walking back from the canonical head, older blocks are loaded from the db.
+func (bs *ChainService) GetRecentGraffitis(limit uint32) []*dbtypes.SlotGraffiti {
+	graffitis := []*dbtypes.SlotGraffiti{}
+	maxDbSlot := uint64(math.MaxInt64)
+
+	block := bs.beaconIndexer.GetCanonicalHead(nil)
+	for block != nil && uint32(len(graffitis)) < limit {
+		maxDbSlot = uint64(block.Slot)
+		if maxDbSlot > 0 {
+			maxDbSlot--
+		}
+
+		blockIndex := block.GetBlockIndex()
+		blockHeader := block.GetHeader()
+		if blockIndex != nil && blockHeader != nil {
+			graffitiText := utils.GraffitiToString(blockIndex.Graffiti[:])
+			if graffitiText != "" {
+				graffitis = append(graffitis, &dbtypes.SlotGraffiti{
+					Slot:         uint64(block.Slot),
+					Proposer:     uint64(blockHeader.Message.ProposerIndex),
+					GraffitiText: graffitiText,
+				})
+			}
+		}
+
+		if block.Slot == 0 {
+			return graffitis
+		}
+
+		parentRoot := block.GetParentRoot()
+		if parentRoot == nil {
+			break
+		}
+		block = bs.beaconIndexer.GetBlockByRoot(*parentRoot)
+	}
+
+	if uint32(len(graffitis)) < limit {
+		graffitis = append(graffitis, db.GetRecentSlotGraffitiTexts(maxDbSlot, limit-uint32(len(graffitis)))...)
+	}
+
+	return graffitis
+}
+
 func (bs *ChainService) CheckBlockOrphanedStatus(blockRoot phase0.Root) dbtypes.SlotStatus {
 	cachedBlock := bs.beaconIndexer.GetBlockByRoot(blockRoot)
 	if cachedBlock != nil {
@@ -771,6 +873,12 @@ func (bs *ChainService) CheckBlockOrphanedStatus(blockRoot phase0.Root) dbtypes.
 	return dbtypes.Missing
 }
 
+// ReindexBlock invalidates a stored block of a finalized epoch and refetches its epoch from a healthy client
+// (or the client with the given name), see beacon.Indexer.ReindexBlock.
+func (bs *ChainService) ReindexBlock(ctx context.Context, blockRoot phase0.Root, clientName string) (*beacon.BlockReindexResult, error) {
+	return bs.beaconIndexer.ReindexBlock(ctx, blockRoot, clientName)
+}
+
 func (bs *ChainService) GetHighestElBlockNumber(overrideForkId *beacon.ForkKey) uint64 {
 	canonicalHead := bs.beaconIndexer.GetCanonicalHead(overrideForkId)
 	for {
@@ -794,3 +902,54 @@ func (bs *ChainService) GetHighestElBlockNumber(overrideForkId *beacon.ForkKey)
 
 	return 0
 }
+
+// GetLastValidatorProposals returns the slot of the latest canonical block proposed by each of the given validators.
+// unfinalized blocks are taken from the block cache by walking back from the canonical head, older proposals are loaded from the db.
+// validators that never proposed a block are not included in the result.
+func (bs *ChainService) GetLastValidatorProposals(indices []phase0.ValidatorIndex) map[phase0.ValidatorIndex]phase0.Slot {
+	proposals := map[phase0.ValidatorIndex]phase0.Slot{}
+	pending := map[phase0.ValidatorIndex]bool{}
+	for _, index := range indices {
+		pending[index] = true
+	}
+
+	maxDbSlot := uint64(math.MaxInt64)
+	block := bs.beaconIndexer.GetCanonicalHead(nil)
+	for block != nil && len(pending) > 0 {
+		maxDbSlot = uint64(block.Slot)
+
+		if blockHeader := block.GetHeader(); blockHeader != nil {
+			proposer := blockHeader.Message.ProposerIndex
+			if pending[proposer] {
+				proposals[proposer] = block.Slot
+				delete(pending, proposer)
+			}
+		}
+
+		if block.Slot == 0 {
+			return proposals
+		}
+
+		parentRoot := block.GetParentRoot()
+		if parentRoot == nil {
+			break
+		}
+		block = bs.beaconIndexer.GetBlockByRoot(*parentRoot)
+	}
+
+	if len(pending) > 0 {
+		if maxDbSlot > 0 && maxDbSlot != uint64(math.MaxInt64) {
+			maxDbSlot--
+		}
+
+		dbIndices := make([]uint64, 0, len(pending))
+		for index := range pending {
+			dbIndices = append(dbIndices, uint64(index))
+		}
+		for proposer, slot := range db.GetLastProposedSlots(dbIndices, maxDbSlot) {
+			proposals[phase0.ValidatorIndex(proposer)] = phase0.Slot(slot)
+		}
+	}
+
+	return proposals
+}
@@ -75,6 +75,11 @@ func (ccr *CombinedConsolidationRequest) TargetPubkey() []byte {
 }
 
 func (bs *ChainService) GetConsolidationRequestsByFilter(filter *CombinedConsolidationRequestFilter, pageOffset uint64, pageSize uint32) ([]*CombinedConsolidationRequest, uint64, uint64) {
+	pageSize, allowed := limitListQuery(pageOffset, pageSize)
+	if !allowed {
+		return []*CombinedConsolidationRequest{}, 0, 0
+	}
+
 	totalPendingTxResults := uint64(0)
 	totalReqResults := uint64(0)
 
@@ -4,8 +4,13 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
 )
 
+// survivalModeVoteEpochs is the number of unfinalized epochs with live vote aggregation in non-finality survival mode.
+const survivalModeVoteEpochs = 4
+
 func (bs *ChainService) GetDbEpochs(firstEpoch uint64, limit uint32) []*dbtypes.Epoch {
 	resEpochs := make([]*dbtypes.Epoch, limit)
 	resIdx := 0
@@ -31,7 +36,7 @@ func (bs *ChainService) GetDbEpochs(firstEpoch uint64, limit uint32) []*dbtypes.
 		}
 		if epoch >= finalizedEpoch && epoch <= currentEpoch {
 			if epochStats := bs.beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
-				resEpoch = epochStats.GetDbEpoch(bs.beaconIndexer, nil)
+				resEpoch = epochStats.GetDbEpoch(bs.beaconIndexer, nil, bs.IsEpochInVoteWindow(epoch))
 			}
 		}
 		if resEpoch == nil {
@@ -46,3 +51,40 @@ func (bs *ChainService) GetDbEpochs(firstEpoch uint64, limit uint32) []*dbtypes.
 
 	return resEpochs
 }
+
+// GetEpochVotes returns the live aggregated votes of an unfinalized epoch within the vote window.
+// the votes are aggregated from the canonical chain the same way the synchronizer aggregates them when persisting the epoch.
+// returns nil for finalized epochs, their votes are only available from the persisted epoch aggregation.
+func (bs *ChainService) GetEpochVotes(epoch phase0.Epoch) *beacon.EpochVotes {
+	finalizedEpoch, _ := bs.beaconIndexer.GetBlockCacheState()
+	if epoch < finalizedEpoch || epoch > bs.consensusPool.GetChainState().CurrentEpoch() || !bs.IsEpochInVoteWindow(epoch) {
+		return nil
+	}
+
+	epochStats := bs.beaconIndexer.GetEpochStats(epoch, nil)
+	if epochStats == nil {
+		return nil
+	}
+
+	return epochStats.GetEpochVotes(bs.beaconIndexer, nil)
+}
+
+// IsEpochInVoteWindow checks if live votes are aggregated for the epoch.
+// finalized epochs are always covered, unfinalized epochs only within the configured number of epochs back from the current epoch.
+// In non-finality survival mode, the window is capped to the most recent epochs.
+func (bs *ChainService) IsEpochInVoteWindow(epoch phase0.Epoch) bool {
+	voteEpochs := phase0.Epoch(utils.Config.Indexer.UnfinalizedVoteEpochs)
+	if bs.beaconIndexer.IsSurvivalMode() && (voteEpochs == 0 || voteEpochs > survivalModeVoteEpochs) {
+		voteEpochs = survivalModeVoteEpochs
+	}
+	if voteEpochs == 0 {
+		return true
+	}
+
+	finalizedEpoch, _ := bs.beaconIndexer.GetBlockCacheState()
+	if epoch < finalizedEpoch {
+		return true
+	}
+
+	return epoch+voteEpochs > bs.consensusPool.GetChainState().CurrentEpoch()
+}
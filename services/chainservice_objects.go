@@ -15,6 +15,11 @@ import (
 )
 
 func (bs *ChainService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Deposit, uint64) {
+	pageSize, allowed := limitListQuery(pageIdx*uint64(pageSize), pageSize)
+	if !allowed {
+		return []*dbtypes.Deposit{}, 0
+	}
+
 	chainState := bs.consensusPool.GetChainState()
 	finalizedBlock, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
@@ -70,10 +75,10 @@ func (bs *ChainService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilte
 					if len(filter.PublicKey) > 0 && !bytes.Equal(deposit.PublicKey, filter.PublicKey) {
 						continue
 					}
-					if filter.MinAmount > 0 && deposit.Amount < filter.MinAmount*utils.GWEI.Uint64() {
+					if filter.MinAmount > 0 && deposit.Amount < utils.GweiFromConsensusUnits(filter.MinAmount) {
 						continue
 					}
-					if filter.MaxAmount > 0 && deposit.Amount > filter.MaxAmount*utils.GWEI.Uint64() {
+					if filter.MaxAmount > 0 && deposit.Amount > utils.GweiFromConsensusUnits(filter.MaxAmount) {
 						continue
 					}
 					if filter.ValidatorName != "" {
@@ -148,7 +153,59 @@ func (bs *ChainService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilte
 	return resObjs, cachedMatchesLen + dbCount
 }
 
+// ValidatorDepositSummary aggregates the included deposits of a validator.
+type ValidatorDepositSummary struct {
+	DepositCount  uint64
+	TotalAmount   phase0.Gwei
+	InitialAmount phase0.Gwei
+	InitialSlot   uint64
+	TopUpCount    uint64 // deposits included after the initial deposit
+	TopUpAmount   phase0.Gwei
+	LastTopUpSlot uint64
+}
+
+// GetValidatorDepositSummary aggregates all canonical included deposits for the given validator pubkey from cache & database.
+// the first included deposit is considered the initial deposit, all subsequent deposits are top-ups.
+func (bs *ChainService) GetValidatorDepositSummary(pubkey []byte) *ValidatorDepositSummary {
+	filter := &dbtypes.DepositFilter{
+		PublicKey: pubkey,
+	}
+
+	deposits := []*dbtypes.Deposit{}
+	for pageIdx := uint64(0); ; pageIdx++ {
+		pageDeposits, totalDeposits := bs.GetIncludedDepositsByFilter(filter, pageIdx, 100)
+		deposits = append(deposits, pageDeposits...)
+		if len(pageDeposits) == 0 || uint64(len(deposits)) >= totalDeposits {
+			break
+		}
+	}
+
+	// deposits are sorted descending, the initial deposit is the last one
+	summary := &ValidatorDepositSummary{}
+	for idx := len(deposits) - 1; idx >= 0; idx-- {
+		deposit := deposits[idx]
+		summary.DepositCount++
+		summary.TotalAmount += phase0.Gwei(deposit.Amount)
+
+		if summary.DepositCount == 1 {
+			summary.InitialAmount = phase0.Gwei(deposit.Amount)
+			summary.InitialSlot = deposit.SlotNumber
+		} else {
+			summary.TopUpCount++
+			summary.TopUpAmount += phase0.Gwei(deposit.Amount)
+			summary.LastTopUpSlot = deposit.SlotNumber
+		}
+	}
+
+	return summary
+}
+
 func (bs *ChainService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.VoluntaryExit, uint64) {
+	pageSize, allowed := limitListQuery(pageIdx*uint64(pageSize), pageSize)
+	if !allowed {
+		return []*dbtypes.VoluntaryExit{}, 0
+	}
+
 	chainState := bs.consensusPool.GetChainState()
 	finalizedBlock, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
@@ -259,7 +316,29 @@ func (bs *ChainService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitF
 	return resObjs, cachedMatchesLen + dbCount
 }
 
+// GetValidatorEventsByFilter returns the validator status transitions matching the filter.
+// events are derived from the finalized validator set only, so there are no unfinalized objects to merge in.
+func (bs *ChainService) GetValidatorEventsByFilter(filter *dbtypes.ValidatorEventFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.ValidatorEvent, uint64) {
+	pageSize, allowed := limitListQuery(pageIdx*uint64(pageSize), pageSize)
+	if !allowed {
+		return []*dbtypes.ValidatorEvent{}, 0
+	}
+
+	validatorEvents, totalRows, err := db.GetValidatorEventsFiltered(pageIdx*uint64(pageSize), pageSize, filter)
+	if err != nil {
+		logrus.Warnf("ChainService.GetValidatorEventsByFilter error: %v", err)
+		return []*dbtypes.ValidatorEvent{}, 0
+	}
+
+	return validatorEvents, totalRows
+}
+
 func (bs *ChainService) GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Slashing, uint64) {
+	pageSize, allowed := limitListQuery(pageIdx*uint64(pageSize), pageSize)
+	if !allowed {
+		return []*dbtypes.Slashing{}, 0
+	}
+
 	chainState := bs.consensusPool.GetChainState()
 	finalizedBlock, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
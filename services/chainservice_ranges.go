@@ -0,0 +1,128 @@
+package services
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// EpochRangeStats holds the aggregated vote stats of a range of epochs.
+type EpochRangeStats struct {
+	EpochCount            uint64
+	SynchronizedCount     uint64
+	VotesUnavailableCount uint64
+	Eligible              uint64
+	VotedTarget           uint64
+	VotedHead             uint64
+	VotedTotal            uint64
+}
+
+// GetSlotRangeStats aggregates the block stats of all elapsed slots in the given range (inclusive).
+// finalized slots are aggregated in the database, unfinalized slots are loaded from cache.
+func (bs *ChainService) GetSlotRangeStats(firstSlot uint64, lastSlot uint64) (*dbtypes.SlotRangeStats, error) {
+	chainState := bs.consensusPool.GetChainState()
+	finalizedEpoch, _ := bs.beaconIndexer.GetBlockCacheState()
+	finalizedSlot := uint64(chainState.EpochToSlot(finalizedEpoch))
+
+	// do not count the current slot and future slots as missed
+	currentSlot := uint64(chainState.CurrentSlot())
+	if currentSlot == 0 {
+		return &dbtypes.SlotRangeStats{}, nil
+	}
+	if lastSlot >= currentSlot {
+		lastSlot = currentSlot - 1
+	}
+	if firstSlot > lastSlot {
+		return &dbtypes.SlotRangeStats{}, nil
+	}
+
+	stats := &dbtypes.SlotRangeStats{}
+	if firstSlot < finalizedSlot {
+		dbLastSlot := lastSlot
+		if dbLastSlot >= finalizedSlot {
+			dbLastSlot = finalizedSlot - 1
+		}
+
+		dbStats, err := db.GetSlotRangeStats(firstSlot, dbLastSlot)
+		if err != nil {
+			return nil, err
+		}
+		stats = dbStats
+	}
+
+	if lastSlot >= finalizedSlot {
+		unfinalizedFirstSlot := firstSlot
+		if unfinalizedFirstSlot < finalizedSlot {
+			unfinalizedFirstSlot = finalizedSlot
+		}
+
+		for _, slot := range bs.GetDbBlocksForSlots(lastSlot, uint32(lastSlot-unfinalizedFirstSlot), true, true) {
+			if slot.Slot < unfinalizedFirstSlot || slot.Slot > lastSlot {
+				continue
+			}
+
+			switch slot.Status {
+			case dbtypes.Missing:
+				stats.MissedCount++
+			case dbtypes.Orphaned:
+				stats.OrphanedCount++
+			case dbtypes.Canonical:
+				stats.CanonicalCount++
+				stats.AttestationCount += slot.AttestationCount
+				stats.DepositCount += slot.DepositCount
+				stats.ExitCount += slot.ExitCount
+				stats.ProposerSlashingCount += slot.ProposerSlashingCount
+				stats.AttesterSlashingCount += slot.AttesterSlashingCount
+				stats.EthTransactionCount += slot.EthTransactionCount
+				if slot.SyncParticipation >= 0 {
+					stats.SyncParticipationCount++
+					stats.SyncParticipationSum += float64(slot.SyncParticipation)
+				}
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// GetEpochRangeStats aggregates the vote stats of all epochs in the given range (inclusive).
+// returns the aggregated stats and the epochs of the range (newest first).
+// unfinalized epochs beyond the vote window are excluded from the vote aggregation.
+func (bs *ChainService) GetEpochRangeStats(firstEpoch uint64, lastEpoch uint64) (*EpochRangeStats, []*dbtypes.Epoch) {
+	currentEpoch := uint64(bs.consensusPool.GetChainState().CurrentEpoch())
+	if lastEpoch > currentEpoch {
+		lastEpoch = currentEpoch
+	}
+	if firstEpoch > lastEpoch {
+		return &EpochRangeStats{}, []*dbtypes.Epoch{}
+	}
+
+	finalizedEpoch, _ := bs.beaconIndexer.GetBlockCacheState()
+	epochs := bs.GetDbEpochs(lastEpoch, uint32(lastEpoch-firstEpoch+1))
+
+	stats := &EpochRangeStats{}
+	for _, epoch := range epochs {
+		if epoch == nil || epoch.Epoch < firstEpoch || epoch.Epoch > lastEpoch {
+			continue
+		}
+
+		stats.EpochCount++
+		if epoch.ValidatorCount == 0 {
+			continue
+		}
+		stats.SynchronizedCount++
+
+		if epoch.Epoch >= uint64(finalizedEpoch) && epoch.VotedTotal == 0 && !bs.IsEpochInVoteWindow(phase0.Epoch(epoch.Epoch)) {
+			stats.VotesUnavailableCount++
+			continue
+		}
+
+		stats.Eligible += epoch.Eligible
+		stats.VotedTarget += epoch.VotedTarget
+		stats.VotedHead += epoch.VotedHead
+		stats.VotedTotal += epoch.VotedTotal
+	}
+
+	return stats, epochs
+}
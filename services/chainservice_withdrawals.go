@@ -65,6 +65,11 @@ func (cwr *CombinedWithdrawalRequest) Amount() uint64 {
 }
 
 func (bs *ChainService) GetWithdrawalRequestsByFilter(filter *CombinedWithdrawalRequestFilter, pageOffset uint64, pageSize uint32) ([]*CombinedWithdrawalRequest, uint64, uint64) {
+	pageSize, allowed := limitListQuery(pageOffset, pageSize)
+	if !allowed {
+		return []*CombinedWithdrawalRequest{}, 0, 0
+	}
+
 	totalPendingTxResults := uint64(0)
 	totalReqResults := uint64(0)
 
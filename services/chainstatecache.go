@@ -0,0 +1,80 @@
+package services
+
+import (
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// chainStateCache is the persisted chain state that allows starting the explorer from the db without a ready client.
+type chainStateCache struct {
+	Specs    *consensus.ChainSpec `json:"specs"`
+	Genesis  *v1.Genesis          `json:"genesis"`
+	Finality *v1.Finality         `json:"finality"`
+}
+
+const chainStateCacheKey = "chain.statecache"
+
+// restoreChainStateCache restores the chain specs, genesis & finality checkpoints of the last run from the db.
+// returns true if the chain state has been restored.
+func (cs *ChainService) restoreChainStateCache() bool {
+	if utils.Config.KillSwitch.DisableStartupCache {
+		return false
+	}
+
+	cache := &chainStateCache{}
+	if _, err := db.GetExplorerState(chainStateCacheKey, cache); err != nil {
+		return false
+	}
+	if cache.Specs == nil || cache.Genesis == nil {
+		return false
+	}
+
+	cs.consensusPool.GetChainState().RestoreCachedState(cache.Specs, cache.Genesis, cache.Finality)
+	return true
+}
+
+// runChainStateCacheUpdater persists the chain state as soon as the specs have been loaded from a client
+// and updates the persisted finality checkpoints on every finality change.
+func (cs *ChainService) runChainStateCacheUpdater() {
+	defer utils.HandleSubroutinePanic("runChainStateCacheUpdater", cs.runChainStateCacheUpdater)
+
+	if utils.Config.KillSwitch.DisableStartupCache {
+		return
+	}
+
+	chainState := cs.consensusPool.GetChainState()
+	finalitySubscription := cs.consensusPool.SubscribeFinalizedEvent(10)
+	defer finalitySubscription.Unsubscribe()
+
+	for !chainState.HasClientSpecs() {
+		time.Sleep(10 * time.Second)
+	}
+
+	cs.updateChainStateCache()
+
+	for range finalitySubscription.Channel() {
+		cs.updateChainStateCache()
+	}
+}
+
+func (cs *ChainService) updateChainStateCache() {
+	chainState := cs.consensusPool.GetChainState()
+	cache := &chainStateCache{
+		Specs:    chainState.GetSpecs(),
+		Genesis:  chainState.GetGenesis(),
+		Finality: chainState.GetFinality(),
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.SetExplorerState(chainStateCacheKey, cache, tx)
+	})
+	if err != nil {
+		cs.logger.Warnf("failed persisting chain state cache: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DbStatsService periodically collects table sizes and row counts of the explorer database.
+type DbStatsService struct {
+	mutex       sync.RWMutex
+	tableStats  []*dbtypes.TableStats
+	lastUpdate  time.Time
+	lastError   error
+	collectTime time.Duration
+}
+
+var GlobalDbStatsService *DbStatsService
+
+var (
+	dbTableRowsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dora_db_table_rows",
+		Help: "Number of rows per database table (estimated for pgsql)",
+	}, []string{"table"})
+	dbTableSizeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dora_db_table_size_bytes",
+		Help: "Disk size of the table data per database table",
+	}, []string{"table"})
+	dbIndexSizeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dora_db_index_size_bytes",
+		Help: "Disk size of all indexes per database table",
+	}, []string{"table"})
+)
+
+// StartDbStatsService starts the periodic db stats collection.
+func StartDbStatsService() error {
+	if GlobalDbStatsService != nil {
+		return nil
+	}
+
+	GlobalDbStatsService = &DbStatsService{}
+
+	go GlobalDbStatsService.runCollectorLoop()
+	return nil
+}
+
+// GetTableStats returns the latest collected table stats and the time of collection.
+func (dss *DbStatsService) GetTableStats() ([]*dbtypes.TableStats, time.Time, time.Duration, error) {
+	if dss == nil {
+		return nil, time.Time{}, 0, nil
+	}
+
+	dss.mutex.RLock()
+	defer dss.mutex.RUnlock()
+	return dss.tableStats, dss.lastUpdate, dss.collectTime, dss.lastError
+}
+
+func (dss *DbStatsService) runCollectorLoop() {
+	defer utils.HandleSubroutinePanic("DbStatsService.runCollectorLoop", dss.runCollectorLoop)
+
+	interval := utils.Config.Metrics.DbStatsInterval
+	if interval == 0 {
+		interval = 10 * time.Minute
+	}
+
+	for {
+		dss.collectStats()
+		time.Sleep(interval)
+	}
+}
+
+func (dss *DbStatsService) collectStats() {
+	t1 := time.Now()
+	tableStats, err := db.GetTableStats()
+	collectTime := time.Since(t1)
+
+	dss.mutex.Lock()
+	defer dss.mutex.Unlock()
+
+	dss.lastError = err
+	if err != nil {
+		logrus.WithError(err).Errorf("error collecting db table stats")
+		return
+	}
+
+	dss.tableStats = tableStats
+	dss.lastUpdate = time.Now()
+	dss.collectTime = collectTime
+
+	for _, stats := range tableStats {
+		dbTableRowsGauge.WithLabelValues(stats.Table).Set(float64(stats.RowCount))
+		dbTableSizeGauge.WithLabelValues(stats.Table).Set(float64(stats.TableSize))
+		dbIndexSizeGauge.WithLabelValues(stats.Table).Set(float64(stats.IndexSize))
+	}
+
+	logrus.Debugf("collected db table stats (%v tables, %v ms)", len(tableStats), collectTime.Milliseconds())
+}
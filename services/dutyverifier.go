@@ -0,0 +1,197 @@
+package services
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/ethwallclock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	dutyVerifierMaxEpochReports = 100
+	dutyVerifierMaxMismatches   = 200
+)
+
+var (
+	proposerDutyChecksCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_proposer_duty_checks_total",
+		Help: "Number of proposed blocks checked against the computed proposer duties",
+	})
+	proposerDutyMismatchesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dora_proposer_duty_mismatches_total",
+		Help: "Number of proposed blocks with a proposer that differs from the computed proposer duty",
+	})
+)
+
+// DutyVerifier cross-checks the computed proposer duties against the actual block proposers.
+// Mismatches indicate wrong duty data or client bugs.
+type DutyVerifier struct {
+	logger        logrus.FieldLogger
+	beaconIndexer *beacon.Indexer
+	chainState    *consensus.ChainState
+
+	mutex        sync.RWMutex
+	lastEpoch    phase0.Epoch
+	epochReports []*DutyVerificationEpoch
+	mismatches   []*DutyVerificationMismatch
+}
+
+// DutyVerificationEpoch is the verification result of an epoch.
+type DutyVerificationEpoch struct {
+	Epoch         phase0.Epoch
+	DependentRoot phase0.Root
+	CheckedBlocks uint64
+	Mismatches    uint64
+	DutiesMissing bool
+	VerifiedAt    time.Time
+}
+
+// DutyVerificationMismatch is a block that was proposed by another validator than expected by the proposer duties.
+type DutyVerificationMismatch struct {
+	Slot             phase0.Slot
+	BlockRoot        phase0.Root
+	ExpectedProposer phase0.ValidatorIndex
+	ActualProposer   phase0.ValidatorIndex
+	DetectedAt       time.Time
+}
+
+func newDutyVerifier(logger logrus.FieldLogger, beaconIndexer *beacon.Indexer, chainState *consensus.ChainState) *DutyVerifier {
+	return &DutyVerifier{
+		logger:        logger,
+		beaconIndexer: beaconIndexer,
+		chainState:    chainState,
+	}
+}
+
+func (dv *DutyVerifier) start(consensusPool *consensus.Pool) {
+	epochSubscription := consensusPool.SubscribeWallclockEpochEvent(10)
+
+	go dv.runVerifierLoop(epochSubscription)
+}
+
+func (dv *DutyVerifier) runVerifierLoop(epochSubscription *consensus.Subscription[*ethwallclock.Epoch]) {
+	defer utils.HandleSubroutinePanic("DutyVerifier.runVerifierLoop", func() {
+		dv.runVerifierLoop(epochSubscription)
+	})
+
+	for wallclockEpoch := range epochSubscription.Channel() {
+		// verify the epoch before the previous one, so late blocks of the previous epoch are included
+		if wallclockEpoch.Number() < 2 {
+			continue
+		}
+
+		dv.verifyEpoch(phase0.Epoch(wallclockEpoch.Number() - 2))
+	}
+}
+
+// verifyEpoch compares the proposer duties of an epoch with the proposers of the canonical blocks.
+func (dv *DutyVerifier) verifyEpoch(epoch phase0.Epoch) {
+	dv.mutex.RLock()
+	alreadyVerified := dv.lastEpoch >= epoch && len(dv.epochReports) > 0
+	dv.mutex.RUnlock()
+	if alreadyVerified {
+		return
+	}
+
+	report := &DutyVerificationEpoch{
+		Epoch:      epoch,
+		VerifiedAt: time.Now(),
+	}
+	mismatches := []*DutyVerificationMismatch{}
+
+	var proposerDuties []phase0.ValidatorIndex
+	if epochStats := dv.beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
+		report.DependentRoot = epochStats.GetDependentRoot()
+		if epochStatsValues := epochStats.GetValues(false); epochStatsValues != nil {
+			proposerDuties = epochStatsValues.ProposerDuties
+		}
+	}
+
+	if len(proposerDuties) == 0 {
+		report.DutiesMissing = true
+	} else {
+		specs := dv.chainState.GetSpecs()
+		firstSlot := dv.chainState.EpochToSlot(epoch)
+		lastSlot := uint64(firstSlot) + specs.SlotsPerEpoch - 1
+		dbSlots := GlobalBeaconService.GetDbBlocksForSlots(lastSlot, uint32(specs.SlotsPerEpoch), false, false)
+
+		for _, dbSlot := range dbSlots {
+			if dbSlot == nil || dbSlot.Status != dbtypes.Canonical || dbSlot.Slot < uint64(firstSlot) || dbSlot.Slot > lastSlot {
+				continue
+			}
+
+			slotIndex := int(dv.chainState.SlotToSlotIndex(phase0.Slot(dbSlot.Slot)))
+			if slotIndex >= len(proposerDuties) || proposerDuties[slotIndex] == math.MaxInt64 {
+				continue
+			}
+
+			report.CheckedBlocks++
+			expectedProposer := proposerDuties[slotIndex]
+			if uint64(expectedProposer) == dbSlot.Proposer {
+				continue
+			}
+
+			report.Mismatches++
+			mismatches = append(mismatches, &DutyVerificationMismatch{
+				Slot:             phase0.Slot(dbSlot.Slot),
+				BlockRoot:        phase0.Root(dbSlot.Root),
+				ExpectedProposer: expectedProposer,
+				ActualProposer:   phase0.ValidatorIndex(dbSlot.Proposer),
+				DetectedAt:       report.VerifiedAt,
+			})
+
+			dv.logger.Warnf("proposer duty mismatch in slot %v (block %x): expected proposer %v, actual proposer %v", dbSlot.Slot, dbSlot.Root, expectedProposer, dbSlot.Proposer)
+		}
+	}
+
+	proposerDutyChecksCounter.Add(float64(report.CheckedBlocks))
+	proposerDutyMismatchesCounter.Add(float64(report.Mismatches))
+
+	if report.DutiesMissing {
+		dv.logger.Debugf("skipped proposer duty verification for epoch %v: duties not available", epoch)
+	} else {
+		dv.logger.Debugf("verified proposer duties for epoch %v: %v blocks checked, %v mismatches", epoch, report.CheckedBlocks, report.Mismatches)
+	}
+
+	dv.mutex.Lock()
+	defer dv.mutex.Unlock()
+
+	dv.lastEpoch = epoch
+	dv.epochReports = append(dv.epochReports, report)
+	if len(dv.epochReports) > dutyVerifierMaxEpochReports {
+		dv.epochReports = dv.epochReports[len(dv.epochReports)-dutyVerifierMaxEpochReports:]
+	}
+
+	dv.mismatches = append(dv.mismatches, mismatches...)
+	if len(dv.mismatches) > dutyVerifierMaxMismatches {
+		dv.mismatches = dv.mismatches[len(dv.mismatches)-dutyVerifierMaxMismatches:]
+	}
+}
+
+// GetReport returns the recent epoch verification results and detected mismatches (newest first).
+func (dv *DutyVerifier) GetReport() ([]*DutyVerificationEpoch, []*DutyVerificationMismatch) {
+	dv.mutex.RLock()
+	defer dv.mutex.RUnlock()
+
+	epochReports := make([]*DutyVerificationEpoch, len(dv.epochReports))
+	for i, report := range dv.epochReports {
+		epochReports[len(dv.epochReports)-i-1] = report
+	}
+
+	mismatches := make([]*DutyVerificationMismatch, len(dv.mismatches))
+	for i, mismatch := range dv.mismatches {
+		mismatches[len(dv.mismatches)-i-1] = mismatch
+	}
+
+	return epochReports, mismatches
+}
@@ -0,0 +1,483 @@
+package services
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// maximum number of blocks to walk back when resolving new canonical blocks or reorgs
+const eventHubMaxHeadDistance = 64
+
+const (
+	EventTypeBlock            = "block"
+	EventTypeReorg            = "reorg"
+	EventTypeFinality         = "finality"
+	EventTypeSlashing         = "slashing"
+	EventTypeMissedProposal   = "missed_proposal"
+	EventTypeFinalityIncident = "finality_incident"
+	EventTypeOrphanResolution = "orphan_resolution"
+)
+
+// persistedEventTypes are stored in the db with a sequence number, so consumers can replay them after reconnecting.
+var persistedEventTypes = map[string]bool{
+	EventTypeReorg:            true,
+	EventTypeSlashing:         true,
+	EventTypeMissedProposal:   true,
+	EventTypeFinalityIncident: true,
+	EventTypeOrphanResolution: true,
+}
+
+// IsPersistedEventType checks if events of the given type are persisted and can be replayed.
+func IsPersistedEventType(eventType string) bool {
+	return persistedEventTypes[eventType]
+}
+
+// ExplorerEvent is an event detected by the explorer, as delivered to stream consumers.
+// Seq is only set for persisted events.
+type ExplorerEvent struct {
+	Seq  uint64      `json:"seq,omitempty"`
+	Type string      `json:"type"`
+	Slot uint64      `json:"slot"`
+	Time int64       `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+type EventBlockData struct {
+	Slot           uint64 `json:"slot"`
+	Epoch          uint64 `json:"epoch"`
+	BlockRoot      string `json:"block_root"`
+	ParentRoot     string `json:"parent_root"`
+	Proposer       uint64 `json:"proposer"`
+	ProposerName   string `json:"proposer_name"`
+	Graffiti       string `json:"graffiti"`
+	EthBlockNumber uint64 `json:"eth_block_number,omitempty"`
+	EthBlockHash   string `json:"eth_block_hash,omitempty"`
+}
+
+type EventReorgData struct {
+	OldHeadSlot        uint64 `json:"old_head_slot"`
+	OldHeadRoot        string `json:"old_head_root"`
+	NewHeadSlot        uint64 `json:"new_head_slot"`
+	NewHeadRoot        string `json:"new_head_root"`
+	CommonAncestorSlot uint64 `json:"common_ancestor_slot"`
+	CommonAncestorRoot string `json:"common_ancestor_root"`
+	Depth              uint64 `json:"depth"`
+}
+
+// EventOrphanResolutionData is emitted when a block flagged as orphaned turned out to be canonical (e.g. restored by a deeper reorg).
+type EventOrphanResolutionData struct {
+	Slot         uint64 `json:"slot"`
+	BlockRoot    string `json:"block_root"`
+	Finalized    bool   `json:"finalized"`
+	Proposer     uint64 `json:"proposer"`
+	ProposerName string `json:"proposer_name"`
+}
+
+type EventFinalityData struct {
+	Epoch         uint64 `json:"epoch"`
+	Root          string `json:"root"`
+	JustifiedRoot string `json:"justified_root"`
+}
+
+type EventSlashingData struct {
+	Slot          uint64 `json:"slot"`
+	BlockRoot     string `json:"block_root"`
+	Validator     uint64 `json:"validator"`
+	ValidatorName string `json:"validator_name"`
+	Slasher       uint64 `json:"slasher"`
+	SlasherName   string `json:"slasher_name"`
+	Reason        string `json:"reason"`
+}
+
+type EventMissedProposalData struct {
+	Slot         uint64 `json:"slot"`
+	Epoch        uint64 `json:"epoch"`
+	Proposer     uint64 `json:"proposer"`
+	ProposerName string `json:"proposer_name"`
+}
+
+type EventFinalityIncidentData struct {
+	CurrentEpoch   uint64 `json:"current_epoch"`
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	Delay          uint64 `json:"delay"`
+	Resolved       bool   `json:"resolved"`
+}
+
+// EventHub translates indexer updates into explorer events and distributes them to all stream subscribers.
+type EventHub struct {
+	logger        logrus.FieldLogger
+	beaconIndexer *beacon.Indexer
+	chainState    *consensus.ChainState
+	dispatcher    consensus.Dispatcher[*ExplorerEvent]
+	lastHead      *beacon.Block
+
+	seqMutex sync.RWMutex
+	lastSeq  uint64
+
+	watchedValidators map[phase0.ValidatorIndex]bool
+	reportedMissed    map[phase0.Slot]bool
+	reportedSlashings map[uint64]bool
+	finalityIncident  bool
+	lastEventPrune    time.Time
+}
+
+func newEventHub(logger logrus.FieldLogger, beaconIndexer *beacon.Indexer, consensusPool *consensus.Pool) *EventHub {
+	watchedValidators := map[phase0.ValidatorIndex]bool{}
+	for _, validator := range utils.Config.Api.WatchedValidators {
+		watchedValidators[phase0.ValidatorIndex(validator)] = true
+	}
+
+	return &EventHub{
+		logger:            logger,
+		beaconIndexer:     beaconIndexer,
+		chainState:        consensusPool.GetChainState(),
+		watchedValidators: watchedValidators,
+		reportedMissed:    map[phase0.Slot]bool{},
+		reportedSlashings: map[uint64]bool{},
+	}
+}
+
+// Subscribe subscribes to all explorer events.
+// slow subscribers do not block the hub, events are dropped if the subscription buffer is full.
+func (hub *EventHub) Subscribe(capacity int) *consensus.Subscription[*ExplorerEvent] {
+	return hub.dispatcher.Subscribe(capacity, false)
+}
+
+// GetLastSeq returns the sequence number of the latest persisted event.
+func (hub *EventHub) GetLastSeq() uint64 {
+	hub.seqMutex.RLock()
+	defer hub.seqMutex.RUnlock()
+	return hub.lastSeq
+}
+
+// GetPersistedEvents returns persisted events with a sequence number higher than afterSeq.
+func (hub *EventHub) GetPersistedEvents(afterSeq uint64, eventTypes []string, limit uint64) []*ExplorerEvent {
+	dbEvents := db.GetExplorerEvents(afterSeq, eventTypes, limit)
+	events := make([]*ExplorerEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		events = append(events, &ExplorerEvent{
+			Seq:  dbEvent.Seq,
+			Type: dbEvent.Type,
+			Slot: dbEvent.Slot,
+			Time: int64(dbEvent.Time),
+			Data: json.RawMessage(dbEvent.Data),
+		})
+	}
+	return events
+}
+
+func (hub *EventHub) start(consensusPool *consensus.Pool) {
+	hub.lastSeq = db.GetLastExplorerEventSeq()
+
+	headSubscription := hub.beaconIndexer.SubscribeCanonicalHead(10)
+	finalitySubscription := consensusPool.SubscribeFinalizedEvent(10)
+	orphanSubscription := hub.beaconIndexer.SubscribeOrphanResolution(10)
+
+	go hub.runEventLoop(headSubscription, finalitySubscription, orphanSubscription)
+}
+
+func (hub *EventHub) runEventLoop(headSubscription *consensus.Subscription[*beacon.Block], finalitySubscription *consensus.Subscription[*v1.Finality], orphanSubscription *consensus.Subscription[*beacon.OrphanResolution]) {
+	defer utils.HandleSubroutinePanic("EventHub.runEventLoop", func() {
+		hub.runEventLoop(headSubscription, finalitySubscription, orphanSubscription)
+	})
+
+	for {
+		select {
+		case headBlock := <-headSubscription.Channel():
+			hub.processHeadUpdate(headBlock)
+			hub.checkFinalityIncident()
+		case finality := <-finalitySubscription.Channel():
+			hub.emitEvent(EventTypeFinality, hub.chainState.EpochToSlot(finality.Finalized.Epoch), &EventFinalityData{
+				Epoch:         uint64(finality.Finalized.Epoch),
+				Root:          finality.Finalized.Root.String(),
+				JustifiedRoot: finality.Justified.Root.String(),
+			})
+			hub.checkFinalityIncident()
+			hub.pruneReportedEvents(hub.chainState.EpochToSlot(finality.Finalized.Epoch))
+		case resolution := <-orphanSubscription.Channel():
+			hub.emitEvent(EventTypeOrphanResolution, resolution.Slot, hub.buildOrphanResolutionEventData(resolution))
+		}
+	}
+}
+
+// emitEvent persists the event if its type supports replay and fires it to all subscribers.
+func (hub *EventHub) emitEvent(eventType string, slot phase0.Slot, data interface{}) {
+	event := &ExplorerEvent{
+		Type: eventType,
+		Slot: uint64(slot),
+		Time: hub.chainState.SlotToTime(slot).Unix(),
+		Data: data,
+	}
+
+	if persistedEventTypes[eventType] {
+		hub.persistEvent(event)
+	}
+
+	hub.dispatcher.Fire(event)
+}
+
+func (hub *EventHub) persistEvent(event *ExplorerEvent) {
+	eventData, err := json.Marshal(event.Data)
+	if err != nil {
+		hub.logger.Errorf("error serializing %v event: %v", event.Type, err)
+		return
+	}
+
+	hub.seqMutex.Lock()
+	defer hub.seqMutex.Unlock()
+
+	dbEvent := &dbtypes.ExplorerEvent{
+		Seq:  hub.lastSeq + 1,
+		Type: event.Type,
+		Slot: event.Slot,
+		Time: uint64(event.Time),
+		Data: string(eventData),
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertExplorerEvent(dbEvent, tx)
+	})
+	if err != nil {
+		hub.logger.Errorf("error persisting %v event: %v", event.Type, err)
+		return
+	}
+
+	hub.lastSeq = dbEvent.Seq
+	event.Seq = dbEvent.Seq
+}
+
+// checkFinalityIncident reports when finality is delayed for more than the configured number of epochs, and when it recovers.
+func (hub *EventHub) checkFinalityIncident() {
+	threshold := utils.Config.Api.FinalityIncidentEpochs
+	if threshold == 0 {
+		threshold = 4
+	}
+
+	currentEpoch := hub.chainState.CurrentEpoch()
+	finalizedEpoch, finalizedRoot := hub.chainState.GetFinalizedCheckpoint()
+	if finalizedRoot == consensus.NullRoot && currentEpoch > phase0.Epoch(threshold) {
+		// finalized checkpoint not loaded yet
+		return
+	}
+	delay := uint64(0)
+	if currentEpoch > finalizedEpoch {
+		delay = uint64(currentEpoch - finalizedEpoch)
+	}
+
+	isIncident := delay > threshold
+	if isIncident == hub.finalityIncident {
+		return
+	}
+	hub.finalityIncident = isIncident
+
+	hub.emitEvent(EventTypeFinalityIncident, hub.chainState.CurrentSlot(), &EventFinalityIncidentData{
+		CurrentEpoch:   uint64(currentEpoch),
+		FinalizedEpoch: uint64(finalizedEpoch),
+		Delay:          delay,
+		Resolved:       !isIncident,
+	})
+}
+
+// pruneReportedEvents cleans up the duplicate tracking for finalized slots and removes expired events from the db.
+func (hub *EventHub) pruneReportedEvents(finalizedSlot phase0.Slot) {
+	for slot := range hub.reportedMissed {
+		if slot < finalizedSlot {
+			delete(hub.reportedMissed, slot)
+		}
+	}
+
+	if time.Since(hub.lastEventPrune) < 1*time.Hour {
+		return
+	}
+	hub.lastEventPrune = time.Now()
+
+	retention := utils.Config.Api.EventRetention
+	if retention == 0 {
+		retention = 30 * 24 * time.Hour
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.DeleteExplorerEventsBefore(uint64(time.Now().Add(-retention).Unix()), tx)
+	})
+	if err != nil {
+		hub.logger.Errorf("error pruning expired events: %v", err)
+	}
+}
+
+// processHeadUpdate emits block events for all blocks that became canonical with the new head,
+// and a reorg event if the previous head is not part of the new canonical chain.
+func (hub *EventHub) processHeadUpdate(headBlock *beacon.Block) {
+	lastHead := hub.lastHead
+	hub.lastHead = headBlock
+	if lastHead == nil || lastHead == headBlock {
+		return
+	}
+
+	// find common ancestor of the old and new head
+	ancestor := lastHead
+	for distance := 0; ancestor != nil && !hub.beaconIndexer.IsCanonicalBlockByHead(ancestor, headBlock); distance++ {
+		if distance >= eventHubMaxHeadDistance {
+			ancestor = nil
+			break
+		}
+		ancestor = hub.getParentBlock(ancestor)
+	}
+
+	if ancestor != lastHead {
+		reorgData := &EventReorgData{
+			OldHeadSlot: uint64(lastHead.Slot),
+			OldHeadRoot: lastHead.Root.String(),
+			NewHeadSlot: uint64(headBlock.Slot),
+			NewHeadRoot: headBlock.Root.String(),
+		}
+		if ancestor != nil {
+			reorgData.CommonAncestorSlot = uint64(ancestor.Slot)
+			reorgData.CommonAncestorRoot = ancestor.Root.String()
+			reorgData.Depth = uint64(lastHead.Slot - ancestor.Slot)
+		}
+
+		hub.emitEvent(EventTypeReorg, headBlock.Slot, reorgData)
+	}
+
+	// collect new canonical blocks
+	newBlocks := []*beacon.Block{}
+	for block := headBlock; block != nil && block != ancestor && len(newBlocks) < eventHubMaxHeadDistance; block = hub.getParentBlock(block) {
+		if ancestor != nil && block.Slot <= ancestor.Slot {
+			break
+		}
+		newBlocks = append(newBlocks, block)
+	}
+
+	for i := len(newBlocks) - 1; i >= 0; i-- {
+		block := newBlocks[i]
+		if len(hub.watchedValidators) > 0 {
+			hub.checkMissedProposals(block)
+		}
+
+		hub.emitEvent(EventTypeBlock, block.Slot, hub.buildBlockEventData(block))
+
+		for _, slashing := range block.GetDbSlashings(hub.beaconIndexer, true) {
+			if hub.reportedSlashings[slashing.ValidatorIndex] {
+				continue
+			}
+			hub.reportedSlashings[slashing.ValidatorIndex] = true
+
+			reason := "unspecified"
+			switch slashing.Reason {
+			case dbtypes.ProposerSlashing:
+				reason = "proposer"
+			case dbtypes.AttesterSlashing:
+				reason = "attester"
+			}
+
+			hub.emitEvent(EventTypeSlashing, block.Slot, &EventSlashingData{
+				Slot:          uint64(block.Slot),
+				BlockRoot:     block.Root.String(),
+				Validator:     slashing.ValidatorIndex,
+				ValidatorName: GlobalBeaconService.GetValidatorName(slashing.ValidatorIndex),
+				Slasher:       slashing.SlasherIndex,
+				SlasherName:   GlobalBeaconService.GetValidatorName(slashing.SlasherIndex),
+				Reason:        reason,
+			})
+		}
+	}
+}
+
+// checkMissedProposals reports missed proposals of watched validators in the slots between the block and its parent.
+func (hub *EventHub) checkMissedProposals(block *beacon.Block) {
+	parentBlock := hub.getParentBlock(block)
+	if parentBlock == nil {
+		return
+	}
+
+	for slot := parentBlock.Slot + 1; slot < block.Slot; slot++ {
+		if hub.reportedMissed[slot] {
+			continue
+		}
+
+		epoch := hub.chainState.EpochOfSlot(slot)
+		epochStats := hub.beaconIndexer.GetEpochStats(epoch, nil)
+		if epochStats == nil {
+			continue
+		}
+		epochStatsValues := epochStats.GetValues(true)
+		if epochStatsValues == nil {
+			continue
+		}
+
+		slotIndex := int(hub.chainState.SlotToSlotIndex(slot))
+		if slotIndex >= len(epochStatsValues.ProposerDuties) {
+			continue
+		}
+		proposer := epochStatsValues.ProposerDuties[slotIndex]
+		if proposer == math.MaxInt64 || !hub.watchedValidators[proposer] {
+			continue
+		}
+
+		hub.reportedMissed[slot] = true
+		hub.emitEvent(EventTypeMissedProposal, slot, &EventMissedProposalData{
+			Slot:         uint64(slot),
+			Epoch:        uint64(epoch),
+			Proposer:     uint64(proposer),
+			ProposerName: GlobalBeaconService.GetValidatorName(uint64(proposer)),
+		})
+	}
+}
+
+func (hub *EventHub) getParentBlock(block *beacon.Block) *beacon.Block {
+	parentRoot := block.GetParentRoot()
+	if parentRoot == nil {
+		return nil
+	}
+	return hub.beaconIndexer.GetBlockByRoot(*parentRoot)
+}
+
+func (hub *EventHub) buildOrphanResolutionEventData(resolution *beacon.OrphanResolution) *EventOrphanResolutionData {
+	return &EventOrphanResolutionData{
+		Slot:         uint64(resolution.Slot),
+		BlockRoot:    resolution.Root.String(),
+		Finalized:    resolution.Finalized,
+		Proposer:     uint64(resolution.Proposer),
+		ProposerName: GlobalBeaconService.GetValidatorName(uint64(resolution.Proposer)),
+	}
+}
+
+func (hub *EventHub) buildBlockEventData(block *beacon.Block) *EventBlockData {
+	blockData := &EventBlockData{
+		Slot:      uint64(block.Slot),
+		Epoch:     uint64(hub.chainState.EpochOfSlot(block.Slot)),
+		BlockRoot: block.Root.String(),
+	}
+
+	if parentRoot := block.GetParentRoot(); parentRoot != nil {
+		blockData.ParentRoot = parentRoot.String()
+	}
+
+	if header := block.GetHeader(); header != nil {
+		blockData.Proposer = uint64(header.Message.ProposerIndex)
+		blockData.ProposerName = GlobalBeaconService.GetValidatorName(blockData.Proposer)
+	}
+
+	if blockIndex := block.GetBlockIndex(); blockIndex != nil {
+		blockData.Graffiti = utils.GraffitiToString(blockIndex.Graffiti[:])
+		if blockIndex.ExecutionNumber > 0 {
+			blockData.EthBlockNumber = blockIndex.ExecutionNumber
+			blockData.EthBlockHash = phase0.Root(blockIndex.ExecutionHash).String()
+		}
+	}
+
+	return blockData
+}
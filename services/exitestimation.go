@@ -0,0 +1,188 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+// ExitQueueStats holds the exit queue state derived from the cached validator set of an epoch.
+type ExitQueueStats struct {
+	Epoch              phase0.Epoch
+	ActiveCount        uint64
+	TotalActiveBalance phase0.Gwei
+
+	PendingExitCount   uint64      // validators with an exit epoch in the future
+	PendingExitBalance phase0.Gwei // effective balance of validators with an exit epoch in the future
+	MaxExitEpoch       phase0.Epoch
+	MaxExitEpochCount  uint64      // validators exiting in the max exit epoch
+	MaxExitEpochChurn  phase0.Gwei // effective balance exiting in the max exit epoch
+
+	BalanceChurn bool   // churn is balance based (electra), otherwise validator count based
+	ChurnLimit   uint64 // exit churn per epoch (gwei for balance based churn, validators otherwise)
+}
+
+// ValidatorExitEstimation holds the estimated exit of a validator if a voluntary exit was submitted now.
+type ValidatorExitEstimation struct {
+	ValidatorIndex phase0.ValidatorIndex
+	Queue          *ExitQueueStats
+
+	AlreadyExiting     bool         // validator already has an exit epoch, the actual epochs are returned
+	CanExit            bool         // validator is active and passed the shard committee period
+	EarliestExitSubmit phase0.Epoch // earliest epoch a voluntary exit is accepted
+
+	ExitEpoch         phase0.Epoch
+	ExitTime          time.Time
+	WithdrawableEpoch phase0.Epoch
+	WithdrawableTime  time.Time
+}
+
+// ExitEstimator computes exit estimations based on the exit queue of the cached validator set.
+// the queue stats are computed once per epoch and shared for all estimations.
+type ExitEstimator struct {
+	mutex sync.Mutex
+	stats *ExitQueueStats
+}
+
+func newExitEstimator() *ExitEstimator {
+	return &ExitEstimator{}
+}
+
+func (bs *ChainService) getExitQueueStats(epoch phase0.Epoch) *ExitQueueStats {
+	bs.exitEstimator.mutex.Lock()
+	defer bs.exitEstimator.mutex.Unlock()
+
+	if bs.exitEstimator.stats != nil && bs.exitEstimator.stats.Epoch == epoch {
+		return bs.exitEstimator.stats
+	}
+
+	validatorSet := bs.beaconIndexer.GetEpochValidatorSet(epoch, nil, false)
+	if validatorSet == nil {
+		return nil
+	}
+
+	stats := &ExitQueueStats{
+		Epoch: epoch,
+	}
+	for _, validator := range validatorSet {
+		if validator.Validator.ActivationEpoch <= epoch && epoch < validator.Validator.ExitEpoch {
+			stats.ActiveCount++
+			stats.TotalActiveBalance += validator.Validator.EffectiveBalance
+		}
+
+		exitEpoch := validator.Validator.ExitEpoch
+		if exitEpoch == beacon.FarFutureEpoch {
+			continue
+		}
+
+		if exitEpoch > epoch {
+			stats.PendingExitCount++
+			stats.PendingExitBalance += validator.Validator.EffectiveBalance
+		}
+
+		if exitEpoch > stats.MaxExitEpoch {
+			stats.MaxExitEpoch = exitEpoch
+			stats.MaxExitEpochCount = 0
+			stats.MaxExitEpochChurn = 0
+		}
+		if exitEpoch == stats.MaxExitEpoch {
+			stats.MaxExitEpochCount++
+			stats.MaxExitEpochChurn += validator.Validator.EffectiveBalance
+		}
+	}
+
+	chainState := bs.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	if specs.ElectraForkEpoch != nil && epoch >= phase0.Epoch(*specs.ElectraForkEpoch) && specs.MaxPerEpochActivationExitChurnLimit > 0 {
+		// get_activation_exit_churn_limit
+		balanceChurn := uint64(stats.TotalActiveBalance) / specs.ChurnLimitQuotient
+		if balanceChurn < specs.MinPerEpochChurnLimitElectra {
+			balanceChurn = specs.MinPerEpochChurnLimitElectra
+		}
+		if specs.EffectiveBalanceIncrement > 0 {
+			balanceChurn -= balanceChurn % specs.EffectiveBalanceIncrement
+		}
+		if balanceChurn > specs.MaxPerEpochActivationExitChurnLimit {
+			balanceChurn = specs.MaxPerEpochActivationExitChurnLimit
+		}
+
+		stats.BalanceChurn = true
+		stats.ChurnLimit = balanceChurn
+	} else {
+		stats.ChurnLimit = chainState.GetValidatorChurnLimit(stats.ActiveCount)
+	}
+
+	bs.exitEstimator.stats = stats
+	return stats
+}
+
+// EstimateValidatorExit estimates the exit and withdrawable epochs of a validator if a voluntary exit was submitted now.
+// the estimation follows the spec exit queue logic on the cached validator set. for balance based churn (electra) the
+// churn already consumed by earlier exits is approximated from the exits in the last queued epoch.
+func (bs *ChainService) EstimateValidatorExit(validatorIndex phase0.ValidatorIndex) (*ValidatorExitEstimation, error) {
+	chainState := bs.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	currentEpoch := chainState.CurrentEpoch()
+
+	validator := bs.beaconIndexer.GetEpochValidator(validatorIndex, currentEpoch, nil, false)
+	if validator == nil {
+		return nil, fmt.Errorf("validator %v not found", validatorIndex)
+	}
+
+	queue := bs.getExitQueueStats(currentEpoch)
+	if queue == nil {
+		return nil, fmt.Errorf("validator set for epoch %v not available", currentEpoch)
+	}
+
+	estimation := &ValidatorExitEstimation{
+		ValidatorIndex:     validatorIndex,
+		Queue:              queue,
+		EarliestExitSubmit: validator.Validator.ActivationEpoch + phase0.Epoch(specs.ShardCommitteePeriod),
+	}
+
+	if validator.Validator.ExitEpoch != beacon.FarFutureEpoch {
+		estimation.AlreadyExiting = true
+		estimation.ExitEpoch = validator.Validator.ExitEpoch
+		estimation.WithdrawableEpoch = validator.Validator.WithdrawableEpoch
+	} else {
+		estimation.CanExit = validator.Status == v1.ValidatorStateActiveOngoing && currentEpoch >= estimation.EarliestExitSubmit
+
+		// compute_activation_exit_epoch
+		exitEpoch := currentEpoch + 1 + phase0.Epoch(specs.MaxSeedLookahead)
+		if queue.MaxExitEpoch > exitEpoch {
+			exitEpoch = queue.MaxExitEpoch
+		}
+
+		if queue.BalanceChurn {
+			// compute_exit_epoch_and_update_churn
+			exitBalance := uint64(validator.Validator.EffectiveBalance)
+			availableChurn := queue.ChurnLimit
+			if exitEpoch == queue.MaxExitEpoch {
+				if uint64(queue.MaxExitEpochChurn) >= availableChurn {
+					availableChurn = 0
+				} else {
+					availableChurn -= uint64(queue.MaxExitEpochChurn)
+				}
+			}
+			if exitBalance > availableChurn && queue.ChurnLimit > 0 {
+				exitEpoch += phase0.Epoch((exitBalance-availableChurn-1)/queue.ChurnLimit + 1)
+			}
+		} else if exitEpoch == queue.MaxExitEpoch && queue.MaxExitEpochCount >= queue.ChurnLimit {
+			// initiate_validator_exit (pre-electra)
+			exitEpoch++
+		}
+
+		estimation.ExitEpoch = exitEpoch
+		estimation.WithdrawableEpoch = exitEpoch + phase0.Epoch(specs.MinValidatorWithdrawabilityDelay)
+	}
+
+	estimation.ExitTime = chainState.EpochToTime(estimation.ExitEpoch)
+	estimation.WithdrawableTime = chainState.EpochToTime(estimation.WithdrawableEpoch)
+
+	return estimation, nil
+}
@@ -0,0 +1,600 @@
+// Package fakebeacon provides a fixture based implementation of the services.BeaconService interface.
+// it serves the frontend handlers from static data without client pools, indexers or database, e.g. for handler tests.
+// methods without fixture data return empty results, the beacon indexer is replaced by a fixture based indexer without cached data.
+package fakebeacon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// BeaconService is a fixture based services.BeaconService.
+// fixture fields may be modified until the service is in use, mutating methods (annotations, test runs, name overrides) are synchronized.
+type BeaconService struct {
+	ChainState        *consensus.ChainState
+	LiveDataAvailable bool
+
+	Blocks          []*services.CombinedBlockResponse // full blocks, served by the slot details
+	Blobs           []*deneb.BlobSidecar
+	DbSlots         []*dbtypes.Slot // block list entries, also used for root & parent root lookups
+	DbEpochs        []*dbtypes.Epoch
+	EpochVotes      map[phase0.Epoch]*beacon.EpochVotes // live vote aggregations of unfinalized epochs
+	Validators      []*v1.Validator                     // validator set, ordered by index
+	ValidatorNames  map[uint64]string
+	Deposits        []*dbtypes.Deposit
+	VoluntaryExits  []*dbtypes.VoluntaryExit
+	Slashings       []*dbtypes.Slashing
+	ValidatorEvents []*dbtypes.ValidatorEvent
+	Annotations     []*dbtypes.Annotation
+	TestRuns        []*dbtypes.TestRun
+
+	indexer *Indexer
+	mutex   sync.Mutex
+}
+
+var _ services.BeaconService = (*BeaconService)(nil)
+
+// NewBeaconService creates a fake beacon service for the given chain specs & genesis time with all other fixtures empty.
+func NewBeaconService(specs *consensus.ChainSpec, genesisTime time.Time) *BeaconService {
+	return &BeaconService{
+		ChainState: consensus.NewStaticChainState(specs, &v1.Genesis{
+			GenesisTime:        genesisTime,
+			GenesisForkVersion: specs.GenesisForkVersion,
+		}, nil),
+		LiveDataAvailable: true,
+		ValidatorNames:    map[uint64]string{},
+	}
+}
+
+func (fs *BeaconService) StartService() error {
+	return nil
+}
+
+func (fs *BeaconService) GetChainState() *consensus.ChainState {
+	return fs.ChainState
+}
+
+func (fs *BeaconService) GetGenesis() (*v1.Genesis, error) {
+	genesis := fs.ChainState.GetGenesis()
+	if genesis == nil {
+		return nil, fmt.Errorf("genesis not available")
+	}
+	return genesis, nil
+}
+
+func (fs *BeaconService) GetFinalizedEpoch() (phase0.Epoch, phase0.Root) {
+	return fs.ChainState.GetFinalizedCheckpoint()
+}
+
+func (fs *BeaconService) GetBeaconIndexer() services.BeaconIndexer {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	if fs.indexer == nil {
+		fs.indexer = newIndexer(fs)
+	}
+	return fs.indexer
+}
+
+func (fs *BeaconService) GetEventHub() *services.EventHub {
+	return nil
+}
+
+func (fs *BeaconService) GetDutyVerifier() *services.DutyVerifier {
+	return nil
+}
+
+func (fs *BeaconService) GetConsensusClients() []*consensus.Client {
+	return []*consensus.Client{}
+}
+
+func (fs *BeaconService) GetExecutionClients() []*execution.Client {
+	return []*execution.Client{}
+}
+
+func (fs *BeaconService) GetConsensusClientForks() []*services.ConsensusClientFork {
+	return []*services.ConsensusClientFork{}
+}
+
+func (fs *BeaconService) GetChainHeads() []*services.ChainHeadInfo {
+	return []*services.ChainHeadInfo{}
+}
+
+func (fs *BeaconService) IsLiveDataAvailable() bool {
+	return fs.LiveDataAvailable
+}
+
+func (fs *BeaconService) IsEpochInVoteWindow(epoch phase0.Epoch) bool {
+	return false
+}
+
+func (fs *BeaconService) GetSlotDetailsByBlockroot(ctx context.Context, blockroot phase0.Root) (*services.CombinedBlockResponse, error) {
+	for _, block := range fs.Blocks {
+		if block.Root == blockroot {
+			return block, nil
+		}
+	}
+	return nil, nil
+}
+
+func (fs *BeaconService) GetSlotDetailsBySlot(ctx context.Context, slot phase0.Slot) (*services.CombinedBlockResponse, error) {
+	for _, block := range fs.Blocks {
+		if !block.Orphaned && block.Header != nil && block.Header.Message.Slot == slot {
+			return block, nil
+		}
+	}
+	return nil, nil
+}
+
+func (fs *BeaconService) GetSlotBlockCandidates(slot phase0.Slot) []*services.SlotBlockCandidate {
+	return []*services.SlotBlockCandidate{}
+}
+
+func (fs *BeaconService) GetBlockBlob(ctx context.Context, blockroot phase0.Root, commitment deneb.KZGCommitment) (*deneb.BlobSidecar, error) {
+	for _, blob := range fs.Blobs {
+		if blob.SignedBlockHeader == nil || blob.KZGCommitment != commitment {
+			continue
+		}
+		blockHeaderRoot, err := blob.SignedBlockHeader.Message.HashTreeRoot()
+		if err == nil && blockHeaderRoot == blockroot {
+			return blob, nil
+		}
+	}
+	return nil, nil
+}
+
+func (fs *BeaconService) GetDbBlockByRoot(blockRoot phase0.Root) *dbtypes.Slot {
+	for _, slot := range fs.DbSlots {
+		if bytes.Equal(slot.Root, blockRoot[:]) {
+			return slot
+		}
+	}
+	return nil
+}
+
+func (fs *BeaconService) GetDbBlocksForSlots(firstSlot uint64, slotLimit uint32, withMissing bool, withOrphaned bool) []*dbtypes.Slot {
+	// firstSlot is the highest slot, blocks are returned in descending order
+	lastSlot := uint64(0)
+	if firstSlot >= uint64(slotLimit) {
+		lastSlot = firstSlot - uint64(slotLimit) + 1
+	}
+
+	slots := []*dbtypes.Slot{}
+	for _, slot := range fs.DbSlots {
+		if slot.Slot > firstSlot || slot.Slot < lastSlot {
+			continue
+		}
+		if (slot.Status == dbtypes.Missing && !withMissing) || (slot.Status == dbtypes.Orphaned && !withOrphaned) {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(a, b int) bool {
+		return slots[a].Slot > slots[b].Slot
+	})
+	return slots
+}
+
+func (fs *BeaconService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx uint64, pageSize uint32, withScheduledCount uint64) []*dbtypes.AssignedSlot {
+	assignedSlots := []*dbtypes.AssignedSlot{}
+	for _, slot := range fs.DbSlots {
+		assignedSlots = append(assignedSlots, &dbtypes.AssignedSlot{
+			Slot:     slot.Slot,
+			Proposer: slot.Proposer,
+			Block:    slot,
+		})
+	}
+	sort.Slice(assignedSlots, func(a, b int) bool {
+		return assignedSlots[a].Slot > assignedSlots[b].Slot
+	})
+	page, _ := getPage(assignedSlots, pageIdx*uint64(pageSize), pageSize)
+	return page
+}
+
+func (fs *BeaconService) GetDbBlocksByParentRoot(parentRoot phase0.Root) []*dbtypes.Slot {
+	slots := []*dbtypes.Slot{}
+	for _, slot := range fs.DbSlots {
+		if bytes.Equal(slot.ParentRoot, parentRoot[:]) {
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+func (fs *BeaconService) CheckBlockOrphanedStatus(blockRoot phase0.Root) dbtypes.SlotStatus {
+	if slot := fs.GetDbBlockByRoot(blockRoot); slot != nil {
+		return slot.Status
+	}
+	return dbtypes.Missing
+}
+
+func (fs *BeaconService) ReindexBlock(ctx context.Context, blockRoot phase0.Root, clientName string) (*beacon.BlockReindexResult, error) {
+	return nil, fmt.Errorf("block re-index not supported")
+}
+
+func (fs *BeaconService) GetRecentGraffitis(limit uint32) []*dbtypes.SlotGraffiti {
+	graffitis := []*dbtypes.SlotGraffiti{}
+	for _, slot := range fs.GetDbBlocksForSlots(uint64(fs.ChainState.CurrentSlot()), uint32(fs.ChainState.CurrentSlot())+1, false, false) {
+		if slot.GraffitiText == "" {
+			continue
+		}
+		graffitis = append(graffitis, &dbtypes.SlotGraffiti{
+			Slot:         slot.Slot,
+			Proposer:     slot.Proposer,
+			GraffitiText: slot.GraffitiText,
+		})
+		if uint32(len(graffitis)) >= limit {
+			break
+		}
+	}
+	return graffitis
+}
+
+func (fs *BeaconService) GetEpochVotes(epoch phase0.Epoch) *beacon.EpochVotes {
+	return fs.EpochVotes[epoch]
+}
+
+func (fs *BeaconService) GetDbEpochs(firstEpoch uint64, limit uint32) []*dbtypes.Epoch {
+	// firstEpoch is the highest epoch, epochs are returned in descending order.
+	// like the chain service, the result has limit entries with placeholders for epochs without fixture.
+	epochs := make([]*dbtypes.Epoch, limit)
+	for idx := range epochs {
+		if uint64(idx) > firstEpoch {
+			break
+		}
+
+		epochNumber := firstEpoch - uint64(idx)
+		epochs[idx] = &dbtypes.Epoch{Epoch: epochNumber}
+		for _, epoch := range fs.DbEpochs {
+			if epoch.Epoch == epochNumber {
+				epochs[idx] = epoch
+				break
+			}
+		}
+	}
+	return epochs
+}
+
+func (fs *BeaconService) GetSlotRangeStats(firstSlot uint64, lastSlot uint64) (*dbtypes.SlotRangeStats, error) {
+	stats := &dbtypes.SlotRangeStats{}
+	for _, slot := range fs.DbSlots {
+		if slot.Slot < firstSlot || slot.Slot > lastSlot {
+			continue
+		}
+		switch slot.Status {
+		case dbtypes.Canonical:
+			stats.CanonicalCount++
+			stats.AttestationCount += slot.AttestationCount
+			stats.DepositCount += slot.DepositCount
+			stats.ExitCount += slot.ExitCount
+			stats.ProposerSlashingCount += slot.ProposerSlashingCount
+			stats.AttesterSlashingCount += slot.AttesterSlashingCount
+			stats.EthTransactionCount += slot.EthTransactionCount
+		case dbtypes.Orphaned:
+			stats.OrphanedCount++
+		case dbtypes.Missing:
+			stats.MissedCount++
+		}
+	}
+	return stats, nil
+}
+
+func (fs *BeaconService) GetBlobInclusionStats(firstSlot phase0.Slot, lastSlot phase0.Slot, groupByEntity bool) []*services.BlobInclusionStats {
+	proposerMap := map[uint64]*dbtypes.ProposerBlobStats{}
+	proposerStats := []*dbtypes.ProposerBlobStats{}
+	for _, slot := range fs.DbSlots {
+		if slot.Slot < uint64(firstSlot) || slot.Slot > uint64(lastSlot) || slot.Status != dbtypes.Canonical || slot.EthBlockNumber == nil {
+			continue
+		}
+		stats := proposerMap[slot.Proposer]
+		if stats == nil {
+			stats = &dbtypes.ProposerBlobStats{
+				Proposer: slot.Proposer,
+			}
+			proposerMap[slot.Proposer] = stats
+			proposerStats = append(proposerStats, stats)
+		}
+		stats.BlockCount++
+		stats.BlobCount += slot.BlobCount
+		if slot.BlobCount > 0 {
+			stats.BlobBlockCount++
+		}
+		if slot.BlobCount > stats.MaxBlobCount {
+			stats.MaxBlobCount = slot.BlobCount
+		}
+	}
+	return services.AggregateBlobInclusionStats(proposerStats, fs.GetValidatorName, groupByEntity)
+}
+
+func (fs *BeaconService) GetEpochRangeStats(firstEpoch uint64, lastEpoch uint64) (*services.EpochRangeStats, []*dbtypes.Epoch) {
+	stats := &services.EpochRangeStats{}
+	epochs := []*dbtypes.Epoch{}
+	for _, epoch := range fs.DbEpochs {
+		if epoch.Epoch < firstEpoch || epoch.Epoch > lastEpoch {
+			continue
+		}
+		epochs = append(epochs, epoch)
+		stats.EpochCount++
+		stats.SynchronizedCount++
+		stats.Eligible += epoch.Eligible
+		stats.VotedTarget += epoch.VotedTarget
+		stats.VotedHead += epoch.VotedHead
+		stats.VotedTotal += epoch.VotedTotal
+	}
+	return stats, epochs
+}
+
+func (fs *BeaconService) GetCachedValidatorSet(withBalance bool) []*v1.Validator {
+	return fs.Validators
+}
+
+func (fs *BeaconService) GetValidatorSetSnapshot() *services.ValidatorSetSnapshot {
+	return services.NewValidatorSetSnapshot(fs.ChainState.CurrentEpoch(), fs.Validators)
+}
+
+func (fs *BeaconService) GetValidatorByIndex(index phase0.ValidatorIndex, withBalance bool) *v1.Validator {
+	if uint64(index) >= uint64(len(fs.Validators)) {
+		return nil
+	}
+	return fs.Validators[index]
+}
+
+func (fs *BeaconService) GetValidatorIndexByPubkey(pubkey phase0.BLSPubKey) (phase0.ValidatorIndex, bool) {
+	for _, validator := range fs.Validators {
+		if validator.Validator != nil && validator.Validator.PublicKey == pubkey {
+			return validator.Index, true
+		}
+	}
+	return 0, false
+}
+
+func (fs *BeaconService) GetValidatorLiveness(validatorIndex phase0.ValidatorIndex, lookbackEpochs uint64) (votedEpochs uint64) {
+	return 0
+}
+
+func (fs *BeaconService) GetValidatorVotingActivity(validatorIndex phase0.ValidatorIndex) ([]beacon.ValidatorActivity, phase0.Epoch) {
+	return []beacon.ValidatorActivity{}, fs.ChainState.CurrentEpoch()
+}
+
+func (fs *BeaconService) GetValidatorDepositSummary(pubkey []byte) *services.ValidatorDepositSummary {
+	return nil
+}
+
+func (fs *BeaconService) GetLastValidatorProposals(indices []phase0.ValidatorIndex) map[phase0.ValidatorIndex]phase0.Slot {
+	lastProposals := map[phase0.ValidatorIndex]phase0.Slot{}
+	for _, index := range indices {
+		for _, slot := range fs.DbSlots {
+			if slot.Status == dbtypes.Canonical && slot.Proposer == uint64(index) && phase0.Slot(slot.Slot) > lastProposals[index] {
+				lastProposals[index] = phase0.Slot(slot.Slot)
+			}
+		}
+	}
+	return lastProposals
+}
+
+func (fs *BeaconService) EstimateValidatorExit(validatorIndex phase0.ValidatorIndex) (*services.ValidatorExitEstimation, error) {
+	return nil, services.ErrLiveDataUnavailable
+}
+
+func (fs *BeaconService) EstimateSlashingPenalty(validatorIndex phase0.ValidatorIndex, slashedEpoch *phase0.Epoch) (*services.SlashingPenaltyEstimation, error) {
+	return nil, services.ErrLiveDataUnavailable
+}
+
+func (fs *BeaconService) GetValidatorName(index uint64) string {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	return fs.ValidatorNames[index]
+}
+
+func (fs *BeaconService) GetValidatorNamesCount() uint64 {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	return uint64(len(fs.ValidatorNames))
+}
+
+func (fs *BeaconService) GetValidatorNameRules() []*services.ValidatorNameRule {
+	return []*services.ValidatorNameRule{}
+}
+
+func (fs *BeaconService) SetValidatorNameOverride(key string, name string) (string, error) {
+	var index uint64
+	if _, err := fmt.Sscanf(key, "%d", &index); err != nil {
+		return "", fmt.Errorf("invalid validator index: %v", key)
+	}
+
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	fs.ValidatorNames[index] = name
+	return key, nil
+}
+
+func (fs *BeaconService) DeleteValidatorNameOverride(key string) (bool, error) {
+	var index uint64
+	if _, err := fmt.Sscanf(key, "%d", &index); err != nil {
+		return false, nil
+	}
+
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	_, found := fs.ValidatorNames[index]
+	delete(fs.ValidatorNames, index)
+	return found, nil
+}
+
+func (fs *BeaconService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Deposit, uint64) {
+	return getPage(fs.Deposits, pageIdx*uint64(pageSize), pageSize)
+}
+
+func (fs *BeaconService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.VoluntaryExit, uint64) {
+	return getPage(fs.VoluntaryExits, pageIdx*uint64(pageSize), pageSize)
+}
+
+func (fs *BeaconService) GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Slashing, uint64) {
+	return getPage(fs.Slashings, pageIdx*uint64(pageSize), pageSize)
+}
+
+func (fs *BeaconService) GetWithdrawalRequestsByFilter(filter *services.CombinedWithdrawalRequestFilter, pageOffset uint64, pageSize uint32) ([]*services.CombinedWithdrawalRequest, uint64, uint64) {
+	return []*services.CombinedWithdrawalRequest{}, 0, 0
+}
+
+func (fs *BeaconService) GetConsolidationRequestsByFilter(filter *services.CombinedConsolidationRequestFilter, pageOffset uint64, pageSize uint32) ([]*services.CombinedConsolidationRequest, uint64, uint64) {
+	return []*services.CombinedConsolidationRequest{}, 0, 0
+}
+
+func (fs *BeaconService) GetValidatorEventsByFilter(filter *dbtypes.ValidatorEventFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.ValidatorEvent, uint64) {
+	return getPage(fs.ValidatorEvents, pageIdx*uint64(pageSize), pageSize)
+}
+
+func (fs *BeaconService) GetAnnotations(firstSlot uint64, lastSlot uint64) []*dbtypes.Annotation {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	annotations := []*dbtypes.Annotation{}
+	for _, annotation := range fs.Annotations {
+		if annotation.EndSlot >= firstSlot && annotation.StartSlot <= lastSlot {
+			annotations = append(annotations, annotation)
+		}
+	}
+	return annotations
+}
+
+func (fs *BeaconService) GetAnnotationsBySlot(firstSlot uint64, lastSlot uint64) map[uint64][]*dbtypes.Annotation {
+	annotationsBySlot := map[uint64][]*dbtypes.Annotation{}
+	for _, annotation := range fs.GetAnnotations(firstSlot, lastSlot) {
+		for slot := max(annotation.StartSlot, firstSlot); slot <= min(annotation.EndSlot, lastSlot); slot++ {
+			annotationsBySlot[slot] = append(annotationsBySlot[slot], annotation)
+		}
+	}
+	return annotationsBySlot
+}
+
+func (fs *BeaconService) GetAnnotationsCacheKey() string {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	return fmt.Sprintf("%v", len(fs.Annotations))
+}
+
+func (fs *BeaconService) CreateAnnotation(startSlot uint64, endSlot uint64, label string, description string) (*dbtypes.Annotation, error) {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	annotation := &dbtypes.Annotation{
+		Id:          uint64(len(fs.Annotations)) + 1,
+		StartSlot:   startSlot,
+		EndSlot:     endSlot,
+		Label:       label,
+		Description: description,
+		CreatedAt:   uint64(time.Now().Unix()),
+	}
+	fs.Annotations = append(fs.Annotations, annotation)
+	return annotation, nil
+}
+
+func (fs *BeaconService) DeleteAnnotation(id uint64) (bool, error) {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	for idx, annotation := range fs.Annotations {
+		if annotation.Id == id {
+			fs.Annotations = append(fs.Annotations[:idx], fs.Annotations[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (fs *BeaconService) GetTestRun(id uint64) (*dbtypes.TestRun, error) {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	for _, testRun := range fs.TestRuns {
+		if testRun.Id == id {
+			return testRun, nil
+		}
+	}
+	return nil, nil
+}
+
+func (fs *BeaconService) GetTestRuns(firstSlot uint64, lastSlot uint64) []*dbtypes.TestRun {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	testRuns := []*dbtypes.TestRun{}
+	for _, testRun := range fs.TestRuns {
+		if testRun.StartSlot <= lastSlot && (testRun.EndSlot == nil || *testRun.EndSlot >= firstSlot) {
+			testRuns = append(testRuns, testRun)
+		}
+	}
+	return testRuns
+}
+
+func (fs *BeaconService) GetTestRunsCacheKey() string {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	return fmt.Sprintf("%v", len(fs.TestRuns))
+}
+
+func (fs *BeaconService) RegisterTestRun(name string, source string, url string, status string, startSlot uint64, endSlot *uint64) (*dbtypes.TestRun, error) {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	now := uint64(time.Now().Unix())
+	testRun := &dbtypes.TestRun{
+		Id:        uint64(len(fs.TestRuns)) + 1,
+		Name:      name,
+		Source:    source,
+		Url:       url,
+		Status:    status,
+		StartSlot: startSlot,
+		EndSlot:   endSlot,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	fs.TestRuns = append(fs.TestRuns, testRun)
+	return testRun, nil
+}
+
+func (fs *BeaconService) UpdateTestRun(id uint64, status string, endSlot *uint64) (*dbtypes.TestRun, error) {
+	testRun, _ := fs.GetTestRun(id)
+	if testRun == nil {
+		return nil, nil
+	}
+
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	testRun.Status = status
+	if endSlot != nil {
+		testRun.EndSlot = endSlot
+	}
+	testRun.UpdatedAt = uint64(time.Now().Unix())
+	return testRun, nil
+}
+
+// getPage returns the entries of the requested page and the total number of entries.
+func getPage[T any](entries []T, offset uint64, pageSize uint32) ([]T, uint64) {
+	total := uint64(len(entries))
+	if offset >= total {
+		return []T{}, total
+	}
+
+	end := offset + uint64(pageSize)
+	if end > total || pageSize == 0 {
+		end = total
+	}
+	return entries[offset:end], total
+}
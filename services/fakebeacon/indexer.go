@@ -0,0 +1,146 @@
+package fakebeacon
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	dynssz "github.com/pk910/dynamic-ssz"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// Indexer is a fixture based services.BeaconIndexer.
+// it does not keep any unfinalized blocks or epoch stats, so handlers fall back to the fixtures of the fake beacon service.
+// the validator set is served from the validator fixtures.
+type Indexer struct {
+	service *BeaconService
+	dynSsz  *dynssz.DynSsz
+}
+
+var _ services.BeaconIndexer = (*Indexer)(nil)
+
+func newIndexer(service *BeaconService) *Indexer {
+	return &Indexer{
+		service: service,
+		dynSsz:  dynssz.NewDynSsz(nil),
+	}
+}
+
+// GetBlockCacheState returns the finalized epoch of the chain state for both, the processed & pruned epoch (nothing is cached).
+func (fi *Indexer) GetBlockCacheState() (finalizedEpoch phase0.Epoch, prunedEpoch phase0.Epoch) {
+	finalizedEpoch, _ = fi.service.ChainState.GetFinalizedCheckpoint()
+	return finalizedEpoch, finalizedEpoch
+}
+
+func (fi *Indexer) GetActivityHistoryLength() uint16 {
+	return 0
+}
+
+func (fi *Indexer) IsSurvivalMode() bool {
+	return false
+}
+
+func (fi *Indexer) GetCacheDebugStats() *beacon.CacheDebugStats {
+	return &beacon.CacheDebugStats{}
+}
+
+func (fi *Indexer) GetCanonicalHead(overrideForkId *beacon.ForkKey) *beacon.Block {
+	return nil
+}
+
+func (fi *Indexer) GetBlockByRoot(blockRoot phase0.Root) *beacon.Block {
+	return nil
+}
+
+func (fi *Indexer) GetBlockByStateRoot(stateRoot phase0.Root) *beacon.Block {
+	return nil
+}
+
+func (fi *Indexer) GetBlocksByRootPrefix(hexPrefix string) []*beacon.Block {
+	return []*beacon.Block{}
+}
+
+func (fi *Indexer) GetBlocksBySlot(slot phase0.Slot) []*beacon.Block {
+	return []*beacon.Block{}
+}
+
+func (fi *Indexer) GetBlocksByExecutionBlockHash(blockHash phase0.Hash32) []*beacon.Block {
+	return []*beacon.Block{}
+}
+
+func (fi *Indexer) GetBlocksByExecutionBlockNumber(blockNumber uint64) []*beacon.Block {
+	return []*beacon.Block{}
+}
+
+func (fi *Indexer) GetBlockAttestationVotes(block *beacon.Block) map[int]*beacon.EpochVotesAttestation {
+	return nil
+}
+
+func (fi *Indexer) IsCanonicalBlock(block *beacon.Block, overrideForkId *beacon.ForkKey) bool {
+	return false
+}
+
+func (fi *Indexer) IsCanonicalBlockByHead(block *beacon.Block, headBlock *beacon.Block) bool {
+	return false
+}
+
+func (fi *Indexer) GetBlockJson(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	return beacon.BlockJson(block)
+}
+
+func (fi *Indexer) GetBlockSszSizes(block *spec.VersionedSignedBeaconBlock) (*beacon.BlockSizes, error) {
+	return beacon.BlockSszSizes(fi.dynSsz, block)
+}
+
+func (fi *Indexer) GetEpochStats(epoch phase0.Epoch, overrideForkId *beacon.ForkKey) *beacon.EpochStats {
+	return nil
+}
+
+func (fi *Indexer) GetEpochStatsValues(epochStats *beacon.EpochStats) *beacon.EpochStatsValues {
+	return nil
+}
+
+func (fi *Indexer) GetEpochHeadVotes(epoch phase0.Epoch) *beacon.EpochHeadVotes {
+	return nil
+}
+
+func (fi *Indexer) GetProposerPreview(epoch phase0.Epoch) *beacon.ProposerPreview {
+	return nil
+}
+
+func (fi *Indexer) GetValidatorSet(overrideForkId *beacon.ForkKey) []*phase0.Validator {
+	validators := make([]*phase0.Validator, 0, len(fi.service.Validators))
+	for _, validator := range fi.service.Validators {
+		validators = append(validators, validator.Validator)
+	}
+	return validators
+}
+
+func (fi *Indexer) GetValidatorSnapshot(epoch phase0.Epoch) (*beacon.ValidatorSnapshot, error) {
+	return nil, nil
+}
+
+func (fi *Indexer) GetValidatorActivity(validatorIndex phase0.ValidatorIndex) ([]beacon.ValidatorActivity, phase0.Epoch) {
+	return []beacon.ValidatorActivity{}, 0
+}
+
+func (fi *Indexer) GetValidatorIndicesByPubkeyPrefix(hexPrefix string, limit int) []phase0.ValidatorIndex {
+	hexPrefix = strings.ToLower(hexPrefix)
+	indices := []phase0.ValidatorIndex{}
+	for _, validator := range fi.service.Validators {
+		if len(indices) >= limit {
+			break
+		}
+		if strings.HasPrefix(hex.EncodeToString(validator.Validator.PublicKey[:]), hexPrefix) {
+			indices = append(indices, validator.Index)
+		}
+	}
+	return indices
+}
+
+func (fi *Indexer) GetWithdrawalSweepPosition(overrideForkId *beacon.ForkKey) (phase0.ValidatorIndex, phase0.Epoch, bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// GraffitiFilter sanitizes graffiti texts for public display on the graffiti wall.
+// non-printable characters are stripped and words from the configured blocklist are either masked or hide the whole graffiti.
+type GraffitiFilter struct {
+	blocklist   *regexp.Regexp
+	maskBlocked bool
+}
+
+var GlobalGraffitiFilter *GraffitiFilter
+
+// StartGraffitiFilter loads the graffiti wall blocklist from the config and the optional blocklist file.
+func StartGraffitiFilter() error {
+	if GlobalGraffitiFilter != nil {
+		return nil
+	}
+
+	config := &utils.Config.Frontend.GraffitiWall
+	words := []string{}
+	words = append(words, config.Blocklist...)
+
+	if config.BlocklistFile != "" {
+		fileData, err := os.ReadFile(config.BlocklistFile)
+		if err != nil {
+			return fmt.Errorf("error reading graffiti blocklist file: %v", err)
+		}
+
+		for _, line := range strings.Split(string(fileData), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			words = append(words, line)
+		}
+	}
+
+	GlobalGraffitiFilter = newGraffitiFilter(words, config.MaskBlocked)
+	return nil
+}
+
+func newGraffitiFilter(words []string, maskBlocked bool) *GraffitiFilter {
+	filter := &GraffitiFilter{
+		maskBlocked: maskBlocked,
+	}
+
+	patterns := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		patterns = append(patterns, regexp.QuoteMeta(word))
+	}
+	if len(patterns) > 0 {
+		filter.blocklist = regexp.MustCompile(`(?i)\b(?:` + strings.Join(patterns, "|") + `)\b`)
+	}
+
+	return filter
+}
+
+// FilterGraffiti returns the sanitized graffiti text and false if the graffiti should not be shown.
+func (gf *GraffitiFilter) FilterGraffiti(graffiti string) (string, bool) {
+	if graffiti == "INVALID_UTF8_STRING" {
+		return "", false
+	}
+
+	text := strings.TrimSpace(strings.Map(func(r rune) rune {
+		if !unicode.IsPrint(r) {
+			return -1
+		}
+		return r
+	}, graffiti))
+	if text == "" {
+		return "", false
+	}
+
+	if gf == nil || gf.blocklist == nil {
+		return text, true
+	}
+
+	if !gf.maskBlocked {
+		if gf.blocklist.MatchString(text) {
+			return "", false
+		}
+		return text, true
+	}
+
+	text = gf.blocklist.ReplaceAllStringFunc(text, func(match string) string {
+		return strings.Repeat("*", len([]rune(match)))
+	})
+	return text, true
+}
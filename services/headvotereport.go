@@ -0,0 +1,171 @@
+package services
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// number of epochs kept in the head vote report
+	headVoteReportRetention = 225
+	// distance of the reported epochs to the current epoch, so the canonical chain has settled
+	headVoteReportDelay = 2
+)
+
+// HeadVoteReportService periodically aggregates the head vote accuracy per validator entity (validator name) and epoch.
+// votes are classified once the canonical chain of the epoch & its inclusion window has settled.
+type HeadVoteReportService struct {
+	mutex     sync.RWMutex
+	epochs    map[phase0.Epoch]*HeadVoteReportEpoch
+	lastEpoch *phase0.Epoch
+}
+
+// HeadVoteReportEpoch holds the head vote stats of an epoch by entity name.
+type HeadVoteReportEpoch struct {
+	Epoch    phase0.Epoch
+	Entities map[string]*HeadVoteReportStats
+}
+
+// HeadVoteReportStats holds the number of attester duties, included votes & correct head votes.
+type HeadVoteReportStats struct {
+	Expected uint64
+	Included uint64
+	Correct  uint64
+}
+
+var GlobalHeadVoteReportService *HeadVoteReportService
+
+// StartHeadVoteReportService starts the periodic head vote report aggregation.
+func StartHeadVoteReportService() error {
+	if GlobalHeadVoteReportService != nil {
+		return nil
+	}
+
+	GlobalHeadVoteReportService = &HeadVoteReportService{
+		epochs: map[phase0.Epoch]*HeadVoteReportEpoch{},
+	}
+
+	go GlobalHeadVoteReportService.runReportLoop()
+	return nil
+}
+
+// GetEpochs returns the reported epochs within the given range (ascending).
+// epochs without report (e.g. already pruned from the block cache on startup) are not included.
+func (hvr *HeadVoteReportService) GetEpochs(firstEpoch phase0.Epoch, lastEpoch phase0.Epoch) []*HeadVoteReportEpoch {
+	if hvr == nil {
+		return nil
+	}
+
+	hvr.mutex.RLock()
+	defer hvr.mutex.RUnlock()
+
+	epochs := []*HeadVoteReportEpoch{}
+	for epoch, reportEpoch := range hvr.epochs {
+		if epoch >= firstEpoch && epoch <= lastEpoch {
+			epochs = append(epochs, reportEpoch)
+		}
+	}
+	sort.Slice(epochs, func(a, b int) bool {
+		return epochs[a].Epoch < epochs[b].Epoch
+	})
+
+	return epochs
+}
+
+func (hvr *HeadVoteReportService) runReportLoop() {
+	defer utils.HandleSubroutinePanic("HeadVoteReportService.runReportLoop", hvr.runReportLoop)
+
+	chainState := GlobalBeaconService.GetChainState()
+	for chainState.GetSpecs() == nil || GlobalBeaconService.GetBeaconIndexer() == nil {
+		time.Sleep(10 * time.Second)
+	}
+
+	for {
+		hvr.processEpochs()
+		time.Sleep(chainState.GetSpecs().SecondsPerSlot)
+	}
+}
+
+func (hvr *HeadVoteReportService) processEpochs() {
+	currentEpoch := GlobalBeaconService.GetChainState().CurrentEpoch()
+	if currentEpoch < headVoteReportDelay {
+		return
+	}
+	reportEpoch := currentEpoch - headVoteReportDelay
+
+	// backfill the epochs still available in the block cache on startup
+	firstEpoch := phase0.Epoch(0)
+	if hvr.lastEpoch != nil {
+		firstEpoch = *hvr.lastEpoch + 1
+	} else if reportEpoch >= headVoteReportRetention {
+		firstEpoch = reportEpoch - headVoteReportRetention + 1
+	}
+	if hvr.lastEpoch == nil {
+		_, prunedEpoch := GlobalBeaconService.GetBeaconIndexer().GetBlockCacheState()
+		if prunedEpoch > firstEpoch {
+			firstEpoch = prunedEpoch
+		}
+	}
+
+	for epoch := firstEpoch; epoch <= reportEpoch; epoch++ {
+		t1 := time.Now()
+		reportStats := hvr.buildEpochReport(epoch)
+		processedEpoch := epoch
+
+		hvr.mutex.Lock()
+		if reportStats != nil {
+			hvr.epochs[epoch] = reportStats
+		}
+		for reportedEpoch := range hvr.epochs {
+			if reportedEpoch+headVoteReportRetention <= epoch {
+				delete(hvr.epochs, reportedEpoch)
+			}
+		}
+		hvr.lastEpoch = &processedEpoch
+		hvr.mutex.Unlock()
+
+		if reportStats == nil {
+			logrus.Debugf("head vote report for epoch %v skipped, blocks or duties not available", epoch)
+		} else {
+			logrus.Debugf("head vote report for epoch %v aggregated in %v (%v entities)", epoch, time.Since(t1), len(reportStats.Entities))
+		}
+	}
+}
+
+func (hvr *HeadVoteReportService) buildEpochReport(epoch phase0.Epoch) *HeadVoteReportEpoch {
+	headVotes := GlobalBeaconService.GetBeaconIndexer().GetEpochHeadVotes(epoch)
+	if headVotes == nil {
+		return nil
+	}
+
+	reportEpoch := &HeadVoteReportEpoch{
+		Epoch:    epoch,
+		Entities: map[string]*HeadVoteReportStats{},
+	}
+	for validatorIndex, voteStatus := range headVotes.Votes {
+		entityName := GlobalBeaconService.GetValidatorName(uint64(validatorIndex))
+		entityStats := reportEpoch.Entities[entityName]
+		if entityStats == nil {
+			entityStats = &HeadVoteReportStats{}
+			reportEpoch.Entities[entityName] = entityStats
+		}
+
+		entityStats.Expected++
+		switch voteStatus {
+		case beacon.HeadVoteCorrect:
+			entityStats.Included++
+			entityStats.Correct++
+		case beacon.HeadVoteWrong:
+			entityStats.Included++
+		}
+	}
+
+	return reportEpoch
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	indexSummaryEpochCount  = 7
+	indexSummaryBlockCount  = 7
+	indexSummarySlotsCount  = 16
+	indexSummaryMaxSlotsAge = 4
+)
+
+// IndexSummaryService maintains the precomputed summary record of the front page.
+// the summary is refreshed once per slot, so the index page is served from a single record
+// instead of assembling it from many live queries per request.
+// the summary is only kept in memory, as it is outdated after a few slots anyway.
+type IndexSummaryService struct {
+	mutex   sync.RWMutex
+	summary *dbtypes.IndexSummary
+}
+
+var GlobalIndexSummaryService *IndexSummaryService
+
+var indexSummaryUpdateTimeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "dora_index_summary_update_seconds",
+	Help: "Time spent on the last update of the index page summary",
+})
+
+// StartIndexSummaryService starts the periodic index summary updates.
+func StartIndexSummaryService() error {
+	if GlobalIndexSummaryService != nil {
+		return nil
+	}
+
+	GlobalIndexSummaryService = &IndexSummaryService{}
+
+	go GlobalIndexSummaryService.runUpdaterLoop()
+	return nil
+}
+
+// GetSummary returns the latest index summary, or nil if there is no summary recent enough to be shown.
+func (iss *IndexSummaryService) GetSummary() *dbtypes.IndexSummary {
+	if iss == nil {
+		return nil
+	}
+
+	iss.mutex.RLock()
+	summary := iss.summary
+	iss.mutex.RUnlock()
+
+	if summary == nil {
+		return nil
+	}
+
+	currentSlot := uint64(GlobalBeaconService.GetChainState().CurrentSlot())
+	if summary.Slot+indexSummaryMaxSlotsAge < currentSlot {
+		return nil
+	}
+
+	return summary
+}
+
+func (iss *IndexSummaryService) runUpdaterLoop() {
+	defer utils.HandleSubroutinePanic("IndexSummaryService.runUpdaterLoop", iss.runUpdaterLoop)
+
+	chainState := GlobalBeaconService.GetChainState()
+	for chainState.GetSpecs() == nil {
+		time.Sleep(10 * time.Second)
+	}
+
+	for {
+		iss.updateSummary()
+
+		// update a third into the next slot, when its block is usually processed
+		specs := chainState.GetSpecs()
+		nextSlot := chainState.CurrentSlot() + 1
+		nextUpdate := chainState.SlotToTime(nextSlot).Add(specs.SecondsPerSlot / 3)
+		time.Sleep(time.Until(nextUpdate))
+	}
+}
+
+func (iss *IndexSummaryService) updateSummary() {
+	t1 := time.Now()
+
+	iss.mutex.RLock()
+	prevSummary := iss.summary
+	iss.mutex.RUnlock()
+
+	summary := buildIndexSummary(prevSummary)
+
+	iss.mutex.Lock()
+	iss.summary = summary
+	iss.mutex.Unlock()
+
+	indexSummaryUpdateTimeGauge.Set(time.Since(t1).Seconds())
+}
+
+// BuildIndexSummary builds the index summary from live data.
+func BuildIndexSummary() *dbtypes.IndexSummary {
+	return buildIndexSummary(nil)
+}
+
+// buildIndexSummary builds the index summary from live data.
+// the validator counts only change once per epoch and are taken over from the previous summary of the same epoch.
+func buildIndexSummary(prevSummary *dbtypes.IndexSummary) *dbtypes.IndexSummary {
+	chainState := GlobalBeaconService.GetChainState()
+	currentSlot := chainState.CurrentSlot()
+	currentEpoch := chainState.CurrentEpoch()
+	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
+	justifiedEpoch, _ := chainState.GetJustifiedCheckpoint()
+
+	summary := &dbtypes.IndexSummary{
+		Slot:           uint64(currentSlot),
+		Epoch:          uint64(currentEpoch),
+		UpdatedAt:      time.Now().Unix(),
+		FinalizedEpoch: uint64(finalizedEpoch),
+		JustifiedEpoch: uint64(justifiedEpoch),
+	}
+
+	syncState := dbtypes.IndexerSyncState{}
+	db.GetExplorerState("indexer.syncstate", &syncState)
+	if finalizedEpoch >= 1 {
+		summary.Synced = syncState.Epoch >= uint64(finalizedEpoch-1)
+	} else {
+		summary.Synced = true
+	}
+
+	if prevSummary != nil && prevSummary.ValidatorEpoch == uint64(currentEpoch) && prevSummary.ActiveValidatorCount > 0 {
+		summary.ValidatorEpoch = prevSummary.ValidatorEpoch
+		summary.ActiveValidatorCount = prevSummary.ActiveValidatorCount
+		summary.EnteringValidatorCount = prevSummary.EnteringValidatorCount
+		summary.ExitingValidatorCount = prevSummary.ExitingValidatorCount
+		summary.TotalEligibleEther = prevSummary.TotalEligibleEther
+		summary.AverageValidatorBalance = prevSummary.AverageValidatorBalance
+	} else {
+		buildIndexSummaryValidators(summary)
+	}
+
+	// recent epochs
+	summary.RecentEpochs = make([]*dbtypes.IndexSummaryEpoch, 0, indexSummaryEpochCount)
+	for _, epochData := range GlobalBeaconService.GetDbEpochs(uint64(currentEpoch), indexSummaryEpochCount) {
+		if epochData == nil {
+			continue
+		}
+		summary.RecentEpochs = append(summary.RecentEpochs, &dbtypes.IndexSummaryEpoch{
+			Epoch:       epochData.Epoch,
+			Eligible:    epochData.Eligible,
+			VotedTarget: epochData.VotedTarget,
+		})
+	}
+
+	// recent blocks
+	summary.RecentBlocks = make([]*dbtypes.IndexSummaryBlock, 0, indexSummaryBlockCount)
+	blocksData := GlobalBeaconService.GetDbBlocksByFilter(&dbtypes.BlockFilter{
+		WithOrphaned: 0,
+		WithMissing:  0,
+	}, 0, indexSummaryBlockCount, 0)
+	for _, blockData := range blocksData {
+		if len(summary.RecentBlocks) >= indexSummaryBlockCount {
+			break
+		}
+		if blockData.Block == nil {
+			continue
+		}
+		summary.RecentBlocks = append(summary.RecentBlocks, &dbtypes.IndexSummaryBlock{
+			Slot:           blockData.Block.Slot,
+			Proposer:       blockData.Block.Proposer,
+			Status:         uint64(blockData.Block.Status),
+			Root:           blockData.Block.Root,
+			EthBlockNumber: blockData.Block.EthBlockNumber,
+		})
+	}
+
+	// recent slots
+	summary.RecentSlots = make([]*dbtypes.IndexSummarySlot, 0, indexSummarySlotsCount)
+	var lastSlot uint64
+	if uint64(currentSlot) >= indexSummarySlotsCount {
+		lastSlot = uint64(currentSlot) - indexSummarySlotsCount
+	}
+	for _, dbSlot := range GlobalBeaconService.GetDbBlocksForSlots(uint64(currentSlot), indexSummarySlotsCount, true, true) {
+		if len(summary.RecentSlots) >= indexSummarySlotsCount {
+			break
+		}
+		if dbSlot == nil || dbSlot.Slot < lastSlot || dbSlot.Slot > uint64(currentSlot) {
+			continue
+		}
+		summary.RecentSlots = append(summary.RecentSlots, &dbtypes.IndexSummarySlot{
+			Slot:       dbSlot.Slot,
+			Proposer:   dbSlot.Proposer,
+			Status:     uint64(dbSlot.Status),
+			Root:       dbSlot.Root,
+			ParentRoot: dbSlot.ParentRoot,
+		})
+	}
+
+	return summary
+}
+
+func buildIndexSummaryValidators(summary *dbtypes.IndexSummary) {
+	currentValidatorSet := GlobalBeaconService.GetCachedValidatorSet(true)
+	if currentValidatorSet == nil {
+		return
+	}
+
+	summary.ValidatorEpoch = summary.Epoch
+	for _, validator := range currentValidatorSet {
+		if strings.HasPrefix(validator.Status.String(), "active") {
+			summary.ActiveValidatorCount++
+			summary.TotalEligibleEther += uint64(validator.Validator.EffectiveBalance)
+			summary.AverageValidatorBalance += uint64(validator.Balance)
+		}
+		if validator.Status == v1.ValidatorStatePendingQueued {
+			summary.EnteringValidatorCount++
+		}
+		if validator.Status == v1.ValidatorStateActiveExiting {
+			summary.ExitingValidatorCount++
+		}
+	}
+	if summary.AverageValidatorBalance > 0 {
+		summary.AverageValidatorBalance = summary.AverageValidatorBalance / summary.ActiveValidatorCount
+	}
+}
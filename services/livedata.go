@@ -0,0 +1,23 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+)
+
+// ErrLiveDataUnavailable is returned by service calls that need to query a beacon node while no node is reachable.
+// all data served from the db & indexer caches stays available in this case.
+var ErrLiveDataUnavailable = errors.New("live data unavailable: no beacon node reachable")
+
+// IsLiveDataAvailable returns true if at least one consensus client is ready to serve live queries.
+func (bs *ChainService) IsLiveDataAvailable() bool {
+	for _, client := range bs.consensusPool.GetAllEndpoints() {
+		status := client.GetStatus()
+		if status == consensus.ClientStatusOnline || status == consensus.ClientStatusOptimistic {
+			return true
+		}
+	}
+
+	return false
+}
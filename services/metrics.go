@@ -0,0 +1,49 @@
+package services
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// StartMetricsServer starts a separate http listener serving the prometheus metrics of all explorer components.
+func StartMetricsServer(logger logrus.FieldLogger) error {
+	host := utils.Config.Metrics.Host
+	if host == "" {
+		host = "localhost"
+	}
+	port := utils.Config.Metrics.Port
+	if port == "" {
+		port = "9090"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	metricsServer := &http.Server{
+		Addr:         net.JoinHostPort(host, port),
+		Handler:      mux,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	listener, err := net.Listen("tcp", metricsServer.Addr)
+	if err != nil {
+		return fmt.Errorf("error listening on %v: %w", metricsServer.Addr, err)
+	}
+
+	logger.Printf("metrics listener started on %v", metricsServer.Addr)
+	go func() {
+		if err := metricsServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logger.WithError(err).Error("metrics listener stopped")
+		}
+	}()
+
+	return nil
+}
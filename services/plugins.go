@@ -0,0 +1,69 @@
+package services
+
+import (
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/plugins"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// startPluginHooks starts the indexer hooks of all registered plugins and forwards chain events to them.
+func (cs *ChainService) startPluginHooks() {
+	headHooks := []plugins.CanonicalHeadHook{}
+	finalityHooks := []plugins.FinalityHook{}
+
+	for _, plugin := range plugins.GetPlugins() {
+		pluginLogger := cs.logger.WithField("plugin", plugin.Name())
+
+		if indexerHook, ok := plugin.(plugins.IndexerHook); ok {
+			err := indexerHook.StartIndexerHook(&plugins.IndexerContext{
+				Logger:        pluginLogger,
+				ConsensusPool: cs.consensusPool,
+				BeaconIndexer: cs.beaconIndexer,
+			})
+			if err != nil {
+				pluginLogger.Errorf("error starting plugin indexer hook: %v", err)
+				continue
+			}
+		}
+
+		if headHook, ok := plugin.(plugins.CanonicalHeadHook); ok {
+			headHooks = append(headHooks, headHook)
+		}
+		if finalityHook, ok := plugin.(plugins.FinalityHook); ok {
+			finalityHooks = append(finalityHooks, finalityHook)
+		}
+
+		pluginLogger.Infof("plugin loaded")
+	}
+
+	if len(headHooks) == 0 && len(finalityHooks) == 0 {
+		return
+	}
+
+	headSubscription := cs.beaconIndexer.SubscribeCanonicalHead(10)
+	finalitySubscription := cs.consensusPool.SubscribeFinalizedEvent(10)
+
+	go cs.runPluginHookLoop(headSubscription, finalitySubscription, headHooks, finalityHooks)
+}
+
+func (cs *ChainService) runPluginHookLoop(headSubscription *consensus.Subscription[*beacon.Block], finalitySubscription *consensus.Subscription[*v1.Finality], headHooks []plugins.CanonicalHeadHook, finalityHooks []plugins.FinalityHook) {
+	defer utils.HandleSubroutinePanic("ChainService.runPluginHookLoop", func() {
+		cs.runPluginHookLoop(headSubscription, finalitySubscription, headHooks, finalityHooks)
+	})
+
+	for {
+		select {
+		case headBlock := <-headSubscription.Channel():
+			for _, hook := range headHooks {
+				hook.OnCanonicalHead(headBlock)
+			}
+		case finality := <-finalitySubscription.Channel():
+			for _, hook := range finalityHooks {
+				hook.OnFinalityUpdate(finality)
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// GetMaxPageSize returns the max number of entries per page on list pages.
+func GetMaxPageSize() uint64 {
+	if utils.Config.Limits.MaxPageSize > 0 {
+		return utils.Config.Limits.MaxPageSize
+	}
+	return 100
+}
+
+// GetMaxValidatorsPageSize returns the max number of entries per page on the validators list or json export.
+func GetMaxValidatorsPageSize(isJson bool) uint64 {
+	if isJson {
+		if utils.Config.Limits.MaxValidatorsJsonSize > 0 {
+			return utils.Config.Limits.MaxValidatorsJsonSize
+		}
+		return 10000
+	}
+
+	if utils.Config.Limits.MaxValidatorsPageSize > 0 {
+		return utils.Config.Limits.MaxValidatorsPageSize
+	}
+	return 1000
+}
+
+// GetMaxValidatorsBatchSize returns the max number of validators per bulk validator status api request.
+func GetMaxValidatorsBatchSize() uint64 {
+	if utils.Config.Limits.MaxValidatorsBatch > 0 {
+		return utils.Config.Limits.MaxValidatorsBatch
+	}
+	return 1000
+}
+
+// LimitPageSize caps the page size to the configured max page size.
+func LimitPageSize(pageSize uint64) uint64 {
+	if maxPageSize := GetMaxPageSize(); pageSize > maxPageSize {
+		return maxPageSize
+	}
+	return pageSize
+}
+
+// IsListOffsetAllowed checks if a list may skip the given number of entries.
+func IsListOffsetAllowed(offset uint64) bool {
+	maxOffset := utils.Config.Limits.MaxListOffset
+	return maxOffset == 0 || offset <= maxOffset
+}
+
+// IsSearchTermAllowed checks if the search term is long enough for substring searches.
+func IsSearchTermAllowed(search string) bool {
+	return uint64(len(search)) >= utils.Config.Limits.MinSearchLength
+}
+
+// IsPrefixSearchAllowed checks if the hex search term is long enough for block root & pubkey prefix searches.
+func IsPrefixSearchAllowed(hexPrefix string) bool {
+	minLength := utils.Config.Limits.MinPrefixSearchLength
+	if minLength == 0 {
+		minLength = 8
+	}
+	return uint64(len(hexPrefix)) >= minLength
+}
+
+// GetSearchContext returns a context that aborts search queries after the configured search timeout.
+func GetSearchContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := utils.Config.Limits.SearchTimeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// limitListQuery applies the page size & offset limits to list queries in the services layer.
+// returns false if the requested page is beyond the allowed lookback.
+func limitListQuery(offset uint64, pageSize uint32) (uint32, bool) {
+	pageSize = uint32(LimitPageSize(uint64(pageSize)))
+	return pageSize, IsListOffsetAllowed(offset)
+}
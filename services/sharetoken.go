@@ -0,0 +1,47 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// shareTokenSecretState is the persisted share token secret, used if no secret is configured.
+type shareTokenSecretState struct {
+	Secret []byte `json:"secret"`
+}
+
+const shareTokenSecretStateKey = "frontend.sharetokensecret"
+
+// InitShareTokenSecret loads the share token secret from the db if no secret is configured.
+// a random secret is generated & persisted on the first start, so shared links stay valid across restarts.
+func InitShareTokenSecret() error {
+	if utils.Config.Frontend.ShareTokenSecret != "" {
+		return nil
+	}
+
+	state := &shareTokenSecretState{}
+	if _, err := db.GetExplorerState(shareTokenSecretStateKey, state); err == nil && len(state.Secret) > 0 {
+		utils.SetShareTokenSecret(state.Secret)
+		return nil
+	}
+
+	secret, err := utils.GenerateShareTokenSecret()
+	if err != nil {
+		return err
+	}
+
+	state.Secret = secret
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.SetExplorerState(shareTokenSecretStateKey, state, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("error persisting share token secret: %v", err)
+	}
+
+	utils.SetShareTokenSecret(secret)
+	return nil
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+// SlashingStats holds the slashed validators of the cached validator set of an epoch.
+type SlashingStats struct {
+	Epoch              phase0.Epoch
+	TotalActiveBalance phase0.Gwei
+	SlashedValidators  []*SlashedValidatorStats
+}
+
+// SlashedValidatorStats holds the withdrawable epoch and effective balance of a slashed validator.
+type SlashedValidatorStats struct {
+	Index             phase0.ValidatorIndex
+	WithdrawableEpoch phase0.Epoch
+	EffectiveBalance  phase0.Gwei
+}
+
+// SlashingPenaltyEstimation holds the projected penalties of a slashed validator.
+type SlashingPenaltyEstimation struct {
+	ValidatorIndex   phase0.ValidatorIndex
+	EffectiveBalance phase0.Gwei
+	SlashedEpoch     phase0.Epoch
+
+	InitialPenalty phase0.Gwei
+
+	CorrelationEpoch   phase0.Epoch // halfway point of the withdrawability delay, the correlation penalty is applied in this epoch
+	CorrelationTime    time.Time
+	CorrelationApplied bool        // correlation epoch already passed
+	CorrelationPenalty phase0.Gwei // projected correlation penalty based on the slashings known so far
+	SlashingsBalance   phase0.Gwei // effective balance slashed within the slashings vector of the correlation epoch
+	SlashingsCount     uint64      // number of validators slashed within the slashings vector of the correlation epoch
+	TotalActiveBalance phase0.Gwei
+	Multiplier         uint64
+
+	WithdrawableEpoch phase0.Epoch
+	WithdrawableTime  time.Time
+}
+
+// SlashingEstimator computes slashing penalty projections based on the slashed validators of the cached validator set.
+// the slashing stats are computed once per epoch and shared for all projections.
+type SlashingEstimator struct {
+	mutex sync.Mutex
+	stats *SlashingStats
+}
+
+func newSlashingEstimator() *SlashingEstimator {
+	return &SlashingEstimator{}
+}
+
+func (bs *ChainService) getSlashingStats(epoch phase0.Epoch) *SlashingStats {
+	bs.slashingEstimator.mutex.Lock()
+	defer bs.slashingEstimator.mutex.Unlock()
+
+	if bs.slashingEstimator.stats != nil && bs.slashingEstimator.stats.Epoch == epoch {
+		return bs.slashingEstimator.stats
+	}
+
+	validatorSet := bs.beaconIndexer.GetEpochValidatorSet(epoch, nil, false)
+	if validatorSet == nil {
+		return nil
+	}
+
+	stats := &SlashingStats{
+		Epoch:             epoch,
+		SlashedValidators: []*SlashedValidatorStats{},
+	}
+	for _, validator := range validatorSet {
+		if validator.Validator.ActivationEpoch <= epoch && epoch < validator.Validator.ExitEpoch {
+			stats.TotalActiveBalance += validator.Validator.EffectiveBalance
+		}
+
+		if validator.Validator.Slashed {
+			stats.SlashedValidators = append(stats.SlashedValidators, &SlashedValidatorStats{
+				Index:             validator.Index,
+				WithdrawableEpoch: validator.Validator.WithdrawableEpoch,
+				EffectiveBalance:  validator.Validator.EffectiveBalance,
+			})
+		}
+	}
+
+	bs.slashingEstimator.stats = stats
+	return stats
+}
+
+// EstimateSlashingPenalty projects the initial and correlation penalties of a slashed validator with the spec slashing logic.
+// the slashings vector is reconstructed from the slashed validators of the cached validator set, a validator slashed in epoch E
+// gets withdrawable in epoch E + EPOCHS_PER_SLASHINGS_VECTOR, so the slashings within the vector of the correlation epoch are the
+// slashed validators with a withdrawable epoch within half a vector of the validators withdrawable epoch.
+// slashings that happen until the correlation epoch increase the penalty, so the projection is a lower bound.
+// slashedEpoch is the epoch of the slashing inclusion if known, otherwise it is derived from the withdrawable epoch.
+func (bs *ChainService) EstimateSlashingPenalty(validatorIndex phase0.ValidatorIndex, slashedEpoch *phase0.Epoch) (*SlashingPenaltyEstimation, error) {
+	chainState := bs.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	currentEpoch := chainState.CurrentEpoch()
+
+	validator := bs.beaconIndexer.GetEpochValidator(validatorIndex, currentEpoch, nil, false)
+	if validator == nil {
+		return nil, fmt.Errorf("validator %v not found", validatorIndex)
+	}
+	if !validator.Validator.Slashed {
+		return nil, fmt.Errorf("validator %v is not slashed", validatorIndex)
+	}
+	if validator.Validator.WithdrawableEpoch == beacon.FarFutureEpoch || specs.EpochsPerSlashingVector == 0 || specs.EffectiveBalanceIncrement == 0 {
+		return nil, fmt.Errorf("validator %v has no withdrawable epoch", validatorIndex)
+	}
+
+	stats := bs.getSlashingStats(currentEpoch)
+	if stats == nil {
+		return nil, fmt.Errorf("validator set for epoch %v not available", currentEpoch)
+	}
+
+	slashingsVector := phase0.Epoch(specs.EpochsPerSlashingVector)
+	estimation := &SlashingPenaltyEstimation{
+		ValidatorIndex:     validatorIndex,
+		EffectiveBalance:   validator.Validator.EffectiveBalance,
+		WithdrawableEpoch:  validator.Validator.WithdrawableEpoch,
+		TotalActiveBalance: stats.TotalActiveBalance,
+	}
+
+	if slashedEpoch != nil {
+		estimation.SlashedEpoch = *slashedEpoch
+	} else if estimation.WithdrawableEpoch > slashingsVector {
+		estimation.SlashedEpoch = estimation.WithdrawableEpoch - slashingsVector
+	}
+
+	// slash_validator: initial penalty
+	estimation.InitialPenalty = estimation.EffectiveBalance / phase0.Gwei(specs.GetMinSlashingPenaltyQuotient(estimation.SlashedEpoch))
+
+	// process_slashings: correlation penalty at the halfway point of the withdrawability delay
+	if estimation.WithdrawableEpoch > slashingsVector/2 {
+		estimation.CorrelationEpoch = estimation.WithdrawableEpoch - slashingsVector/2
+	}
+	estimation.CorrelationApplied = estimation.CorrelationEpoch <= currentEpoch
+
+	for _, slashedValidator := range stats.SlashedValidators {
+		if slashedValidator.WithdrawableEpoch+slashingsVector/2 <= estimation.WithdrawableEpoch || slashedValidator.WithdrawableEpoch > estimation.WithdrawableEpoch+slashingsVector/2 {
+			continue
+		}
+		estimation.SlashingsCount++
+		estimation.SlashingsBalance += slashedValidator.EffectiveBalance
+	}
+
+	estimation.Multiplier = specs.GetProportionalSlashingMultiplier(estimation.CorrelationEpoch)
+	adjustedSlashingsBalance := estimation.SlashingsBalance * phase0.Gwei(estimation.Multiplier)
+	if adjustedSlashingsBalance > estimation.TotalActiveBalance {
+		adjustedSlashingsBalance = estimation.TotalActiveBalance
+	}
+
+	increment := phase0.Gwei(specs.EffectiveBalanceIncrement)
+	if estimation.TotalActiveBalance >= increment {
+		if specs.IsElectraActive(estimation.CorrelationEpoch) {
+			penaltyPerIncrement := adjustedSlashingsBalance / (estimation.TotalActiveBalance / increment)
+			estimation.CorrelationPenalty = penaltyPerIncrement * (estimation.EffectiveBalance / increment)
+		} else {
+			penaltyNumerator := estimation.EffectiveBalance / increment * adjustedSlashingsBalance
+			estimation.CorrelationPenalty = penaltyNumerator / estimation.TotalActiveBalance * increment
+		}
+	}
+
+	estimation.CorrelationTime = chainState.EpochToTime(estimation.CorrelationEpoch)
+	estimation.WithdrawableTime = chainState.EpochToTime(estimation.WithdrawableEpoch)
+
+	return estimation, nil
+}
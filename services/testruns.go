@@ -0,0 +1,216 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+const (
+	TestRunStatusRunning = "running"
+	TestRunStatusSuccess = "success"
+	TestRunStatusFailure = "failure"
+	TestRunStatusAborted = "aborted"
+
+	maxTestRunNameLength   = 100
+	maxTestRunSourceLength = 50
+	maxTestRunUrlLength    = 500
+)
+
+// TestRuns keeps the externally registered test runs (assertoor, kurtosis test suites, ...) in memory.
+// like annotations, test runs are few in number, so the full list is cached and reloaded on changes.
+type TestRuns struct {
+	mutex    sync.RWMutex
+	loaded   bool
+	testRuns []*dbtypes.TestRun
+}
+
+func newTestRuns() *TestRuns {
+	return &TestRuns{}
+}
+
+func (t *TestRuns) getAll() ([]*dbtypes.TestRun, error) {
+	t.mutex.RLock()
+	if t.loaded {
+		defer t.mutex.RUnlock()
+		return t.testRuns, nil
+	}
+	t.mutex.RUnlock()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !t.loaded {
+		testRuns, err := db.GetTestRuns()
+		if err != nil {
+			return nil, err
+		}
+		t.testRuns = testRuns
+		t.loaded = true
+	}
+
+	return t.testRuns, nil
+}
+
+func (t *TestRuns) reset() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.loaded = false
+	t.testRuns = nil
+}
+
+// IsTestRunStatus checks if the given status is a known test run status.
+func IsTestRunStatus(status string) bool {
+	switch status {
+	case TestRunStatusRunning, TestRunStatusSuccess, TestRunStatusFailure, TestRunStatusAborted:
+		return true
+	}
+	return false
+}
+
+// GetTestRunsCacheKey returns a short fingerprint of the current test runs for use in page cache keys.
+// test runs are updated in place, so the latest update time is part of the fingerprint.
+func (bs *ChainService) GetTestRunsCacheKey() string {
+	testRuns, err := bs.testRuns.getAll()
+	if err != nil {
+		return "0-0-0"
+	}
+
+	maxId := uint64(0)
+	maxUpdate := uint64(0)
+	for _, testRun := range testRuns {
+		if testRun.Id > maxId {
+			maxId = testRun.Id
+		}
+		if testRun.UpdatedAt > maxUpdate {
+			maxUpdate = testRun.UpdatedAt
+		}
+	}
+	return fmt.Sprintf("%v-%v-%v", maxId, len(testRuns), maxUpdate)
+}
+
+// GetTestRuns returns all test runs that overlap with the given slot range (inclusive), ordered by start slot.
+// test runs without end slot are still running and overlap with all slots after their start slot.
+func (bs *ChainService) GetTestRuns(firstSlot uint64, lastSlot uint64) []*dbtypes.TestRun {
+	testRuns, err := bs.testRuns.getAll()
+	if err != nil {
+		bs.logger.Errorf("error loading test runs: %v", err)
+		return []*dbtypes.TestRun{}
+	}
+
+	result := []*dbtypes.TestRun{}
+	for _, testRun := range testRuns {
+		if testRun.StartSlot <= lastSlot && (testRun.EndSlot == nil || *testRun.EndSlot >= firstSlot) {
+			result = append(result, testRun)
+		}
+	}
+	return result
+}
+
+// GetTestRun returns the test run with the given id or nil if it does not exist.
+func (bs *ChainService) GetTestRun(id uint64) (*dbtypes.TestRun, error) {
+	testRuns, err := bs.testRuns.getAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, testRun := range testRuns {
+		if testRun.Id == id {
+			return testRun, nil
+		}
+	}
+	return nil, nil
+}
+
+// ValidateTestRun checks the user provided fields of a test run.
+func ValidateTestRun(name string, source string, url string, status string, startSlot uint64, endSlot *uint64) error {
+	if name == "" {
+		return fmt.Errorf("name must not be empty")
+	}
+	if len(name) > maxTestRunNameLength {
+		return fmt.Errorf("name must not be longer than %v characters", maxTestRunNameLength)
+	}
+	if len(source) > maxTestRunSourceLength {
+		return fmt.Errorf("source must not be longer than %v characters", maxTestRunSourceLength)
+	}
+	if len(url) > maxTestRunUrlLength {
+		return fmt.Errorf("url must not be longer than %v characters", maxTestRunUrlLength)
+	}
+	if url != "" && !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return fmt.Errorf("url must be a http or https url")
+	}
+	if !IsTestRunStatus(status) {
+		return fmt.Errorf("invalid status: %v", status)
+	}
+	if endSlot != nil && *endSlot < startSlot {
+		return fmt.Errorf("end slot must not be before start slot")
+	}
+	return nil
+}
+
+// RegisterTestRun validates and stores a new test run.
+func (bs *ChainService) RegisterTestRun(name string, source string, url string, status string, startSlot uint64, endSlot *uint64) (*dbtypes.TestRun, error) {
+	if err := ValidateTestRun(name, source, url, status, startSlot, endSlot); err != nil {
+		return nil, err
+	}
+
+	now := uint64(time.Now().Unix())
+	testRun := &dbtypes.TestRun{
+		Name:      name,
+		Source:    source,
+		Url:       url,
+		Status:    status,
+		StartSlot: startSlot,
+		EndSlot:   endSlot,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertTestRun(testRun, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bs.testRuns.reset()
+	bs.logger.Infof("registered test run %v (%v) at slot %v: %v", testRun.Id, source, startSlot, name)
+
+	return testRun, nil
+}
+
+// UpdateTestRun updates the status and end slot of a test run, returns nil if the test run does not exist.
+func (bs *ChainService) UpdateTestRun(id uint64, status string, endSlot *uint64) (*dbtypes.TestRun, error) {
+	cachedTestRun, err := bs.GetTestRun(id)
+	if err != nil || cachedTestRun == nil {
+		return nil, err
+	}
+
+	// copy the cached entry, it's shared with concurrent readers
+	testRun := *cachedTestRun
+	testRun.Status = status
+	testRun.EndSlot = endSlot
+	testRun.UpdatedAt = uint64(time.Now().Unix())
+	if err := ValidateTestRun(testRun.Name, testRun.Source, testRun.Url, testRun.Status, testRun.StartSlot, testRun.EndSlot); err != nil {
+		return nil, err
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.UpdateTestRun(&testRun, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bs.testRuns.reset()
+	bs.logger.Infof("updated test run %v: %v", id, status)
+
+	return &testRun, nil
+}
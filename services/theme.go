@@ -0,0 +1,147 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+var logger_theme = logrus.StandardLogger().WithField("module", "theme")
+
+// ThemeService provides the active theme settings for the layout templates.
+// settings from the optional theme file take precedence over the static config and are reloaded when the file changes.
+type ThemeService struct {
+	mutex       sync.RWMutex
+	theme       *types.ThemeConfig
+	fileModTime time.Time
+}
+
+var GlobalThemeService *ThemeService
+
+// StartThemeService loads the theme settings and starts watching the theme file for changes.
+func StartThemeService() error {
+	if GlobalThemeService != nil {
+		return nil
+	}
+
+	themeService := &ThemeService{}
+	err := themeService.reloadTheme(true)
+	if err != nil {
+		return err
+	}
+
+	GlobalThemeService = themeService
+
+	if utils.Config.Frontend.ThemeFile != "" {
+		go themeService.runReloaderLoop()
+	}
+
+	return nil
+}
+
+// GetTheme returns the active theme settings.
+func (ts *ThemeService) GetTheme() *types.ThemeConfig {
+	if ts == nil {
+		return &utils.Config.Frontend.Theme
+	}
+
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+	return ts.theme
+}
+
+func (ts *ThemeService) runReloaderLoop() {
+	defer utils.HandleSubroutinePanic("ThemeService.runReloaderLoop", ts.runReloaderLoop)
+
+	interval := utils.Config.Frontend.ThemeReloadInterval
+	if interval == 0 {
+		interval = 30 * time.Second
+	}
+
+	for {
+		time.Sleep(interval)
+
+		err := ts.reloadTheme(false)
+		if err != nil {
+			logger_theme.Warnf("error reloading theme file, keeping previous theme: %v", err)
+		}
+	}
+}
+
+func (ts *ThemeService) reloadTheme(force bool) error {
+	theme := utils.Config.Frontend.Theme
+	themeFile := utils.Config.Frontend.ThemeFile
+
+	if themeFile != "" {
+		fileInfo, err := os.Stat(themeFile)
+		if err != nil {
+			return fmt.Errorf("error reading theme file %v: %v", themeFile, err)
+		}
+
+		fileModTime := fileInfo.ModTime()
+		if !force && fileModTime.Equal(ts.fileModTime) {
+			return nil
+		}
+
+		// remember the modification time of broken files too, so errors are only reported once per change
+		ts.fileModTime = fileModTime
+
+		fileTheme, err := readThemeFile(themeFile)
+		if err != nil {
+			return err
+		}
+
+		if fileTheme.PrimaryColor != "" {
+			theme.PrimaryColor = fileTheme.PrimaryColor
+		}
+		if fileTheme.SecondaryColor != "" {
+			theme.SecondaryColor = fileTheme.SecondaryColor
+		}
+		if fileTheme.Logo != "" {
+			theme.Logo = fileTheme.Logo
+		}
+		if fileTheme.Favicon != "" {
+			theme.Favicon = fileTheme.Favicon
+		}
+		if fileTheme.FooterText != "" {
+			theme.FooterText = fileTheme.FooterText
+		}
+	}
+
+	err := utils.ValidateThemeConfig(&theme)
+	if err != nil {
+		return err
+	}
+
+	ts.mutex.Lock()
+	ts.theme = &theme
+	ts.mutex.Unlock()
+
+	if !force {
+		logger_theme.Infof("reloaded theme from %v", themeFile)
+	}
+
+	return nil
+}
+
+func readThemeFile(themeFile string) (*types.ThemeConfig, error) {
+	data, err := os.ReadFile(themeFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading theme file %v: %v", themeFile, err)
+	}
+
+	theme := &types.ThemeConfig{}
+	err = yaml.Unmarshal(data, theme)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding theme file %v: %v", themeFile, err)
+	}
+
+	return theme, nil
+}
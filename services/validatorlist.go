@@ -0,0 +1,66 @@
+package services
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ParseValidatorList parses a comma or whitespace separated list of validator indices, index ranges (from-to) and pubkeys.
+func ParseValidatorList(arg string, maxCount uint64) ([]uint64, error) {
+	indices := []uint64{}
+	addIndex := func(index uint64) error {
+		if uint64(len(indices)) >= maxCount {
+			return fmt.Errorf("too many validators (max %v)", maxCount)
+		}
+		indices = append(indices, index)
+		return nil
+	}
+
+	for _, entry := range strings.FieldsFunc(arg, func(c rune) bool { return c == ',' || c == ' ' || c == '\n' || c == '\t' }) {
+		if strings.HasPrefix(entry, "0x") {
+			pubkey, err := hex.DecodeString(entry[2:])
+			if err != nil || len(pubkey) != 48 {
+				return nil, fmt.Errorf("invalid validator pubkey: %v", entry)
+			}
+			index, found := GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(pubkey))
+			if !found {
+				return nil, fmt.Errorf("unknown validator pubkey: %v", entry)
+			}
+			if err := addIndex(uint64(index)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if rangeFrom, rangeTo, isRange := strings.Cut(entry, "-"); isRange {
+			fromIndex, err1 := strconv.ParseUint(rangeFrom, 10, 64)
+			toIndex, err2 := strconv.ParseUint(rangeTo, 10, 64)
+			if err1 != nil || err2 != nil || toIndex < fromIndex {
+				return nil, fmt.Errorf("invalid validator range: %v", entry)
+			}
+			if toIndex-fromIndex >= maxCount {
+				return nil, fmt.Errorf("too many validators (max %v)", maxCount)
+			}
+			for index := fromIndex; index <= toIndex; index++ {
+				if err := addIndex(index); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		index, err := strconv.ParseUint(entry, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator index: %v", entry)
+		}
+		if err := addIndex(index); err != nil {
+			return nil, err
+		}
+	}
+
+	return indices, nil
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -42,10 +43,47 @@ type ValidatorNames struct {
 	namesByDepositOrigin  map[common.Address]*validatorNameEntry
 	namesByDepositTarget  map[common.Address]*validatorNameEntry
 	resolvedNamesByIndex  map[uint64]*validatorNameEntry
+	graffitiPattern       *regexp.Regexp
+	graffitiNamesLoaded   bool
+	graffitiScanSlot      uint64
+	graffitiNamesByIndex  map[uint64]*dbtypes.ValidatorGraffitiName
+	nameRules             map[string]*validatorNameRule
+	updateMutex           sync.Mutex
 }
 
+// validator name sources (provenance of a name)
+const (
+	ValidatorNameSourceConfig   = "config"
+	ValidatorNameSourceRemote   = "remote"
+	ValidatorNameSourceOverride = "override"
+	ValidatorNameSourceGraffiti = "graffiti"
+)
+
+const (
+	maxValidatorNameLength     = 250
+	maxValidatorNameRangeWidth = 1000000
+)
+
 type validatorNameEntry struct {
-	name string
+	name   string
+	source string
+}
+
+// validatorNameRule is a name definition as loaded from one of the name sources (single index, index range or address).
+type validatorNameRule struct {
+	key       string
+	entry     *validatorNameEntry
+	updatedAt uint64
+}
+
+// ValidatorNameRule is a known validator name definition with its provenance.
+type ValidatorNameRule struct {
+	Key            string
+	Name           string
+	Source         string
+	ValidatorCount uint64
+	Overridden     bool
+	UpdatedAt      uint64
 }
 
 func NewValidatorNames(beaconIndexer *beacon.Indexer, chainState *consensus.ChainState) *ValidatorNames {
@@ -53,6 +91,16 @@ func NewValidatorNames(beaconIndexer *beacon.Indexer, chainState *consensus.Chai
 		beaconIndexer: beaconIndexer,
 		chainState:    chainState,
 	}
+
+	if utils.Config.Frontend.ValidatorNamesGraffitiPattern != "" {
+		graffitiPattern, err := regexp.Compile(utils.Config.Frontend.ValidatorNamesGraffitiPattern)
+		if err != nil {
+			logger_vn.WithError(err).Errorf("invalid validator names graffiti pattern, graffiti name resolution disabled")
+		} else {
+			validatorNames.graffitiPattern = graffitiPattern
+		}
+	}
+
 	return validatorNames
 }
 
@@ -85,6 +133,9 @@ func (vn *ValidatorNames) runUpdaterLoop() {
 }
 
 func (vn *ValidatorNames) runUpdater() error {
+	vn.updateMutex.Lock()
+	defer vn.updateMutex.Unlock()
+
 	needUpdate := false
 
 	if utils.Config.Frontend.ValidatorNamesRefreshInterval > 0 && time.Since(vn.lastInventoryRefresh) > utils.Config.Frontend.ValidatorNamesRefreshInterval {
@@ -106,6 +157,17 @@ func (vn *ValidatorNames) runUpdater() error {
 		}
 	}
 
+	if vn.graffitiPattern != nil {
+		changes, err := vn.resolveGraffitiNames()
+		if err != nil {
+			return err
+		}
+
+		if changes {
+			needUpdate = true
+		}
+	}
+
 	if needUpdate {
 		err := vn.UpdateDb()
 		if err != nil {
@@ -233,6 +295,109 @@ func (vn *ValidatorNames) resolveNames() (bool, error) {
 	return hasUpdates, nil
 }
 
+// resolveGraffitiNames scans the graffitis of finalized canonical blocks for names matching the configured pattern.
+// the names are learned from the validators own proposals and persisted with the slot they've been announced in.
+func (vn *ValidatorNames) resolveGraffitiNames() (bool, error) {
+	if !vn.graffitiNamesLoaded {
+		graffitiNames := map[uint64]*dbtypes.ValidatorGraffitiName{}
+		for _, graffitiName := range db.GetValidatorGraffitiNames() {
+			graffitiNames[graffitiName.Index] = graffitiName
+		}
+
+		graffitiState := dbtypes.ValidatorNamesGraffitiState{}
+		db.GetExplorerState("validatornames.graffiti", &graffitiState)
+
+		vn.namesMutex.Lock()
+		vn.graffitiNamesByIndex = graffitiNames
+		vn.namesMutex.Unlock()
+
+		vn.graffitiScanSlot = graffitiState.Slot
+		vn.graffitiNamesLoaded = true
+	}
+
+	finalizedEpoch, _ := vn.chainState.GetFinalizedCheckpoint()
+	finalizedSlot := uint64(vn.chainState.EpochToSlot(finalizedEpoch))
+
+	hasUpdates := false
+	batchSize := uint32(1000)
+	for vn.graffitiScanSlot < finalizedSlot {
+		slotGraffitis := db.GetSlotGraffitiTexts(vn.graffitiScanSlot, finalizedSlot-1, batchSize)
+		if slotGraffitis == nil {
+			return hasUpdates, fmt.Errorf("failed loading slot graffitis from db")
+		}
+
+		nextScanSlot := finalizedSlot
+		if len(slotGraffitis) == int(batchSize) {
+			nextScanSlot = slotGraffitis[len(slotGraffitis)-1].Slot + 1
+		}
+
+		updatedNames := map[uint64]*dbtypes.ValidatorGraffitiName{}
+		for _, slotGraffiti := range slotGraffitis {
+			name := vn.parseGraffitiName(slotGraffiti.GraffitiText)
+			if name == "" {
+				continue
+			}
+
+			if existingName := vn.graffitiNamesByIndex[slotGraffiti.Proposer]; existingName != nil && existingName.Name == name {
+				continue
+			}
+
+			updatedNames[slotGraffiti.Proposer] = &dbtypes.ValidatorGraffitiName{
+				Index: slotGraffiti.Proposer,
+				Name:  name,
+				Slot:  slotGraffiti.Slot,
+			}
+		}
+
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			if len(updatedNames) > 0 {
+				err := db.InsertValidatorGraffitiNames(maps.Values(updatedNames), tx)
+				if err != nil {
+					return fmt.Errorf("error while adding validator graffiti names to db: %v", err)
+				}
+			}
+
+			return db.SetExplorerState("validatornames.graffiti", &dbtypes.ValidatorNamesGraffitiState{
+				Slot: nextScanSlot,
+			}, tx)
+		})
+		if err != nil {
+			return hasUpdates, err
+		}
+
+		if len(updatedNames) > 0 {
+			vn.namesMutex.Lock()
+			for index, graffitiName := range updatedNames {
+				vn.graffitiNamesByIndex[index] = graffitiName
+			}
+			vn.namesMutex.Unlock()
+
+			logger_vn.Infof("learned %v validator names from graffitis (slot %v - %v)", len(updatedNames), vn.graffitiScanSlot, nextScanSlot-1)
+			hasUpdates = true
+		}
+
+		vn.graffitiScanSlot = nextScanSlot
+	}
+
+	return hasUpdates, nil
+}
+
+// parseGraffitiName extracts the validator name from a graffiti text using the configured pattern.
+// the first capture group is used as name if the pattern contains groups, otherwise the whole match is used.
+func (vn *ValidatorNames) parseGraffitiName(graffiti string) string {
+	match := vn.graffitiPattern.FindStringSubmatch(graffiti)
+	if len(match) == 0 {
+		return ""
+	}
+
+	name := match[0]
+	if len(match) > 1 {
+		name = match[1]
+	}
+
+	return strings.TrimSpace(name)
+}
+
 func (vn *ValidatorNames) GetValidatorName(index uint64) string {
 	if !vn.namesMutex.TryRLock() {
 		return ""
@@ -252,6 +417,11 @@ func (vn *ValidatorNames) GetValidatorName(index uint64) string {
 		return name.name
 	}
 
+	graffitiName := vn.graffitiNamesByIndex[index]
+	if graffitiName != nil {
+		return graffitiName.Name
+	}
+
 	return ""
 }
 
@@ -297,6 +467,7 @@ func (vn *ValidatorNames) LoadValidatorNames() chan bool {
 		vn.namesByWithdrawal = make(map[common.Address]*validatorNameEntry)
 		vn.namesByDepositOrigin = make(map[common.Address]*validatorNameEntry)
 		vn.namesByDepositTarget = make(map[common.Address]*validatorNameEntry)
+		vn.nameRules = make(map[string]*validatorNameRule)
 		vn.namesMutex.Unlock()
 
 		validatorNamesYaml := utils.Config.Frontend.ValidatorNamesYaml
@@ -322,6 +493,19 @@ func (vn *ValidatorNames) LoadValidatorNames() chan bool {
 				logger_vn.WithError(err).Errorf("error while loading validator names inventory")
 			}
 		}
+
+		if utils.Config.Frontend.ValidatorNamesRangesYaml != "" {
+			err := vn.loadFromRangesYaml(utils.Config.Frontend.ValidatorNamesRangesYaml)
+			if err != nil {
+				logger_vn.WithError(err).Errorf("error while loading validator ranges yaml")
+			}
+		}
+
+		// runtime overrides are loaded last, so they take precedence over all other sources
+		err := vn.loadFromOverrides()
+		if err != nil {
+			logger_vn.WithError(err).Errorf("error while loading validator name overrides")
+		}
 	}()
 
 	return vn.loading
@@ -341,7 +525,7 @@ func (vn *ValidatorNames) loadFromYaml(fileName string) error {
 		return fmt.Errorf("error decoding validator names file %v: %v", fileName, err)
 	}
 
-	nameCount := vn.parseNamesMap(namesYaml)
+	nameCount := vn.parseNamesMap(namesYaml, ValidatorNameSourceConfig)
 	logger_vn.Infof("loaded %v validator names from yaml (%v)", nameCount, fileName)
 
 	return nil
@@ -360,20 +544,21 @@ func (vn *ValidatorNames) loadFromInternalYaml(fileName string) error {
 		return fmt.Errorf("could not find internal validator names file %v: %v", fileName, err)
 	}
 
-	nameCount := vn.parseNamesMap(namesYaml)
+	nameCount := vn.parseNamesMap(namesYaml, ValidatorNameSourceConfig)
 	logger_vn.Infof("loaded %v validator names from internal yaml (%v)", nameCount, fileName)
 
 	return nil
 }
 
-func (vn *ValidatorNames) parseNamesMap(names map[string]string) int {
+func (vn *ValidatorNames) parseNamesMap(names map[string]string, source string) int {
 	vn.namesMutex.Lock()
 	defer vn.namesMutex.Unlock()
 	nameCount := 0
 	for idxStr, name := range names {
 		rangeParts := strings.Split(idxStr, ":")
 		nameEntry := &validatorNameEntry{
-			name: name,
+			name:   name,
+			source: source,
 		}
 
 		if len(rangeParts) > 1 {
@@ -390,6 +575,8 @@ func (vn *ValidatorNames) parseNamesMap(names map[string]string) int {
 				target := common.HexToAddress(rangeParts[1])
 				vn.namesByDepositTarget[target] = nameEntry
 				nameCount++
+			default:
+				continue
 			}
 
 		} else {
@@ -410,6 +597,13 @@ func (vn *ValidatorNames) parseNamesMap(names map[string]string) int {
 				nameCount++
 			}
 		}
+
+		if vn.nameRules != nil {
+			vn.nameRules[source+"|"+idxStr] = &validatorNameRule{
+				key:   idxStr,
+				entry: nameEntry,
+			}
+		}
 	}
 	return nameCount
 }
@@ -442,11 +636,67 @@ func (vn *ValidatorNames) loadFromRangesApi(apiUrl string) error {
 		return fmt.Errorf("error parsing validator ranges response: %v", err)
 	}
 
-	nameCount := vn.parseNamesMap(rangesResponse.Ranges)
+	nameCount := vn.parseNamesMap(rangesResponse.Ranges, ValidatorNameSourceRemote)
 	logger_vn.Infof("loaded %v validator names from inventory api (%v)", nameCount, utils.GetRedactedUrl(apiUrl))
 	return nil
 }
 
+// loadFromRangesYaml loads validator names from a validator-ranges.yaml file or url as published for ethpandaops devnets.
+// the ranges are either defined as top level "start-end: name" entries or nested in a "validator_ranges" / "ranges" section.
+func (vn *ValidatorNames) loadFromRangesYaml(source string) error {
+	var reader io.Reader
+
+	nameSource := ValidatorNameSourceConfig
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		nameSource = ValidatorNameSourceRemote
+		client := &http.Client{Timeout: time.Second * 120}
+		resp, err := client.Get(source)
+		if err != nil {
+			return fmt.Errorf("could not fetch validator ranges (%v): %v", utils.GetRedactedUrl(source), err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			data, _ := io.ReadAll(resp.Body)
+			return fmt.Errorf("url: %v, error-response: %s", utils.GetRedactedUrl(source), data)
+		}
+		reader = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return fmt.Errorf("error opening validator ranges file %v: %v", source, err)
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	rangesYaml := map[string]interface{}{}
+	decoder := yaml.NewDecoder(reader)
+	err := decoder.Decode(&rangesYaml)
+	if err != nil {
+		return fmt.Errorf("error decoding validator ranges %v: %v", utils.GetRedactedUrl(source), err)
+	}
+
+	for _, sectionKey := range []string{"validator_ranges", "ranges"} {
+		if section, ok := rangesYaml[sectionKey].(map[string]interface{}); ok {
+			rangesYaml = section
+			break
+		}
+	}
+
+	ranges := map[string]string{}
+	for rangeKey, rangeName := range rangesYaml {
+		name, ok := rangeName.(string)
+		if !ok {
+			continue
+		}
+		ranges[strings.ReplaceAll(rangeKey, " ", "")] = name
+	}
+
+	nameCount := vn.parseNamesMap(ranges, nameSource)
+	logger_vn.Infof("loaded %v validator names from validator ranges (%v)", nameCount, utils.GetRedactedUrl(source))
+	return nil
+}
+
 func (vn *ValidatorNames) UpdateDb() error {
 	vn.namesMutex.RLock()
 	nameRows := make([]*dbtypes.ValidatorName, 0)
@@ -462,11 +712,21 @@ func (vn *ValidatorNames) UpdateDb() error {
 		if hasName[index] {
 			continue
 		}
+		hasName[index] = true
 		nameRows = append(nameRows, &dbtypes.ValidatorName{
 			Index: index,
 			Name:  name.name,
 		})
 	}
+	for index, graffitiName := range vn.graffitiNamesByIndex {
+		if hasName[index] {
+			continue
+		}
+		nameRows = append(nameRows, &dbtypes.ValidatorName{
+			Index: index,
+			Name:  graffitiName.Name,
+		})
+	}
 	vn.namesMutex.RUnlock()
 
 	sort.Slice(nameRows, func(a, b int) bool {
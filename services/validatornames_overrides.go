@@ -0,0 +1,254 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// loadFromOverrides loads the validator names that have been added or overridden at runtime from the db.
+func (vn *ValidatorNames) loadFromOverrides() error {
+	overrides, err := db.GetValidatorNameOverrides()
+	if err != nil {
+		return err
+	}
+
+	overrideNames := map[string]string{}
+	for _, override := range overrides {
+		overrideNames[override.Key] = override.Name
+	}
+
+	nameCount := vn.parseNamesMap(overrideNames, ValidatorNameSourceOverride)
+
+	vn.namesMutex.Lock()
+	for _, override := range overrides {
+		if rule := vn.nameRules[ValidatorNameSourceOverride+"|"+override.Key]; rule != nil {
+			rule.updatedAt = override.UpdatedAt
+		}
+	}
+	vn.namesMutex.Unlock()
+
+	if len(overrides) > 0 {
+		logger_vn.Infof("loaded %v validator names from %v overrides", nameCount, len(overrides))
+	}
+
+	return nil
+}
+
+// ValidateValidatorNameOverride checks a validator name override and returns the normalized key.
+// supported keys are the same as in the validator names yaml: "<index>", "<from>-<to>", "withdrawal:<address>",
+// "deposit_origin:<address>" and "deposit_target:<address>".
+func ValidateValidatorNameOverride(key string, name string) (string, error) {
+	key = strings.ReplaceAll(key, " ", "")
+	if strings.TrimSpace(name) == "" {
+		return "", fmt.Errorf("name must not be empty")
+	}
+	if len(name) > maxValidatorNameLength {
+		return "", fmt.Errorf("name must not be longer than %v characters", maxValidatorNameLength)
+	}
+
+	if keyParts := strings.Split(key, ":"); len(keyParts) > 1 {
+		if len(keyParts) != 2 || !common.IsHexAddress(keyParts[1]) {
+			return "", fmt.Errorf("invalid address in key: %v", key)
+		}
+		address := strings.ToLower(common.HexToAddress(keyParts[1]).String())
+		switch keyParts[0] {
+		case "withdrawal", "deposit_target":
+			return keyParts[0] + ":" + address, nil
+		case "depositor", "deposit_origin":
+			return "deposit_origin:" + address, nil
+		default:
+			return "", fmt.Errorf("invalid key type: %v", keyParts[0])
+		}
+	}
+
+	rangeParts := strings.Split(key, "-")
+	if len(rangeParts) > 2 {
+		return "", fmt.Errorf("invalid index range: %v", key)
+	}
+	minIdx, err := strconv.ParseUint(rangeParts[0], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid validator index: %v", rangeParts[0])
+	}
+	if len(rangeParts) == 1 {
+		return fmt.Sprintf("%v", minIdx), nil
+	}
+
+	maxIdx, err := strconv.ParseUint(rangeParts[1], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid validator index: %v", rangeParts[1])
+	}
+	if maxIdx < minIdx {
+		return "", fmt.Errorf("invalid index range: %v", key)
+	}
+	if maxIdx-minIdx >= maxValidatorNameRangeWidth {
+		return "", fmt.Errorf("index range must not exceed %v validators", maxValidatorNameRangeWidth)
+	}
+
+	return fmt.Sprintf("%v-%v", minIdx, maxIdx), nil
+}
+
+// SetNameOverride adds or overrides a validator name at runtime. the override is persisted in the db and takes precedence
+// over names from the config & remote sources with the same key.
+func (vn *ValidatorNames) SetNameOverride(key string, name string) (string, error) {
+	key, err := ValidateValidatorNameOverride(key, name)
+	if err != nil {
+		return "", err
+	}
+
+	override := &dbtypes.ValidatorNameOverride{
+		Key:       key,
+		Name:      strings.TrimSpace(name),
+		UpdatedAt: uint64(time.Now().Unix()),
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertValidatorNameOverride(override, tx)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	vn.parseNamesMap(map[string]string{key: override.Name}, ValidatorNameSourceOverride)
+
+	vn.namesMutex.Lock()
+	if rule := vn.nameRules[ValidatorNameSourceOverride+"|"+key]; rule != nil {
+		rule.updatedAt = override.UpdatedAt
+	}
+	vn.namesMutex.Unlock()
+
+	logger_vn.Infof("set validator name override %v: %v", key, override.Name)
+	go vn.refreshNames(false)
+
+	return key, nil
+}
+
+// DeleteNameOverride removes a runtime validator name override, returns false if the override does not exist.
+// the names are reloaded afterwards, so names from other sources with the same key apply again.
+func (vn *ValidatorNames) DeleteNameOverride(key string) (bool, error) {
+	deleted := false
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		var err error
+		deleted, err = db.DeleteValidatorNameOverride(key, tx)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if deleted {
+		logger_vn.Infof("deleted validator name override %v", key)
+		go vn.refreshNames(true)
+	}
+
+	return deleted, nil
+}
+
+// refreshNames re-resolves the address based names and updates the validator names in the db after a runtime change.
+func (vn *ValidatorNames) refreshNames(reload bool) {
+	vn.updateMutex.Lock()
+	defer vn.updateMutex.Unlock()
+
+	if reload {
+		<-vn.LoadValidatorNames()
+	}
+
+	if _, err := vn.resolveNames(); err != nil {
+		logger_vn.Warnf("failed resolving validator names: %v", err)
+	} else {
+		vn.lastResolvedMapUpdate = time.Now()
+	}
+
+	if err := vn.UpdateDb(); err != nil {
+		logger_vn.Errorf("failed updating validator names in db: %v", err)
+	}
+}
+
+// GetNameRules returns all known validator name definitions with their provenance.
+// graffiti derived names are returned as single index rules.
+func (vn *ValidatorNames) GetNameRules() []*ValidatorNameRule {
+	vn.namesMutex.RLock()
+	defer vn.namesMutex.RUnlock()
+
+	resolvedCounts := map[*validatorNameEntry]uint64{}
+	for _, entry := range vn.resolvedNamesByIndex {
+		resolvedCounts[entry]++
+	}
+
+	overriddenKeys := map[string]bool{}
+	for _, rule := range vn.nameRules {
+		if rule.entry.source == ValidatorNameSourceOverride {
+			overriddenKeys[strings.ToLower(rule.key)] = true
+		}
+	}
+
+	rules := make([]*ValidatorNameRule, 0, len(vn.nameRules)+len(vn.graffitiNamesByIndex))
+	for _, rule := range vn.nameRules {
+		nameRule := &ValidatorNameRule{
+			Key:        rule.key,
+			Name:       rule.entry.name,
+			Source:     rule.entry.source,
+			Overridden: rule.entry.source != ValidatorNameSourceOverride && overriddenKeys[strings.ToLower(rule.key)],
+			UpdatedAt:  rule.updatedAt,
+		}
+
+		if minIdx, maxIdx, isRange := parseValidatorNameRange(rule.key); isRange {
+			nameRule.ValidatorCount = maxIdx - minIdx + 1
+		} else {
+			nameRule.ValidatorCount = resolvedCounts[rule.entry]
+		}
+
+		rules = append(rules, nameRule)
+	}
+
+	for index, graffitiName := range vn.graffitiNamesByIndex {
+		rules = append(rules, &ValidatorNameRule{
+			Key:            fmt.Sprintf("%v", index),
+			Name:           graffitiName.Name,
+			Source:         ValidatorNameSourceGraffiti,
+			ValidatorCount: 1,
+		})
+	}
+
+	sort.Slice(rules, func(a, b int) bool {
+		minIdxA, _, isRangeA := parseValidatorNameRange(rules[a].Key)
+		minIdxB, _, isRangeB := parseValidatorNameRange(rules[b].Key)
+		if isRangeA != isRangeB {
+			return isRangeA
+		}
+		if isRangeA && minIdxA != minIdxB {
+			return minIdxA < minIdxB
+		}
+		if rules[a].Key != rules[b].Key {
+			return rules[a].Key < rules[b].Key
+		}
+		return rules[a].Source < rules[b].Source
+	})
+
+	return rules
+}
+
+// parseValidatorNameRange parses an index or index range key, returns false for address based keys.
+func parseValidatorNameRange(key string) (uint64, uint64, bool) {
+	rangeParts := strings.Split(key, "-")
+	minIdx, err := strconv.ParseUint(rangeParts[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	maxIdx := minIdx
+	if len(rangeParts) > 1 {
+		maxIdx, err = strconv.ParseUint(rangeParts[1], 10, 64)
+		if err != nil || maxIdx < minIdx {
+			return 0, 0, false
+		}
+	}
+	return minIdx, maxIdx, true
+}
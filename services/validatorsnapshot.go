@@ -0,0 +1,133 @@
+package services
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ValidatorStateGroups are the simplified validator states the validator set can be filtered by.
+var ValidatorStateGroups = []string{"active", "pending", "exiting", "slashed", "exited"}
+
+// ValidatorSetSnapshot is a snapshot of the validator set (with balances) of an epoch.
+// sorted views of the set are built on first use and shared by all readers of the snapshot.
+type ValidatorSetSnapshot struct {
+	Epoch        phase0.Epoch
+	Validators   []*v1.Validator // ordered by index
+	StatusCounts map[v1.ValidatorState]uint64
+	StateCounts  map[string]uint64 // counts per state group (see ValidatorStateGroups)
+
+	sortMutex  sync.Mutex
+	sortOrders map[string][]*v1.Validator
+}
+
+// NewValidatorSetSnapshot creates a snapshot of the given validator set.
+func NewValidatorSetSnapshot(epoch phase0.Epoch, validators []*v1.Validator) *ValidatorSetSnapshot {
+	snapshot := &ValidatorSetSnapshot{
+		Epoch:        epoch,
+		Validators:   make([]*v1.Validator, 0, len(validators)),
+		StatusCounts: map[v1.ValidatorState]uint64{},
+		StateCounts:  map[string]uint64{},
+		sortOrders:   map[string][]*v1.Validator{},
+	}
+
+	for _, validator := range validators {
+		if validator == nil || validator.Validator == nil {
+			continue
+		}
+		snapshot.Validators = append(snapshot.Validators, validator)
+		snapshot.StatusCounts[validator.Status]++
+		snapshot.StateCounts[GetValidatorStateGroup(validator)]++
+	}
+
+	return snapshot
+}
+
+// GetValidatorStateGroup returns the simplified state of the validator (see ValidatorStateGroups).
+// slashed validators are grouped as slashed, regardless of their exit progress.
+func GetValidatorStateGroup(validator *v1.Validator) string {
+	switch {
+	case validator.Validator != nil && validator.Validator.Slashed:
+		return "slashed"
+	case strings.HasPrefix(validator.Status.String(), "pending"):
+		return "pending"
+	case validator.Status == v1.ValidatorStateActiveOngoing:
+		return "active"
+	case validator.Status == v1.ValidatorStateActiveExiting:
+		return "exiting"
+	default:
+		return "exited"
+	}
+}
+
+// GetSortedValidators returns the validators of the snapshot in the given sort order (index, pubkey, balance, activation or exit,
+// with "-d" suffix for descending order). unknown sort orders return the validators by index.
+// the returned slice is shared and must not be modified.
+func (snapshot *ValidatorSetSnapshot) GetSortedValidators(sortOrder string) []*v1.Validator {
+	if sortOrder == "" || sortOrder == "index" {
+		return snapshot.Validators
+	}
+
+	snapshot.sortMutex.Lock()
+	defer snapshot.sortMutex.Unlock()
+
+	if sorted := snapshot.sortOrders[sortOrder]; sorted != nil {
+		return sorted
+	}
+
+	var less func(a, b *v1.Validator) bool
+	switch strings.TrimSuffix(sortOrder, "-d") {
+	case "index":
+		less = func(a, b *v1.Validator) bool { return a.Index < b.Index }
+	case "pubkey":
+		less = func(a, b *v1.Validator) bool {
+			return bytes.Compare(a.Validator.PublicKey[:], b.Validator.PublicKey[:]) < 0
+		}
+	case "balance":
+		less = func(a, b *v1.Validator) bool { return a.Balance < b.Balance }
+	case "activation":
+		less = func(a, b *v1.Validator) bool { return a.Validator.ActivationEpoch < b.Validator.ActivationEpoch }
+	case "exit":
+		less = func(a, b *v1.Validator) bool { return a.Validator.ExitEpoch < b.Validator.ExitEpoch }
+	default:
+		return snapshot.Validators
+	}
+
+	sorted := make([]*v1.Validator, len(snapshot.Validators))
+	copy(sorted, snapshot.Validators)
+	if strings.HasSuffix(sortOrder, "-d") {
+		// stable sorting keeps the index order for equal values in both directions
+		sort.SliceStable(sorted, func(a, b int) bool { return less(sorted[b], sorted[a]) })
+	} else {
+		sort.SliceStable(sorted, func(a, b int) bool { return less(sorted[a], sorted[b]) })
+	}
+
+	snapshot.sortOrders[sortOrder] = sorted
+	return sorted
+}
+
+// GetValidatorSetSnapshot returns the snapshot of the validator set of the current epoch.
+// the snapshot is refreshed on the first call in each epoch, nil is returned if the validator set is not available yet.
+func (bs *ChainService) GetValidatorSetSnapshot() *ValidatorSetSnapshot {
+	currentEpoch := bs.consensusPool.GetChainState().CurrentEpoch()
+
+	bs.validatorSnapshotMutex.Lock()
+	defer bs.validatorSnapshotMutex.Unlock()
+
+	if bs.validatorSnapshot != nil && bs.validatorSnapshot.Epoch == currentEpoch {
+		return bs.validatorSnapshot
+	}
+
+	validatorSet := bs.GetCachedValidatorSet(true)
+	if validatorSet == nil {
+		// keep serving the snapshot of the previous epoch until the new validator set is available
+		return bs.validatorSnapshot
+	}
+
+	bs.validatorSnapshot = NewValidatorSetSnapshot(currentEpoch, validatorSet)
+	return bs.validatorSnapshot
+}
@@ -188,6 +188,18 @@
         maxPendingRequests: requestNum,
       },
     });
+    var bhValidators = new Bloodhound({
+      datumTokenizer: Bloodhound.tokenizers.whitespace,
+      queryTokenizer: Bloodhound.tokenizers.whitespace,
+      identify: function (obj) {
+        return obj.index
+      },
+      remote: {
+        url: "/search/validators?q=",
+        prepare: prepareQueryFn,
+        maxPendingRequests: requestNum,
+      },
+    });
 
 
     searchEl.typeahead(
@@ -229,6 +241,19 @@
           },
         },
       },
+      {
+        limit: 5,
+        name: "validators",
+        source: bhValidators,
+        display: "pubkey",
+        templates: {
+          header: '<h3 class="h5">Validators (by public key):</h3>',
+          suggestion: function (data) {
+            var name = data.valname ? ` (${data.valname})` : "";
+            return `<div class="text-monospace"><div class="search-table"><span class="search-cell">${data.index}${name}:</span><span class="search-cell search-truncate">${data.pubkey}</span></div></div>`;
+          },
+        },
+      },
       {
         limit: 5,
         name: "name",
@@ -280,7 +305,9 @@
     })
 
     searchEl.on("typeahead:select", function (ev, sug) {
-      if (sug.root !== undefined) {
+      if (sug.pubkey !== undefined) {
+        window.location = "/validator/" + sug.index
+      } else if (sug.root !== undefined) {
         if (sug.orphaned) {
           window.location = "/slot/" + sug.root
         } else {
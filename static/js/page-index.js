@@ -9,6 +9,7 @@
   var loopTimer = null;
   var isRefreshing = false;
   var viewModel = null;
+  var currency = window.explorerCurrency || { symbol: "ETH", gweiPerUnit: 1000000000 };
   var baseModel = {
     formatAddCommas: function(x) { return x; },
     unixtime: function(x) { return Math.floor(new Date(x).getTime() / 1000); },
@@ -18,7 +19,8 @@
       return p[1] + " " + p[2] + " +0000 UTC";
     },
     formatRecentTimeShort: function(x) { return window.explorer.renderRecentTime(Math.floor(new Date(x).getTime() / 1000)); },
-    formatEth: function(x) { return formatFloat(x / 1000000000, 4); },
+    formatEth: function(x) { return formatFloat(x / currency.gweiPerUnit, 4); },
+    currencySymbol: currency.symbol,
     formatFloat: function(x) { return formatFloat(x, 2); },
     formatValidator: function(idx, name) { return formatValidator(idx, name); },
     hexstr: function(x) { return "0x" + base64ToHex(x); },
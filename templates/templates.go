@@ -16,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/tdewolff/minify"
 
+	"github.com/ethpandaops/dora/plugins"
 	"github.com/ethpandaops/dora/utils"
 )
 
@@ -28,7 +29,24 @@ var (
 
 var templateCache = make(map[string]*template.Template)
 var templateCacheMux = &sync.RWMutex{}
-var templateFuncs = utils.GetTemplateFuncs()
+var templateFuncs template.FuncMap
+var templateFuncsOnce sync.Once
+
+// getTemplateFuncs returns the core template functions extended by the plugin template functions.
+// the map is built lazily, as plugins register themselves during package initialization.
+func getTemplateFuncs() template.FuncMap {
+	templateFuncsOnce.Do(func() {
+		templateFuncs = utils.GetTemplateFuncs()
+		for name, fn := range plugins.GetTemplateFuncs() {
+			if _, exists := templateFuncs[name]; exists {
+				logger.Warnf("ignoring plugin template function %v: name collides with core template function", name)
+				continue
+			}
+			templateFuncs[name] = fn
+		}
+	})
+	return templateFuncs
+}
 
 // compile time check for templates
 //var _ error = CompileTimeCheck(fs.FS(Files))
@@ -46,7 +64,7 @@ func GetTemplate(files ...string) *template.Template {
 				templateFiles[i] = "templates/" + files[i]
 			}
 		}
-		return template.Must(template.New(name).Funcs(template.FuncMap(templateFuncs)).ParseFiles(templateFiles...))
+		return template.Must(template.New(name).Funcs(getTemplateFuncs()).ParseFiles(templateFiles...))
 	}
 
 	templateCacheMux.RLock()
@@ -56,7 +74,7 @@ func GetTemplate(files ...string) *template.Template {
 	}
 	templateCacheMux.RUnlock()
 
-	tmpl := template.New(name).Funcs(template.FuncMap(templateFuncs))
+	tmpl := template.New(name).Funcs(getTemplateFuncs())
 	tmpl = template.Must(parseTemplateFiles(tmpl, readFileFS(Files), files...))
 	templateCacheMux.Lock()
 	defer templateCacheMux.Unlock()
@@ -138,7 +156,7 @@ func CompileTimeCheck(fsys fs.FS) error {
 	if err != nil {
 		return err
 	}
-	template.Must(template.New("layout").Funcs(template.FuncMap(templateFuncs)).ParseFS(Files, files...))
+	template.Must(template.New("layout").Funcs(getTemplateFuncs()).ParseFS(Files, files...))
 	logger.Infof("compile time check completed")
 
 	return nil
@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Config is a struct to hold the configuration data
 type Config struct {
@@ -12,14 +15,50 @@ type Config struct {
 		FileLevel string `yaml:"fileLevel" envconfig:"LOGGING_FILE_LEVEL"`
 	} `yaml:"logging"`
 
+	Devnet struct {
+		Enabled bool   `yaml:"enabled" envconfig:"DEVNET_MODE"`     // apply zero-config defaults for devnets (listen on all interfaces, sqlite in data dir, all features visible)
+		DataDir string `yaml:"dataDir" envconfig:"DEVNET_DATA_DIR"` // directory for the sqlite database in devnet mode
+	} `yaml:"devnet"`
+
 	Server struct {
 		Port string `yaml:"port" envconfig:"FRONTEND_SERVER_PORT"`
 		Host string `yaml:"host" envconfig:"FRONTEND_SERVER_HOST"`
 	} `yaml:"server"`
 
+	Metrics struct {
+		Enabled bool   `yaml:"enabled" envconfig:"METRICS_ENABLED"`
+		Host    string `yaml:"host" envconfig:"METRICS_HOST"`
+		Port    string `yaml:"port" envconfig:"METRICS_PORT"`
+
+		DbStatsInterval time.Duration `yaml:"dbStatsInterval" envconfig:"METRICS_DB_STATS_INTERVAL"`
+	} `yaml:"metrics"`
+
 	Chain struct {
 		DisplayName string `yaml:"displayName" envconfig:"CHAIN_DISPLAY_NAME"`
 
+		// currency denomination, defaults to ETH (GNO / xDAI on gnosis networks)
+		ConsensusCurrency            string `yaml:"consensusCurrency" envconfig:"CHAIN_CONSENSUS_CURRENCY"`                          // label for consensus layer amounts
+		ConsensusCurrencyGweiPerUnit uint64 `yaml:"consensusCurrencyGweiPerUnit" envconfig:"CHAIN_CONSENSUS_CURRENCY_GWEI_PER_UNIT"` // number of gwei per consensus layer unit
+		ExecutionCurrency            string `yaml:"executionCurrency" envconfig:"CHAIN_EXECUTION_CURRENCY"`                          // label for execution layer amounts
+
+		// fork epoch overrides for shadow forks, take precedence over the fork epochs from the node specs
+		ForkEpochs struct {
+			Altair    *uint64 `yaml:"altair" envconfig:"CHAIN_FORK_EPOCH_ALTAIR"`
+			Bellatrix *uint64 `yaml:"bellatrix" envconfig:"CHAIN_FORK_EPOCH_BELLATRIX"`
+			Capella   *uint64 `yaml:"capella" envconfig:"CHAIN_FORK_EPOCH_CAPELLA"`
+			Deneb     *uint64 `yaml:"deneb" envconfig:"CHAIN_FORK_EPOCH_DENEB"`
+			Electra   *uint64 `yaml:"electra" envconfig:"CHAIN_FORK_EPOCH_ELECTRA"`
+			Eip7594   *uint64 `yaml:"eip7594" envconfig:"CHAIN_FORK_EPOCH_EIP7594"`
+		} `yaml:"forkEpochs"`
+
+		// time source of the slot clock & slot/epoch events: "local" (host clock) or "nodes" (host clock corrected by the clock drift to the consensus clients)
+		TimeSource          string        `yaml:"timeSource" envconfig:"CHAIN_TIME_SOURCE"`
+		ClockDriftThreshold time.Duration `yaml:"clockDriftThreshold" envconfig:"CHAIN_CLOCK_DRIFT_THRESHOLD"` // clock drift to the consensus clients that is reported as skew
+
+		// bootnodes shown on the network info page
+		ClBootnodes []string `yaml:"clBootnodes" envconfig:"CHAIN_CL_BOOTNODES"` // consensus layer ENRs
+		ElBootnodes []string `yaml:"elBootnodes" envconfig:"CHAIN_EL_BOOTNODES"` // execution layer enodes
+
 		// optional features
 		WhiskForkEpoch *uint64 `yaml:"whiskForkEpoch" envconfig:"WHISK_FORK_EPOCH"`
 	} `yaml:"chain"`
@@ -42,8 +81,10 @@ type Config struct {
 
 		ValidatorNamesYaml            string        `yaml:"validatorNamesYaml" envconfig:"FRONTEND_VALIDATOR_NAMES_YAML"`
 		ValidatorNamesInventory       string        `yaml:"validatorNamesInventory" envconfig:"FRONTEND_VALIDATOR_NAMES_INVENTORY"`
+		ValidatorNamesRangesYaml      string        `yaml:"validatorNamesRangesYaml" envconfig:"FRONTEND_VALIDATOR_NAMES_RANGES_YAML"`
 		ValidatorNamesRefreshInterval time.Duration `yaml:"validatorNamesRefreshInterval" envconfig:"FRONTEND_VALIDATOR_REFRESH_INTERVAL"`
 		ValidatorNamesResolveInterval time.Duration `yaml:"validatorNamesResolveInterval" envconfig:"FRONTEND_VALIDATOR_RESOLVE_INTERVAL"`
+		ValidatorNamesGraffitiPattern string        `yaml:"validatorNamesGraffitiPattern" envconfig:"FRONTEND_VALIDATOR_NAMES_GRAFFITI_PATTERN"`
 
 		PageCallTimeout  time.Duration `yaml:"pageCallTimeout" envconfig:"FRONTEND_PAGE_CALL_TIMEOUT"`
 		HttpReadTimeout  time.Duration `yaml:"httpReadTimeout" envconfig:"FRONTEND_HTTP_READ_TIMEOUT"`
@@ -55,8 +96,43 @@ type Config struct {
 		ShowPeerDASInfos       bool `yaml:"showPeerDASInfos" envconfig:"FRONTEND_SHOW_PEER_DAS_INFOS"`
 		ShowSubmitDeposit      bool `yaml:"showSubmitDeposit" envconfig:"FRONTEND_SHOW_SUBMIT_DEPOSIT"`
 		ShowSubmitElRequests   bool `yaml:"showSubmitElRequests" envconfig:"FRONTEND_SHOW_SUBMIT_EL_REQUESTS"`
+
+		ShareTokenSecret string `yaml:"shareTokenSecret" envconfig:"FRONTEND_SHARE_TOKEN_SECRET"` // secret to sign shared dashboard links, a random secret is generated & stored in the db if empty
+
+		Theme               ThemeConfig   `yaml:"theme"`
+		ThemeFile           string        `yaml:"themeFile" envconfig:"FRONTEND_THEME_FILE"`                      // yaml file with theme settings, reloaded on change
+		ThemeReloadInterval time.Duration `yaml:"themeReloadInterval" envconfig:"FRONTEND_THEME_RELOAD_INTERVAL"` // interval to check the theme file for changes
+
+		GraffitiWall GraffitiWallConfig `yaml:"graffitiWall"`
+
+		ExternalLinks []ExternalLinkConfig `yaml:"externalLinks"` // cross-links to external explorers, shown next to blocks, transactions, addresses & blobs
 	} `yaml:"frontend"`
 
+	Api struct {
+		CorsEnabled        bool     `yaml:"corsEnabled" envconfig:"API_CORS_ENABLED"`
+		CorsAllowedOrigins []string `yaml:"corsAllowedOrigins" envconfig:"API_CORS_ALLOWED_ORIGINS"`
+		CorsAllowedMethods []string `yaml:"corsAllowedMethods" envconfig:"API_CORS_ALLOWED_METHODS"`
+		CorsAllowedHeaders []string `yaml:"corsAllowedHeaders" envconfig:"API_CORS_ALLOWED_HEADERS"`
+		CorsMaxAge         uint     `yaml:"corsMaxAge" envconfig:"API_CORS_MAX_AGE"` // max age of preflight responses in seconds
+
+		EventRetention         time.Duration `yaml:"eventRetention" envconfig:"API_EVENT_RETENTION"`                  // how long persisted events are kept for replay
+		WatchedValidators      []uint64      `yaml:"watchedValidators" envconfig:"API_WATCHED_VALIDATORS"`            // validators to report missed proposals for
+		FinalityIncidentEpochs uint64        `yaml:"finalityIncidentEpochs" envconfig:"API_FINALITY_INCIDENT_EPOCHS"` // finality delay in epochs to report as incident
+
+		AdminToken string `yaml:"adminToken" envconfig:"API_ADMIN_TOKEN"` // bearer token for the admin endpoints (annotations, test runs), admin endpoints are disabled if empty
+	} `yaml:"api"`
+
+	Limits struct {
+		MaxPageSize           uint64        `yaml:"maxPageSize" envconfig:"LIMITS_MAX_PAGE_SIZE"`                      // max entries per page on list pages
+		MaxValidatorsPageSize uint64        `yaml:"maxValidatorsPageSize" envconfig:"LIMITS_MAX_VALIDATORS_PAGE_SIZE"` // max entries per page on the validators list
+		MaxValidatorsJsonSize uint64        `yaml:"maxValidatorsJsonSize" envconfig:"LIMITS_MAX_VALIDATORS_JSON_SIZE"` // max entries per page on the validators json export
+		MaxValidatorsBatch    uint64        `yaml:"maxValidatorsBatch" envconfig:"LIMITS_MAX_VALIDATORS_BATCH"`        // max validators per bulk validator status api request
+		MaxListOffset         uint64        `yaml:"maxListOffset" envconfig:"LIMITS_MAX_LIST_OFFSET"`                  // max number of entries list pages may skip (0 = unlimited)
+		MinSearchLength       uint64        `yaml:"minSearchLength" envconfig:"LIMITS_MIN_SEARCH_LENGTH"`              // min length of search terms for substring searches
+		MinPrefixSearchLength uint64        `yaml:"minPrefixSearchLength" envconfig:"LIMITS_MIN_PREFIX_SEARCH_LENGTH"` // min number of hex chars for block root & pubkey prefix searches
+		SearchTimeout         time.Duration `yaml:"searchTimeout" envconfig:"LIMITS_SEARCH_TIMEOUT"`                   // max execution time of search queries
+	} `yaml:"limits"`
+
 	RateLimit struct {
 		Enabled    bool `yaml:"enabled" envconfig:"RATELIMIT_ENABLED"`
 		ProxyCount uint `yaml:"proxyCount" envconfig:"RATELIMIT_PROXY_COUNT"`
@@ -65,8 +141,11 @@ type Config struct {
 	} `yaml:"rateLimit"`
 
 	BeaconApi struct {
-		Endpoint  string           `yaml:"endpoint" envconfig:"BEACONAPI_ENDPOINT"`
-		Endpoints []EndpointConfig `yaml:"endpoints"`
+		Endpoint  string       `yaml:"endpoint" envconfig:"BEACONAPI_ENDPOINT"`
+		Endpoints EndpointList `yaml:"endpoints" envconfig:"BEACONAPI_ENDPOINTS"`
+
+		// archive beacon nodes only used to synchronize historic epochs (no live indexing)
+		HistoryEndpoints EndpointList `yaml:"historyEndpoints" envconfig:"BEACONAPI_HISTORY_ENDPOINTS"`
 
 		LocalCacheSize       int    `yaml:"localCacheSize" envconfig:"BEACONAPI_LOCAL_CACHE_SIZE"`
 		SkipFinalAssignments bool   `yaml:"skipFinalAssignments" envconfig:"BEACONAPI_SKIP_FINAL_ASSIGNMENTS"`
@@ -76,23 +155,50 @@ type Config struct {
 	} `yaml:"beaconapi"`
 
 	ExecutionApi struct {
-		Endpoint  string           `yaml:"endpoint" envconfig:"EXECUTIONAPI_ENDPOINT"`
-		Endpoints []EndpointConfig `yaml:"endpoints"`
+		Endpoint  string       `yaml:"endpoint" envconfig:"EXECUTIONAPI_ENDPOINT"`
+		Endpoints EndpointList `yaml:"endpoints" envconfig:"EXECUTIONAPI_ENDPOINTS"`
 
 		LogBatchSize       int `yaml:"logBatchSize" envconfig:"EXECUTIONAPI_LOG_BATCH_SIZE"`
 		DepositDeployBlock int `yaml:"depositDeployBlock" envconfig:"EXECUTIONAPI_DEPOSIT_DEPLOY_BLOCK"` // el block number from where to crawl the deposit system contract (should be <=, but close to deposit contract deployment)
 		ElectraDeployBlock int `yaml:"electraDeployBlock" envconfig:"EXECUTIONAPI_ELECTRA_DEPLOY_BLOCK"` // el block number from where to crawl the electra system contracts (should be <=, but close to electra fork activation block)
+
+		DisablePriorityFees bool `yaml:"disablePriorityFees" envconfig:"EXECUTIONAPI_DISABLE_PRIORITY_FEES"`
+		PriorityFeeRate     uint `yaml:"priorityFeeRate" envconfig:"EXECUTIONAPI_PRIORITY_FEE_RATE"`
 	} `yaml:"executionapi"`
 
 	Indexer struct {
 		ResyncFromEpoch   *uint64 `yaml:"resyncFromEpoch" envconfig:"INDEXER_RESYNC_FROM_EPOCH"`
 		ResyncForceUpdate bool    `yaml:"resyncForceUpdate" envconfig:"INDEXER_RESYNC_FORCE_UPDATE"`
 
-		InMemoryEpochs                  uint16 `yaml:"inMemoryEpochs" envconfig:"INDEXER_IN_MEMORY_EPOCHS"`
-		ActivityHistoryLength           uint16 `yaml:"activityHistoryLength" envconfig:"INDEXER_ACTIVITY_HISTORY_LENGTH"`
-		DisableSynchronizer             bool   `yaml:"disableSynchronizer" envconfig:"INDEXER_DISABLE_SYNCHRONIZER"`
-		SyncEpochCooldown               uint   `yaml:"syncEpochCooldown" envconfig:"INDEXER_SYNC_EPOCH_COOLDOWN"`
-		MaxParallelValidatorSetRequests uint   `yaml:"maxParallelValidatorSetRequests" envconfig:"INDEXER_MAX_PARALLEL_VALIDATOR_SET_REQUESTS"`
+		InMemoryEpochs                  uint16        `yaml:"inMemoryEpochs" envconfig:"INDEXER_IN_MEMORY_EPOCHS"`
+		ActivityHistoryLength           uint16        `yaml:"activityHistoryLength" envconfig:"INDEXER_ACTIVITY_HISTORY_LENGTH"`
+		DisableSynchronizer             bool          `yaml:"disableSynchronizer" envconfig:"INDEXER_DISABLE_SYNCHRONIZER"`
+		SyncEpochCooldown               uint          `yaml:"syncEpochCooldown" envconfig:"INDEXER_SYNC_EPOCH_COOLDOWN"`
+		DisableAdaptiveSyncCooldown     bool          `yaml:"disableAdaptiveSyncCooldown" envconfig:"INDEXER_DISABLE_ADAPTIVE_SYNC_COOLDOWN"`
+		SyncCooldownMin                 time.Duration `yaml:"syncCooldownMin" envconfig:"INDEXER_SYNC_COOLDOWN_MIN"`
+		SyncCooldownMax                 time.Duration `yaml:"syncCooldownMax" envconfig:"INDEXER_SYNC_COOLDOWN_MAX"`
+		SyncWorkers                     uint          `yaml:"syncWorkers" envconfig:"INDEXER_SYNC_WORKERS"`
+		MaxParallelValidatorSetRequests uint          `yaml:"maxParallelValidatorSetRequests" envconfig:"INDEXER_MAX_PARALLEL_VALIDATOR_SET_REQUESTS"`
+		UnfinalizedVoteEpochs           uint16        `yaml:"unfinalizedVoteEpochs" envconfig:"INDEXER_UNFINALIZED_VOTE_EPOCHS"`
+		SurvivalModeEpochs              uint16        `yaml:"survivalModeEpochs" envconfig:"INDEXER_SURVIVAL_MODE_EPOCHS"`
+		EpochStatsMemoryLimit           uint          `yaml:"epochStatsMemoryLimit" envconfig:"INDEXER_EPOCH_STATS_MEMORY_LIMIT"`
+		DisableColumnBackfill           bool          `yaml:"disableColumnBackfill" envconfig:"INDEXER_DISABLE_COLUMN_BACKFILL"`
+		ColumnBackfillRate              uint          `yaml:"columnBackfillRate" envconfig:"INDEXER_COLUMN_BACKFILL_RATE"`
+		WriteQueueDir                   string        `yaml:"writeQueueDir" envconfig:"INDEXER_WRITE_QUEUE_DIR"`
+		WriteQueueMaxSize               uint          `yaml:"writeQueueMaxSize" envconfig:"INDEXER_WRITE_QUEUE_MAX_SIZE"`
+		DisableConsistencyCheck         bool          `yaml:"disableConsistencyCheck" envconfig:"INDEXER_DISABLE_CONSISTENCY_CHECK"`
+		DisableOrphanRecheck            bool          `yaml:"disableOrphanRecheck" envconfig:"INDEXER_DISABLE_ORPHAN_RECHECK"`
+		OrphanRecheckEpochs             uint64        `yaml:"orphanRecheckEpochs" envconfig:"INDEXER_ORPHAN_RECHECK_EPOCHS"`
+		DisableEpochRepair              bool          `yaml:"disableEpochRepair" envconfig:"INDEXER_DISABLE_EPOCH_REPAIR"`
+		HeadSelection                   string        `yaml:"headSelection" envconfig:"INDEXER_HEAD_SELECTION"`
+		DisableEpochRewards             bool          `yaml:"disableEpochRewards" envconfig:"INDEXER_DISABLE_EPOCH_REWARDS"`
+		EpochRewardsApiEpochs           uint64        `yaml:"epochRewardsApiEpochs" envconfig:"INDEXER_EPOCH_REWARDS_API_EPOCHS"`
+		ValidatorSnapshotInterval       uint64        `yaml:"validatorSnapshotInterval" envconfig:"INDEXER_VALIDATOR_SNAPSHOT_INTERVAL"`
+		ValidatorSnapshotRetention      uint64        `yaml:"validatorSnapshotRetention" envconfig:"INDEXER_VALIDATOR_SNAPSHOT_RETENTION"`
+		DisableWatchdog                 bool          `yaml:"disableWatchdog" envconfig:"INDEXER_DISABLE_WATCHDOG"`
+		WatchdogStallTimeout            time.Duration `yaml:"watchdogStallTimeout" envconfig:"INDEXER_WATCHDOG_STALL_TIMEOUT"`
+		WatchdogWebhookUrl              string        `yaml:"watchdogWebhookUrl" envconfig:"INDEXER_WATCHDOG_WEBHOOK_URL"`
+		DisableDutyPrewarm              bool          `yaml:"disableDutyPrewarm" envconfig:"INDEXER_DISABLE_DUTY_PREWARM"`
 	} `yaml:"indexer"`
 
 	TxSignature struct {
@@ -140,9 +246,66 @@ type Config struct {
 		DisableSSZEncoding      bool `yaml:"disableSSZEncoding" envconfig:"KILLSWITCH_DISABLE_SSZ_ENCODING"`
 		DisableSSZRequests      bool `yaml:"disableSSZRequests" envconfig:"KILLSWITCH_DISABLE_SSZ_REQUESTS"`
 		DisableBlockCompression bool `yaml:"disableBlockCompression" envconfig:"KILLSWITCH_DISABLE_BLOCK_COMPRESSION"`
+		DisableStartupCache     bool `yaml:"disableStartupCache" envconfig:"KILLSWITCH_DISABLE_STARTUP_CACHE"`
 	} `yaml:"killSwitch"`
 }
 
+// EndpointList is a list of endpoints that can also be set from a comma-separated
+// list of urls via environment variables (e.g. "http://bn1:5052,lighthouse=http://bn2:5052").
+type EndpointList []EndpointConfig
+
+// Decode parses a comma-separated list of "url" or "name=url" entries.
+func (l *EndpointList) Decode(value string) error {
+	endpoints := EndpointList{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		endpoint := EndpointConfig{Url: entry}
+		if sepIdx := strings.Index(entry, "="); sepIdx > 0 && !strings.Contains(entry[:sepIdx], "://") {
+			endpoint.Name = entry[:sepIdx]
+			endpoint.Url = entry[sepIdx+1:]
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	*l = endpoints
+	return nil
+}
+
+// ThemeConfig holds the branding settings injected into the layout templates.
+type ThemeConfig struct {
+	PrimaryColor   string `yaml:"primaryColor" envconfig:"FRONTEND_THEME_PRIMARY_COLOR"`     // hex color, e.g. "#0d6efd"
+	SecondaryColor string `yaml:"secondaryColor" envconfig:"FRONTEND_THEME_SECONDARY_COLOR"` // hex color, e.g. "#6c757d"
+	Logo           string `yaml:"logo" envconfig:"FRONTEND_THEME_LOGO"`                      // logo url or path, overrides frontend.siteLogo
+	Favicon        string `yaml:"favicon" envconfig:"FRONTEND_THEME_FAVICON"`                // favicon url or path
+	FooterText     string `yaml:"footerText" envconfig:"FRONTEND_THEME_FOOTER_TEXT"`         // additional text shown in the footer
+}
+
+// GraffitiWallConfig holds the filter settings for the graffiti wall page.
+type GraffitiWallConfig struct {
+	Blocklist     []string `yaml:"blocklist" envconfig:"FRONTEND_GRAFFITI_WALL_BLOCKLIST"`          // words that are filtered from the wall (case insensitive, whole words)
+	BlocklistFile string   `yaml:"blocklistFile" envconfig:"FRONTEND_GRAFFITI_WALL_BLOCKLIST_FILE"` // text file with one blocked word per line
+	MaskBlocked   bool     `yaml:"maskBlocked" envconfig:"FRONTEND_GRAFFITI_WALL_MASK_BLOCKED"`     // mask blocked words instead of hiding the whole graffiti
+}
+
+// ExternalLinkConfig configures a cross-link to an external explorer.
+// the url templates may contain placeholders that are replaced with the linked object, links with unresolved placeholders are not shown:
+// slot: {slot}, {root} / epoch: {epoch} / validator: {index}, {pubkey} / block: {number}, {hash} / transaction: {hash} / address: {address} / blob: {commitment}, {versionedHash}
+type ExternalLinkConfig struct {
+	Name        string   `yaml:"name"`        // display name, shown as tooltip
+	Icon        string   `yaml:"icon"`        // font awesome icon class (e.g. "fa-cube"), defaults to "fa-up-right-from-square"
+	Networks    []string `yaml:"networks"`    // chain spec config names the link applies to (all networks if empty)
+	Slot        string   `yaml:"slot"`        // url template for beacon slots
+	Epoch       string   `yaml:"epoch"`       // url template for epochs
+	Validator   string   `yaml:"validator"`   // url template for validators
+	Block       string   `yaml:"block"`       // url template for execution blocks
+	Transaction string   `yaml:"transaction"` // url template for transactions
+	Address     string   `yaml:"address"`     // url template for execution addresses
+	Blob        string   `yaml:"blob"`        // url template for blobs
+}
+
 type EndpointConfig struct {
 	Ssh            *EndpointSshConfig `yaml:"ssh"`
 	Url            string             `yaml:"url"`
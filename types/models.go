@@ -13,6 +13,7 @@ type PageData struct {
 	ExplorerLogo          string
 	ExplorerTitle         string
 	ExplorerSubtitle      string
+	Theme                 *PageTheme
 	ChainSlotsPerEpoch    uint64
 	ChainSecondsPerSlot   uint64
 	ChainGenesisTimestamp uint64
@@ -20,6 +21,11 @@ type PageData struct {
 	LatestFinalizedEpoch  uint64
 	CurrentSlot           uint64
 	FinalizationDelay     uint64
+	SurvivalMode          bool
+	LiveDataUnavailable   bool
+	ClockSkewed           bool
+	ClockDrift            string // formatted drift of the explorer host clock to the consensus clients (e.g. "3.5s behind")
+	ClockCorrected        bool   // true if the slot clock is corrected by the drift (node time source)
 	IsReady               bool
 	Mainnet               bool
 	DepositContract       string
@@ -32,6 +38,16 @@ type PageData struct {
 	MainMenuItems         []MainMenuItem
 }
 
+// PageTheme holds the branding settings rendered into the layout
+type PageTheme struct {
+	PrimaryColor      string
+	PrimaryColorRgb   string
+	SecondaryColor    string
+	SecondaryColorRgb string
+	Favicon           string
+	FooterText        string
+}
+
 type MainMenuItem struct {
 	Label        string
 	Path         string
@@ -0,0 +1,12 @@
+package models
+
+// AnnotationPageData is a struct to hold an operator provided incident annotation of a slot range
+type AnnotationPageData struct {
+	Id          uint64 `json:"id"`
+	StartSlot   uint64 `json:"start_slot"`
+	EndSlot     uint64 `json:"end_slot"`
+	StartEpoch  uint64 `json:"start_epoch"`
+	EndEpoch    uint64 `json:"end_epoch"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+// AwaitingDepositsPageData is a struct to hold info for the awaiting deposits page
+type AwaitingDepositsPageData struct {
+	ElIndexerEnabled    bool          `json:"el_indexer_enabled"`
+	Eth1BridgeActive    bool          `json:"eth1_bridge_active"`
+	NextDepositIndex    uint64        `json:"next_deposit_index"`
+	DepositCount        uint64        `json:"deposit_count"`
+	ShownDepositCount   uint64        `json:"shown_deposit_count"`
+	TotalAmount         uint64        `json:"total_amount"`
+	FollowDistance      uint64        `json:"follow_distance"`
+	FollowDistanceTime  time.Duration `json:"follow_distance_time"`
+	VotingPeriodEpochs  uint64        `json:"voting_period_epochs"`
+	MaxDepositsPerBlock uint64        `json:"max_deposits_per_block"`
+
+	Deposits []*AwaitingDepositsPageDataDeposit `json:"deposits"`
+}
+
+type AwaitingDepositsPageDataDeposit struct {
+	Index                 uint64    `json:"index"`
+	Address               []byte    `json:"address"`
+	PublicKey             []byte    `json:"pubkey"`
+	Withdrawalcredentials []byte    `json:"wtdcreds"`
+	Amount                uint64    `json:"amount"`
+	TxHash                []byte    `json:"txhash"`
+	Time                  time.Time `json:"time"`
+	Block                 uint64    `json:"block"`
+	Valid                 bool      `json:"valid"`
+	QueuePosition         uint64    `json:"queue_position"`
+	HasEstimation         bool      `json:"has_estimation"`
+	VotableTime           time.Time `json:"votable_time"`
+	EstimatedEpoch        uint64    `json:"estimated_epoch"`
+	EstimatedTime         time.Time `json:"estimated_time"`
+	Overdue               bool      `json:"overdue"`
+}
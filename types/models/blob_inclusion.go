@@ -0,0 +1,34 @@
+package models
+
+// BlobInclusionPageData is a struct to hold info for the blob inclusion report page
+type BlobInclusionPageData struct {
+	Range      string `json:"range"`
+	Group      string `json:"group"`
+	FirstSlot  uint64 `json:"first_slot"`
+	LastSlot   uint64 `json:"last_slot"`
+	IsPreDeneb bool   `json:"is_pre_deneb"`
+
+	TotalBlocks     uint64  `json:"total_blocks"`
+	TotalBlobBlocks uint64  `json:"total_blob_blocks"`
+	TotalBlobs      uint64  `json:"total_blobs"`
+	BlobsPerBlock   float64 `json:"blobs_per_block"`
+	EmptyBlockShare float64 `json:"empty_block_share"`
+	ExcludingCount  uint64  `json:"excluding_count"`
+
+	Groups     []*BlobInclusionPageDataGroup `json:"groups"`
+	GroupCount uint64                        `json:"group_count"`
+}
+
+type BlobInclusionPageDataGroup struct {
+	Name            string  `json:"name"`
+	ValidatorIndex  uint64  `json:"validator_index"`
+	IsValidator     bool    `json:"is_validator"`
+	Validators      uint64  `json:"validators"`
+	Blocks          uint64  `json:"blocks"`
+	BlobBlocks      uint64  `json:"blob_blocks"`
+	Blobs           uint64  `json:"blobs"`
+	MaxBlobs        uint64  `json:"max_blobs"`
+	BlobsPerBlock   float64 `json:"blobs_per_block"`
+	EmptyBlockShare float64 `json:"empty_block_share"`
+	ExcludingBlobs  bool    `json:"excluding_blobs"`
+}
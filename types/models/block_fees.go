@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// BlockFeesPageData is a struct to hold info for the block fees chart page
+type BlockFeesPageData struct {
+	Range      string `json:"range"`
+	FirstSlot  uint64 `json:"first_slot"`
+	LastSlot   uint64 `json:"last_slot"`
+	BucketSize uint64 `json:"bucket_size"`
+
+	BlockCount         uint64 `json:"block_count"`
+	BurnedFees         uint64 `json:"burned_fees"` // gwei
+	AvgBurnedFees      uint64 `json:"avg_burned_fees"`
+	PriorityBlockCount uint64 `json:"priority_block_count"`
+	PriorityFees       uint64 `json:"priority_fees"` // gwei
+	AvgPriorityFees    uint64 `json:"avg_priority_fees"`
+
+	Buckets     []*BlockFeesPageDataBucket `json:"buckets"`
+	BucketCount uint64                     `json:"bucket_count"`
+	Chart       *ChartData                 `json:"chart"`
+}
+
+type BlockFeesPageDataBucket struct {
+	FirstSlot          uint64    `json:"first_slot"`
+	LastSlot           uint64    `json:"last_slot"`
+	Time               time.Time `json:"time"`
+	BlockCount         uint64    `json:"block_count"`
+	AvgGasUsed         uint64    `json:"avg_gas_used"`
+	BurnedFees         uint64    `json:"burned_fees"`
+	PriorityBlockCount uint64    `json:"priority_block_count"`
+	PriorityFees       uint64    `json:"priority_fees"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// BlockSizesPageData is a struct to hold info for the block sizes chart page
+type BlockSizesPageData struct {
+	Range      string `json:"range"`
+	FirstSlot  uint64 `json:"first_slot"`
+	LastSlot   uint64 `json:"last_slot"`
+	BucketSize uint64 `json:"bucket_size"`
+
+	BlockCount          uint64 `json:"block_count"`
+	AvgBlockSize        uint64 `json:"avg_block_size"`
+	MaxBlockSize        uint64 `json:"max_block_size"`
+	AvgAttestationsSize uint64 `json:"avg_attestations_size"`
+	AvgPayloadSize      uint64 `json:"avg_payload_size"`
+	AvgBlobRefsSize     uint64 `json:"avg_blob_refs_size"`
+
+	Buckets     []*BlockSizesPageDataBucket `json:"buckets"`
+	BucketCount uint64                      `json:"bucket_count"`
+	Chart       *ChartData                  `json:"chart"`
+}
+
+type BlockSizesPageDataBucket struct {
+	FirstSlot           uint64    `json:"first_slot"`
+	LastSlot            uint64    `json:"last_slot"`
+	Time                time.Time `json:"time"`
+	BlockCount          uint64    `json:"block_count"`
+	AvgBlockSize        uint64    `json:"avg_block_size"`
+	MaxBlockSize        uint64    `json:"max_block_size"`
+	AvgAttestationsSize uint64    `json:"avg_attestations_size"`
+	AvgPayloadSize      uint64    `json:"avg_payload_size"`
+	AvgBlobRefsSize     uint64    `json:"avg_blob_refs_size"`
+}
@@ -0,0 +1,29 @@
+package models
+
+// ChartData is a struct to hold a server side rendered line chart (see templates/_svg/linechart.html)
+type ChartData struct {
+	Width      uint64 `json:"width"`
+	Height     uint64 `json:"height"`
+	PlotLeft   uint64 `json:"plot_left"`
+	PlotRight  uint64 `json:"plot_right"`
+	PlotTop    uint64 `json:"plot_top"`
+	PlotBottom uint64 `json:"plot_bottom"`
+
+	Series  []*ChartSeries `json:"series"`
+	XTicks  []*ChartTick   `json:"x_ticks"`
+	YTicks  []*ChartTick   `json:"y_ticks"`
+	Y2Ticks []*ChartTick   `json:"y2_ticks"`
+}
+
+type ChartSeries struct {
+	Name      string `json:"name"`
+	Color     string `json:"color"`
+	Points    string `json:"points"`
+	RightAxis bool   `json:"right_axis"`
+	LastValue string `json:"last_value"`
+}
+
+type ChartTick struct {
+	Pos   float64 `json:"pos"`
+	Label string  `json:"label"`
+}
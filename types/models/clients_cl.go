@@ -82,6 +82,15 @@ type ClientsCLPageDataClient struct {
 	PeerCount            uint32    `json:"peer_count"`
 	PeersInboundCounter  uint32    `json:"peers_inbound_counter"`
 	PeersOutboundCounter uint32    `json:"peers_outbound_counter"`
+	RpcRequests          uint64    `json:"rpc_requests"`
+	RpcErrors            uint64    `json:"rpc_errors"`
+	RpcAvgLatency        uint64    `json:"rpc_avg_latency"`
+	RpcSlowestEndpoint   string    `json:"rpc_slowest_endpoint"`
+	RpcSlowestLatency    uint64    `json:"rpc_slowest_latency"`
+	RpcLastError         string    `json:"rpc_last_error"`
+	RpcLastErrorCall     string    `json:"rpc_last_error_call"`
+	RpcLastErrorTime     time.Time `json:"rpc_last_error_time"`
+	RpcApiFallbacks      []string  `json:"rpc_api_fallbacks"`
 }
 
 // ClientCLPageDataNode represents a generic node on the CL network. Can be a client or a peer of a client
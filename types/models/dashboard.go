@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// DashboardPageData is a struct to hold info for the validator dashboard page
+type DashboardPageData struct {
+	ShareMode     bool   `json:"share_mode"`
+	ShareToken    string `json:"share_token"`
+	ValidatorsArg string `json:"validators_arg"`
+	Error         string `json:"error"`
+	MaxValidators uint64 `json:"max_validators"`
+
+	ValidatorCount        uint64 `json:"validator_count"`
+	ActiveCount           uint64 `json:"active_count"`
+	PendingCount          uint64 `json:"pending_count"`
+	ExitedCount           uint64 `json:"exited_count"`
+	SlashedCount          uint64 `json:"slashed_count"`
+	OnlineCount           uint64 `json:"online_count"`
+	TotalBalance          uint64 `json:"total_balance"`
+	TotalEffectiveBalance uint64 `json:"total_effective_balance"`
+
+	Validators []*DashboardPageDataValidator `json:"validators"`
+}
+
+type DashboardPageDataValidator struct {
+	Index            uint64    `json:"index"`
+	Name             string    `json:"name"`
+	Found            bool      `json:"found"`
+	State            string    `json:"state"`
+	ShowUpcheck      bool      `json:"show_upcheck"`
+	UpcheckActivity  uint8     `json:"upcheck_act"`
+	UpcheckMaximum   uint8     `json:"upcheck_max"`
+	Balance          uint64    `json:"balance"`
+	EffectiveBalance uint64    `json:"eff_balance"`
+	ShowActivation   bool      `json:"show_activation"`
+	ActivationEpoch  uint64    `json:"activation_epoch"`
+	ActivationTs     time.Time `json:"activation_ts"`
+	ShowExit         bool      `json:"show_exit"`
+	ExitEpoch        uint64    `json:"exit_epoch"`
+	ExitTs           time.Time `json:"exit_ts"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// DebugDatabasePageData is a struct to hold info for the database debug page
+type DebugDatabasePageData struct {
+	LastUpdate     time.Time                     `json:"last_update"`
+	CollectTime    uint64                        `json:"collect_time"`
+	Error          string                        `json:"error"`
+	Tables         []*DebugDatabasePageTableData `json:"tables"`
+	TotalRows      uint64                        `json:"total_rows"`
+	TotalTableSize uint64                        `json:"total_table_size"`
+	TotalIndexSize uint64                        `json:"total_index_size"`
+}
+
+type DebugDatabasePageTableData struct {
+	Name      string `json:"name"`
+	RowCount  uint64 `json:"row_count"`
+	TableSize uint64 `json:"table_size"`
+	IndexSize uint64 `json:"index_size"`
+	TotalSize uint64 `json:"total_size"`
+}
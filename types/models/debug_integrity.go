@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+// DebugIntegrityPageData is a struct to hold info for the data integrity report page
+type DebugIntegrityPageData struct {
+	CheckedEpochs uint64                            `json:"checked_epochs"`
+	CheckedBlocks uint64                            `json:"checked_blocks"`
+	MismatchCount uint64                            `json:"mismatch_count"`
+	Epochs        []*DebugIntegrityPageEpochData    `json:"epochs"`
+	Mismatches    []*DebugIntegrityPageMismatchData `json:"mismatches"`
+}
+
+type DebugIntegrityPageEpochData struct {
+	Epoch         uint64    `json:"epoch"`
+	DependentRoot []byte    `json:"dependent_root"`
+	CheckedBlocks uint64    `json:"checked_blocks"`
+	Mismatches    uint64    `json:"mismatches"`
+	DutiesMissing bool      `json:"duties_missing"`
+	VerifiedAt    time.Time `json:"verified_at"`
+}
+
+type DebugIntegrityPageMismatchData struct {
+	Slot                 uint64    `json:"slot"`
+	BlockRoot            []byte    `json:"block_root"`
+	ExpectedProposer     uint64    `json:"expected_proposer"`
+	ExpectedProposerName string    `json:"expected_proposer_name"`
+	ActualProposer       uint64    `json:"actual_proposer"`
+	ActualProposerName   string    `json:"actual_proposer_name"`
+	DetectedAt           time.Time `json:"detected_at"`
+}
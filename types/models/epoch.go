@@ -12,6 +12,8 @@ type EpochPageData struct {
 	Ts                      time.Time            `json:"ts"`
 	Synchronized            bool                 `json:"synchronized"`
 	Finalized               bool                 `json:"finalized"`
+	VotesUnavailable        bool                 `json:"votes_unavailable"`
+	VoteWindowEpochs        uint64               `json:"vote_window_epochs"`
 	AttestationCount        uint64               `json:"attestation_count"`
 	DepositCount            uint64               `json:"deposit_count"`
 	ExitCount               uint64               `json:"exit_count"`
@@ -27,6 +29,11 @@ type EpochPageData struct {
 	HeadVoteParticipation   float64              `json:"head_vote_participation"`
 	TotalVoteParticipation  float64              `json:"total_vote_participation"`
 	SyncParticipation       float64              `json:"sync_participation"`
+	SyncCommitteeActive     bool                 `json:"sync_committee_active"`
+	FinalityDelay           uint64               `json:"finality_delay"`
+	InactivityLeak          bool                 `json:"inactivity_leak"`
+	PenalizedCount          uint64               `json:"penalized_count"`
+	PenaltyAmount           uint64               `json:"penalty_amount"`
 	ValidatorCount          uint64               `json:"validator_count"`
 	AverageValidatorBalance uint64               `json:"avg_validator_balance"`
 	BlockCount              uint64               `json:"block_count"`
@@ -35,7 +42,40 @@ type EpochPageData struct {
 	ScheduledCount          uint64               `json:"scheduled_count"`
 	OrphanedCount           uint64               `json:"orphaned_count"`
 	EthTransactionCount     uint64               `json:"eth_transaction_count"`
+	RandaoMix               []byte               `json:"randao_mix"`
 	Slots                   []*EpochPageDataSlot `json:"slots"`
+
+	Votes            *EpochPageVotes           `json:"votes"`
+	NextEpochPreview *EpochPageProposerPreview `json:"next_epoch_preview"`
+}
+
+// EpochPageVotes holds the vote breakdown of an epoch by inclusion epoch and inclusion distance.
+type EpochPageVotes struct {
+	Live                 bool                  `json:"live"`            // votes are aggregated live from the unfinalized chain
+	AmountIsCount        bool                  `json:"amount_is_count"` // vote amounts are validator counts instead of gwei
+	CurrentEpoch         *EpochPageVoteAmounts `json:"current_epoch"`   // votes included in blocks of the epoch (live only)
+	NextEpoch            *EpochPageVoteAmounts `json:"next_epoch"`      // votes included in blocks of the next epoch (live only)
+	InclusionDistances   []uint64              `json:"inclusion_distances"`
+	AvgInclusionDistance float64               `json:"avg_inclusion_distance"`
+}
+
+type EpochPageVoteAmounts struct {
+	TargetVoted uint64 `json:"target_voted"`
+	HeadVoted   uint64 `json:"head_voted"`
+	TotalVoted  uint64 `json:"total_voted"`
+}
+
+type EpochPageProposerPreview struct {
+	Epoch     uint64                          `json:"epoch"`
+	RandaoMix []byte                          `json:"randao_mix"`
+	Slots     []*EpochPageProposerPreviewSlot `json:"slots"`
+}
+
+type EpochPageProposerPreviewSlot struct {
+	Slot         uint64    `json:"slot"`
+	Ts           time.Time `json:"ts"`
+	Proposer     uint64    `json:"proposer"`
+	ProposerName string    `json:"proposer_name"`
 }
 
 type EpochPageDataSlot struct {
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// EpochRewardsPageData is a struct to hold info for the epoch rewards chart page
+type EpochRewardsPageData struct {
+	Range      string `json:"range"`
+	Group      string `json:"group"`
+	BucketSize uint64 `json:"bucket_size"`
+	FirstEpoch uint64 `json:"first_epoch"`
+	LastEpoch  uint64 `json:"last_epoch"`
+
+	EpochCount           uint64 `json:"epoch_count"`
+	EstimatedCount       uint64 `json:"estimated_count"`
+	AttestationRewards   uint64 `json:"attestation_rewards"`
+	AttestationPenalties uint64 `json:"attestation_penalties"`
+	ProposerRewards      uint64 `json:"proposer_rewards"`
+	SyncRewards          uint64 `json:"sync_rewards"`
+	SyncPenalties        uint64 `json:"sync_penalties"`
+	Issuance             uint64 `json:"issuance"`
+	IssuanceNegative     bool   `json:"issuance_negative"`
+
+	Buckets     []*EpochRewardsPageDataBucket `json:"buckets"`
+	BucketCount uint64                        `json:"bucket_count"`
+	Chart       *ChartData                    `json:"chart"`
+}
+
+type EpochRewardsPageDataBucket struct {
+	FirstEpoch           uint64    `json:"first_epoch"`
+	LastEpoch            uint64    `json:"last_epoch"`
+	Time                 time.Time `json:"time"`
+	EpochCount           uint64    `json:"epoch_count"`
+	EstimatedCount       uint64    `json:"estimated_count"`
+	AttestationRewards   uint64    `json:"attestation_rewards"`
+	AttestationPenalties uint64    `json:"attestation_penalties"`
+	ProposerRewards      uint64    `json:"proposer_rewards"`
+	SyncRewards          uint64    `json:"sync_rewards"`
+	SyncPenalties        uint64    `json:"sync_penalties"`
+	Issuance             uint64    `json:"issuance"`
+	IssuanceNegative     bool      `json:"issuance_negative"`
+}
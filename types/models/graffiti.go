@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// GraffitiPageData is a struct to hold info for the graffiti search & statistics page
+type GraffitiPageData struct {
+	Search   string `json:"search"`
+	IsSearch bool   `json:"is_search"`
+	Range    string `json:"range"`
+
+	Leaderboard      []*GraffitiPageDataStat `json:"leaderboard"`
+	LeaderboardCount uint64                  `json:"leaderboard_count"`
+	TotalGraffitis   uint64                  `json:"total_graffitis"`
+	TotalBlocks      uint64                  `json:"total_blocks"`
+
+	SearchResults     []*GraffitiPageDataEntry `json:"search_results"`
+	SearchResultCount uint64                   `json:"search_result_count"`
+	SearchTooShort    bool                     `json:"search_too_short"`
+
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type GraffitiPageDataStat struct {
+	Rank          uint64    `json:"rank"`
+	Graffiti      string    `json:"graffiti"`
+	BlockCount    uint64    `json:"block_count"`
+	ProposerCount uint64    `json:"proposer_count"`
+	Share         float64   `json:"share"`
+	LastSlot      uint64    `json:"last_slot"`
+	LastTime      time.Time `json:"last_time"`
+}
+
+type GraffitiPageDataEntry struct {
+	Slot         uint64    `json:"slot"`
+	Time         time.Time `json:"time"`
+	Proposer     uint64    `json:"proposer"`
+	ProposerName string    `json:"proposer_name"`
+	Graffiti     string    `json:"graffiti"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// GraffitiWallPageData is a struct to hold info for the graffiti wall page
+type GraffitiWallPageData struct {
+	LastSlot       uint64                       `json:"last_slot"`
+	SecondsPerSlot uint64                       `json:"seconds_per_slot"`
+	Entries        []*GraffitiWallPageDataEntry `json:"entries"`
+	EntryCount     uint64                       `json:"entry_count"`
+}
+
+type GraffitiWallPageDataEntry struct {
+	Slot         uint64    `json:"slot"`
+	Time         time.Time `json:"time"`
+	Proposer     uint64    `json:"proposer"`
+	ProposerName string    `json:"proposer_name"`
+	Graffiti     string    `json:"graffiti"`
+}
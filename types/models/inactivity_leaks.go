@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// InactivityLeaksPageData is a struct to hold info for the penalties & inactivity leaks page
+type InactivityLeaksPageData struct {
+	Range      string `json:"range"`
+	Group      string `json:"group"`
+	BucketSize uint64 `json:"bucket_size"`
+	FirstEpoch uint64 `json:"first_epoch"`
+	LastEpoch  uint64 `json:"last_epoch"`
+
+	CurrentEpoch   uint64 `json:"current_epoch"`
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	FinalityDelay  uint64 `json:"finality_delay"`
+	InactivityLeak bool   `json:"inactivity_leak"`
+	LeakThreshold  uint64 `json:"leak_threshold"`
+
+	EpochCount     uint64 `json:"epoch_count"`
+	LeakEpochCount uint64 `json:"leak_epoch_count"`
+	PenaltyAmount  uint64 `json:"penalty_amount"`
+
+	Buckets     []*InactivityLeaksPageDataBucket `json:"buckets"`
+	BucketCount uint64                           `json:"bucket_count"`
+	Chart       *ChartData                       `json:"chart"`
+
+	Incidents     []*InactivityLeaksPageDataIncident `json:"incidents"`
+	IncidentCount uint64                             `json:"incident_count"`
+}
+
+type InactivityLeaksPageDataBucket struct {
+	FirstEpoch       uint64    `json:"first_epoch"`
+	LastEpoch        uint64    `json:"last_epoch"`
+	Time             time.Time `json:"time"`
+	EpochCount       uint64    `json:"epoch_count"`
+	LeakEpochCount   uint64    `json:"leak_epoch_count"`
+	MaxFinalityDelay uint64    `json:"max_finality_delay"`
+	PenalizedCount   uint64    `json:"penalized_count"`
+	PenaltyAmount    uint64    `json:"penalty_amount"`
+	Unfinalized      bool      `json:"unfinalized"`
+}
+
+type InactivityLeaksPageDataIncident struct {
+	FirstEpoch       uint64    `json:"first_epoch"`
+	LastEpoch        uint64    `json:"last_epoch"`
+	StartTime        time.Time `json:"start_time"`
+	EndTime          time.Time `json:"end_time"`
+	EpochCount       uint64    `json:"epoch_count"`
+	MaxFinalityDelay uint64    `json:"max_finality_delay"`
+	PenalizedCount   uint64    `json:"penalized_count"`
+	PenaltyAmount    uint64    `json:"penalty_amount"`
+	Ongoing          bool      `json:"ongoing"`
+}
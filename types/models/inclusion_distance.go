@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// InclusionDistancePageData is a struct to hold info for the attestation inclusion distance page
+type InclusionDistancePageData struct {
+	Range      string `json:"range"`
+	Group      string `json:"group"`
+	BucketSize uint64 `json:"bucket_size"`
+	FirstEpoch uint64 `json:"first_epoch"`
+	LastEpoch  uint64 `json:"last_epoch"`
+
+	EpochCount      uint64                                   `json:"epoch_count"`
+	VoteCount       uint64                                   `json:"vote_count"`
+	AverageDistance float64                                  `json:"avg_distance"`
+	Distances       []*InclusionDistancePageDataDistribution `json:"distances"`
+
+	Buckets     []*InclusionDistancePageDataBucket `json:"buckets"`
+	BucketCount uint64                             `json:"bucket_count"`
+	Chart       *ChartData                         `json:"chart"`
+
+	CompareEpochs       uint64                              `json:"compare_epochs"`
+	RegressionThreshold float64                             `json:"regression_threshold"`
+	Releases            []*InclusionDistancePageDataRelease `json:"releases"`
+	ReleaseCount        uint64                              `json:"release_count"`
+}
+
+type InclusionDistancePageDataDistribution struct {
+	Label     string  `json:"label"`
+	VoteCount uint64  `json:"vote_count"`
+	Percent   float64 `json:"percent"`
+}
+
+type InclusionDistancePageDataBucket struct {
+	FirstEpoch      uint64    `json:"first_epoch"`
+	LastEpoch       uint64    `json:"last_epoch"`
+	Time            time.Time `json:"time"`
+	EpochCount      uint64    `json:"epoch_count"`
+	Distances       [4]uint64 `json:"distances"`
+	DistanceSum     uint64    `json:"distance_sum"`
+	VoteCount       uint64    `json:"vote_count"`
+	AverageDistance float64   `json:"avg_distance"`
+	Unfinalized     bool      `json:"unfinalized"`
+}
+
+type InclusionDistancePageDataRelease struct {
+	Annotation    *AnnotationPageData `json:"annotation"`
+	Epoch         uint64              `json:"epoch"`
+	Time          time.Time           `json:"time"`
+	BeforeAverage float64             `json:"before_avg"`
+	AfterAverage  float64             `json:"after_avg"`
+	Change        float64             `json:"change"`
+	Pending       bool                `json:"pending"`
+	Regression    bool                `json:"regression"`
+}
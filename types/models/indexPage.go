@@ -36,6 +36,18 @@ type IndexPageData struct {
 	RecentSlots      []*IndexPageDataSlots  `json:"slots"`
 	RecentSlotCount  uint64                 `json:"slot_count"`
 	ForkTreeWidth    int                    `json:"forktree_width"`
+
+	ChainHeads     []*IndexPageDataChainHead `json:"chain_heads"`
+	ChainHeadCount uint64                    `json:"chain_head_count"`
+}
+
+type IndexPageDataChainHead struct {
+	Slot          uint64   `json:"slot"`
+	Root          []byte   `json:"root"`
+	Canonical     bool     `json:"canonical"`
+	Votes         uint64   `json:"votes"`
+	VotingPercent float64  `json:"votep"`
+	Clients       []string `json:"clients"`
 }
 
 type IndexPageDataForks struct {
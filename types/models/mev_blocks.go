@@ -1,6 +1,7 @@
 package models
 
 import (
+	"math/big"
 	"time"
 )
 
@@ -48,7 +49,7 @@ type MevBlocksPageDataBlock struct {
 	FeeRecipient   []byte                    `json:"fee_recipient"`
 	TxCount        uint64                    `json:"tx_count"`
 	GasUsed        uint64                    `json:"gas_used"`
-	BlockValue     uint64                    `json:"block_value"`
+	BlockValue     *big.Int                  `json:"block_value"`
 	BlockValueStr  string                    `json:"block_value_str"`
 }
 
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// NetworkPageData is a struct to hold the network metadata for the network info page
+// hashes, roots & versions are hex encoded to keep the json variant usable for tooling
+type NetworkPageData struct {
+	NetworkName    string `json:"network_name"`
+	ConfigName     string `json:"config_name"`
+	PresetBase     string `json:"preset_base"`
+	SecondsPerSlot uint64 `json:"seconds_per_slot"`
+	SlotsPerEpoch  uint64 `json:"slots_per_epoch"`
+
+	GenesisTime           time.Time `json:"genesis_time"`
+	GenesisTimestamp      uint64    `json:"genesis_timestamp"`
+	GenesisForkVersion    string    `json:"genesis_fork_version"`
+	GenesisValidatorsRoot string    `json:"genesis_validators_root"`
+	ExecutionGenesisHash  string    `json:"execution_genesis_hash,omitempty"`
+	ExecutionChainID      string    `json:"execution_chain_id,omitempty"`
+
+	DepositContract string `json:"deposit_contract"`
+	DepositChainID  uint64 `json:"deposit_chain_id"`
+
+	CurrentEpoch      uint64                 `json:"current_epoch"`
+	CurrentForkDigest string                 `json:"current_fork_digest"`
+	Forks             []*NetworkPageDataFork `json:"forks"`
+
+	ClBootnodes []string               `json:"cl_bootnodes"`
+	ElBootnodes []string               `json:"el_bootnodes"`
+	ClNodes     []*NetworkPageDataNode `json:"cl_nodes"`
+	ElNodes     []*NetworkPageDataNode `json:"el_nodes"`
+	ShowNodes   bool                   `json:"-"`
+}
+
+type NetworkPageDataFork struct {
+	Name    string    `json:"name"`
+	Epoch   uint64    `json:"epoch"`
+	Time    time.Time `json:"time"`
+	Version string    `json:"version"`
+	Digest  string    `json:"digest"`
+	Active  bool      `json:"active"`
+}
+
+type NetworkPageDataNode struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Record  string `json:"record"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// OrphanRatesPageData is a struct to hold info for the orphan rate & reorg depth chart page
+type OrphanRatesPageData struct {
+	Range       string `json:"range"`
+	Grouping    string `json:"grouping"`
+	FirstEpoch  uint64 `json:"first_epoch"`
+	LastEpoch   uint64 `json:"last_epoch"`
+	BucketSize  uint64 `json:"bucket_size"`
+	BucketLabel string `json:"bucket_label"`
+
+	CanonicalCount uint64  `json:"canonical_count"`
+	OrphanedCount  uint64  `json:"orphaned_count"`
+	OrphanRate     float64 `json:"orphan_rate"`
+	MaxReorgDepth  uint64  `json:"max_reorg_depth"`
+
+	Buckets     []*OrphanRatesPageDataBucket `json:"buckets"`
+	BucketCount uint64                       `json:"bucket_count"`
+	Chart       *ChartData                   `json:"chart"`
+}
+
+type OrphanRatesPageDataBucket struct {
+	FirstEpoch     uint64    `json:"first_epoch"`
+	LastEpoch      uint64    `json:"last_epoch"`
+	Time           time.Time `json:"time"`
+	CanonicalCount uint64    `json:"canonical_count"`
+	OrphanedCount  uint64    `json:"orphaned_count"`
+	OrphanRate     float64   `json:"orphan_rate"`
+	MaxReorgDepth  uint64    `json:"max_reorg_depth"`
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+)
+
+// RangePageDataSlotStats is a struct to hold the aggregated slot stats of a slot or epoch range
+type RangePageDataSlotStats struct {
+	SlotCount             uint64  `json:"slot_count"`
+	CanonicalCount        uint64  `json:"canonical_count"`
+	MissedCount           uint64  `json:"missed_count"`
+	OrphanedCount         uint64  `json:"orphaned_count"`
+	MissedPercent         float64 `json:"missed_percent"`
+	OrphanedPercent       float64 `json:"orphaned_percent"`
+	AttestationCount      uint64  `json:"attestation_count"`
+	DepositCount          uint64  `json:"deposit_count"`
+	ExitCount             uint64  `json:"exit_count"`
+	ProposerSlashingCount uint64  `json:"proposer_slashing_count"`
+	AttesterSlashingCount uint64  `json:"attester_slashing_count"`
+	EthTransactionCount   uint64  `json:"eth_transaction_count"`
+	HasSyncParticipation  bool    `json:"has_sync_participation"`
+	SyncParticipation     float64 `json:"sync_participation"`
+}
+
+// SlotsRangePageData is a struct to hold info for the slot range page
+type SlotsRangePageData struct {
+	FirstSlot  uint64                  `json:"first_slot"`
+	LastSlot   uint64                  `json:"last_slot"`
+	FirstEpoch uint64                  `json:"first_epoch"`
+	LastEpoch  uint64                  `json:"last_epoch"`
+	StartTime  time.Time               `json:"start_time"`
+	EndTime    time.Time               `json:"end_time"`
+	Stats      *RangePageDataSlotStats `json:"stats"`
+
+	Annotations []*AnnotationPageData `json:"annotations"`
+	TestRuns    []*TestRunPageData    `json:"test_runs"`
+
+	Slots         []*SlotsPageDataSlot `json:"slots"`
+	ListTruncated bool                 `json:"list_truncated"`
+	ListLimit     uint64               `json:"list_limit"`
+}
+
+// EpochsRangePageData is a struct to hold info for the epoch range page
+type EpochsRangePageData struct {
+	FirstEpoch            uint64                  `json:"first_epoch"`
+	LastEpoch             uint64                  `json:"last_epoch"`
+	FirstSlot             uint64                  `json:"first_slot"`
+	LastSlot              uint64                  `json:"last_slot"`
+	StartTime             time.Time               `json:"start_time"`
+	EndTime               time.Time               `json:"end_time"`
+	EpochCount            uint64                  `json:"epoch_count"`
+	SynchronizedCount     uint64                  `json:"synchronized_count"`
+	VotesUnavailableCount uint64                  `json:"votes_unavailable_count"`
+	EligibleEther         uint64                  `json:"eligible_ether"`
+	TargetVoted           uint64                  `json:"target_voted"`
+	HeadVoted             uint64                  `json:"head_voted"`
+	TotalVoted            uint64                  `json:"total_voted"`
+	TargetParticipation   float64                 `json:"target_participation"`
+	HeadParticipation     float64                 `json:"head_participation"`
+	TotalParticipation    float64                 `json:"total_participation"`
+	Stats                 *RangePageDataSlotStats `json:"stats"`
+
+	Annotations []*AnnotationPageData `json:"annotations"`
+	TestRuns    []*TestRunPageData    `json:"test_runs"`
+
+	Epochs        []*EpochsPageDataEpoch `json:"epochs"`
+	ListTruncated bool                   `json:"list_truncated"`
+	ListLimit     uint64                 `json:"list_limit"`
+}
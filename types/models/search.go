@@ -9,6 +9,20 @@ type SearchBlockResult struct {
 	Orphaned bool        `json:"orphaned,omitempty"`
 }
 
+// SearchValidatorResult is a struct to hold a validator matching the searched pubkey prefix
+type SearchValidatorResult struct {
+	Index  uint64 `json:"index"`
+	Name   string `json:"name,omitempty"`
+	Pubkey []byte `json:"pubkey,omitempty"`
+}
+
+// SearchResultsPageData is a struct to hold the disambiguation page for searches with multiple matches
+type SearchResultsPageData struct {
+	Query      string                   `json:"query"`
+	Blocks     []*SearchBlockResult     `json:"blocks"`
+	Validators []*SearchValidatorResult `json:"validators"`
+}
+
 // SearchGraffitiResult is a struct to hold the search block result with a given graffiti
 type SearchGraffitiResult struct {
 	Graffiti string `json:"graffiti,omitempty"`
@@ -46,3 +60,10 @@ type SearchAheadValidatorNameResult struct {
 	Name  string `json:"name,omitempty"`
 	Count string `json:"count,omitempty"`
 }
+
+// SearchAheadValidatorResult is a struct to hold the search ahead validator results with a given pubkey prefix
+type SearchAheadValidatorResult struct {
+	Index  string `json:"index,omitempty"`
+	Name   string `json:"valname,omitempty"`
+	Pubkey string `json:"pubkey,omitempty"`
+}
@@ -49,4 +49,5 @@ type SlashingsPageDataSlashing struct {
 	Balance         uint64    `json:"balance"`
 	SlasherIndex    uint64    `json:"sindex"`
 	SlasherName     string    `json:"sname"`
+	SlasherReward   uint64    `json:"sreward"`
 }
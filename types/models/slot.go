@@ -1,6 +1,7 @@
 package models
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/ethpandaops/dora/types"
@@ -21,6 +22,32 @@ type SlotPageData struct {
 	ProposerName           string                `json:"proposer_name"`
 	Block                  *SlotPageBlockData    `json:"block"`
 	Badges                 []*SlotPageBlockBadge `json:"badges"`
+	MissedDuties           *SlotPageMissedDuties `json:"missed_duties"`
+	SlotBlocks             []*SlotPageSlotBlock  `json:"slot_blocks"`
+}
+
+// SlotPageSlotBlock represents a known block for the slot (multiple blocks exist on equivocations or forks)
+type SlotPageSlotBlock struct {
+	BlockRoot    []byte `json:"blockroot"`
+	Status       uint16 `json:"status"`
+	Proposer     uint64 `json:"proposer"`
+	ProposerName string `json:"proposer_name"`
+	Selected     bool   `json:"selected"`
+}
+
+type SlotPageMissedDuties struct {
+	CommitteeCount    uint64                 `json:"committee_count"`
+	AttesterCount     uint64                 `json:"attester_count"`
+	SyncCommittee     []types.NamedValidator `json:"sync_committee"`
+	ForkBlocks        []*SlotPageForkBlock   `json:"fork_blocks"`
+	ProposerForkBlock bool                   `json:"proposer_fork_block"`
+}
+
+type SlotPageForkBlock struct {
+	BlockRoot    []byte `json:"blockroot"`
+	Proposer     uint64 `json:"proposer"`
+	ProposerName string `json:"proposer_name"`
+	ByProposer   bool   `json:"by_proposer"`
 }
 
 type SlotPageBlockBadge struct {
@@ -38,6 +65,11 @@ const (
 	SlotStatusOrphaned SlotStatus = 2
 )
 
+type SlotPageSeenBy struct {
+	Name string    `json:"name"`
+	Time time.Time `json:"time"`
+}
+
 type SlotPageBlockData struct {
 	BlockRoot                  []byte                 `json:"blockroot"`
 	ParentRoot                 []byte                 `json:"parentroot"`
@@ -45,6 +77,7 @@ type SlotPageBlockData struct {
 	Signature                  []byte                 `json:"signature"`
 	RandaoReveal               []byte                 `json:"randaoreveal"`
 	Graffiti                   []byte                 `json:"graffiti"`
+	SeenBy                     []*SlotPageSeenBy      `json:"seen_by"`
 	Eth1dataDepositroot        []byte                 `json:"eth1data_depositroot"`
 	Eth1dataDepositcount       uint64                 `json:"eth1data_depositcount"`
 	Eth1dataBlockhash          []byte                 `json:"eth1data_blockhash"`
@@ -55,16 +88,26 @@ type SlotPageBlockData struct {
 	ProposerSlashingsCount     uint64                 `json:"proposer_slashings_count"`
 	AttesterSlashingsCount     uint64                 `json:"attester_slashings_count"`
 	AttestationsCount          uint64                 `json:"attestations_count"`
+	AttestationVotesAvailable  bool                   `json:"attestation_votes_available"`
+	AttestationIncludedBits    uint64                 `json:"attestation_included_bits"`
+	AttestationNewVotes        uint64                 `json:"attestation_new_votes"`
+	AttestationsNextPage       uint64                 `json:"attestations_next_page"`
 	DepositsCount              uint64                 `json:"deposits_count"`
 	WithdrawalsCount           uint64                 `json:"withdrawals_count"`
 	BLSChangesCount            uint64                 `json:"bls_changes_count"`
 	VoluntaryExitsCount        uint64                 `json:"voluntaryexits_count"`
 	SlashingsCount             uint64                 `json:"slashings_count"`
 	BlobsCount                 uint64                 `json:"blobs_count"`
+	BlobsNextPage              uint64                 `json:"blobs_next_page"`
 	TransactionsCount          uint64                 `json:"transactions_count"`
+	TransactionTypes           *SlotPageTxTypeCounts  `json:"transaction_types"`
 	DepositRequestsCount       uint64                 `json:"deposit_receipts_count"`
 	WithdrawalRequestsCount    uint64                 `json:"withdrawal_requests_count"`
 	ConsolidationRequestsCount uint64                 `json:"consolidation_requests_count"`
+	BlockSize                  uint64                 `json:"block_size"`
+	AttestationsSize           uint64                 `json:"attestations_size"`
+	PayloadSize                uint64                 `json:"payload_size"`
+	BlobRefsSize               uint64                 `json:"blob_refs_size"`
 
 	ExecutionData         *SlotPageExecutionData          `json:"execution_data"`
 	Attestations          []*SlotPageAttestation          `json:"attestations"`           // Attestations included in this block
@@ -93,27 +136,49 @@ type SlotPageExecutionData struct {
 	Timestamp     uint64    `json:"timestamp"`
 	Time          time.Time `json:"time"`
 	ExtraData     []byte    `json:"extra_data"`
-	BaseFeePerGas uint64    `json:"base_fee_per_gas"`
+	BaseFeePerGas *big.Int  `json:"base_fee_per_gas"`
 	BlockHash     []byte    `json:"block_hash"`
 	BlockNumber   uint64    `json:"block_number"`
+
+	BurnedFees      *big.Int `json:"burned_fees"` // wei
+	HasPriorityFees bool     `json:"has_priority_fees"`
+	PriorityFees    *big.Int `json:"priority_fees"` // wei
 }
 
 type SlotPageAttestation struct {
+	Index          uint64   `json:"index"`
 	Slot           uint64   `json:"slot"`
 	CommitteeIndex []uint64 `json:"committeeindex"`
 
 	AggregationBits []byte                 `json:"aggregationbits"`
 	Validators      []types.NamedValidator `json:"validators"`
 
-	IncludedValidators []types.NamedValidator `json:"included_validators"`
+	IncludedValidators []types.NamedValidator          `json:"included_validators"`
+	MissingValidators  []types.NamedValidator          `json:"missing_validators"`
+	Committees         []*SlotPageAttestationCommittee `json:"committees"`
+	Participation      float64                         `json:"participation"`
+	VotesAvailable     bool                            `json:"votes_available"`
+	IncludedBits       uint64                          `json:"included_bits"`
+	NewVotes           uint64                          `json:"new_votes"`
 
 	Signature []byte `json:"signature"`
 
-	BeaconBlockRoot []byte `json:"beaconblockroot"`
-	SourceEpoch     uint64 `json:"source_epoch"`
-	SourceRoot      []byte `json:"source_root"`
-	TargetEpoch     uint64 `json:"target_epoch"`
-	TargetRoot      []byte `json:"target_root"`
+	BeaconBlockRoot   []byte `json:"beaconblockroot"`
+	BeaconBlockFound  bool   `json:"beaconblock_found"`
+	BeaconBlockSlot   uint64 `json:"beaconblock_slot"`
+	HeadVoteCanonical bool   `json:"headvote_canonical"`
+	SourceEpoch       uint64 `json:"source_epoch"`
+	SourceRoot        []byte `json:"source_root"`
+	TargetEpoch       uint64 `json:"target_epoch"`
+	TargetRoot        []byte `json:"target_root"`
+}
+
+type SlotPageAttestationCommittee struct {
+	Index             uint64                 `json:"index"`
+	Size              uint64                 `json:"size"`
+	IncludedCount     uint64                 `json:"included_count"`
+	Participation     float64                `json:"participation"`
+	MissingValidators []types.NamedValidator `json:"missing_validators"`
 }
 
 type SlotPageDeposit struct {
@@ -195,6 +260,18 @@ type SlotPageBlob struct {
 	KzgProof      []byte `json:"kzg_proof"`
 }
 
+// SlotPageAttestationsPage is a lazy loaded page of the attestations tab
+type SlotPageAttestationsPage struct {
+	Attestations []*SlotPageAttestation `json:"attestations"`
+	NextPage     uint64                 `json:"next_page"`
+}
+
+// SlotPageBlobsPage is a lazy loaded page of the blob sidecars tab
+type SlotPageBlobsPage struct {
+	Blobs    []*SlotPageBlob `json:"blobs"`
+	NextPage uint64          `json:"next_page"`
+}
+
 type SlotPageBlobDetails struct {
 	Index         uint64 `json:"index"`
 	Blob          string `json:"blob"`
@@ -202,19 +279,55 @@ type SlotPageBlobDetails struct {
 	KzgProof      string `json:"kzg_proof"`
 }
 
+// SlotPageRawBlock holds the lazy loaded raw json tab of the slot page
+type SlotPageRawBlock struct {
+	Slot      uint64           `json:"slot"`
+	BlockRoot []byte           `json:"block_root"`
+	Version   string           `json:"version"`
+	Json      string           `json:"json"`
+	Tree      *SlotPageRawNode `json:"tree"`
+}
+
+// SlotPageRawNode is a json object, array or value in the pretty printed raw block
+type SlotPageRawNode struct {
+	Key        string             `json:"key"`
+	KeyIsIndex bool               `json:"key_is_index"`
+	IsObject   bool               `json:"is_object"`
+	IsArray    bool               `json:"is_array"`
+	Value      string             `json:"value"`
+	ValueType  string             `json:"value_type"`
+	Collapsed  bool               `json:"collapsed"`
+	Children   []*SlotPageRawNode `json:"children"`
+}
+
+type SlotPageTxTypeCounts struct {
+	Legacy     uint64 `json:"legacy"`
+	AccessList uint64 `json:"access_list"`
+	DynamicFee uint64 `json:"dynamic_fee"`
+	Blob       uint64 `json:"blob"`
+	SetCode    uint64 `json:"setcode"`
+	Unknown    uint64 `json:"unknown"`
+}
+
 type SlotPageTransaction struct {
-	Index         uint64  `json:"index"`
-	Hash          []byte  `json:"hash"`
-	From          string  `json:"from"`
-	To            string  `json:"to"`
-	Value         float64 `json:"value"`
-	Data          []byte  `json:"data"`
-	DataLen       uint64  `json:"datalen"`
-	FuncSigStatus uint64  `json:"func_sig_status"`
-	FuncBytes     string  `json:"func_bytes"`
-	FuncName      string  `json:"func_name"`
-	FuncSig       string  `json:"func_sig"`
-	Type          uint64  `json:"type"`
+	Index         uint64   `json:"index"`
+	Hash          []byte   `json:"hash"`
+	From          string   `json:"from"`
+	To            string   `json:"to"`
+	Value         *big.Int `json:"value"` // wei
+	Data          []byte   `json:"data"`
+	DataLen       uint64   `json:"datalen"`
+	DataPrefix    []byte   `json:"data_prefix"`
+	FuncSigStatus uint64   `json:"func_sig_status"`
+	FuncBytes     string   `json:"func_bytes"`
+	FuncName      string   `json:"func_name"`
+	FuncSig       string   `json:"func_sig"`
+	Type          uint64   `json:"type"`
+	Nonce         uint64   `json:"nonce"`
+	GasLimit      uint64   `json:"gas_limit"`
+	GasPrice      float64  `json:"gas_price"`    // legacy & access list transactions (gwei)
+	MaxFee        float64  `json:"max_fee"`      // dynamic fee transactions (gwei)
+	MaxPriority   float64  `json:"max_priority"` // dynamic fee transactions (gwei)
 }
 
 type SlotPageDepositRequest struct {
@@ -47,6 +47,8 @@ type SlotsPageDataSlot struct {
 	BlockRoot             []byte                    `json:"block_root"`
 	ParentRoot            []byte                    `json:"parent_root"`
 	ForkGraph             []*SlotsPageDataForkGraph `json:"fork_graph"`
+	Annotations           []*AnnotationPageData     `json:"annotations"`
+	TestRuns              []*TestRunPageData        `json:"test_runs"`
 }
 
 type SlotsPageDataForkGraph struct {
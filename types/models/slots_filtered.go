@@ -12,6 +12,10 @@ type SlotsFilteredPageData struct {
 	FilterProposerName string `json:"filter_pname"`
 	FilterWithOrphaned uint8  `json:"filter_orphaned"`
 	FilterWithMissing  uint8  `json:"filter_missing"`
+	FilterMinSync      string `json:"filter_minsync"`
+	FilterMaxSync      string `json:"filter_maxsync"`
+	FilterMinAtt       string `json:"filter_minatt"`
+	FilterMaxAtt       string `json:"filter_maxatt"`
 
 	DisplayEpoch        bool   `json:"dp_epoch"`
 	DisplaySlot         bool   `json:"dp_slot"`
@@ -0,0 +1,13 @@
+package models
+
+// TestRunPageData is a struct to hold an externally registered test run
+type TestRunPageData struct {
+	Id        uint64 `json:"id"`
+	Name      string `json:"name"`
+	Source    string `json:"source"`
+	Url       string `json:"url"`
+	Status    string `json:"status"`
+	StartSlot uint64 `json:"start_slot"`
+	EndSlot   uint64 `json:"end_slot"`
+	Running   bool   `json:"running"`
+}
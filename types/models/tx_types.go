@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// TxTypesPageData is a struct to hold info for the transaction types chart page
+type TxTypesPageData struct {
+	Range      string `json:"range"`
+	FirstSlot  uint64 `json:"first_slot"`
+	LastSlot   uint64 `json:"last_slot"`
+	BucketSize uint64 `json:"bucket_size"`
+
+	BlockCount       uint64  `json:"block_count"`
+	TransactionCount uint64  `json:"transaction_count"`
+	LegacyCount      uint64  `json:"legacy_count"`
+	AccessListCount  uint64  `json:"access_list_count"`
+	DynamicFeeCount  uint64  `json:"dynamic_fee_count"`
+	BlobCount        uint64  `json:"blob_count"`
+	SetCodeCount     uint64  `json:"setcode_count"`
+	LegacyShare      float64 `json:"legacy_share"`
+	AccessListShare  float64 `json:"access_list_share"`
+	DynamicFeeShare  float64 `json:"dynamic_fee_share"`
+	BlobShare        float64 `json:"blob_share"`
+	SetCodeShare     float64 `json:"setcode_share"`
+
+	Buckets     []*TxTypesPageDataBucket `json:"buckets"`
+	BucketCount uint64                   `json:"bucket_count"`
+	Chart       *ChartData               `json:"chart"`
+}
+
+type TxTypesPageDataBucket struct {
+	FirstSlot        uint64    `json:"first_slot"`
+	LastSlot         uint64    `json:"last_slot"`
+	Time             time.Time `json:"time"`
+	BlockCount       uint64    `json:"block_count"`
+	TransactionCount uint64    `json:"transaction_count"`
+	LegacyCount      uint64    `json:"legacy_count"`
+	AccessListCount  uint64    `json:"access_list_count"`
+	DynamicFeeCount  uint64    `json:"dynamic_fee_count"`
+	BlobCount        uint64    `json:"blob_count"`
+	SetCodeCount     uint64    `json:"setcode_count"`
+}
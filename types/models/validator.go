@@ -42,6 +42,39 @@ type ValidatorPageData struct {
 	ExitReasonTxHash         []byte                                `json:"exit_reason_tx_hash"`
 	ExitReasonTxDetails      *ValidatorPageDataWithdrawalTxDetails `json:"exit_reason_tx_details"`
 
+	ShowExitEstimation              bool      `json:"show_exit_estimation"`
+	ExitEstimationCanExit           bool      `json:"exit_estimation_can_exit"`
+	ExitEstimationSubmitEpoch       uint64    `json:"exit_estimation_submit_epoch"`
+	ExitEstimationEpoch             uint64    `json:"exit_estimation_epoch"`
+	ExitEstimationTs                time.Time `json:"exit_estimation_ts"`
+	ExitEstimationWithdrawableEpoch uint64    `json:"exit_estimation_withdrawable_epoch"`
+	ExitEstimationWithdrawableTs    time.Time `json:"exit_estimation_withdrawable_ts"`
+	ExitEstimationQueueCount        uint64    `json:"exit_estimation_queue_count"`
+	ExitEstimationQueueBalance      uint64    `json:"exit_estimation_queue_balance"`
+	ExitEstimationBalanceChurn      bool      `json:"exit_estimation_balance_churn"`
+	ExitEstimationChurnLimit        uint64    `json:"exit_estimation_churn_limit"`
+
+	ShowSlashingPenalty               bool      `json:"show_slashing_penalty"`
+	SlashingPenaltyInitial            uint64    `json:"slashing_penalty_initial"`
+	SlashingPenaltyCorrelation        uint64    `json:"slashing_penalty_correlation"`
+	SlashingPenaltyCorrelationEpoch   uint64    `json:"slashing_penalty_correlation_epoch"`
+	SlashingPenaltyCorrelationTs      time.Time `json:"slashing_penalty_correlation_ts"`
+	SlashingPenaltyCorrelationApplied bool      `json:"slashing_penalty_correlation_applied"`
+	SlashingPenaltySlashingsCount     uint64    `json:"slashing_penalty_slashings_count"`
+	SlashingPenaltySlashingsBalance   uint64    `json:"slashing_penalty_slashings_balance"`
+	SlashingPenaltyMultiplier         uint64    `json:"slashing_penalty_multiplier"`
+	SlashingPenaltyWithdrawableEpoch  uint64    `json:"slashing_penalty_withdrawable_epoch"`
+	SlashingPenaltyWithdrawableTs     time.Time `json:"slashing_penalty_withdrawable_ts"`
+
+	ShowDepositSummary   bool   `json:"show_deposit_summary"`
+	DepositCount         uint64 `json:"deposit_count"`
+	DepositTotalAmount   uint64 `json:"deposit_total_amount"`
+	DepositInitialAmount uint64 `json:"deposit_initial_amount"`
+	DepositInitialSlot   uint64 `json:"deposit_initial_slot"`
+	DepositTopUpCount    uint64 `json:"deposit_topup_count"`
+	DepositTopUpAmount   uint64 `json:"deposit_topup_amount"`
+	DepositLastTopUpSlot uint64 `json:"deposit_last_topup_slot"`
+
 	TabView         string `json:"tab_view"`
 	ElectraIsActive bool   `json:"electra_is_active"`
 
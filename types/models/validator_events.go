@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+// ValidatorEventsPageData is a struct to hold info for the validator events page
+type ValidatorEventsPageData struct {
+	FilterMinEpoch      uint64 `json:"filter_mine"`
+	FilterMaxEpoch      uint64 `json:"filter_maxe"`
+	FilterMinIndex      uint64 `json:"filter_mini"`
+	FilterMaxIndex      uint64 `json:"filter_maxi"`
+	FilterValidatorName string `json:"filter_vname"`
+	FilterEventType     uint8  `json:"filter_type"`
+
+	Events     []*ValidatorEventsPageDataEvent `json:"events"`
+	EventCount uint64                          `json:"event_count"`
+	FirstEpoch uint64                          `json:"first_epoch"`
+	LastEpoch  uint64                          `json:"last_epoch"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type ValidatorEventsPageDataEvent struct {
+	Epoch          uint64    `json:"epoch"`
+	Time           time.Time `json:"time"`
+	IsFuture       bool      `json:"is_future"`
+	EventType      uint8     `json:"type"`
+	ValidatorIndex uint64    `json:"vindex"`
+	ValidatorName  string    `json:"vname"`
+}
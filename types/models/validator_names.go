@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// ValidatorNamesPageData is a struct to hold info for the validator names page
+type ValidatorNamesPageData struct {
+	FilterName   string `json:"filter_name"`
+	FilterSource string `json:"filter_source"`
+
+	Names     []*ValidatorNamesPageDataName `json:"names"`
+	NameCount uint64                        `json:"name_count"`
+	FirstName uint64                        `json:"first_name"`
+	LastName  uint64                        `json:"last_name"`
+	TotalRows uint64                        `json:"total_rows"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type ValidatorNamesPageDataName struct {
+	Key            string    `json:"key"`
+	Name           string    `json:"name"`
+	Source         string    `json:"source"`
+	ValidatorCount uint64    `json:"validator_count"`
+	IsIndexKey     bool      `json:"is_index_key"`
+	Overridden     bool      `json:"overridden"`
+	HasUpdatedAt   bool      `json:"has_updated_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
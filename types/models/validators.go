@@ -11,6 +11,8 @@ type ValidatorsPageData struct {
 	FilterName       string                           `json:"filter_name"`
 	FilterStatus     string                           `json:"filter_status"`
 	FilterStatusOpts []ValidatorsPageDataStatusOption `json:"filter_status_opts"`
+	FilterState      string                           `json:"filter_state"`
+	FilterStateOpts  []ValidatorsPageDataStatusOption `json:"filter_state_opts"`
 
 	Validators        []*ValidatorsPageDataValidator `json:"validators"`
 	ValidatorCount    uint64                         `json:"validator_count"`
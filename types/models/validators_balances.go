@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// ValidatorsBalancesPageData is a struct to hold info for the validator balance distribution page
+type ValidatorsBalancesPageData struct {
+	TopCount    uint64    `json:"top_count"`
+	Epoch       uint64    `json:"epoch"`
+	RefreshTime time.Time `json:"refresh_time"`
+
+	ActiveCount           uint64 `json:"active_count"`
+	TotalBalance          uint64 `json:"total_balance"`
+	TotalEffectiveBalance uint64 `json:"total_effective_balance"`
+	AvgBalance            uint64 `json:"avg_balance"`
+	BelowEffectiveCount   uint64 `json:"below_effective_count"`
+
+	Buckets     []*ValidatorsBalancesPageDataBucket `json:"buckets"`
+	BucketCount uint64                              `json:"bucket_count"`
+
+	TopBalance      []*ValidatorsBalancesPageDataValidator `json:"top_balance"`
+	BottomBalance   []*ValidatorsBalancesPageDataValidator `json:"bottom_balance"`
+	TopEffective    []*ValidatorsBalancesPageDataValidator `json:"top_effective"`
+	BottomEffective []*ValidatorsBalancesPageDataValidator `json:"bottom_effective"`
+}
+
+type ValidatorsBalancesPageDataBucket struct {
+	Label           string  `json:"label"`
+	MinBalance      uint64  `json:"min_balance"`
+	MaxBalance      uint64  `json:"max_balance"`
+	BalanceCount    uint64  `json:"balance_count"`
+	BalanceWidth    float64 `json:"balance_width"`
+	EffectiveCount  uint64  `json:"effective_count"`
+	EffectiveWidth  float64 `json:"effective_width"`
+	BelowMaxBalance bool    `json:"below_max_balance"`
+}
+
+type ValidatorsBalancesPageDataValidator struct {
+	Index            uint64 `json:"index"`
+	Name             string `json:"name"`
+	Balance          uint64 `json:"balance"`
+	EffectiveBalance uint64 `json:"effective_balance"`
+	State            string `json:"state"`
+}
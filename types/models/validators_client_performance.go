@@ -0,0 +1,44 @@
+package models
+
+// ValidatorsClientPerformancePageData is a struct to hold info for the client performance report page
+type ValidatorsClientPerformancePageData struct {
+	ViewOptionGroupBy uint64 `json:"vopt_groupby"`
+	ViewOptionEpochs  uint64 `json:"vopt_epochs"`
+
+	FirstEpoch         uint64 `json:"first_epoch"`
+	LastEpoch          uint64 `json:"last_epoch"`
+	FirstActivityEpoch uint64 `json:"first_activity_epoch"`
+	FirstSyncEpoch     uint64 `json:"first_sync_epoch"`
+
+	Groups     []*ValidatorsClientPerformancePageDataGroup `json:"groups"`
+	GroupCount uint64                                      `json:"group_count"`
+	Sorting    string                                      `json:"sorting"`
+	ViewLink   string                                      `json:"view_link"`
+}
+
+type ValidatorsClientPerformancePageDataGroup struct {
+	Group      string `json:"group"`
+	GroupLower string `json:"-"`
+	Validators uint64 `json:"validators"`
+	Activated  uint64 `json:"activated"`
+
+	ProposedBlocks uint64  `json:"proposed_blocks"`
+	MissedBlocks   uint64  `json:"missed_blocks"`
+	OrphanedBlocks uint64  `json:"orphaned_blocks"`
+	ProposalRate   float64 `json:"proposal_rate"`
+
+	AttestationsExpected uint64  `json:"attestations_expected"`
+	AttestationsIncluded uint64  `json:"attestations_included"`
+	AttestationsTimely   uint64  `json:"attestations_timely"`
+	AttestationsTarget   uint64  `json:"attestations_target"`
+	AttestationsHead     uint64  `json:"attestations_head"`
+	AttestationRate      float64 `json:"attestation_rate"`
+	AttestationTimely    float64 `json:"attestation_timely"`
+	AttestationTarget    float64 `json:"attestation_target"`
+	AttestationHead      float64 `json:"attestation_head"`
+	AttestationAvgDelay  float64 `json:"attestation_avg_delay"`
+
+	SyncExpected uint64  `json:"sync_expected"`
+	SyncIncluded uint64  `json:"sync_included"`
+	SyncRate     float64 `json:"sync_rate"`
+}
@@ -0,0 +1,39 @@
+package models
+
+// ValidatorsHeadVotesPageData is a struct to hold info for the head vote accuracy report page
+type ValidatorsHeadVotesPageData struct {
+	ViewOptionGroupBy uint64 `json:"vopt_groupby"`
+	ViewOptionEpochs  uint64 `json:"vopt_epochs"`
+
+	FirstEpoch    uint64   `json:"first_epoch"`
+	LastEpoch     uint64   `json:"last_epoch"`
+	Epochs        []uint64 `json:"epochs"`
+	EpochCount    uint64   `json:"epoch_count"`
+	ReportEnabled bool     `json:"report_enabled"`
+
+	Groups     []*ValidatorsHeadVotesPageDataGroup `json:"groups"`
+	GroupCount uint64                              `json:"group_count"`
+	Sorting    string                              `json:"sorting"`
+	ViewLink   string                              `json:"view_link"`
+}
+
+type ValidatorsHeadVotesPageDataGroup struct {
+	Group      string `json:"group"`
+	GroupLower string `json:"-"`
+
+	Expected      uint64  `json:"expected"`
+	Included      uint64  `json:"included"`
+	Correct       uint64  `json:"correct"`
+	Accuracy      float64 `json:"accuracy"`
+	Participation float64 `json:"participation"`
+
+	Epochs []*ValidatorsHeadVotesPageDataEpoch `json:"epochs"`
+}
+
+type ValidatorsHeadVotesPageDataEpoch struct {
+	Epoch    uint64  `json:"epoch"`
+	Expected uint64  `json:"expected"`
+	Included uint64  `json:"included"`
+	Correct  uint64  `json:"correct"`
+	Accuracy float64 `json:"accuracy"`
+}
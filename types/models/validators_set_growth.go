@@ -0,0 +1,19 @@
+package models
+
+// ValidatorsSetGrowthPageData is a struct to hold info for the validator set growth chart page
+type ValidatorsSetGrowthPageData struct {
+	Range      string `json:"range"`
+	FirstEpoch uint64 `json:"first_epoch"`
+	LastEpoch  uint64 `json:"last_epoch"`
+	EpochStep  uint64 `json:"epoch_step"`
+
+	Epochs     []*ValidatorsSetGrowthPageDataEpoch `json:"epochs"`
+	EpochCount uint64                              `json:"epoch_count"`
+	Chart      *ChartData                          `json:"chart"`
+}
+
+type ValidatorsSetGrowthPageDataEpoch struct {
+	Epoch            uint64 `json:"epoch"`
+	ValidatorCount   uint64 `json:"validator_count"`
+	EffectiveBalance uint64 `json:"effective_balance"`
+}
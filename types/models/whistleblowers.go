@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// WhistleblowersPageData is a struct to hold info for the whistleblower leaderboard page
+type WhistleblowersPageData struct {
+	Range string `json:"range"`
+
+	Whistleblowers      []*WhistleblowersPageDataEntry `json:"whistleblowers"`
+	WhistleblowerCount  uint64                         `json:"whistleblower_count"`
+	TotalWhistleblowers uint64                         `json:"total_whistleblowers"`
+
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type WhistleblowersPageDataEntry struct {
+	Rank                  uint64    `json:"rank"`
+	Index                 uint64    `json:"index"`
+	Name                  string    `json:"name"`
+	SlashingCount         uint64    `json:"slashing_count"`
+	ProposerSlashingCount uint64    `json:"proposer_slashing_count"`
+	AttesterSlashingCount uint64    `json:"attester_slashing_count"`
+	TotalReward           uint64    `json:"total_reward"`
+	LastSlot              uint64    `json:"last_slot"`
+	LastTime              time.Time `json:"last_time"`
+}
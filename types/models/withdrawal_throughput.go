@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// WithdrawalThroughputPageData is a struct to hold info for the withdrawal throughput chart page
+type WithdrawalThroughputPageData struct {
+	Range      string `json:"range"`
+	Group      string `json:"group"`
+	BucketSize uint64 `json:"bucket_size"`
+	FirstEpoch uint64 `json:"first_epoch"`
+	LastEpoch  uint64 `json:"last_epoch"`
+
+	TotalCount    uint64 `json:"total_count"`
+	TotalAmount   uint64 `json:"total_amount"`
+	FullCount     uint64 `json:"full_count"`
+	FullAmount    uint64 `json:"full_amount"`
+	PartialCount  uint64 `json:"partial_count"`
+	PartialAmount uint64 `json:"partial_amount"`
+
+	ShowSweep           bool    `json:"show_sweep"`
+	SweepValidatorIndex uint64  `json:"sweep_validator_index"`
+	SweepValidatorName  string  `json:"sweep_validator_name"`
+	SweepEpoch          uint64  `json:"sweep_epoch"`
+	SweepProgress       float64 `json:"sweep_progress"`
+	ValidatorCount      uint64  `json:"validator_count"`
+
+	Buckets     []*WithdrawalThroughputPageDataBucket `json:"buckets"`
+	BucketCount uint64                                `json:"bucket_count"`
+	Chart       *ChartData                            `json:"chart"`
+}
+
+type WithdrawalThroughputPageDataBucket struct {
+	FirstEpoch     uint64    `json:"first_epoch"`
+	LastEpoch      uint64    `json:"last_epoch"`
+	Time           time.Time `json:"time"`
+	WithdrawCount  uint64    `json:"withdraw_count"`
+	WithdrawAmount uint64    `json:"withdraw_amount"`
+	FullCount      uint64    `json:"full_count"`
+	FullAmount     uint64    `json:"full_amount"`
+	PartialCount   uint64    `json:"partial_count"`
+	PartialAmount  uint64    `json:"partial_amount"`
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+const (
+	badgeHeight      = 20
+	badgeCharWidth   = 6.5 // approx. average glyph width of 11px Verdana
+	badgeTextPadding = 10
+)
+
+// badgeTextWidth estimates the rendered width of the badge text, svg text cannot be measured server side.
+func badgeTextWidth(text string) int {
+	width := 0.0
+	for _, char := range text {
+		switch {
+		case strings.ContainsRune("iljtf.,:;'!| ", char):
+			width += badgeCharWidth * 0.55
+		case strings.ContainsRune("mwMW", char):
+			width += badgeCharWidth * 1.5
+		default:
+			width += badgeCharWidth
+		}
+	}
+	return int(width) + badgeTextPadding
+}
+
+// RenderBadgeSVG renders a flat two-part status badge (label on grey, message on the given color) as svg document.
+func RenderBadgeSVG(label string, message string, color string) []byte {
+	labelWidth := badgeTextWidth(label)
+	messageWidth := badgeTextWidth(message)
+	totalWidth := labelWidth + messageWidth
+	label = html.EscapeString(label)
+	message = html.EscapeString(message)
+	color = html.EscapeString(color)
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" role="img" aria-label="%s: %s">`, totalWidth, badgeHeight, label, message)
+	fmt.Fprintf(&svg, `<title>%s: %s</title>`, label, message)
+	fmt.Fprintf(&svg, `<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	fmt.Fprintf(&svg, `<clipPath id="r"><rect width="%d" height="%d" rx="3" fill="#fff"/></clipPath>`, totalWidth, badgeHeight)
+	fmt.Fprintf(&svg, `<g clip-path="url(#r)"><rect width="%d" height="%d" fill="#555"/><rect x="%d" width="%d" height="%d" fill="%s"/><rect width="%d" height="%d" fill="url(#s)"/></g>`,
+		labelWidth, badgeHeight, labelWidth, messageWidth, badgeHeight, color, totalWidth, badgeHeight)
+	fmt.Fprintf(&svg, `<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`)
+	fmt.Fprintf(&svg, `<text x="%d" y="15" fill="#010101" fill-opacity=".3">%s</text><text x="%d" y="14">%s</text>`, labelWidth/2, label, labelWidth/2, label)
+	fmt.Fprintf(&svg, `<text x="%d" y="15" fill="#010101" fill-opacity=".3">%s</text><text x="%d" y="14">%s</text>`, labelWidth+messageWidth/2, message, labelWidth+messageWidth/2, message)
+	fmt.Fprintf(&svg, `</g></svg>`)
+
+	return []byte(svg.String())
+}
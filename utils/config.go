@@ -2,8 +2,12 @@ package utils
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/kelseyhightower/envconfig"
 	"gopkg.in/yaml.v3"
@@ -24,6 +28,16 @@ func ReadConfig(cfg *types.Config, path string) error {
 
 	readConfigEnv(cfg)
 
+	if cfg.Devnet.Enabled {
+		err = applyDevnetDefaults(cfg, path == "")
+		if err != nil {
+			return err
+		}
+
+		// explicitly set environment variables take precedence over the devnet defaults
+		readConfigEnv(cfg)
+	}
+
 	// endpoints
 	if cfg.BeaconApi.Endpoints == nil && cfg.BeaconApi.Endpoint != "" {
 		cfg.BeaconApi.Endpoints = []types.EndpointConfig{
@@ -43,7 +57,20 @@ func ReadConfig(cfg *types.Config, path string) error {
 			}
 		}
 	}
+	for idx, endpoint := range cfg.BeaconApi.HistoryEndpoints {
+		if endpoint.Name == "" {
+			url, _ := url.Parse(endpoint.Url)
+			if url != nil {
+				cfg.BeaconApi.HistoryEndpoints[idx].Name = url.Hostname()
+			} else {
+				cfg.BeaconApi.HistoryEndpoints[idx].Name = fmt.Sprintf("history-endpoint-%v", idx+1)
+			}
+		}
+	}
 	if len(cfg.BeaconApi.Endpoints) == 0 {
+		if cfg.Devnet.Enabled {
+			return fmt.Errorf("missing beacon node endpoints (set BEACONAPI_ENDPOINTS to a comma-separated list of beacon node urls)")
+		}
 		return fmt.Errorf("missing beacon node endpoints (need at least 1 endpoint to run the explorer)")
 	}
 
@@ -67,28 +94,162 @@ func ReadConfig(cfg *types.Config, path string) error {
 		}
 	}
 
+	// theme
+	err = ValidateThemeConfig(&cfg.Frontend.Theme)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// applyDevnetDefaults fills the config with defaults for a zero-config devnet setup,
+// where only the beacon node urls need to be provided via BEACONAPI_ENDPOINTS.
+// With the built-in default config, the devnet defaults replace the built-in defaults.
+// With a config file, only unset (zero-value) settings are filled, so the file config is kept.
+// The chain spec is loaded from the beacon nodes as usual.
+func applyDevnetDefaults(cfg *types.Config, defaultConfig bool) error {
+	if defaultConfig {
+		// drop the placeholder endpoints from the default config, only use endpoints from env
+		cfg.BeaconApi.Endpoints = nil
+		cfg.ExecutionApi.Endpoints = nil
+
+		cfg.Server.Host = ""
+		cfg.Database.Engine = ""
+		cfg.Database.Sqlite.File = ""
+
+		cfg.Frontend.Enabled = true
+		cfg.Frontend.ShowSensitivePeerInfos = true
+		cfg.Frontend.ShowPeerDASInfos = true
+		cfg.Frontend.ShowSubmitDeposit = true
+		cfg.Frontend.ShowSubmitElRequests = true
+	}
+
+	if cfg.Server.Host == "" {
+		cfg.Server.Host = "0.0.0.0"
+	}
+
+	if cfg.Database.Engine == "" {
+		cfg.Database.Engine = "sqlite"
+	}
+	if cfg.Database.Engine == "sqlite" && cfg.Database.Sqlite.File == "" {
+		dataDir := cfg.Devnet.DataDir
+		if dataDir == "" {
+			dataDir = "data"
+		}
+		err := os.MkdirAll(dataDir, 0755)
+		if err != nil {
+			return fmt.Errorf("error creating devnet data dir %v: %v", dataDir, err)
+		}
+		cfg.Database.Sqlite.File = filepath.Join(dataDir, "dora.sqlite")
+	}
+
 	return nil
 }
 
 func readConfigFile(cfg *types.Config, path string) error {
+	var reader io.Reader
 	if path == "" {
-		return yaml.Unmarshal([]byte(config.DefaultConfigYml), cfg)
+		reader = strings.NewReader(config.DefaultConfigYml)
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("error opening config file %v: %v", path, err)
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	root := &yaml.Node{}
+	decoder := yaml.NewDecoder(reader)
+	err := decoder.Decode(root)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error decoding explorer config: %v", err)
 	}
 
-	f, err := os.Open(path)
+	err = substituteConfigValues(root)
 	if err != nil {
-		return fmt.Errorf("error opening config file %v: %v", path, err)
+		return fmt.Errorf("error substituting explorer config values: %v", err)
 	}
-	defer f.Close()
 
-	decoder := yaml.NewDecoder(f)
-	err = decoder.Decode(cfg)
+	err = root.Decode(cfg)
 	if err != nil {
 		return fmt.Errorf("error decoding explorer config: %v", err)
 	}
 	return nil
 }
 
+var configSubstitutionPattern = regexp.MustCompile(`\$\$|\$\{([^}]*)\}`)
+
+// substituteConfigValues replaces references in all scalar values of the config file:
+//   - ${ENV_VAR} with the value of the environment variable (fails if not set)
+//   - ${ENV_VAR:-default} with the value of the environment variable or the default if not set
+//   - ${file:/path/to/secret} with the content of the file (trailing newlines are trimmed)
+//   - $$ with a literal $
+func substituteConfigValues(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		if !strings.Contains(node.Value, "$") {
+			return nil
+		}
+
+		var substErr error
+		value := configSubstitutionPattern.ReplaceAllStringFunc(node.Value, func(match string) string {
+			if match == "$$" {
+				return "$"
+			}
+
+			reference := match[2 : len(match)-1]
+			replacement, err := resolveConfigReference(reference)
+			if err != nil && substErr == nil {
+				substErr = fmt.Errorf("line %v: %v", node.Line, err)
+			}
+			return replacement
+		})
+		if substErr != nil {
+			return substErr
+		}
+
+		node.Value = value
+		if node.Style == 0 {
+			// re-resolve the type of unquoted values (numbers, bools) from the substituted value
+			node.Tag = ""
+		}
+		return nil
+	}
+
+	for _, child := range node.Content {
+		if err := substituteConfigValues(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resolveConfigReference(reference string) (string, error) {
+	if filePath, isFile := strings.CutPrefix(reference, "file:"); isFile {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return "", fmt.Errorf("error reading secret file: %v", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	envName, defaultValue, hasDefault := strings.Cut(reference, ":-")
+	if envName == "" {
+		return "", fmt.Errorf("empty environment variable reference")
+	}
+	if value, isSet := os.LookupEnv(envName); isSet {
+		return value, nil
+	}
+	if hasDefault {
+		return defaultValue, nil
+	}
+	return "", fmt.Errorf("environment variable %v is not set", envName)
+}
+
 func readConfigEnv(cfg *types.Config) error {
 	return envconfig.Process("", cfg)
 }
@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ethpandaops/dora/types"
+)
+
+// ConfigIssue is a problem found while validating the config.
+// fatal issues prevent the explorer from running, all others are reported as warnings.
+type ConfigIssue struct {
+	Fatal   bool
+	Message string
+}
+
+// ValidateConfig checks a loaded config for problems that would otherwise only surface at runtime.
+// path is the config file the config was loaded from (empty for the embedded default config).
+func ValidateConfig(cfg *types.Config, path string) []ConfigIssue {
+	issues := []ConfigIssue{}
+	addIssue := func(fatal bool, format string, args ...interface{}) {
+		issues = append(issues, ConfigIssue{
+			Fatal:   fatal,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	// unknown keys are silently ignored by the regular config loader
+	if path != "" {
+		unknownKeys, err := findUnknownConfigKeys(path)
+		if err != nil {
+			addIssue(true, "config file: %v", err)
+		}
+		for _, key := range unknownKeys {
+			addIssue(false, "%v: unknown config key", key)
+		}
+	}
+
+	// logging
+	if cfg.Logging.OutputLevel != "" {
+		if _, err := logrus.ParseLevel(cfg.Logging.OutputLevel); err != nil {
+			addIssue(false, "logging.outputLevel: %v", err)
+		}
+	}
+	if cfg.Logging.FilePath != "" && cfg.Logging.FileLevel != "" {
+		if _, err := logrus.ParseLevel(cfg.Logging.FileLevel); err != nil {
+			addIssue(false, "logging.fileLevel: %v", err)
+		}
+	}
+
+	// endpoints
+	validateEndpoints := func(key string, endpoints []types.EndpointConfig) {
+		names := map[string]bool{}
+		for idx, endpoint := range endpoints {
+			endpointUrl, err := url.Parse(endpoint.Url)
+			if err != nil || endpointUrl.Host == "" {
+				addIssue(true, "%v[%v]: invalid url %v", key, idx, endpoint.Url)
+			} else if endpointUrl.Scheme != "http" && endpointUrl.Scheme != "https" {
+				addIssue(true, "%v[%v]: unsupported url scheme %v", key, idx, endpointUrl.Scheme)
+			}
+			if names[endpoint.Name] {
+				addIssue(false, "%v[%v]: duplicate endpoint name %v", key, idx, endpoint.Name)
+			}
+			names[endpoint.Name] = true
+			if endpoint.Ssh != nil && endpoint.Ssh.Keyfile != "" {
+				if _, err := os.Stat(endpoint.Ssh.Keyfile); err != nil {
+					addIssue(true, "%v[%v]: ssh keyfile not accessible: %v", key, idx, err)
+				}
+			}
+		}
+	}
+	validateEndpoints("beaconapi.endpoints", cfg.BeaconApi.Endpoints)
+	validateEndpoints("beaconapi.historyEndpoints", cfg.BeaconApi.HistoryEndpoints)
+	validateEndpoints("executionapi.endpoints", cfg.ExecutionApi.Endpoints)
+	if len(cfg.ExecutionApi.Endpoints) == 0 {
+		addIssue(false, "executionapi: no execution endpoints configured, execution layer data will not be indexed")
+	}
+
+	// database
+	switch cfg.Database.Engine {
+	case "sqlite":
+		if cfg.Database.Sqlite.File == "" {
+			addIssue(true, "database.sqlite.file: missing database file")
+		} else if dir := filepath.Dir(cfg.Database.Sqlite.File); dir != "" {
+			if stat, err := os.Stat(dir); err != nil || !stat.IsDir() {
+				addIssue(true, "database.sqlite.file: directory %v does not exist", dir)
+			}
+		}
+	case "pgsql":
+		if cfg.Database.Pgsql.Host == "" {
+			addIssue(true, "database.pgsql.host: missing database host")
+		}
+		if cfg.Database.Pgsql.Name == "" {
+			addIssue(true, "database.pgsql.name: missing database name")
+		}
+	default:
+		addIssue(true, "database.engine: unknown database engine %v", cfg.Database.Engine)
+	}
+
+	// frontend & api
+	if cfg.Frontend.Enabled && cfg.Server.Port == "" {
+		addIssue(true, "server.port: missing port for the enabled frontend")
+	}
+	if cfg.Metrics.Enabled && cfg.Metrics.Port == "" {
+		addIssue(true, "metrics.port: missing port for the enabled metrics server")
+	}
+	if cfg.Api.CorsEnabled && len(cfg.Api.CorsAllowedOrigins) == 0 {
+		addIssue(false, "api.corsAllowedOrigins: cors is enabled, but no origins are allowed")
+	}
+	if cfg.Api.AdminToken != "" && len(cfg.Api.AdminToken) < 16 {
+		addIssue(false, "api.adminToken: admin token is shorter than 16 characters")
+	}
+	for _, namesFile := range []struct {
+		key  string
+		path string
+	}{
+		{"frontend.validatorNamesYaml", cfg.Frontend.ValidatorNamesYaml},
+		{"frontend.validatorNamesRangesYaml", cfg.Frontend.ValidatorNamesRangesYaml},
+	} {
+		if namesFile.path == "" {
+			continue
+		}
+		if _, err := os.Stat(namesFile.path); err != nil {
+			addIssue(false, "%v: file not accessible: %v", namesFile.key, err)
+		}
+	}
+
+	return issues
+}
+
+// findUnknownConfigKeys returns the keys of the config file that do not map to a config field.
+func findUnknownConfigKeys(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(data, root); err != nil {
+		return nil, err
+	}
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+
+	return collectUnknownConfigKeys(root.Content[0], reflect.TypeOf(types.Config{}), ""), nil
+}
+
+func collectUnknownConfigKeys(node *yaml.Node, fieldType reflect.Type, path string) []string {
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	unknownKeys := []string{}
+	switch {
+	case fieldType.Kind() == reflect.Struct && node.Kind == yaml.MappingNode:
+		fields := map[string]reflect.Type{}
+		for i := 0; i < fieldType.NumField(); i++ {
+			field := fieldType.Field(i)
+			tag := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if tag == "" || tag == "-" {
+				continue
+			}
+			fields[tag] = field.Type
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+
+			keyType, found := fields[key]
+			if !found {
+				unknownKeys = append(unknownKeys, keyPath)
+				continue
+			}
+			unknownKeys = append(unknownKeys, collectUnknownConfigKeys(node.Content[i+1], keyType, keyPath)...)
+		}
+	case fieldType.Kind() == reflect.Slice && node.Kind == yaml.SequenceNode:
+		for idx, item := range node.Content {
+			unknownKeys = append(unknownKeys, collectUnknownConfigKeys(item, fieldType.Elem(), fmt.Sprintf("%v[%v]", path, idx))...)
+		}
+	}
+	return unknownKeys
+}
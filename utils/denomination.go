@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+	"sync/atomic"
+)
+
+// Denomination describes how amounts are converted & labeled in the frontend.
+// consensus layer amounts are gwei based, execution layer amounts are wei based.
+type Denomination struct {
+	ConsensusSymbol      string // label for consensus layer amounts (e.g. "ETH", "GNO")
+	ConsensusGweiPerUnit uint64 // number of gwei per displayed consensus unit (1e9 for ETH, 32e9 for GNO)
+	ExecutionSymbol      string // label for execution layer amounts (e.g. "ETH", "xDAI")
+}
+
+var defaultDenomination = &Denomination{
+	ConsensusSymbol:      "ETH",
+	ConsensusGweiPerUnit: 1000000000,
+	ExecutionSymbol:      "ETH",
+}
+
+// gnosis networks stake GNO on the consensus layer, with 32 ETH-equivalent gwei amounts per GNO
+var gnosisDenomination = &Denomination{
+	ConsensusSymbol:      "GNO",
+	ConsensusGweiPerUnit: 32000000000,
+	ExecutionSymbol:      "xDAI",
+}
+
+var activeDenomination atomic.Pointer[Denomination]
+
+// GetDenomination returns the active currency denomination.
+func GetDenomination() *Denomination {
+	if denomination := activeDenomination.Load(); denomination != nil {
+		return denomination
+	}
+	return defaultDenomination
+}
+
+// ApplyChainDenomination sets the currency denomination for the given network (chain spec config name).
+// explicitly configured symbols & divisors take precedence over the network defaults.
+func ApplyChainDenomination(configName string) {
+	denomination := *defaultDenomination
+	switch strings.ToLower(configName) {
+	case "gnosis", "chiado":
+		denomination = *gnosisDenomination
+	}
+
+	if Config != nil {
+		if Config.Chain.ConsensusCurrency != "" {
+			denomination.ConsensusSymbol = Config.Chain.ConsensusCurrency
+		}
+		if Config.Chain.ConsensusCurrencyGweiPerUnit > 0 {
+			denomination.ConsensusGweiPerUnit = Config.Chain.ConsensusCurrencyGweiPerUnit
+		}
+		if Config.Chain.ExecutionCurrency != "" {
+			denomination.ExecutionSymbol = Config.Chain.ExecutionCurrency
+		}
+	}
+
+	activeDenomination.Store(&denomination)
+}
+
+// ConsensusUnitsFromGwei converts a consensus layer gwei amount to display units (e.g. ETH).
+// only use for charts & aggregations, the formatting functions below avoid float rounding.
+func ConsensusUnitsFromGwei(gwei uint64) float64 {
+	return float64(gwei) / float64(GetDenomination().ConsensusGweiPerUnit)
+}
+
+// GweiFromConsensusUnits converts whole display units (e.g. from amount filters) to a consensus layer gwei amount.
+func GweiFromConsensusUnits(units uint64) uint64 {
+	return units * GetDenomination().ConsensusGweiPerUnit
+}
+
+// FormatConsensusAmount formats a consensus layer gwei amount with the given number of decimals and no unit.
+// amounts are rounded down, so balances are never shown higher than they are (31.99999 ETH is shown as 31.9999, not 32.0000).
+func FormatConsensusAmount(gwei uint64, decimals int) string {
+	return formatTruncatedAmount(new(big.Int).SetUint64(gwei), new(big.Int).SetUint64(GetDenomination().ConsensusGweiPerUnit), decimals)
+}
+
+// FormatExecutionAmount formats an execution layer wei amount with the given number of decimals and no unit.
+// amounts are rounded down, like consensus layer amounts.
+func FormatExecutionAmount(wei *big.Int, decimals int) string {
+	return formatTruncatedAmount(wei, ETH, decimals)
+}
+
+func formatTruncatedAmount(amount *big.Int, unit *big.Int, decimals int) string {
+	if amount == nil {
+		amount = big.NewInt(0)
+	}
+
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+		amount = new(big.Int).Neg(amount)
+	}
+
+	whole, rem := new(big.Int).QuoRem(amount, unit, new(big.Int))
+	if decimals <= 0 {
+		return sign + whole.String()
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	frac := rem.Mul(rem, scale).Quo(rem, unit).String()
+	return sign + whole.String() + "." + strings.Repeat("0", decimals-len(frac)) + frac
+}
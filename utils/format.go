@@ -20,24 +20,24 @@ import (
 )
 
 func FormatETH(num string) string {
-	floatNum, _ := strconv.ParseFloat(num, 64)
-	return fmt.Sprintf("%.4f", floatNum/math.Pow10(18)) + " ETH"
+	wei, _ := new(big.Int).SetString(num, 10)
+	return FormatExecutionAmount(wei, 4) + " " + GetDenomination().ExecutionSymbol
 }
 
 func FormatETHFromGwei(gwei uint64) string {
-	return fmt.Sprintf("%.4f", float64(gwei)/math.Pow10(9)) + " ETH"
+	return FormatConsensusAmount(gwei, 4) + " " + GetDenomination().ConsensusSymbol
 }
 
 func FormatETHFromGweiShort(gwei uint64) string {
-	return fmt.Sprintf("%.4f", float64(gwei)/math.Pow10(9))
+	return FormatConsensusAmount(gwei, 4)
 }
 
 func FormatFullETHFromGwei(gwei uint64) string {
-	return fmt.Sprintf("%v ETH", uint64(float64(gwei)/math.Pow10(9)))
+	return FormatConsensusAmount(gwei, 0) + " " + GetDenomination().ConsensusSymbol
 }
 
 func FormatETHAddCommasFromGwei(gwei uint64) template.HTML {
-	return FormatAddCommas(uint64(float64(gwei) / math.Pow10(9)))
+	return FormatAddCommas(gwei / GetDenomination().ConsensusGweiPerUnit)
 }
 
 func FormatFloat(num float64, precision int) string {
@@ -58,7 +58,9 @@ func FormatAddCommasFormated(num float64, precision uint) template.HTML {
 }
 
 func FormatBigNumberAddCommasFormated(val hexutil.Big, precision uint) template.HTML {
-	return FormatAddCommasFormated(float64(val.ToInt().Int64()), 0)
+	// convert via big.Float, Int64() wraps for values above 2^63
+	floatVal, _ := new(big.Float).SetInt(val.ToInt()).Float64()
+	return FormatAddCommasFormated(floatVal, 0)
 }
 
 func FormatAddCommas(n uint64) template.HTML {
@@ -68,6 +70,17 @@ func FormatAddCommas(n uint64) template.HTML {
 	return template.HTML(number)
 }
 
+func FormatByteAmount(bytes uint64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	amount := float64(bytes)
+	unitIdx := 0
+	for amount >= 1024 && unitIdx < len(units)-1 {
+		amount /= 1024
+		unitIdx++
+	}
+	return fmt.Sprintf("%v %v", FormatFloat(amount, 2), units[unitIdx])
+}
+
 func FormatBitlist(b []byte, v []types.NamedValidator) template.HTML {
 	p := bitfield.Bitlist(b)
 	return formatBits(p.BytesNoTrim(), int(p.Len()), v)
@@ -173,6 +186,7 @@ func formatAmount(amount *big.Int, unit string, digits int, maxPreCommaDigitsBef
 	displayUnit := " " + unit
 	var unitDigits int
 	if unit == "ETH" || unit == "Ether" {
+		displayUnit = " " + GetDenomination().ExecutionSymbol
 		unitDigits = 18
 	} else if unit == "GWei" {
 		unitDigits = 9
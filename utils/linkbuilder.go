@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"html"
+	"html/template"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethpandaops/dora/types"
+)
+
+// ExternalLink is a resolved cross-link to an external explorer.
+type ExternalLink struct {
+	Name string
+	Icon string
+	Url  string
+}
+
+var activeExternalLinks atomic.Pointer[[]*types.ExternalLinkConfig]
+
+var externalLinkPlaceholderRegex = regexp.MustCompile(`\{[a-zA-Z]+\}`)
+
+// InitLinkBuilder selects the configured external links that apply to the given network (chain spec config name).
+func InitLinkBuilder(configName string) {
+	links := []*types.ExternalLinkConfig{}
+	if Config != nil {
+		for i := range Config.Frontend.ExternalLinks {
+			linkConfig := &Config.Frontend.ExternalLinks[i]
+			if len(linkConfig.Networks) > 0 {
+				matched := false
+				for _, network := range linkConfig.Networks {
+					if strings.EqualFold(network, configName) {
+						matched = true
+						break
+					}
+				}
+				if !matched {
+					continue
+				}
+			}
+
+			links = append(links, linkConfig)
+		}
+	}
+
+	activeExternalLinks.Store(&links)
+}
+
+// GetExternalLinks builds the external links for an object of the given type (slot, epoch, validator, block, transaction, address or blob).
+// args are placeholder name / value pairs, byte slices are formatted as 0x prefixed hex.
+// blob links get the {versionedHash} placeholder derived from the {commitment} if not given.
+func GetExternalLinks(linkType string, args ...interface{}) []*ExternalLink {
+	links := activeExternalLinks.Load()
+	if links == nil || len(*links) == 0 {
+		return nil
+	}
+
+	values := map[string]string{}
+	for i := 0; i+1 < len(args); i += 2 {
+		name, ok := args[i].(string)
+		if !ok {
+			continue
+		}
+		values[name] = formatExternalLinkValue(args[i+1])
+	}
+
+	if linkType == "blob" && values["versionedHash"] == "" {
+		if commitment, ok := getExternalLinkBytesArg(args, "commitment"); ok {
+			versionedHash := sha256.Sum256(commitment)
+			versionedHash[0] = 0x01 // kzg versioned hash version
+			values["versionedHash"] = fmt.Sprintf("0x%x", versionedHash[:])
+		}
+	}
+
+	result := []*ExternalLink{}
+	for _, linkConfig := range *links {
+		var urlTemplate string
+		switch linkType {
+		case "slot":
+			urlTemplate = linkConfig.Slot
+		case "epoch":
+			urlTemplate = linkConfig.Epoch
+		case "validator":
+			urlTemplate = linkConfig.Validator
+		case "block":
+			urlTemplate = linkConfig.Block
+		case "transaction":
+			urlTemplate = linkConfig.Transaction
+		case "address":
+			urlTemplate = linkConfig.Address
+		case "blob":
+			urlTemplate = linkConfig.Blob
+		}
+		if urlTemplate == "" {
+			continue
+		}
+
+		unresolved := false
+		link := externalLinkPlaceholderRegex.ReplaceAllStringFunc(urlTemplate, func(placeholder string) string {
+			value := values[placeholder[1:len(placeholder)-1]]
+			if value == "" {
+				unresolved = true
+			}
+			return url.PathEscape(value)
+		})
+		if unresolved {
+			continue
+		}
+
+		icon := linkConfig.Icon
+		if icon == "" {
+			icon = "fa-up-right-from-square"
+		}
+
+		result = append(result, &ExternalLink{
+			Name: linkConfig.Name,
+			Icon: icon,
+			Url:  link,
+		})
+	}
+
+	return result
+}
+
+// FormatExternalLinks renders the external links for an object as small icon links (see GetExternalLinks).
+func FormatExternalLinks(linkType string, args ...interface{}) template.HTML {
+	links := GetExternalLinks(linkType, args...)
+	if len(links) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	for _, link := range links {
+		fmt.Fprintf(&result, `<a href="%v" target="_blank" rel="noopener" class="text-muted p-1" data-bs-toggle="tooltip" title="View on %v"><i class="fa %v"></i></a>`,
+			html.EscapeString(link.Url), html.EscapeString(link.Name), html.EscapeString(link.Icon))
+	}
+	return template.HTML(result.String())
+}
+
+func formatExternalLinkValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		if len(v) == 0 {
+			return ""
+		}
+		return fmt.Sprintf("0x%x", v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func getExternalLinkBytesArg(args []interface{}, name string) ([]byte, bool) {
+	for i := 0; i+1 < len(args); i += 2 {
+		if argName, ok := args[i].(string); ok && argName == name {
+			bytes, ok := args[i+1].([]byte)
+			return bytes, ok && len(bytes) > 0
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// share token layout: version byte, uvarint count, uvarint deltas of the sorted indices, truncated hmac-sha256 signature
+const (
+	shareTokenVersion       = 1
+	shareTokenSignatureSize = 8
+)
+
+var (
+	shareTokenSecret     []byte
+	shareTokenSecretOnce sync.Once
+)
+
+// GenerateShareTokenSecret returns a new random secret to sign share tokens.
+func GenerateShareTokenSecret() ([]byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed generating share token secret: %v", err)
+	}
+	return secret, nil
+}
+
+// SetShareTokenSecret sets the secret used to sign share tokens if no secret is configured (e.g. a generated secret persisted in the db).
+// needs to be called before the first share token is encoded or decoded.
+func SetShareTokenSecret(secret []byte) {
+	shareTokenSecretOnce.Do(func() {
+		shareTokenSecret = secret
+	})
+}
+
+func getShareTokenSecret() []byte {
+	shareTokenSecretOnce.Do(func() {
+		if Config != nil && Config.Frontend.ShareTokenSecret != "" {
+			shareTokenSecret = []byte(Config.Frontend.ShareTokenSecret)
+			return
+		}
+
+		secret, err := GenerateShareTokenSecret()
+		if err != nil {
+			panic(err)
+		}
+		shareTokenSecret = secret
+	})
+	return shareTokenSecret
+}
+
+func getShareTokenSignature(payload []byte) []byte {
+	mac := hmac.New(sha256.New, getShareTokenSecret())
+	mac.Write(payload)
+	return mac.Sum(nil)[:shareTokenSignatureSize]
+}
+
+// EncodeValidatorShareToken encodes a set of validator indices into a compact, signed url token.
+// the indices are sorted and deduplicated, so the same set always results in the same token.
+func EncodeValidatorShareToken(indices []uint64) string {
+	sorted := slices.Clone(indices)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	payload := make([]byte, 0, 1+binary.MaxVarintLen64*(len(sorted)+1))
+	payload = append(payload, shareTokenVersion)
+	payload = binary.AppendUvarint(payload, uint64(len(sorted)))
+
+	lastIndex := uint64(0)
+	for _, index := range sorted {
+		payload = binary.AppendUvarint(payload, index-lastIndex)
+		lastIndex = index
+	}
+
+	payload = append(payload, getShareTokenSignature(payload)...)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// DecodeValidatorShareToken verifies a share token and returns the encoded validator indices (sorted).
+// maxCount limits the number of indices accepted from the token.
+func DecodeValidatorShareToken(token string, maxCount uint64) ([]uint64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid share token encoding")
+	}
+	if len(data) < 2+shareTokenSignatureSize {
+		return nil, errors.New("invalid share token length")
+	}
+
+	payload := data[:len(data)-shareTokenSignatureSize]
+	if !hmac.Equal(data[len(payload):], getShareTokenSignature(payload)) {
+		return nil, errors.New("invalid share token signature")
+	}
+	if payload[0] != shareTokenVersion {
+		return nil, fmt.Errorf("unsupported share token version %v", payload[0])
+	}
+
+	count, offset := binary.Uvarint(payload[1:])
+	if offset <= 0 {
+		return nil, errors.New("invalid share token payload")
+	}
+	if count > maxCount {
+		return nil, fmt.Errorf("too many validators in share token (max %v)", maxCount)
+	}
+	payload = payload[1+offset:]
+
+	indices := make([]uint64, 0, count)
+	lastIndex := uint64(0)
+	for i := uint64(0); i < count; i++ {
+		delta, n := binary.Uvarint(payload)
+		if n <= 0 {
+			return nil, errors.New("invalid share token payload")
+		}
+		payload = payload[n:]
+
+		lastIndex += delta
+		indices = append(indices, lastIndex)
+	}
+	if len(payload) > 0 {
+		return nil, errors.New("invalid share token payload")
+	}
+
+	return indices, nil
+}
@@ -56,10 +56,15 @@ func GetTemplateFuncs() template.FuncMap {
 		"formatEthFromGweiShort":       FormatETHFromGweiShort,
 		"formatFullEthFromGwei":        FormatFullETHFromGwei,
 		"formatEthAddCommasFromGwei":   FormatETHAddCommasFromGwei,
+		"consensusCurrency":            func() string { return GetDenomination().ConsensusSymbol },
+		"consensusGweiPerUnit":         func() uint64 { return GetDenomination().ConsensusGweiPerUnit },
+		"executionCurrency":            func() string { return GetDenomination().ExecutionSymbol },
 		"formatAmount":                 FormatAmount,
+		"formatByteAmount":             FormatByteAmount,
 		"ethBlockLink":                 FormatEthBlockLink,
 		"ethBlockHashLink":             FormatEthBlockHashLink,
 		"ethAddressLink":               FormatEthAddressLink,
+		"externalLinks":                FormatExternalLinks,
 		"ethTransactionLink":           FormatEthTransactionLink,
 		"formatEthAddress":             FormatEthAddress,
 		"formatValidator":              FormatValidator,
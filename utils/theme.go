@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/dora/types"
+)
+
+var themeColorPattern = regexp.MustCompile("^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$")
+
+// maxThemeFooterLength limits the footer text to keep the layout intact
+const maxThemeFooterLength = 500
+
+// ValidateThemeConfig checks the theme settings for values that can safely be injected into the layout.
+func ValidateThemeConfig(theme *types.ThemeConfig) error {
+	if theme.PrimaryColor != "" && !themeColorPattern.MatchString(theme.PrimaryColor) {
+		return fmt.Errorf("invalid theme primaryColor %q (expected hex color like #0d6efd)", theme.PrimaryColor)
+	}
+	if theme.SecondaryColor != "" && !themeColorPattern.MatchString(theme.SecondaryColor) {
+		return fmt.Errorf("invalid theme secondaryColor %q (expected hex color like #6c757d)", theme.SecondaryColor)
+	}
+	if err := validateThemeAssetPath(theme.Logo); err != nil {
+		return fmt.Errorf("invalid theme logo: %v", err)
+	}
+	if err := validateThemeAssetPath(theme.Favicon); err != nil {
+		return fmt.Errorf("invalid theme favicon: %v", err)
+	}
+	if len(theme.FooterText) > maxThemeFooterLength {
+		return fmt.Errorf("theme footerText too long (%v > %v chars)", len(theme.FooterText), maxThemeFooterLength)
+	}
+
+	return nil
+}
+
+func validateThemeAssetPath(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(path, "/") {
+		return nil
+	}
+
+	assetUrl, err := url.Parse(path)
+	if err != nil {
+		return err
+	}
+	if assetUrl.Scheme != "http" && assetUrl.Scheme != "https" {
+		return fmt.Errorf("%q is neither an absolute path nor a http(s) url", path)
+	}
+
+	return nil
+}
+
+// ThemeColorToRgb converts a validated hex color to the "r, g, b" notation used by bootstrap css variables.
+func ThemeColorToRgb(color string) string {
+	hex := strings.TrimPrefix(color, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) != 6 {
+		return ""
+	}
+
+	return fmt.Sprintf("%v, %v, %v", (rgb>>16)&0xff, (rgb>>8)&0xff, rgb&0xff)
+}
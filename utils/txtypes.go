@@ -0,0 +1,63 @@
+package utils
+
+// transaction envelope types (EIP-2718)
+const (
+	TxTypeLegacy     uint8 = 0x00
+	TxTypeAccessList uint8 = 0x01 // EIP-2930
+	TxTypeDynamicFee uint8 = 0x02 // EIP-1559
+	TxTypeBlob       uint8 = 0x03 // EIP-4844
+	TxTypeSetCode    uint8 = 0x04 // EIP-7702
+)
+
+// TransactionTypeCounts holds the number of transactions per envelope type.
+type TransactionTypeCounts struct {
+	Legacy     uint64
+	AccessList uint64
+	DynamicFee uint64
+	Blob       uint64
+	SetCode    uint64
+	Unknown    uint64 // unsupported or malformed envelopes
+}
+
+// GetTransactionType returns the envelope type of a consensus encoded transaction without decoding it.
+// legacy transactions are plain rlp lists (first byte >= 0xc0), typed transactions are prefixed with their type byte (<= 0x7f).
+func GetTransactionType(tx []byte) (uint8, bool) {
+	if len(tx) == 0 {
+		return 0, false
+	}
+	if tx[0] >= 0xc0 {
+		return TxTypeLegacy, true
+	}
+	if tx[0] <= 0x7f {
+		return tx[0], true
+	}
+	return 0, false
+}
+
+// CountTransactionTypes counts the envelope types of a list of consensus encoded transactions.
+func CountTransactionTypes[T ~[]byte](transactions []T) *TransactionTypeCounts {
+	counts := &TransactionTypeCounts{}
+	for _, tx := range transactions {
+		txType, ok := GetTransactionType(tx)
+		if !ok {
+			counts.Unknown++
+			continue
+		}
+
+		switch txType {
+		case TxTypeLegacy:
+			counts.Legacy++
+		case TxTypeAccessList:
+			counts.AccessList++
+		case TxTypeDynamicFee:
+			counts.DynamicFee++
+		case TxTypeBlob:
+			counts.Blob++
+		case TxTypeSetCode:
+			counts.SetCode++
+		default:
+			counts.Unknown++
+		}
+	}
+	return counts
+}